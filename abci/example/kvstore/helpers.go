@@ -3,6 +3,7 @@ package kvstore
 import (
 	"github.com/line/ostracon/abci/types"
 	"github.com/line/ostracon/crypto"
+	"github.com/line/ostracon/crypto/bls"
 	"github.com/line/ostracon/crypto/composite"
 	tmrand "github.com/line/ostracon/libs/rand"
 )
@@ -15,9 +16,10 @@ func GenDefaultPrivKey() crypto.PrivKey {
 // RandVal creates one random validator, with a key derived
 // from the input value
 func RandVal(i int) types.ValidatorUpdate {
-	pk := GenDefaultPrivKey().PubKey()
+	privKey := GenDefaultPrivKey()
 	power := tmrand.Uint16() + 1
-	v := types.NewValidatorUpdate(pk, int64(power))
+	v := types.NewValidatorUpdate(privKey.PubKey(), int64(power))
+	v.ProofOfPossession, _ = privKey.(*composite.PrivKey).SignKey.(bls.PrivKey).ProofOfPossession()
 	return v
 }
 
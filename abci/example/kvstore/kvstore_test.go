@@ -184,6 +184,31 @@ func TestValUpdates(t *testing.T) {
 
 }
 
+func TestPersistentKVStorePrepareProcessProposal(t *testing.T) {
+	dir, err := ioutil.TempDir("/tmp", "abci-kvstore-test") // TODO
+	if err != nil {
+		t.Fatal(err)
+	}
+	kvstore := NewPersistentKVStoreApplication(dir)
+
+	vals := RandVals(1)
+	valTx := MakeValSetChangeTx(vals[0].PubKey, vals[0].Power)
+	malformedValTx := []byte("val:malformed")
+	kvTx1, kvTx2 := []byte("a=1"), []byte("b=2")
+
+	// the validator set change txs are put last, and the malformed ones are dropped
+	resPrepare := kvstore.PrepareProposal(types.RequestPrepareProposal{
+		Txs: [][]byte{valTx, kvTx1, malformedValTx, kvTx2},
+	})
+	require.Equal(t, [][]byte{kvTx1, kvTx2, valTx}, resPrepare.Txs)
+
+	resProcess := kvstore.ProcessProposal(types.RequestProcessProposal{Txs: resPrepare.Txs})
+	require.True(t, resProcess.IsAccepted())
+
+	resProcess = kvstore.ProcessProposal(types.RequestProcessProposal{Txs: [][]byte{kvTx1, malformedValTx}})
+	require.False(t, resProcess.IsAccepted())
+}
+
 func makeApplyBlock(
 	t *testing.T,
 	kvstore types.Application,
@@ -124,17 +124,19 @@ func (app *PersistentKVStoreApplication) BeginBlock(req types.RequestBeginBlock)
 	// reset valset changes
 	app.ValUpdates = make([]types.ValidatorUpdate, 0)
 
-	// Punish validators who committed equivocation.
+	// Punish validators who committed equivocation or proposed with an invalid proof.
 	for _, ev := range req.ByzantineValidators {
-		if ev.Type == types.EvidenceType_DUPLICATE_VOTE {
+		switch ev.Type {
+		case types.EvidenceType_DUPLICATE_VOTE, types.EvidenceType_CONFLICTING_PROPOSAL,
+			types.EvidenceType_INVALID_PROPOSER_PROOF:
 			addr := string(ev.Validator.Address)
 			if pubKey, ok := app.valAddrToPubKeyMap[addr]; ok {
 				app.updateValidator(types.ValidatorUpdate{
 					PubKey: pubKey,
 					Power:  ev.Validator.Power - 1,
 				})
-				app.logger.Info("Decreased val power by 1 because of the equivocation",
-					"val", addr)
+				app.logger.Info("Decreased val power by 1 because of the misbehavior",
+					"val", addr, "type", ev.Type)
 			} else {
 				app.logger.Error("Wanted to punish val, but can't find it",
 					"val", addr)
@@ -170,6 +172,51 @@ func (app *PersistentKVStoreApplication) ApplySnapshotChunk(
 	return types.ResponseApplySnapshotChunk{Result: types.ResponseApplySnapshotChunk_ABORT}
 }
 
+// PrepareProposal drops the malformed validator set change txs, and moves the
+// rest of them after the other txs, so that the validator set changes in a
+// block are applied after its key-value updates.
+func (app *PersistentKVStoreApplication) PrepareProposal(
+	req types.RequestPrepareProposal) types.ResponsePrepareProposal {
+	txs := make([][]byte, 0, len(req.Txs))
+	var valTxs [][]byte
+	for _, tx := range req.Txs {
+		if !isValidatorTx(tx) {
+			txs = append(txs, tx)
+			continue
+		}
+		if _, res := parseValidatorTx(tx); res.IsErr() {
+			app.logger.Info("Dropped the malformed validator set change tx", "log", res.Log)
+			continue
+		}
+		valTxs = append(valTxs, tx)
+	}
+	return types.ResponsePrepareProposal{Txs: append(txs, valTxs...)}
+}
+
+// ProcessProposal rejects the block with a malformed validator set change tx.
+func (app *PersistentKVStoreApplication) ProcessProposal(
+	req types.RequestProcessProposal) types.ResponseProcessProposal {
+	for _, tx := range req.Txs {
+		if !isValidatorTx(tx) {
+			continue
+		}
+		if _, res := parseValidatorTx(tx); res.IsErr() {
+			app.logger.Info("Rejected the proposal with a malformed validator set change tx", "log", res.Log)
+			return types.ResponseProcessProposal{Status: types.ResponseProcessProposal_REJECT}
+		}
+	}
+	return types.ResponseProcessProposal{Status: types.ResponseProcessProposal_ACCEPT}
+}
+
+func (app *PersistentKVStoreApplication) ExtendVote(req types.RequestExtendVote) types.ResponseExtendVote {
+	return app.app.ExtendVote(req)
+}
+
+func (app *PersistentKVStoreApplication) VerifyVoteExtension(
+	req types.RequestVerifyVoteExtension) types.ResponseVerifyVoteExtension {
+	return app.app.VerifyVoteExtension(req)
+}
+
 //---------------------------------------------
 // update validators
 
@@ -203,41 +250,59 @@ func MakeValSetChangeTx(pubkey pc.PublicKey, power int64) []byte {
 	return []byte(fmt.Sprintf("val:%s!%d", pubStr, power))
 }
 
+// MakeValSetChangeTxWithProofOfPossession makes the tx to add the validator with the proof-of-possession, which
+// is required if the public key contains a BLS key.
+func MakeValSetChangeTxWithProofOfPossession(pubkey pc.PublicKey, power int64, pop []byte) []byte {
+	popStr := base64.StdEncoding.EncodeToString(pop)
+	return []byte(fmt.Sprintf("%s!%s", MakeValSetChangeTx(pubkey, power), popStr))
+}
+
 func isValidatorTx(tx []byte) bool {
 	return strings.HasPrefix(string(tx), ValidatorSetChangePrefix)
 }
 
-// format is "val:pubkey!power"
+// format is "val:pubkey!power" or "val:pubkey!power!pop"
 // pubkey is a base64-encoded 32-byte ed25519 key
+// pop is a base64-encoded proof-of-possession of the BLS key
 func (app *PersistentKVStoreApplication) execValidatorTx(tx []byte) types.ResponseDeliverTx {
+	update, res := parseValidatorTx(tx)
+	if res.IsErr() {
+		return res
+	}
+	return app.updateValidator(update)
+}
+
+// parseValidatorTx decodes the validator update of the tx. The response has
+// an error code if the tx is malformed.
+func parseValidatorTx(tx []byte) (types.ValidatorUpdate, types.ResponseDeliverTx) {
 	tx = tx[len(ValidatorSetChangePrefix):]
 
 	// get the pubkey and power
 	pubKeyAndPower := strings.Split(string(tx), "!")
-	if len(pubKeyAndPower) != 2 {
-		return types.ResponseDeliverTx{
+	if len(pubKeyAndPower) != 2 && len(pubKeyAndPower) != 3 {
+		return types.ValidatorUpdate{}, types.ResponseDeliverTx{
 			Code: code.CodeTypeEncodingError,
-			Log:  fmt.Sprintf("Expected 'pubkey!power'. Got %v", pubKeyAndPower)}
+			Log:  fmt.Sprintf("Expected 'pubkey!power' or 'pubkey!power!pop'. Got %v", pubKeyAndPower)}
 	}
 	pubkeyS, powerS := pubKeyAndPower[0], pubKeyAndPower[1]
 
 	// decode the pubkey
 	pkBytes, err := base64.StdEncoding.DecodeString(pubkeyS)
 	if err != nil {
-		return types.ResponseDeliverTx{
+		return types.ValidatorUpdate{}, types.ResponseDeliverTx{
 			Code: code.CodeTypeEncodingError,
 			Log:  fmt.Sprintf("Pubkey (%s) is invalid base64", pubkeyS)}
 	}
 	var pkProto pc.PublicKey
 	err = pkProto.Unmarshal(pkBytes)
 	if err != nil {
-		return types.ResponseDeliverTx{
+		return types.ValidatorUpdate{}, types.ResponseDeliverTx{
 			Code: code.CodeTypeEncodingError,
 			Log:  fmt.Sprintf("Pubkey (%s) is invalid binary", pubkeyS)}
 	}
 	pubkey, err := cryptoenc.PubKeyFromProto(&pkProto)
 	if err != nil {
-		return types.ResponseDeliverTx{
+		return types.ValidatorUpdate{}, types.ResponseDeliverTx{
 			Code: code.CodeTypeEncodingError,
 			Log:  fmt.Sprintf("Pubkey (%s) is invalid binary", pubkeyS)}
 	}
@@ -245,13 +310,25 @@ func (app *PersistentKVStoreApplication) execValidatorTx(tx []byte) types.Respon
 	// decode the power
 	power, err := strconv.ParseInt(powerS, 10, 64)
 	if err != nil {
-		return types.ResponseDeliverTx{
+		return types.ValidatorUpdate{}, types.ResponseDeliverTx{
 			Code: code.CodeTypeEncodingError,
 			Log:  fmt.Sprintf("Power (%s) is not an int", powerS)}
 	}
 
-	// update
-	return app.updateValidator(types.NewValidatorUpdate(pubkey, power))
+	// decode the proof-of-possession
+	var pop []byte
+	if len(pubKeyAndPower) == 3 {
+		pop, err = base64.StdEncoding.DecodeString(pubKeyAndPower[2])
+		if err != nil {
+			return types.ValidatorUpdate{}, types.ResponseDeliverTx{
+				Code: code.CodeTypeEncodingError,
+				Log:  fmt.Sprintf("Proof-of-possession (%s) is invalid base64", pubKeyAndPower[2])}
+		}
+	}
+
+	update := types.NewValidatorUpdate(pubkey, power)
+	update.ProofOfPossession = pop
+	return update, types.ResponseDeliverTx{Code: code.CodeTypeOK}
 }
 
 // add, update, or remove a validator
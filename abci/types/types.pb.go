@@ -64,18 +64,25 @@ const (
 	EvidenceType_UNKNOWN             EvidenceType = 0
 	EvidenceType_DUPLICATE_VOTE      EvidenceType = 1
 	EvidenceType_LIGHT_CLIENT_ATTACK EvidenceType = 2
+	// *** Ostracon Extended Fields ***
+	EvidenceType_CONFLICTING_PROPOSAL   EvidenceType = 1000
+	EvidenceType_INVALID_PROPOSER_PROOF EvidenceType = 1001
 )
 
 var EvidenceType_name = map[int32]string{
-	0: "UNKNOWN",
-	1: "DUPLICATE_VOTE",
-	2: "LIGHT_CLIENT_ATTACK",
+	0:    "UNKNOWN",
+	1:    "DUPLICATE_VOTE",
+	2:    "LIGHT_CLIENT_ATTACK",
+	1000: "CONFLICTING_PROPOSAL",
+	1001: "INVALID_PROPOSER_PROOF",
 }
 
 var EvidenceType_value = map[string]int32{
-	"UNKNOWN":             0,
-	"DUPLICATE_VOTE":      1,
-	"LIGHT_CLIENT_ATTACK": 2,
+	"UNKNOWN":                0,
+	"DUPLICATE_VOTE":         1,
+	"LIGHT_CLIENT_ATTACK":    2,
+	"CONFLICTING_PROPOSAL":   1000,
+	"INVALID_PROPOSER_PROOF": 1001,
 }
 
 func (x EvidenceType) String() string {
@@ -120,7 +127,7 @@ func (x ResponseOfferSnapshot_Result) String() string {
 }
 
 func (ResponseOfferSnapshot_Result) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{30, 0}
+	return fileDescriptor_addf585b2317eb36, []int{34, 0}
 }
 
 type ResponseApplySnapshotChunk_Result int32
@@ -157,7 +164,63 @@ func (x ResponseApplySnapshotChunk_Result) String() string {
 }
 
 func (ResponseApplySnapshotChunk_Result) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{32, 0}
+	return fileDescriptor_addf585b2317eb36, []int{36, 0}
+}
+
+type ResponseVerifyVoteExtension_VerifyStatus int32
+
+const (
+	ResponseVerifyVoteExtension_UNKNOWN ResponseVerifyVoteExtension_VerifyStatus = 0
+	ResponseVerifyVoteExtension_ACCEPT  ResponseVerifyVoteExtension_VerifyStatus = 1
+	ResponseVerifyVoteExtension_REJECT  ResponseVerifyVoteExtension_VerifyStatus = 2
+)
+
+var ResponseVerifyVoteExtension_VerifyStatus_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "ACCEPT",
+	2: "REJECT",
+}
+
+var ResponseVerifyVoteExtension_VerifyStatus_value = map[string]int32{
+	"UNKNOWN": 0,
+	"ACCEPT":  1,
+	"REJECT":  2,
+}
+
+func (x ResponseVerifyVoteExtension_VerifyStatus) String() string {
+	return proto.EnumName(ResponseVerifyVoteExtension_VerifyStatus_name, int32(x))
+}
+
+func (ResponseVerifyVoteExtension_VerifyStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_addf585b2317eb36, []int{39, 0}
+}
+
+type ResponseProcessProposal_ProposalStatus int32
+
+const (
+	ResponseProcessProposal_UNKNOWN ResponseProcessProposal_ProposalStatus = 0
+	ResponseProcessProposal_ACCEPT  ResponseProcessProposal_ProposalStatus = 1
+	ResponseProcessProposal_REJECT  ResponseProcessProposal_ProposalStatus = 2
+)
+
+var ResponseProcessProposal_ProposalStatus_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "ACCEPT",
+	2: "REJECT",
+}
+
+var ResponseProcessProposal_ProposalStatus_value = map[string]int32{
+	"UNKNOWN": 0,
+	"ACCEPT":  1,
+	"REJECT":  2,
+}
+
+func (x ResponseProcessProposal_ProposalStatus) String() string {
+	return proto.EnumName(ResponseProcessProposal_ProposalStatus_name, int32(x))
+}
+
+func (ResponseProcessProposal_ProposalStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_addf585b2317eb36, []int{40, 0}
 }
 
 type Request struct {
@@ -177,6 +240,10 @@ type Request struct {
 	//	*Request_OfferSnapshot
 	//	*Request_LoadSnapshotChunk
 	//	*Request_ApplySnapshotChunk
+	//	*Request_PrepareProposal
+	//	*Request_ExtendVote
+	//	*Request_VerifyVoteExtension
+	//	*Request_ProcessProposal
 	Value isRequest_Value `protobuf_oneof:"value"`
 }
 
@@ -264,22 +331,38 @@ type Request_LoadSnapshotChunk struct {
 type Request_ApplySnapshotChunk struct {
 	ApplySnapshotChunk *RequestApplySnapshotChunk `protobuf:"bytes,15,opt,name=apply_snapshot_chunk,json=applySnapshotChunk,proto3,oneof" json:"apply_snapshot_chunk,omitempty"`
 }
-
-func (*Request_Echo) isRequest_Value()               {}
-func (*Request_Flush) isRequest_Value()              {}
-func (*Request_Info) isRequest_Value()               {}
-func (*Request_SetOption) isRequest_Value()          {}
-func (*Request_InitChain) isRequest_Value()          {}
-func (*Request_Query) isRequest_Value()              {}
-func (*Request_BeginBlock) isRequest_Value()         {}
-func (*Request_CheckTx) isRequest_Value()            {}
-func (*Request_DeliverTx) isRequest_Value()          {}
-func (*Request_EndBlock) isRequest_Value()           {}
-func (*Request_Commit) isRequest_Value()             {}
-func (*Request_ListSnapshots) isRequest_Value()      {}
-func (*Request_OfferSnapshot) isRequest_Value()      {}
-func (*Request_LoadSnapshotChunk) isRequest_Value()  {}
-func (*Request_ApplySnapshotChunk) isRequest_Value() {}
+type Request_PrepareProposal struct {
+	PrepareProposal *RequestPrepareProposal `protobuf:"bytes,1000,opt,name=prepare_proposal,json=prepareProposal,proto3,oneof" json:"prepare_proposal,omitempty"`
+}
+type Request_ExtendVote struct {
+	ExtendVote *RequestExtendVote `protobuf:"bytes,1001,opt,name=extend_vote,json=extendVote,proto3,oneof" json:"extend_vote,omitempty"`
+}
+type Request_VerifyVoteExtension struct {
+	VerifyVoteExtension *RequestVerifyVoteExtension `protobuf:"bytes,1002,opt,name=verify_vote_extension,json=verifyVoteExtension,proto3,oneof" json:"verify_vote_extension,omitempty"`
+}
+type Request_ProcessProposal struct {
+	ProcessProposal *RequestProcessProposal `protobuf:"bytes,1003,opt,name=process_proposal,json=processProposal,proto3,oneof" json:"process_proposal,omitempty"`
+}
+
+func (*Request_Echo) isRequest_Value()                {}
+func (*Request_Flush) isRequest_Value()               {}
+func (*Request_Info) isRequest_Value()                {}
+func (*Request_SetOption) isRequest_Value()           {}
+func (*Request_InitChain) isRequest_Value()           {}
+func (*Request_Query) isRequest_Value()               {}
+func (*Request_BeginBlock) isRequest_Value()          {}
+func (*Request_CheckTx) isRequest_Value()             {}
+func (*Request_DeliverTx) isRequest_Value()           {}
+func (*Request_EndBlock) isRequest_Value()            {}
+func (*Request_Commit) isRequest_Value()              {}
+func (*Request_ListSnapshots) isRequest_Value()       {}
+func (*Request_OfferSnapshot) isRequest_Value()       {}
+func (*Request_LoadSnapshotChunk) isRequest_Value()   {}
+func (*Request_ApplySnapshotChunk) isRequest_Value()  {}
+func (*Request_PrepareProposal) isRequest_Value()     {}
+func (*Request_ExtendVote) isRequest_Value()          {}
+func (*Request_VerifyVoteExtension) isRequest_Value() {}
+func (*Request_ProcessProposal) isRequest_Value()     {}
 
 func (m *Request) GetValue() isRequest_Value {
 	if m != nil {
@@ -393,6 +476,34 @@ func (m *Request) GetApplySnapshotChunk() *RequestApplySnapshotChunk {
 	return nil
 }
 
+func (m *Request) GetPrepareProposal() *RequestPrepareProposal {
+	if x, ok := m.GetValue().(*Request_PrepareProposal); ok {
+		return x.PrepareProposal
+	}
+	return nil
+}
+
+func (m *Request) GetExtendVote() *RequestExtendVote {
+	if x, ok := m.GetValue().(*Request_ExtendVote); ok {
+		return x.ExtendVote
+	}
+	return nil
+}
+
+func (m *Request) GetVerifyVoteExtension() *RequestVerifyVoteExtension {
+	if x, ok := m.GetValue().(*Request_VerifyVoteExtension); ok {
+		return x.VerifyVoteExtension
+	}
+	return nil
+}
+
+func (m *Request) GetProcessProposal() *RequestProcessProposal {
+	if x, ok := m.GetValue().(*Request_ProcessProposal); ok {
+		return x.ProcessProposal
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Request) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -411,6 +522,10 @@ func (*Request) XXX_OneofWrappers() []interface{} {
 		(*Request_OfferSnapshot)(nil),
 		(*Request_LoadSnapshotChunk)(nil),
 		(*Request_ApplySnapshotChunk)(nil),
+		(*Request_PrepareProposal)(nil),
+		(*Request_ExtendVote)(nil),
+		(*Request_VerifyVoteExtension)(nil),
+		(*Request_ProcessProposal)(nil),
 	}
 }
 
@@ -1215,6 +1330,283 @@ func (m *RequestApplySnapshotChunk) GetSender() string {
 	return ""
 }
 
+// nondeterministic
+type RequestPrepareProposal struct {
+	// the modified transactions cannot exceed this size
+	MaxTxBytes int64 `protobuf:"varint,1,opt,name=max_tx_bytes,json=maxTxBytes,proto3" json:"max_tx_bytes,omitempty"`
+	// the transactions reaped from the mempool, which the app may modify
+	Txs [][]byte `protobuf:"bytes,2,rep,name=txs,proto3" json:"txs,omitempty"`
+	// the precommits of the last height, along with their vote extensions, seen by the proposer
+	LocalLastCommit ExtendedCommitInfo `protobuf:"bytes,3,opt,name=local_last_commit,json=localLastCommit,proto3" json:"local_last_commit"`
+	Height          int64              `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	ProposerAddress []byte             `protobuf:"bytes,5,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
+}
+
+func (m *RequestPrepareProposal) Reset()         { *m = RequestPrepareProposal{} }
+func (m *RequestPrepareProposal) String() string { return proto.CompactTextString(m) }
+func (*RequestPrepareProposal) ProtoMessage()    {}
+func (*RequestPrepareProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_addf585b2317eb36, []int{16}
+}
+func (m *RequestPrepareProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestPrepareProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestPrepareProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestPrepareProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestPrepareProposal.Merge(m, src)
+}
+func (m *RequestPrepareProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestPrepareProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestPrepareProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestPrepareProposal proto.InternalMessageInfo
+
+func (m *RequestPrepareProposal) GetMaxTxBytes() int64 {
+	if m != nil {
+		return m.MaxTxBytes
+	}
+	return 0
+}
+
+func (m *RequestPrepareProposal) GetTxs() [][]byte {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *RequestPrepareProposal) GetLocalLastCommit() ExtendedCommitInfo {
+	if m != nil {
+		return m.LocalLastCommit
+	}
+	return ExtendedCommitInfo{}
+}
+
+func (m *RequestPrepareProposal) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RequestPrepareProposal) GetProposerAddress() []byte {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+// nondeterministic
+type RequestExtendVote struct {
+	Hash   []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RequestExtendVote) Reset()         { *m = RequestExtendVote{} }
+func (m *RequestExtendVote) String() string { return proto.CompactTextString(m) }
+func (*RequestExtendVote) ProtoMessage()    {}
+func (*RequestExtendVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_addf585b2317eb36, []int{17}
+}
+func (m *RequestExtendVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestExtendVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestExtendVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestExtendVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestExtendVote.Merge(m, src)
+}
+func (m *RequestExtendVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestExtendVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestExtendVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestExtendVote proto.InternalMessageInfo
+
+func (m *RequestExtendVote) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *RequestExtendVote) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type RequestVerifyVoteExtension struct {
+	Hash             []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ValidatorAddress []byte `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Height           int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	VoteExtension    []byte `protobuf:"bytes,4,opt,name=vote_extension,json=voteExtension,proto3" json:"vote_extension,omitempty"`
+}
+
+func (m *RequestVerifyVoteExtension) Reset()         { *m = RequestVerifyVoteExtension{} }
+func (m *RequestVerifyVoteExtension) String() string { return proto.CompactTextString(m) }
+func (*RequestVerifyVoteExtension) ProtoMessage()    {}
+func (*RequestVerifyVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_addf585b2317eb36, []int{18}
+}
+func (m *RequestVerifyVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestVerifyVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestVerifyVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestVerifyVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestVerifyVoteExtension.Merge(m, src)
+}
+func (m *RequestVerifyVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestVerifyVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestVerifyVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestVerifyVoteExtension proto.InternalMessageInfo
+
+func (m *RequestVerifyVoteExtension) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *RequestVerifyVoteExtension) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *RequestVerifyVoteExtension) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RequestVerifyVoteExtension) GetVoteExtension() []byte {
+	if m != nil {
+		return m.VoteExtension
+	}
+	return nil
+}
+
+type RequestProcessProposal struct {
+	Hash                []byte         `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Header              types1.Header  `protobuf:"bytes,2,opt,name=header,proto3" json:"header"`
+	Txs                 [][]byte       `protobuf:"bytes,3,rep,name=txs,proto3" json:"txs,omitempty"`
+	ProposedLastCommit  LastCommitInfo `protobuf:"bytes,4,opt,name=proposed_last_commit,json=proposedLastCommit,proto3" json:"proposed_last_commit"`
+	ByzantineValidators []Evidence     `protobuf:"bytes,5,rep,name=byzantine_validators,json=byzantineValidators,proto3" json:"byzantine_validators"`
+}
+
+func (m *RequestProcessProposal) Reset()         { *m = RequestProcessProposal{} }
+func (m *RequestProcessProposal) String() string { return proto.CompactTextString(m) }
+func (*RequestProcessProposal) ProtoMessage()    {}
+func (*RequestProcessProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_addf585b2317eb36, []int{19}
+}
+func (m *RequestProcessProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestProcessProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestProcessProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestProcessProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestProcessProposal.Merge(m, src)
+}
+func (m *RequestProcessProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestProcessProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestProcessProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestProcessProposal proto.InternalMessageInfo
+
+func (m *RequestProcessProposal) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *RequestProcessProposal) GetHeader() types1.Header {
+	if m != nil {
+		return m.Header
+	}
+	return types1.Header{}
+}
+
+func (m *RequestProcessProposal) GetTxs() [][]byte {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *RequestProcessProposal) GetProposedLastCommit() LastCommitInfo {
+	if m != nil {
+		return m.ProposedLastCommit
+	}
+	return LastCommitInfo{}
+}
+
+func (m *RequestProcessProposal) GetByzantineValidators() []Evidence {
+	if m != nil {
+		return m.ByzantineValidators
+	}
+	return nil
+}
+
 type Response struct {
 	// Types that are valid to be assigned to Value:
 	//	*Response_Exception
@@ -1233,6 +1625,10 @@ type Response struct {
 	//	*Response_OfferSnapshot
 	//	*Response_LoadSnapshotChunk
 	//	*Response_ApplySnapshotChunk
+	//	*Response_PrepareProposal
+	//	*Response_ExtendVote
+	//	*Response_VerifyVoteExtension
+	//	*Response_ProcessProposal
 	Value isResponse_Value `protobuf_oneof:"value"`
 }
 
@@ -1240,7 +1636,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{16}
+	return fileDescriptor_addf585b2317eb36, []int{20}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1323,23 +1719,39 @@ type Response_LoadSnapshotChunk struct {
 type Response_ApplySnapshotChunk struct {
 	ApplySnapshotChunk *ResponseApplySnapshotChunk `protobuf:"bytes,16,opt,name=apply_snapshot_chunk,json=applySnapshotChunk,proto3,oneof" json:"apply_snapshot_chunk,omitempty"`
 }
-
-func (*Response_Exception) isResponse_Value()          {}
-func (*Response_Echo) isResponse_Value()               {}
-func (*Response_Flush) isResponse_Value()              {}
-func (*Response_Info) isResponse_Value()               {}
-func (*Response_SetOption) isResponse_Value()          {}
-func (*Response_InitChain) isResponse_Value()          {}
-func (*Response_Query) isResponse_Value()              {}
-func (*Response_BeginBlock) isResponse_Value()         {}
-func (*Response_CheckTx) isResponse_Value()            {}
-func (*Response_DeliverTx) isResponse_Value()          {}
-func (*Response_EndBlock) isResponse_Value()           {}
-func (*Response_Commit) isResponse_Value()             {}
-func (*Response_ListSnapshots) isResponse_Value()      {}
-func (*Response_OfferSnapshot) isResponse_Value()      {}
-func (*Response_LoadSnapshotChunk) isResponse_Value()  {}
-func (*Response_ApplySnapshotChunk) isResponse_Value() {}
+type Response_PrepareProposal struct {
+	PrepareProposal *ResponsePrepareProposal `protobuf:"bytes,1000,opt,name=prepare_proposal,json=prepareProposal,proto3,oneof" json:"prepare_proposal,omitempty"`
+}
+type Response_ExtendVote struct {
+	ExtendVote *ResponseExtendVote `protobuf:"bytes,1001,opt,name=extend_vote,json=extendVote,proto3,oneof" json:"extend_vote,omitempty"`
+}
+type Response_VerifyVoteExtension struct {
+	VerifyVoteExtension *ResponseVerifyVoteExtension `protobuf:"bytes,1002,opt,name=verify_vote_extension,json=verifyVoteExtension,proto3,oneof" json:"verify_vote_extension,omitempty"`
+}
+type Response_ProcessProposal struct {
+	ProcessProposal *ResponseProcessProposal `protobuf:"bytes,1003,opt,name=process_proposal,json=processProposal,proto3,oneof" json:"process_proposal,omitempty"`
+}
+
+func (*Response_Exception) isResponse_Value()           {}
+func (*Response_Echo) isResponse_Value()                {}
+func (*Response_Flush) isResponse_Value()               {}
+func (*Response_Info) isResponse_Value()                {}
+func (*Response_SetOption) isResponse_Value()           {}
+func (*Response_InitChain) isResponse_Value()           {}
+func (*Response_Query) isResponse_Value()               {}
+func (*Response_BeginBlock) isResponse_Value()          {}
+func (*Response_CheckTx) isResponse_Value()             {}
+func (*Response_DeliverTx) isResponse_Value()           {}
+func (*Response_EndBlock) isResponse_Value()            {}
+func (*Response_Commit) isResponse_Value()              {}
+func (*Response_ListSnapshots) isResponse_Value()       {}
+func (*Response_OfferSnapshot) isResponse_Value()       {}
+func (*Response_LoadSnapshotChunk) isResponse_Value()   {}
+func (*Response_ApplySnapshotChunk) isResponse_Value()  {}
+func (*Response_PrepareProposal) isResponse_Value()     {}
+func (*Response_ExtendVote) isResponse_Value()          {}
+func (*Response_VerifyVoteExtension) isResponse_Value() {}
+func (*Response_ProcessProposal) isResponse_Value()     {}
 
 func (m *Response) GetValue() isResponse_Value {
 	if m != nil {
@@ -1460,6 +1872,34 @@ func (m *Response) GetApplySnapshotChunk() *ResponseApplySnapshotChunk {
 	return nil
 }
 
+func (m *Response) GetPrepareProposal() *ResponsePrepareProposal {
+	if x, ok := m.GetValue().(*Response_PrepareProposal); ok {
+		return x.PrepareProposal
+	}
+	return nil
+}
+
+func (m *Response) GetExtendVote() *ResponseExtendVote {
+	if x, ok := m.GetValue().(*Response_ExtendVote); ok {
+		return x.ExtendVote
+	}
+	return nil
+}
+
+func (m *Response) GetVerifyVoteExtension() *ResponseVerifyVoteExtension {
+	if x, ok := m.GetValue().(*Response_VerifyVoteExtension); ok {
+		return x.VerifyVoteExtension
+	}
+	return nil
+}
+
+func (m *Response) GetProcessProposal() *ResponseProcessProposal {
+	if x, ok := m.GetValue().(*Response_ProcessProposal); ok {
+		return x.ProcessProposal
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Response) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1479,6 +1919,10 @@ func (*Response) XXX_OneofWrappers() []interface{} {
 		(*Response_OfferSnapshot)(nil),
 		(*Response_LoadSnapshotChunk)(nil),
 		(*Response_ApplySnapshotChunk)(nil),
+		(*Response_PrepareProposal)(nil),
+		(*Response_ExtendVote)(nil),
+		(*Response_VerifyVoteExtension)(nil),
+		(*Response_ProcessProposal)(nil),
 	}
 }
 
@@ -1491,7 +1935,7 @@ func (m *ResponseException) Reset()         { *m = ResponseException{} }
 func (m *ResponseException) String() string { return proto.CompactTextString(m) }
 func (*ResponseException) ProtoMessage()    {}
 func (*ResponseException) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{17}
+	return fileDescriptor_addf585b2317eb36, []int{21}
 }
 func (m *ResponseException) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1535,7 +1979,7 @@ func (m *ResponseEcho) Reset()         { *m = ResponseEcho{} }
 func (m *ResponseEcho) String() string { return proto.CompactTextString(m) }
 func (*ResponseEcho) ProtoMessage()    {}
 func (*ResponseEcho) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{18}
+	return fileDescriptor_addf585b2317eb36, []int{22}
 }
 func (m *ResponseEcho) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1578,7 +2022,7 @@ func (m *ResponseFlush) Reset()         { *m = ResponseFlush{} }
 func (m *ResponseFlush) String() string { return proto.CompactTextString(m) }
 func (*ResponseFlush) ProtoMessage()    {}
 func (*ResponseFlush) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{19}
+	return fileDescriptor_addf585b2317eb36, []int{23}
 }
 func (m *ResponseFlush) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1619,7 +2063,7 @@ func (m *ResponseInfo) Reset()         { *m = ResponseInfo{} }
 func (m *ResponseInfo) String() string { return proto.CompactTextString(m) }
 func (*ResponseInfo) ProtoMessage()    {}
 func (*ResponseInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{20}
+	return fileDescriptor_addf585b2317eb36, []int{24}
 }
 func (m *ResponseInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1695,7 +2139,7 @@ func (m *ResponseSetOption) Reset()         { *m = ResponseSetOption{} }
 func (m *ResponseSetOption) String() string { return proto.CompactTextString(m) }
 func (*ResponseSetOption) ProtoMessage()    {}
 func (*ResponseSetOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{21}
+	return fileDescriptor_addf585b2317eb36, []int{25}
 }
 func (m *ResponseSetOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1755,7 +2199,7 @@ func (m *ResponseInitChain) Reset()         { *m = ResponseInitChain{} }
 func (m *ResponseInitChain) String() string { return proto.CompactTextString(m) }
 func (*ResponseInitChain) ProtoMessage()    {}
 func (*ResponseInitChain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{22}
+	return fileDescriptor_addf585b2317eb36, []int{26}
 }
 func (m *ResponseInitChain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1822,7 +2266,7 @@ func (m *ResponseQuery) Reset()         { *m = ResponseQuery{} }
 func (m *ResponseQuery) String() string { return proto.CompactTextString(m) }
 func (*ResponseQuery) ProtoMessage()    {}
 func (*ResponseQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{23}
+	return fileDescriptor_addf585b2317eb36, []int{27}
 }
 func (m *ResponseQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1922,7 +2366,7 @@ func (m *ResponseBeginBlock) Reset()         { *m = ResponseBeginBlock{} }
 func (m *ResponseBeginBlock) String() string { return proto.CompactTextString(m) }
 func (*ResponseBeginBlock) ProtoMessage()    {}
 func (*ResponseBeginBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{24}
+	return fileDescriptor_addf585b2317eb36, []int{28}
 }
 func (m *ResponseBeginBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1967,13 +2411,17 @@ type ResponseCheckTx struct {
 	GasUsed   int64   `protobuf:"varint,6,opt,name=gas_used,proto3" json:"gas_used,omitempty"`
 	Events    []Event `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
 	Codespace string  `protobuf:"bytes,8,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// the priority of the tx in a priority mempool, the higher the sooner it's proposed
+	Priority int64 `protobuf:"varint,1000,opt,name=priority,proto3" json:"priority,omitempty"`
+	// the sender of the tx, whose txs are proposed in their order in a priority mempool
+	Sender string `protobuf:"bytes,1001,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
 func (m *ResponseCheckTx) Reset()         { *m = ResponseCheckTx{} }
 func (m *ResponseCheckTx) String() string { return proto.CompactTextString(m) }
 func (*ResponseCheckTx) ProtoMessage()    {}
 func (*ResponseCheckTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{25}
+	return fileDescriptor_addf585b2317eb36, []int{29}
 }
 func (m *ResponseCheckTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2058,6 +2506,20 @@ func (m *ResponseCheckTx) GetCodespace() string {
 	return ""
 }
 
+func (m *ResponseCheckTx) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *ResponseCheckTx) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
 type ResponseDeliverTx struct {
 	Code      uint32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Data      []byte  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -2073,7 +2535,7 @@ func (m *ResponseDeliverTx) Reset()         { *m = ResponseDeliverTx{} }
 func (m *ResponseDeliverTx) String() string { return proto.CompactTextString(m) }
 func (*ResponseDeliverTx) ProtoMessage()    {}
 func (*ResponseDeliverTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{26}
+	return fileDescriptor_addf585b2317eb36, []int{30}
 }
 func (m *ResponseDeliverTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2168,7 +2630,7 @@ func (m *ResponseEndBlock) Reset()         { *m = ResponseEndBlock{} }
 func (m *ResponseEndBlock) String() string { return proto.CompactTextString(m) }
 func (*ResponseEndBlock) ProtoMessage()    {}
 func (*ResponseEndBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{27}
+	return fileDescriptor_addf585b2317eb36, []int{31}
 }
 func (m *ResponseEndBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2228,7 +2690,7 @@ func (m *ResponseCommit) Reset()         { *m = ResponseCommit{} }
 func (m *ResponseCommit) String() string { return proto.CompactTextString(m) }
 func (*ResponseCommit) ProtoMessage()    {}
 func (*ResponseCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{28}
+	return fileDescriptor_addf585b2317eb36, []int{32}
 }
 func (m *ResponseCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2279,7 +2741,7 @@ func (m *ResponseListSnapshots) Reset()         { *m = ResponseListSnapshots{} }
 func (m *ResponseListSnapshots) String() string { return proto.CompactTextString(m) }
 func (*ResponseListSnapshots) ProtoMessage()    {}
 func (*ResponseListSnapshots) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{29}
+	return fileDescriptor_addf585b2317eb36, []int{33}
 }
 func (m *ResponseListSnapshots) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2323,7 +2785,7 @@ func (m *ResponseOfferSnapshot) Reset()         { *m = ResponseOfferSnapshot{} }
 func (m *ResponseOfferSnapshot) String() string { return proto.CompactTextString(m) }
 func (*ResponseOfferSnapshot) ProtoMessage()    {}
 func (*ResponseOfferSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{30}
+	return fileDescriptor_addf585b2317eb36, []int{34}
 }
 func (m *ResponseOfferSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2367,7 +2829,7 @@ func (m *ResponseLoadSnapshotChunk) Reset()         { *m = ResponseLoadSnapshotC
 func (m *ResponseLoadSnapshotChunk) String() string { return proto.CompactTextString(m) }
 func (*ResponseLoadSnapshotChunk) ProtoMessage()    {}
 func (*ResponseLoadSnapshotChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{31}
+	return fileDescriptor_addf585b2317eb36, []int{35}
 }
 func (m *ResponseLoadSnapshotChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2413,7 +2875,7 @@ func (m *ResponseApplySnapshotChunk) Reset()         { *m = ResponseApplySnapsho
 func (m *ResponseApplySnapshotChunk) String() string { return proto.CompactTextString(m) }
 func (*ResponseApplySnapshotChunk) ProtoMessage()    {}
 func (*ResponseApplySnapshotChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{32}
+	return fileDescriptor_addf585b2317eb36, []int{36}
 }
 func (m *ResponseApplySnapshotChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2463,6 +2925,182 @@ func (m *ResponseApplySnapshotChunk) GetRejectSenders() []string {
 	return nil
 }
 
+type ResponsePrepareProposal struct {
+	Txs [][]byte `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (m *ResponsePrepareProposal) Reset()         { *m = ResponsePrepareProposal{} }
+func (m *ResponsePrepareProposal) String() string { return proto.CompactTextString(m) }
+func (*ResponsePrepareProposal) ProtoMessage()    {}
+func (*ResponsePrepareProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_addf585b2317eb36, []int{37}
+}
+func (m *ResponsePrepareProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponsePrepareProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponsePrepareProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponsePrepareProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponsePrepareProposal.Merge(m, src)
+}
+func (m *ResponsePrepareProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponsePrepareProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponsePrepareProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponsePrepareProposal proto.InternalMessageInfo
+
+func (m *ResponsePrepareProposal) GetTxs() [][]byte {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+type ResponseExtendVote struct {
+	VoteExtension []byte `protobuf:"bytes,1,opt,name=vote_extension,json=voteExtension,proto3" json:"vote_extension,omitempty"`
+}
+
+func (m *ResponseExtendVote) Reset()         { *m = ResponseExtendVote{} }
+func (m *ResponseExtendVote) String() string { return proto.CompactTextString(m) }
+func (*ResponseExtendVote) ProtoMessage()    {}
+func (*ResponseExtendVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_addf585b2317eb36, []int{38}
+}
+func (m *ResponseExtendVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseExtendVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseExtendVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseExtendVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseExtendVote.Merge(m, src)
+}
+func (m *ResponseExtendVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseExtendVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseExtendVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseExtendVote proto.InternalMessageInfo
+
+func (m *ResponseExtendVote) GetVoteExtension() []byte {
+	if m != nil {
+		return m.VoteExtension
+	}
+	return nil
+}
+
+type ResponseVerifyVoteExtension struct {
+	Status ResponseVerifyVoteExtension_VerifyStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ostracon.abci.ResponseVerifyVoteExtension_VerifyStatus" json:"status,omitempty"`
+}
+
+func (m *ResponseVerifyVoteExtension) Reset()         { *m = ResponseVerifyVoteExtension{} }
+func (m *ResponseVerifyVoteExtension) String() string { return proto.CompactTextString(m) }
+func (*ResponseVerifyVoteExtension) ProtoMessage()    {}
+func (*ResponseVerifyVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_addf585b2317eb36, []int{39}
+}
+func (m *ResponseVerifyVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseVerifyVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseVerifyVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseVerifyVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseVerifyVoteExtension.Merge(m, src)
+}
+func (m *ResponseVerifyVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseVerifyVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseVerifyVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseVerifyVoteExtension proto.InternalMessageInfo
+
+func (m *ResponseVerifyVoteExtension) GetStatus() ResponseVerifyVoteExtension_VerifyStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseVerifyVoteExtension_UNKNOWN
+}
+
+type ResponseProcessProposal struct {
+	Status ResponseProcessProposal_ProposalStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ostracon.abci.ResponseProcessProposal_ProposalStatus" json:"status,omitempty"`
+}
+
+func (m *ResponseProcessProposal) Reset()         { *m = ResponseProcessProposal{} }
+func (m *ResponseProcessProposal) String() string { return proto.CompactTextString(m) }
+func (*ResponseProcessProposal) ProtoMessage()    {}
+func (*ResponseProcessProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_addf585b2317eb36, []int{40}
+}
+func (m *ResponseProcessProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseProcessProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseProcessProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseProcessProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseProcessProposal.Merge(m, src)
+}
+func (m *ResponseProcessProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseProcessProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseProcessProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseProcessProposal proto.InternalMessageInfo
+
+func (m *ResponseProcessProposal) GetStatus() ResponseProcessProposal_ProposalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseProcessProposal_UNKNOWN
+}
+
 // ConsensusParams contains all consensus-relevant parameters
 // that can be adjusted by the abci app
 type ConsensusParams struct {
@@ -2470,13 +3108,18 @@ type ConsensusParams struct {
 	Evidence  *types1.EvidenceParams  `protobuf:"bytes,2,opt,name=evidence,proto3" json:"evidence,omitempty"`
 	Validator *types1.ValidatorParams `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
 	Version   *types1.VersionParams   `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// *** Ostracon Extended Fields ***
+	Voter     *types1.VoterParams     `protobuf:"bytes,1000,opt,name=voter,proto3" json:"voter,omitempty"`
+	Timestamp *types1.TimestampParams `protobuf:"bytes,1001,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	PartSet   *types1.PartSetParams   `protobuf:"bytes,1002,opt,name=part_set,json=partSet,proto3" json:"part_set,omitempty"`
+	Abci      *types1.ABCIParams      `protobuf:"bytes,1003,opt,name=abci,proto3" json:"abci,omitempty"`
 }
 
 func (m *ConsensusParams) Reset()         { *m = ConsensusParams{} }
 func (m *ConsensusParams) String() string { return proto.CompactTextString(m) }
 func (*ConsensusParams) ProtoMessage()    {}
 func (*ConsensusParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{33}
+	return fileDescriptor_addf585b2317eb36, []int{41}
 }
 func (m *ConsensusParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2533,6 +3176,34 @@ func (m *ConsensusParams) GetVersion() *types1.VersionParams {
 	return nil
 }
 
+func (m *ConsensusParams) GetVoter() *types1.VoterParams {
+	if m != nil {
+		return m.Voter
+	}
+	return nil
+}
+
+func (m *ConsensusParams) GetTimestamp() *types1.TimestampParams {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *ConsensusParams) GetPartSet() *types1.PartSetParams {
+	if m != nil {
+		return m.PartSet
+	}
+	return nil
+}
+
+func (m *ConsensusParams) GetAbci() *types1.ABCIParams {
+	if m != nil {
+		return m.Abci
+	}
+	return nil
+}
+
 // BlockParams contains limits on the block size.
 type BlockParams struct {
 	// Note: must be greater than 0
@@ -2545,7 +3216,7 @@ func (m *BlockParams) Reset()         { *m = BlockParams{} }
 func (m *BlockParams) String() string { return proto.CompactTextString(m) }
 func (*BlockParams) ProtoMessage()    {}
 func (*BlockParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{34}
+	return fileDescriptor_addf585b2317eb36, []int{42}
 }
 func (m *BlockParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2597,7 +3268,7 @@ func (m *LastCommitInfo) Reset()         { *m = LastCommitInfo{} }
 func (m *LastCommitInfo) String() string { return proto.CompactTextString(m) }
 func (*LastCommitInfo) ProtoMessage()    {}
 func (*LastCommitInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{35}
+	return fileDescriptor_addf585b2317eb36, []int{43}
 }
 func (m *LastCommitInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2640,6 +3311,58 @@ func (m *LastCommitInfo) GetVotes() []VoteInfo {
 	return nil
 }
 
+type ExtendedCommitInfo struct {
+	Round int32              `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Votes []ExtendedVoteInfo `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
+}
+
+func (m *ExtendedCommitInfo) Reset()         { *m = ExtendedCommitInfo{} }
+func (m *ExtendedCommitInfo) String() string { return proto.CompactTextString(m) }
+func (*ExtendedCommitInfo) ProtoMessage()    {}
+func (*ExtendedCommitInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_addf585b2317eb36, []int{44}
+}
+func (m *ExtendedCommitInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtendedCommitInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtendedCommitInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtendedCommitInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendedCommitInfo.Merge(m, src)
+}
+func (m *ExtendedCommitInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtendedCommitInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendedCommitInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendedCommitInfo proto.InternalMessageInfo
+
+func (m *ExtendedCommitInfo) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *ExtendedCommitInfo) GetVotes() []ExtendedVoteInfo {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
 // Event allows application developers to attach additional information to
 // ResponseBeginBlock, ResponseEndBlock, ResponseCheckTx and ResponseDeliverTx.
 // Later, transactions may be queried using these events.
@@ -2652,7 +3375,7 @@ func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{36}
+	return fileDescriptor_addf585b2317eb36, []int{45}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2706,7 +3429,7 @@ func (m *EventAttribute) Reset()         { *m = EventAttribute{} }
 func (m *EventAttribute) String() string { return proto.CompactTextString(m) }
 func (*EventAttribute) ProtoMessage()    {}
 func (*EventAttribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{37}
+	return fileDescriptor_addf585b2317eb36, []int{46}
 }
 func (m *EventAttribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2770,7 +3493,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{38}
+	return fileDescriptor_addf585b2317eb36, []int{47}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2838,7 +3561,7 @@ func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{39}
+	return fileDescriptor_addf585b2317eb36, []int{48}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2885,13 +3608,16 @@ func (m *Validator) GetPower() int64 {
 type ValidatorUpdate struct {
 	PubKey crypto.PublicKey `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key"`
 	Power  int64            `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	// *** Ostracon Extended Fields ***
+	// proof_of_possession is required for the validators with BLS public keys to prevent rogue-key attacks
+	ProofOfPossession []byte `protobuf:"bytes,1000,opt,name=proof_of_possession,json=proofOfPossession,proto3" json:"proof_of_possession,omitempty"`
 }
 
 func (m *ValidatorUpdate) Reset()         { *m = ValidatorUpdate{} }
 func (m *ValidatorUpdate) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdate) ProtoMessage()    {}
 func (*ValidatorUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{40}
+	return fileDescriptor_addf585b2317eb36, []int{49}
 }
 func (m *ValidatorUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2934,6 +3660,13 @@ func (m *ValidatorUpdate) GetPower() int64 {
 	return 0
 }
 
+func (m *ValidatorUpdate) GetProofOfPossession() []byte {
+	if m != nil {
+		return m.ProofOfPossession
+	}
+	return nil
+}
+
 // VoteInfo
 type VoteInfo struct {
 	Validator       Validator `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator"`
@@ -2946,7 +3679,7 @@ func (m *VoteInfo) Reset()         { *m = VoteInfo{} }
 func (m *VoteInfo) String() string { return proto.CompactTextString(m) }
 func (*VoteInfo) ProtoMessage()    {}
 func (*VoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{41}
+	return fileDescriptor_addf585b2317eb36, []int{50}
 }
 func (m *VoteInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2996,6 +3729,75 @@ func (m *VoteInfo) GetVotingPower() int64 {
 	return 0
 }
 
+// ExtendedVoteInfo is a VoteInfo along with the vote extension of the precommit.
+type ExtendedVoteInfo struct {
+	Validator       Validator `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator"`
+	SignedLastBlock bool      `protobuf:"varint,2,opt,name=signed_last_block,json=signedLastBlock,proto3" json:"signed_last_block,omitempty"`
+	VoteExtension   []byte    `protobuf:"bytes,3,opt,name=vote_extension,json=voteExtension,proto3" json:"vote_extension,omitempty"`
+	VotingPower     int64     `protobuf:"varint,4,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+}
+
+func (m *ExtendedVoteInfo) Reset()         { *m = ExtendedVoteInfo{} }
+func (m *ExtendedVoteInfo) String() string { return proto.CompactTextString(m) }
+func (*ExtendedVoteInfo) ProtoMessage()    {}
+func (*ExtendedVoteInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_addf585b2317eb36, []int{51}
+}
+func (m *ExtendedVoteInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtendedVoteInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtendedVoteInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtendedVoteInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendedVoteInfo.Merge(m, src)
+}
+func (m *ExtendedVoteInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtendedVoteInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendedVoteInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendedVoteInfo proto.InternalMessageInfo
+
+func (m *ExtendedVoteInfo) GetValidator() Validator {
+	if m != nil {
+		return m.Validator
+	}
+	return Validator{}
+}
+
+func (m *ExtendedVoteInfo) GetSignedLastBlock() bool {
+	if m != nil {
+		return m.SignedLastBlock
+	}
+	return false
+}
+
+func (m *ExtendedVoteInfo) GetVoteExtension() []byte {
+	if m != nil {
+		return m.VoteExtension
+	}
+	return nil
+}
+
+func (m *ExtendedVoteInfo) GetVotingPower() int64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
 type Evidence struct {
 	Type EvidenceType `protobuf:"varint,1,opt,name=type,proto3,enum=ostracon.abci.EvidenceType" json:"type,omitempty"`
 	// The offending validator
@@ -3014,7 +3816,7 @@ func (m *Evidence) Reset()         { *m = Evidence{} }
 func (m *Evidence) String() string { return proto.CompactTextString(m) }
 func (*Evidence) ProtoMessage()    {}
 func (*Evidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{42}
+	return fileDescriptor_addf585b2317eb36, []int{52}
 }
 func (m *Evidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3090,7 +3892,7 @@ func (m *Snapshot) Reset()         { *m = Snapshot{} }
 func (m *Snapshot) String() string { return proto.CompactTextString(m) }
 func (*Snapshot) ProtoMessage()    {}
 func (*Snapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_addf585b2317eb36, []int{43}
+	return fileDescriptor_addf585b2317eb36, []int{53}
 }
 func (m *Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3159,6 +3961,8 @@ func init() {
 	proto.RegisterEnum("ostracon.abci.EvidenceType", EvidenceType_name, EvidenceType_value)
 	proto.RegisterEnum("ostracon.abci.ResponseOfferSnapshot_Result", ResponseOfferSnapshot_Result_name, ResponseOfferSnapshot_Result_value)
 	proto.RegisterEnum("ostracon.abci.ResponseApplySnapshotChunk_Result", ResponseApplySnapshotChunk_Result_name, ResponseApplySnapshotChunk_Result_value)
+	proto.RegisterEnum("ostracon.abci.ResponseVerifyVoteExtension_VerifyStatus", ResponseVerifyVoteExtension_VerifyStatus_name, ResponseVerifyVoteExtension_VerifyStatus_value)
+	proto.RegisterEnum("ostracon.abci.ResponseProcessProposal_ProposalStatus", ResponseProcessProposal_ProposalStatus_name, ResponseProcessProposal_ProposalStatus_value)
 	proto.RegisterType((*Request)(nil), "ostracon.abci.Request")
 	proto.RegisterType((*RequestEcho)(nil), "ostracon.abci.RequestEcho")
 	proto.RegisterType((*RequestFlush)(nil), "ostracon.abci.RequestFlush")
@@ -3175,6 +3979,10 @@ func init() {
 	proto.RegisterType((*RequestOfferSnapshot)(nil), "ostracon.abci.RequestOfferSnapshot")
 	proto.RegisterType((*RequestLoadSnapshotChunk)(nil), "ostracon.abci.RequestLoadSnapshotChunk")
 	proto.RegisterType((*RequestApplySnapshotChunk)(nil), "ostracon.abci.RequestApplySnapshotChunk")
+	proto.RegisterType((*RequestPrepareProposal)(nil), "ostracon.abci.RequestPrepareProposal")
+	proto.RegisterType((*RequestExtendVote)(nil), "ostracon.abci.RequestExtendVote")
+	proto.RegisterType((*RequestVerifyVoteExtension)(nil), "ostracon.abci.RequestVerifyVoteExtension")
+	proto.RegisterType((*RequestProcessProposal)(nil), "ostracon.abci.RequestProcessProposal")
 	proto.RegisterType((*Response)(nil), "ostracon.abci.Response")
 	proto.RegisterType((*ResponseException)(nil), "ostracon.abci.ResponseException")
 	proto.RegisterType((*ResponseEcho)(nil), "ostracon.abci.ResponseEcho")
@@ -3192,15 +4000,21 @@ func init() {
 	proto.RegisterType((*ResponseOfferSnapshot)(nil), "ostracon.abci.ResponseOfferSnapshot")
 	proto.RegisterType((*ResponseLoadSnapshotChunk)(nil), "ostracon.abci.ResponseLoadSnapshotChunk")
 	proto.RegisterType((*ResponseApplySnapshotChunk)(nil), "ostracon.abci.ResponseApplySnapshotChunk")
+	proto.RegisterType((*ResponsePrepareProposal)(nil), "ostracon.abci.ResponsePrepareProposal")
+	proto.RegisterType((*ResponseExtendVote)(nil), "ostracon.abci.ResponseExtendVote")
+	proto.RegisterType((*ResponseVerifyVoteExtension)(nil), "ostracon.abci.ResponseVerifyVoteExtension")
+	proto.RegisterType((*ResponseProcessProposal)(nil), "ostracon.abci.ResponseProcessProposal")
 	proto.RegisterType((*ConsensusParams)(nil), "ostracon.abci.ConsensusParams")
 	proto.RegisterType((*BlockParams)(nil), "ostracon.abci.BlockParams")
 	proto.RegisterType((*LastCommitInfo)(nil), "ostracon.abci.LastCommitInfo")
+	proto.RegisterType((*ExtendedCommitInfo)(nil), "ostracon.abci.ExtendedCommitInfo")
 	proto.RegisterType((*Event)(nil), "ostracon.abci.Event")
 	proto.RegisterType((*EventAttribute)(nil), "ostracon.abci.EventAttribute")
 	proto.RegisterType((*TxResult)(nil), "ostracon.abci.TxResult")
 	proto.RegisterType((*Validator)(nil), "ostracon.abci.Validator")
 	proto.RegisterType((*ValidatorUpdate)(nil), "ostracon.abci.ValidatorUpdate")
 	proto.RegisterType((*VoteInfo)(nil), "ostracon.abci.VoteInfo")
+	proto.RegisterType((*ExtendedVoteInfo)(nil), "ostracon.abci.ExtendedVoteInfo")
 	proto.RegisterType((*Evidence)(nil), "ostracon.abci.Evidence")
 	proto.RegisterType((*Snapshot)(nil), "ostracon.abci.Snapshot")
 }
@@ -3208,180 +4022,223 @@ func init() {
 func init() { proto.RegisterFile("ostracon/abci/types.proto", fileDescriptor_addf585b2317eb36) }
 
 var fileDescriptor_addf585b2317eb36 = []byte{
-	// 2759 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcd, 0x93, 0x1b, 0x47,
-	0x15, 0xd7, 0xe8, 0x5b, 0x4f, 0x2b, 0xad, 0xb6, 0xbd, 0xb1, 0xe5, 0x71, 0xb2, 0x6b, 0xc6, 0x84,
-	0x24, 0x26, 0xec, 0xc6, 0x1f, 0x24, 0xc4, 0xc1, 0x21, 0x92, 0x2c, 0x47, 0xcb, 0xae, 0x77, 0xd7,
-	0xb3, 0xf2, 0xa6, 0xe2, 0x04, 0x26, 0x23, 0xa9, 0x57, 0x1a, 0x2c, 0xcd, 0x4c, 0x34, 0xad, 0xf5,
-	0x2e, 0x37, 0xa0, 0xa8, 0xa2, 0x72, 0xca, 0x89, 0xe2, 0x92, 0xff, 0x83, 0x03, 0x55, 0x9c, 0xa8,
-	0xe4, 0x98, 0x23, 0xa7, 0x40, 0xd9, 0x17, 0x8a, 0x2b, 0x45, 0x51, 0x5c, 0x28, 0xaa, 0x3f, 0xe6,
-	0x73, 0x35, 0x92, 0x9c, 0x70, 0xe3, 0x36, 0xfd, 0xe6, 0xbd, 0x37, 0xdd, 0xad, 0x7e, 0xbf, 0xf7,
-	0x7b, 0xaf, 0x05, 0x17, 0x2d, 0x87, 0x8c, 0xf5, 0xae, 0x65, 0x6e, 0xea, 0x9d, 0xae, 0xb1, 0x49,
-	0x4e, 0x6d, 0xec, 0x6c, 0xd8, 0x63, 0x8b, 0x58, 0xa8, 0xe4, 0xbe, 0xda, 0xa0, 0xaf, 0xe4, 0x4b,
-	0x9e, 0x66, 0x77, 0x7c, 0x6a, 0x13, 0x6b, 0xd3, 0x1e, 0x5b, 0xd6, 0x11, 0xd7, 0x95, 0x65, 0xef,
-	0x25, 0xf3, 0x10, 0xf4, 0x13, 0x78, 0x27, 0x0c, 0x1f, 0xe1, 0x53, 0xf7, 0xdd, 0xa5, 0x88, 0x9d,
-	0xad, 0x8f, 0xf5, 0x91, 0xfb, 0x72, 0xbd, 0x6f, 0x59, 0xfd, 0x21, 0xde, 0x64, 0xa3, 0xce, 0xe4,
-	0x68, 0x93, 0x18, 0x23, 0xec, 0x10, 0x7d, 0x64, 0x0b, 0x85, 0xd5, 0xbe, 0xd5, 0xb7, 0xd8, 0xe3,
-	0x26, 0x7d, 0xe2, 0x52, 0xe5, 0x1f, 0x39, 0xc8, 0xa9, 0xf8, 0xe3, 0x09, 0x76, 0x08, 0x7a, 0x0d,
-	0xd2, 0xb8, 0x3b, 0xb0, 0xaa, 0xd2, 0x65, 0xe9, 0xe5, 0xe2, 0x75, 0x79, 0x23, 0xb4, 0xa4, 0x0d,
-	0xa1, 0xd5, 0xec, 0x0e, 0xac, 0x56, 0x42, 0x65, 0x9a, 0xe8, 0x06, 0x64, 0x8e, 0x86, 0x13, 0x67,
-	0x50, 0x4d, 0x32, 0x93, 0x4b, 0xd3, 0x4d, 0xee, 0x52, 0x95, 0x56, 0x42, 0xe5, 0xba, 0xf4, 0x33,
-	0x86, 0x79, 0x64, 0x55, 0x53, 0xb3, 0x3e, 0xb3, 0x65, 0x1e, 0xb1, 0xcf, 0x50, 0x4d, 0xf4, 0x0e,
-	0x80, 0x83, 0x89, 0x66, 0xd9, 0xc4, 0xb0, 0xcc, 0x6a, 0x9a, 0xd9, 0xad, 0x4f, 0xb7, 0x3b, 0xc0,
-	0x64, 0x8f, 0xa9, 0xb5, 0x12, 0x6a, 0xc1, 0x71, 0x07, 0xd4, 0x83, 0x61, 0x1a, 0x44, 0xeb, 0x0e,
-	0x74, 0xc3, 0xac, 0x66, 0x66, 0x79, 0xd8, 0x32, 0x0d, 0xd2, 0xa0, 0x6a, 0xd4, 0x83, 0xe1, 0x0e,
-	0xe8, 0x52, 0x3f, 0x9e, 0xe0, 0xf1, 0x69, 0x35, 0x3b, 0x6b, 0xa9, 0xf7, 0xa9, 0x0a, 0x5d, 0x2a,
-	0xd3, 0x45, 0x0d, 0x28, 0x76, 0x70, 0xdf, 0x30, 0xb5, 0xce, 0xd0, 0xea, 0x3e, 0xaa, 0xe6, 0x98,
-	0xe9, 0xe5, 0xe9, 0xa6, 0x75, 0xaa, 0x58, 0xa7, 0x7a, 0xad, 0x84, 0x0a, 0x1d, 0x6f, 0x84, 0x6e,
-	0x41, 0xbe, 0x3b, 0xc0, 0xdd, 0x47, 0x1a, 0x39, 0xa9, 0xe6, 0x99, 0x87, 0x17, 0xa6, 0x7b, 0x68,
-	0x50, 0xad, 0xf6, 0x49, 0x2b, 0xa1, 0xe6, 0xba, 0xfc, 0x91, 0xae, 0xbb, 0x87, 0x87, 0xc6, 0x31,
-	0x1e, 0x53, 0xeb, 0xc2, 0xac, 0x75, 0xdf, 0xe1, 0x7a, 0xcc, 0xbe, 0xd0, 0x73, 0x07, 0xe8, 0x36,
-	0x14, 0xb0, 0xd9, 0x13, 0x0b, 0x00, 0xe6, 0x60, 0x2d, 0xe6, 0x64, 0x98, 0x3d, 0x77, 0xfa, 0x79,
-	0x2c, 0x9e, 0xd1, 0xeb, 0x90, 0xed, 0x5a, 0xa3, 0x91, 0x41, 0xaa, 0x45, 0x66, 0xfb, 0x7c, 0xcc,
-	0xd4, 0x99, 0x4e, 0x2b, 0xa1, 0x0a, 0x6d, 0xb4, 0x03, 0xe5, 0xa1, 0xe1, 0x10, 0xcd, 0x31, 0x75,
-	0xdb, 0x19, 0x58, 0xc4, 0xa9, 0x2e, 0x31, 0xfb, 0x2b, 0xd3, 0xed, 0x77, 0x0c, 0x87, 0x1c, 0xb8,
-	0xaa, 0xad, 0x84, 0x5a, 0x1a, 0x06, 0x05, 0xd4, 0x9b, 0x75, 0x74, 0x84, 0xc7, 0x9e, 0xbb, 0x6a,
-	0x69, 0x96, 0xb7, 0x3d, 0xaa, 0xeb, 0x5a, 0x53, 0x6f, 0x56, 0x50, 0x80, 0xde, 0x87, 0x73, 0x43,
-	0x4b, 0xef, 0x79, 0xce, 0xb4, 0xee, 0x60, 0x62, 0x3e, 0xaa, 0x96, 0x99, 0xcb, 0x97, 0x62, 0x26,
-	0x68, 0xe9, 0x3d, 0xd7, 0x41, 0x83, 0xaa, 0xb7, 0x12, 0xea, 0xca, 0x30, 0x2a, 0x44, 0x1f, 0xc2,
-	0xaa, 0x6e, 0xdb, 0xc3, 0xd3, 0xa8, 0xef, 0x65, 0xe6, 0xfb, 0xe5, 0xe9, 0xbe, 0x6b, 0xd4, 0x22,
-	0xea, 0x1c, 0xe9, 0x67, 0xa4, 0xf5, 0x1c, 0x64, 0x8e, 0xf5, 0xe1, 0x04, 0x2b, 0x2f, 0x41, 0x31,
-	0x10, 0xce, 0xa8, 0x0a, 0xb9, 0x11, 0x76, 0x1c, 0xbd, 0x8f, 0x59, 0xec, 0x17, 0x54, 0x77, 0xa8,
-	0x94, 0x61, 0x29, 0x18, 0xc4, 0xca, 0xc8, 0x33, 0xa4, 0x01, 0x4a, 0x0d, 0x8f, 0xf1, 0xd8, 0xa1,
-	0x51, 0x29, 0x0c, 0xc5, 0x10, 0x5d, 0x81, 0x12, 0x3b, 0x32, 0x9a, 0xfb, 0x9e, 0x22, 0x44, 0x5a,
-	0x5d, 0x62, 0xc2, 0x43, 0xa1, 0xb4, 0x0e, 0x45, 0xfb, 0xba, 0xed, 0xa9, 0xa4, 0x98, 0x0a, 0xd8,
-	0xd7, 0x6d, 0xa1, 0xa0, 0xdc, 0x82, 0x4a, 0x34, 0xae, 0x51, 0x05, 0x52, 0x8f, 0xf0, 0xa9, 0xf8,
-	0x1e, 0x7d, 0x44, 0xab, 0x62, 0x59, 0xec, 0x1b, 0x05, 0x55, 0xac, 0xf1, 0xf3, 0xa4, 0x67, 0xec,
-	0x85, 0x34, 0xfa, 0x01, 0xa4, 0x29, 0x2e, 0x7a, 0x10, 0xc7, 0x41, 0x73, 0xc3, 0x05, 0xcd, 0x8d,
-	0xb6, 0x0b, 0x9a, 0xf5, 0xfc, 0x17, 0x5f, 0xad, 0x27, 0x3e, 0xfd, 0xcb, 0xba, 0xa4, 0x32, 0x0b,
-	0x74, 0x91, 0x46, 0xa1, 0x6e, 0x98, 0x9a, 0xd1, 0x13, 0xdf, 0xc9, 0xb1, 0xf1, 0x56, 0x0f, 0x6d,
-	0x41, 0xa5, 0x6b, 0x99, 0x0e, 0x36, 0x9d, 0x89, 0xa3, 0x71, 0x50, 0x16, 0xe0, 0x16, 0x8d, 0x94,
-	0x86, 0xab, 0xb6, 0xcf, 0xb4, 0xd4, 0xe5, 0x6e, 0x58, 0x80, 0xee, 0x00, 0x1c, 0xeb, 0x43, 0xa3,
-	0xa7, 0x13, 0x6b, 0xec, 0x54, 0xd3, 0x97, 0x53, 0x53, 0x9c, 0x1c, 0xba, 0x0a, 0x0f, 0xec, 0x9e,
-	0x4e, 0x70, 0x3d, 0x4d, 0x67, 0xaa, 0x06, 0xec, 0xd0, 0x77, 0x60, 0x59, 0xb7, 0x6d, 0xcd, 0x21,
-	0x3a, 0xc1, 0x5a, 0xe7, 0x94, 0x60, 0x87, 0x41, 0xde, 0x92, 0x5a, 0xd2, 0x6d, 0xfb, 0x80, 0x4a,
-	0xeb, 0x54, 0x88, 0x5e, 0x84, 0x32, 0x05, 0x38, 0x43, 0x1f, 0x6a, 0x03, 0x6c, 0xf4, 0x07, 0x84,
-	0x81, 0x5b, 0x4a, 0x2d, 0x09, 0x69, 0x8b, 0x09, 0x95, 0x9e, 0x77, 0x08, 0x18, 0xbc, 0x21, 0x04,
-	0xe9, 0x9e, 0x4e, 0x74, 0xb6, 0x89, 0x4b, 0x2a, 0x7b, 0xa6, 0x32, 0x5b, 0x27, 0x03, 0xb1, 0x35,
-	0xec, 0x19, 0x9d, 0x87, 0xac, 0x70, 0x9b, 0x62, 0x6e, 0xc5, 0x88, 0xfe, 0x5e, 0xf6, 0xd8, 0x3a,
-	0xc6, 0x0c, 0xc9, 0xf3, 0x2a, 0x1f, 0x28, 0xff, 0x91, 0x60, 0xe5, 0x0c, 0x14, 0x52, 0xbf, 0x03,
-	0xdd, 0x19, 0xb8, 0xdf, 0xa2, 0xcf, 0xe8, 0x26, 0xf5, 0xab, 0xf7, 0xf0, 0x58, 0xa4, 0x9d, 0xf3,
-	0xfe, 0x06, 0xf1, 0x54, 0xda, 0x62, 0x6f, 0xc5, 0xc6, 0x08, 0x5d, 0x74, 0x0f, 0x2a, 0x43, 0xdd,
-	0x21, 0x1a, 0x07, 0x18, 0x2d, 0x90, 0x82, 0xa2, 0x70, 0xba, 0xa3, 0xbb, 0x80, 0x44, 0x0f, 0xb9,
-	0x70, 0x53, 0x1e, 0x86, 0xa4, 0x68, 0x1f, 0x56, 0x3b, 0xa7, 0x3f, 0xd7, 0x4d, 0x62, 0x98, 0x58,
-	0x3b, 0xf3, 0x9b, 0x5d, 0x88, 0xb8, 0x6c, 0x1e, 0x1b, 0x3d, 0x6c, 0x76, 0xdd, 0x1f, 0xeb, 0x9c,
-	0x67, 0xea, 0xfd, 0x98, 0x8e, 0xb2, 0x0f, 0xe5, 0x30, 0x90, 0xa3, 0x32, 0x24, 0xc9, 0x89, 0x58,
-	0x7a, 0x92, 0x9c, 0xa0, 0x0d, 0x48, 0xd3, 0x05, 0xb2, 0x65, 0x97, 0xcf, 0x64, 0x4e, 0x61, 0xd5,
-	0x3e, 0xb5, 0xb1, 0xca, 0xf4, 0x14, 0xc5, 0x8b, 0x00, 0x0f, 0xdc, 0xa3, 0x3e, 0x95, 0x57, 0x60,
-	0x39, 0x82, 0xdf, 0x81, 0xdf, 0x4d, 0x0a, 0xfe, 0x6e, 0xca, 0x32, 0x94, 0x42, 0x70, 0xad, 0x9c,
-	0x87, 0xd5, 0x69, 0xf8, 0xab, 0x1c, 0x79, 0xf2, 0x10, 0x92, 0xa2, 0x1b, 0x90, 0xf7, 0x00, 0x98,
-	0x47, 0x60, 0x74, 0x9f, 0x5c, 0x55, 0xd5, 0x53, 0xa4, 0x81, 0x47, 0x0f, 0x33, 0x3b, 0x05, 0x49,
-	0x36, 0xed, 0x9c, 0x6e, 0xdb, 0x2d, 0xdd, 0x19, 0x28, 0x1f, 0x41, 0x35, 0x0e, 0x5e, 0x23, 0x8b,
-	0x48, 0x7b, 0x87, 0xef, 0x3c, 0x64, 0x8f, 0xac, 0xf1, 0x48, 0x27, 0xcc, 0x59, 0x49, 0x15, 0x23,
-	0x7a, 0x28, 0x39, 0xd4, 0xa6, 0x98, 0x98, 0x0f, 0x14, 0x0d, 0x2e, 0xc6, 0x82, 0x2c, 0x35, 0x31,
-	0xcc, 0x1e, 0xe6, 0xbb, 0x59, 0x52, 0xf9, 0xc0, 0x77, 0xc4, 0x27, 0xcb, 0x07, 0xf4, 0xb3, 0x0e,
-	0x36, 0xe9, 0x99, 0x4d, 0xb1, 0x08, 0x11, 0x23, 0xe5, 0x4f, 0x79, 0xc8, 0xab, 0xd8, 0xb1, 0x29,
-	0x0e, 0xa0, 0x77, 0xa0, 0x80, 0x4f, 0xba, 0x98, 0xd3, 0x1c, 0x29, 0x86, 0x2c, 0x70, 0xdd, 0xa6,
-	0xab, 0x47, 0xb3, 0xb5, 0x67, 0x84, 0xae, 0x09, 0x0a, 0x17, 0xc7, 0xc7, 0x84, 0x71, 0x90, 0xc3,
-	0xdd, 0x74, 0x39, 0x5c, 0x2a, 0x26, 0x41, 0x73, 0x9b, 0x08, 0x89, 0xbb, 0x26, 0x48, 0x5c, 0x7a,
-	0xe6, 0x87, 0x42, 0x2c, 0xae, 0x16, 0x62, 0x71, 0x99, 0x99, 0xcb, 0x8b, 0xa1, 0x71, 0xb5, 0x10,
-	0x8d, 0xcb, 0xce, 0x74, 0x11, 0xc3, 0xe3, 0x6e, 0xba, 0x3c, 0x2e, 0x37, 0x73, 0xb9, 0x11, 0x22,
-	0x77, 0x27, 0x4c, 0xe4, 0x38, 0x0d, 0xfb, 0x56, 0x8c, 0x6d, 0x2c, 0x93, 0x7b, 0x2b, 0xc0, 0xe4,
-	0x0a, 0x31, 0x54, 0x8a, 0xbb, 0x98, 0x42, 0xe5, 0x6a, 0x21, 0x2a, 0x07, 0x33, 0xd7, 0x1e, 0xc3,
-	0xe5, 0xde, 0x0e, 0x72, 0xb9, 0x62, 0x0c, 0x19, 0x14, 0x47, 0x64, 0x1a, 0x99, 0x7b, 0xc3, 0x23,
-	0x73, 0x4b, 0x31, 0x3c, 0x54, 0xcc, 0x3e, 0xca, 0xe6, 0xee, 0x9d, 0x61, 0x73, 0x9c, 0x7f, 0x7d,
-	0x3b, 0xc6, 0xc1, 0x1c, 0x3a, 0x77, 0xef, 0x0c, 0x9d, 0x2b, 0xcf, 0x74, 0x37, 0x87, 0xcf, 0x3d,
-	0x9c, 0xce, 0xe7, 0xe2, 0x38, 0x97, 0x98, 0xe2, 0x62, 0x84, 0xee, 0x27, 0x31, 0x84, 0xae, 0xc2,
-	0x9c, 0xbf, 0x12, 0xe3, 0xfc, 0xd9, 0x19, 0xdd, 0x2b, 0x34, 0x79, 0x46, 0xa0, 0x81, 0x42, 0x11,
-	0x1e, 0x8f, 0xad, 0xb1, 0x20, 0x4b, 0x7c, 0xa0, 0xbc, 0x4c, 0xd3, 0xb9, 0x0f, 0x04, 0x33, 0xd8,
-	0x1f, 0x03, 0xfc, 0x40, 0xf8, 0x2b, 0xbf, 0x97, 0x7c, 0x5b, 0x96, 0x05, 0x83, 0x54, 0xa0, 0x20,
-	0xa8, 0x40, 0x80, 0x14, 0x26, 0xc3, 0xa4, 0x70, 0x1d, 0x8a, 0x14, 0xca, 0x23, 0x7c, 0x4f, 0xb7,
-	0x5d, 0xbe, 0x87, 0xae, 0xc2, 0x0a, 0xcb, 0xd1, 0x9c, 0x3a, 0x0a, 0xfc, 0x4e, 0xb3, 0x24, 0xb4,
-	0x4c, 0x5f, 0xf0, 0x23, 0xc9, 0x81, 0xfc, 0x7b, 0x70, 0x2e, 0xa0, 0xeb, 0xa5, 0x08, 0x4e, 0x74,
-	0x2a, 0x9e, 0x76, 0x4d, 0xe4, 0x8a, 0x7b, 0xfe, 0x06, 0xf9, 0x5c, 0x12, 0x41, 0xba, 0x6b, 0xf5,
-	0xb0, 0x00, 0x70, 0xf6, 0x4c, 0xf9, 0xe5, 0xd0, 0xea, 0x0b, 0x98, 0xa6, 0x8f, 0x54, 0xcb, 0xc3,
-	0xba, 0x02, 0x07, 0x33, 0xe5, 0x8f, 0x92, 0xef, 0xcf, 0xa7, 0x97, 0xd3, 0x98, 0xa0, 0xf4, 0xbf,
-	0x60, 0x82, 0xc9, 0xaf, 0xc9, 0x04, 0x83, 0xc9, 0x33, 0x15, 0x4e, 0x9e, 0xff, 0x94, 0xfc, 0x5f,
-	0xd7, 0xe3, 0x75, 0x5f, 0x6f, 0x37, 0xfc, 0x4c, 0x98, 0x61, 0xbf, 0x95, 0xc8, 0x84, 0x82, 0xa9,
-	0x67, 0xd9, 0x77, 0xc3, 0x4c, 0x3d, 0xc7, 0x73, 0x23, 0x1b, 0xa0, 0xd7, 0xa1, 0xc0, 0xda, 0x23,
-	0x9a, 0x65, 0x3b, 0x02, 0x5a, 0x2f, 0xfa, 0x2b, 0xe5, 0x7d, 0x90, 0x8d, 0x7d, 0xaa, 0xb1, 0x67,
-	0x3b, 0x6a, 0xde, 0x16, 0x4f, 0x81, 0x14, 0x5f, 0x08, 0xf1, 0xcb, 0xe7, 0xa1, 0x40, 0xe7, 0xee,
-	0xd8, 0x7a, 0x17, 0x33, 0xa0, 0x2c, 0xa8, 0xbe, 0x40, 0xf9, 0x10, 0xd0, 0x59, 0xa0, 0x46, 0x77,
-	0x21, 0x8b, 0x8f, 0xb1, 0x49, 0xe8, 0xef, 0x45, 0xb7, 0x7a, 0xf5, 0x0c, 0x81, 0xc3, 0x26, 0xa9,
-	0x57, 0xe9, 0x06, 0xff, 0xfd, 0xab, 0xf5, 0x0a, 0xd7, 0x7d, 0xd5, 0x1a, 0x19, 0x04, 0x8f, 0x6c,
-	0x72, 0xaa, 0x0a, 0x6b, 0xe5, 0x17, 0x49, 0xca, 0xa7, 0x42, 0x20, 0x3e, 0x75, 0x5f, 0xdd, 0xc0,
-	0x49, 0x06, 0x38, 0xf4, 0x62, 0x7b, 0xbd, 0x06, 0xd0, 0xd7, 0x1d, 0xed, 0xb1, 0x6e, 0x12, 0xdc,
-	0x13, 0x1b, 0x1e, 0x90, 0x20, 0x19, 0xf2, 0x74, 0x34, 0x71, 0x70, 0x4f, 0xd0, 0x79, 0x6f, 0x1c,
-	0x58, 0x65, 0xee, 0x9b, 0xac, 0x32, 0xbc, 0xc3, 0xf9, 0xe8, 0x0e, 0xff, 0x2a, 0xe9, 0xc7, 0x86,
-	0x4f, 0x3c, 0xff, 0xdf, 0x76, 0xe1, 0xd7, 0xac, 0xfe, 0x0c, 0x67, 0x53, 0x74, 0x1f, 0x56, 0xbc,
-	0xe8, 0xd4, 0x26, 0x2c, 0x6a, 0xdd, 0x13, 0xb7, 0x58, 0x70, 0x57, 0x8e, 0xc3, 0x62, 0x07, 0x1d,
-	0xc2, 0x85, 0x08, 0xe6, 0x78, 0x8e, 0x93, 0x0b, 0x41, 0xcf, 0x73, 0x61, 0xe8, 0x71, 0xfd, 0xfa,
-	0xbb, 0x94, 0xfa, 0x46, 0x11, 0xb1, 0x45, 0xcb, 0x9a, 0x20, 0x2f, 0x98, 0xfa, 0xab, 0x5f, 0x81,
-	0xd2, 0x18, 0x13, 0x5a, 0x5f, 0x87, 0x4a, 0xc6, 0x25, 0x2e, 0x14, 0x85, 0xe8, 0x2e, 0x3c, 0x37,
-	0x95, 0x21, 0xa0, 0xef, 0x43, 0xc1, 0xa7, 0x16, 0xd2, 0xd4, 0x0a, 0xcc, 0xab, 0x2c, 0x7c, 0x4d,
-	0xe5, 0x0f, 0x92, 0xef, 0x30, 0x5c, 0xa9, 0x34, 0x20, 0x3b, 0xc6, 0xce, 0x64, 0xc8, 0xab, 0x87,
-	0xf2, 0xf5, 0xef, 0x2e, 0xc2, 0x2c, 0xa8, 0x74, 0x32, 0x24, 0xaa, 0x30, 0x55, 0x7e, 0x0a, 0x59,
-	0x2e, 0x41, 0x45, 0xc8, 0x3d, 0xd8, 0xdd, 0xde, 0xdd, 0x7b, 0x6f, 0xb7, 0x92, 0x40, 0x00, 0xd9,
-	0x5a, 0xa3, 0xd1, 0xdc, 0x6f, 0x57, 0x24, 0x54, 0x80, 0x4c, 0xad, 0xbe, 0xa7, 0xb6, 0x2b, 0x49,
-	0x2a, 0x56, 0x9b, 0x3f, 0x6e, 0x36, 0xda, 0x95, 0x14, 0x5a, 0x81, 0x12, 0x7f, 0xd6, 0xee, 0xee,
-	0xa9, 0xf7, 0x6a, 0xed, 0x4a, 0x3a, 0x20, 0x3a, 0x68, 0xee, 0xde, 0x69, 0xaa, 0x95, 0x8c, 0x72,
-	0x8d, 0x16, 0x27, 0x31, 0x6c, 0xc4, 0x2f, 0x43, 0xa4, 0x40, 0x19, 0xa2, 0xfc, 0x36, 0x09, 0x72,
-	0x3c, 0xc9, 0x40, 0xad, 0xc8, 0xb2, 0x5f, 0x5b, 0x98, 0x9f, 0x44, 0xd6, 0x8e, 0x5e, 0x84, 0xf2,
-	0x18, 0x1f, 0x61, 0xd2, 0x1d, 0x70, 0xc2, 0xc3, 0x53, 0x58, 0x49, 0x2d, 0x09, 0x29, 0x33, 0x72,
-	0xb8, 0xda, 0xcf, 0x70, 0x97, 0x68, 0xbc, 0x1e, 0xe2, 0x87, 0xad, 0x40, 0xd5, 0xa8, 0xf4, 0x80,
-	0x0b, 0x95, 0x8f, 0x9e, 0x69, 0x27, 0x0b, 0x90, 0x51, 0x9b, 0x6d, 0xf5, 0xfd, 0x4a, 0x0a, 0x21,
-	0x28, 0xb3, 0x47, 0xed, 0x60, 0xb7, 0xb6, 0x7f, 0xd0, 0xda, 0xa3, 0x3b, 0x79, 0x0e, 0x96, 0xdd,
-	0x9d, 0x74, 0x85, 0x19, 0xe5, 0x5f, 0x12, 0x2c, 0x47, 0x02, 0x03, 0xbd, 0x06, 0x19, 0x4e, 0x95,
-	0xa7, 0x37, 0xc4, 0x59, 0x44, 0x8b, 0x18, 0xe2, 0x8a, 0xe8, 0x16, 0xe4, 0xb1, 0xa8, 0xf4, 0xcf,
-	0x06, 0x1f, 0xef, 0x4d, 0xb8, 0x9d, 0x00, 0x61, 0xe8, 0xe9, 0xa3, 0xdb, 0x50, 0xf0, 0x62, 0x5b,
-	0xd4, 0x62, 0xeb, 0x51, 0x63, 0x0f, 0x13, 0x84, 0xb5, 0x6f, 0x81, 0xde, 0xf0, 0x59, 0x57, 0x3a,
-	0x4a, 0xce, 0x85, 0x31, 0x7f, 0x2d, 0x4c, 0x5d, 0x6d, 0xa5, 0x01, 0xc5, 0xc0, 0x4a, 0xd0, 0x25,
-	0x28, 0x8c, 0xf4, 0x13, 0xd1, 0x35, 0xe2, 0xf5, 0x7f, 0x7e, 0xa4, 0x9f, 0xf0, 0x86, 0xd1, 0x05,
-	0xc8, 0xd1, 0x97, 0x7d, 0x9d, 0x63, 0x4b, 0x4a, 0xcd, 0x8e, 0xf4, 0x93, 0x77, 0x75, 0x47, 0xf9,
-	0x00, 0xca, 0xe1, 0xae, 0x09, 0x3d, 0x7f, 0x63, 0x6b, 0x62, 0xf6, 0x98, 0x8f, 0x8c, 0xca, 0x07,
-	0xe8, 0x06, 0x64, 0x8e, 0x2d, 0x0e, 0x4d, 0xd3, 0x82, 0xf4, 0xd0, 0x22, 0x38, 0xd0, 0x73, 0xe1,
-	0xba, 0xca, 0x09, 0x64, 0x18, 0xd8, 0x50, 0xe0, 0x60, 0xfd, 0x0f, 0xc1, 0x36, 0xe9, 0x33, 0xfa,
-	0x00, 0x40, 0x27, 0x64, 0x6c, 0x74, 0x26, 0xbe, 0xdb, 0x17, 0xa6, 0x41, 0x55, 0xcd, 0xd5, 0xaa,
-	0x3f, 0x2f, 0x30, 0x6b, 0xd5, 0x37, 0x0c, 0xe0, 0x56, 0xc0, 0x9d, 0xb2, 0x0b, 0xe5, 0xb0, 0x6d,
-	0xb0, 0xfb, 0xb8, 0x34, 0xa5, 0xfb, 0xe8, 0x71, 0x1a, 0x8f, 0x11, 0xa5, 0x78, 0x8f, 0x8b, 0x0d,
-	0x94, 0xdf, 0x48, 0x90, 0x6f, 0x9f, 0x88, 0xa3, 0x1c, 0xd3, 0x66, 0xf1, 0x4d, 0x93, 0xc1, 0xb6,
-	0x02, 0xef, 0xdb, 0xa4, 0xbc, 0x5e, 0xd0, 0xdb, 0x5e, 0xa8, 0xa6, 0x17, 0x2b, 0x05, 0xdd, 0x76,
-	0x98, 0x00, 0xa7, 0xb7, 0xa0, 0xe0, 0x9d, 0x26, 0x4a, 0xd9, 0xf5, 0x5e, 0x6f, 0x8c, 0x1d, 0x47,
-	0xac, 0xcc, 0x1d, 0xb2, 0x5e, 0x9d, 0xf5, 0x58, 0xb4, 0x2d, 0x52, 0x2a, 0x1f, 0x28, 0x1d, 0x58,
-	0x8e, 0xa4, 0x27, 0xf4, 0x26, 0xe4, 0xec, 0x49, 0x47, 0x73, 0x37, 0x27, 0x14, 0x2e, 0x2e, 0x85,
-	0x9b, 0x74, 0x86, 0x46, 0x77, 0x1b, 0x9f, 0xba, 0x53, 0xb1, 0x27, 0x9d, 0x6d, 0xbe, 0x83, 0xfc,
-	0x1b, 0xc9, 0xe0, 0x37, 0x7e, 0x27, 0x41, 0xde, 0x3d, 0x0f, 0xe8, 0x87, 0xc1, 0xe0, 0xe0, 0xfe,
-	0xab, 0x71, 0xf9, 0x52, 0x78, 0x0f, 0xc4, 0xc6, 0x55, 0x58, 0x71, 0x8c, 0xbe, 0x89, 0x7b, 0x9a,
-	0x5f, 0x31, 0xb0, 0x8f, 0xe5, 0xd5, 0x65, 0xfe, 0x62, 0xc7, 0x2d, 0x17, 0x90, 0x02, 0x4b, 0xc7,
-	0x16, 0x31, 0xcc, 0xbe, 0xc6, 0xe7, 0xf4, 0xb7, 0x1c, 0x9b, 0x54, 0x91, 0x0b, 0xf7, 0xd9, 0xd4,
-	0xfe, 0x2d, 0x41, 0xde, 0x8d, 0x63, 0xb4, 0x19, 0x38, 0x94, 0xe5, 0x33, 0x9d, 0x10, 0x57, 0xcd,
-	0xef, 0xca, 0x85, 0xd7, 0x92, 0x7c, 0xd6, 0xb5, 0xc4, 0x35, 0x55, 0xdd, 0xce, 0x76, 0xfa, 0x99,
-	0x3b, 0xdb, 0xaf, 0x02, 0x22, 0x16, 0xd1, 0x87, 0x5a, 0x68, 0xdd, 0x9c, 0x52, 0x55, 0xd8, 0x9b,
-	0xc3, 0xc0, 0xda, 0x7f, 0x29, 0x41, 0xde, 0x4b, 0x93, 0xcf, 0xda, 0x64, 0x3b, 0x0f, 0x59, 0x91,
-	0x0d, 0x78, 0x97, 0x4d, 0x8c, 0xbc, 0x2e, 0x6f, 0x3a, 0xd0, 0xe5, 0x95, 0x21, 0x3f, 0xc2, 0x44,
-	0x67, 0x4c, 0x81, 0x17, 0x75, 0xde, 0xf8, 0xea, 0x9b, 0x50, 0x0c, 0x74, 0x3b, 0x69, 0x50, 0xee,
-	0x36, 0xdf, 0xab, 0x24, 0xe4, 0xdc, 0x27, 0x9f, 0x5d, 0x4e, 0xed, 0xe2, 0xc7, 0xf4, 0x40, 0xab,
-	0xcd, 0x46, 0xab, 0xd9, 0xd8, 0xae, 0x48, 0x72, 0xf1, 0x93, 0xcf, 0x2e, 0xe7, 0x54, 0xcc, 0x1a,
-	0x29, 0x57, 0x5b, 0xb0, 0x14, 0xfc, 0x4d, 0xc2, 0x09, 0x05, 0x41, 0xf9, 0xce, 0x83, 0xfd, 0x9d,
-	0xad, 0x46, 0xad, 0xdd, 0xd4, 0x0e, 0xf7, 0xda, 0xcd, 0x8a, 0x84, 0x2e, 0xc0, 0xb9, 0x9d, 0xad,
-	0x77, 0x5b, 0x6d, 0xad, 0xb1, 0xb3, 0xd5, 0xdc, 0x6d, 0x6b, 0xb5, 0x76, 0xbb, 0xd6, 0xd8, 0xae,
-	0x24, 0xaf, 0x7f, 0x5e, 0x80, 0xe5, 0x5a, 0xbd, 0xb1, 0x45, 0x93, 0xa1, 0xd1, 0xd5, 0x59, 0x41,
-	0xf9, 0x23, 0x48, 0xb3, 0x9a, 0x7a, 0xc6, 0xe5, 0xa9, 0x3c, 0xab, 0x2b, 0x87, 0xea, 0x90, 0x61,
-	0xa5, 0x36, 0x9a, 0x75, 0x97, 0x2a, 0xcf, 0x6c, 0xd2, 0xd1, 0x49, 0xb0, 0xa0, 0x99, 0x71, 0xb5,
-	0x2a, 0xcf, 0xea, 0xd8, 0xa1, 0x5d, 0x28, 0xf8, 0x35, 0xf2, 0xbc, 0x8b, 0x56, 0x79, 0x6e, 0x0f,
-	0x8f, 0xfa, 0xf3, 0xeb, 0x80, 0x79, 0xd7, 0x8f, 0xf2, 0x5c, 0x24, 0x43, 0x2d, 0xc8, 0xb9, 0xb5,
-	0xd5, 0xec, 0xab, 0x50, 0x79, 0x4e, 0x7f, 0x8d, 0x6e, 0x37, 0xaf, 0x7d, 0x67, 0xdd, 0xe7, 0xca,
-	0x33, 0x9b, 0x84, 0xa8, 0x09, 0x59, 0x41, 0x6c, 0x67, 0x5e, 0x6e, 0xca, 0xb3, 0xbb, 0x65, 0x74,
-	0x93, 0xfc, 0x46, 0xc2, 0xbc, 0xbb, 0x69, 0x79, 0x6e, 0xd7, 0x13, 0xdd, 0x07, 0x08, 0xd4, 0xb7,
-	0x73, 0x2f, 0x9d, 0xe5, 0xf9, 0xdd, 0x4c, 0xb4, 0x0d, 0x79, 0xaf, 0x92, 0x99, 0x73, 0x09, 0x2c,
-	0xcf, 0x6b, 0x2c, 0xa2, 0x87, 0x50, 0x0a, 0x93, 0xf8, 0x45, 0xae, 0x76, 0xe5, 0x85, 0x3a, 0x86,
-	0xd4, 0x77, 0x98, 0xcf, 0x2f, 0x72, 0xd1, 0x2b, 0x2f, 0xd4, 0x3e, 0x44, 0x47, 0xb0, 0x72, 0x96,
-	0x6d, 0x2f, 0x7a, 0xeb, 0x2b, 0x2f, 0xdc, 0x4e, 0x44, 0x06, 0xa0, 0x29, 0x0c, 0x7d, 0xe1, 0x2b,
-	0x60, 0x79, 0xf1, 0xde, 0x62, 0xfd, 0xf6, 0x17, 0x4f, 0xd6, 0xa4, 0x2f, 0x9f, 0xac, 0x49, 0x7f,
-	0x7d, 0xb2, 0x26, 0x7d, 0xfa, 0x74, 0x2d, 0xf1, 0xe5, 0xd3, 0xb5, 0xc4, 0x9f, 0x9f, 0xae, 0x25,
-	0x1e, 0x5e, 0xe9, 0x1b, 0x64, 0x30, 0xe9, 0x6c, 0x74, 0xad, 0xd1, 0xe6, 0xd0, 0x30, 0xf1, 0xe6,
-	0x94, 0x7f, 0xc0, 0x74, 0xb2, 0x2c, 0xc9, 0xdc, 0xf8, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9b,
-	0x97, 0x59, 0xff, 0x1f, 0x23, 0x00, 0x00,
+	// 3445 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcb, 0x73, 0x1b, 0xe7,
+	0x91, 0xc7, 0xe0, 0x41, 0x00, 0x8d, 0x27, 0x3f, 0x52, 0x12, 0x34, 0xb4, 0x49, 0x79, 0xb4, 0xb6,
+	0x25, 0xd9, 0x4b, 0xda, 0x92, 0x1f, 0x6b, 0x6b, 0xfd, 0x00, 0x21, 0xc8, 0xe0, 0x8a, 0x22, 0xe9,
+	0x21, 0x44, 0x97, 0x1f, 0xbb, 0xa3, 0x21, 0xf0, 0x91, 0x98, 0x15, 0x80, 0x19, 0xcf, 0x0c, 0x68,
+	0x72, 0x4f, 0x5b, 0xbb, 0xb5, 0x55, 0x5b, 0x3e, 0xb9, 0x2a, 0x55, 0xa9, 0x54, 0x25, 0xae, 0x1c,
+	0xf2, 0x47, 0x24, 0x87, 0x54, 0xe5, 0x94, 0xc4, 0x87, 0x1c, 0x7c, 0x4b, 0x4e, 0x4e, 0xca, 0xce,
+	0xc1, 0x76, 0xce, 0x39, 0xe4, 0x92, 0x4a, 0x7d, 0xaf, 0xc1, 0xcc, 0x60, 0x06, 0x80, 0x6c, 0x57,
+	0x2e, 0xb9, 0xcd, 0xd7, 0x5f, 0x77, 0xcf, 0xf7, 0xec, 0xee, 0x5f, 0xf7, 0x07, 0x17, 0x4d, 0xc7,
+	0xb5, 0xf5, 0x8e, 0x39, 0xdc, 0xd0, 0x0f, 0x3b, 0xc6, 0x86, 0x7b, 0x66, 0x61, 0x67, 0xdd, 0xb2,
+	0x4d, 0xd7, 0x44, 0x25, 0xd1, 0xb5, 0x4e, 0xba, 0xe4, 0x15, 0x8f, 0xb3, 0x63, 0x9f, 0x59, 0xae,
+	0xb9, 0x61, 0xd9, 0xa6, 0x79, 0xc4, 0x78, 0x65, 0xd9, 0xeb, 0xa4, 0x1a, 0xfc, 0x7a, 0x64, 0x39,
+	0x2c, 0xf8, 0x00, 0x9f, 0x89, 0xbe, 0x95, 0x90, 0x9c, 0xa5, 0xdb, 0xfa, 0x40, 0x74, 0xae, 0x1d,
+	0x9b, 0xe6, 0x71, 0x1f, 0x6f, 0xd0, 0xd6, 0xe1, 0xe8, 0x68, 0xc3, 0x35, 0x06, 0xd8, 0x71, 0xf5,
+	0x81, 0xc5, 0x19, 0x96, 0x8f, 0xcd, 0x63, 0x93, 0x7e, 0x6e, 0x90, 0x2f, 0x46, 0x55, 0x7e, 0x09,
+	0x90, 0x55, 0xf1, 0xfb, 0x23, 0xec, 0xb8, 0xe8, 0x19, 0x48, 0xe3, 0x4e, 0xcf, 0xac, 0x49, 0x97,
+	0xa4, 0x2b, 0x85, 0xeb, 0xf2, 0x7a, 0x60, 0x4a, 0xeb, 0x9c, 0xab, 0xd9, 0xe9, 0x99, 0xad, 0x84,
+	0x4a, 0x39, 0xd1, 0x0d, 0xc8, 0x1c, 0xf5, 0x47, 0x4e, 0xaf, 0x96, 0xa4, 0x22, 0x2b, 0xd1, 0x22,
+	0xb7, 0x09, 0x4b, 0x2b, 0xa1, 0x32, 0x5e, 0xf2, 0x1b, 0x63, 0x78, 0x64, 0xd6, 0x52, 0xd3, 0x7e,
+	0xb3, 0x35, 0x3c, 0xa2, 0xbf, 0x21, 0x9c, 0xe8, 0x75, 0x00, 0x07, 0xbb, 0x9a, 0x69, 0xb9, 0x86,
+	0x39, 0xac, 0xa5, 0xa9, 0xdc, 0x5a, 0xb4, 0xdc, 0x3e, 0x76, 0x77, 0x29, 0x5b, 0x2b, 0xa1, 0xe6,
+	0x1d, 0xd1, 0x20, 0x1a, 0x8c, 0xa1, 0xe1, 0x6a, 0x9d, 0x9e, 0x6e, 0x0c, 0x6b, 0x99, 0x69, 0x1a,
+	0xb6, 0x86, 0x86, 0xdb, 0x20, 0x6c, 0x44, 0x83, 0x21, 0x1a, 0x64, 0xaa, 0xef, 0x8f, 0xb0, 0x7d,
+	0x56, 0x5b, 0x98, 0x36, 0xd5, 0x37, 0x09, 0x0b, 0x99, 0x2a, 0xe5, 0x45, 0x0d, 0x28, 0x1c, 0xe2,
+	0x63, 0x63, 0xa8, 0x1d, 0xf6, 0xcd, 0xce, 0x83, 0x5a, 0x96, 0x8a, 0x5e, 0x8a, 0x16, 0xdd, 0x24,
+	0x8c, 0x9b, 0x84, 0xaf, 0x95, 0x50, 0xe1, 0xd0, 0x6b, 0xa1, 0x97, 0x21, 0xd7, 0xe9, 0xe1, 0xce,
+	0x03, 0xcd, 0x3d, 0xad, 0xe5, 0xa8, 0x86, 0x47, 0xa3, 0x35, 0x34, 0x08, 0x57, 0xfb, 0xb4, 0x95,
+	0x50, 0xb3, 0x1d, 0xf6, 0x49, 0xe6, 0xdd, 0xc5, 0x7d, 0xe3, 0x04, 0xdb, 0x44, 0x3a, 0x3f, 0x6d,
+	0xde, 0xb7, 0x18, 0x1f, 0x95, 0xcf, 0x77, 0x45, 0x03, 0xbd, 0x02, 0x79, 0x3c, 0xec, 0xf2, 0x09,
+	0x00, 0x55, 0xb0, 0x1a, 0x73, 0x32, 0x86, 0x5d, 0x31, 0xfc, 0x1c, 0xe6, 0xdf, 0xe8, 0x05, 0x58,
+	0xe8, 0x98, 0x83, 0x81, 0xe1, 0xd6, 0x0a, 0x54, 0xf6, 0x91, 0x98, 0xa1, 0x53, 0x9e, 0x56, 0x42,
+	0xe5, 0xdc, 0x68, 0x1b, 0xca, 0x7d, 0xc3, 0x71, 0x35, 0x67, 0xa8, 0x5b, 0x4e, 0xcf, 0x74, 0x9d,
+	0x5a, 0x91, 0xca, 0x5f, 0x8e, 0x96, 0xdf, 0x36, 0x1c, 0x77, 0x5f, 0xb0, 0xb6, 0x12, 0x6a, 0xa9,
+	0xef, 0x27, 0x10, 0x6d, 0xe6, 0xd1, 0x11, 0xb6, 0x3d, 0x75, 0xb5, 0xd2, 0x34, 0x6d, 0xbb, 0x84,
+	0x57, 0x48, 0x13, 0x6d, 0xa6, 0x9f, 0x80, 0xde, 0x86, 0xa5, 0xbe, 0xa9, 0x77, 0x3d, 0x65, 0x5a,
+	0xa7, 0x37, 0x1a, 0x3e, 0xa8, 0x95, 0xa9, 0xca, 0x27, 0x63, 0x06, 0x68, 0xea, 0x5d, 0xa1, 0xa0,
+	0x41, 0xd8, 0x5b, 0x09, 0x75, 0xb1, 0x1f, 0x26, 0xa2, 0xf7, 0x60, 0x59, 0xb7, 0xac, 0xfe, 0x59,
+	0x58, 0x77, 0x85, 0xea, 0xbe, 0x12, 0xad, 0xbb, 0x4e, 0x24, 0xc2, 0xca, 0x91, 0x3e, 0x41, 0x45,
+	0xfb, 0x50, 0xb5, 0x6c, 0x6c, 0xe9, 0x36, 0xd6, 0x2c, 0xdb, 0xb4, 0x4c, 0x47, 0xef, 0xd7, 0xbe,
+	0x64, 0x87, 0xf2, 0xf1, 0x68, 0xd5, 0x7b, 0x8c, 0x7d, 0x8f, 0x73, 0xb7, 0x12, 0x6a, 0xc5, 0x0a,
+	0x92, 0xd0, 0x2d, 0x28, 0xe0, 0x53, 0x97, 0x9c, 0x91, 0x13, 0xd3, 0xc5, 0xb5, 0xaf, 0xa6, 0x1e,
+	0xf2, 0x26, 0xe5, 0x3c, 0x30, 0x5d, 0x4c, 0x0e, 0x39, 0xf6, 0x5a, 0xe8, 0x3e, 0x9c, 0x3b, 0xc1,
+	0xb6, 0x71, 0x74, 0x46, 0xb5, 0x68, 0xb4, 0xc7, 0x21, 0xb7, 0xfd, 0x6b, 0xa6, 0xef, 0x6a, 0xb4,
+	0xbe, 0x03, 0x2a, 0x43, 0x34, 0x34, 0x85, 0x44, 0x2b, 0xa1, 0x2e, 0x9d, 0x4c, 0x92, 0xd9, 0xe4,
+	0xcd, 0x0e, 0x76, 0x9c, 0xf1, 0xe4, 0xff, 0x34, 0x63, 0xf2, 0x94, 0x3d, 0x38, 0xf9, 0x00, 0x69,
+	0x33, 0x0b, 0x99, 0x13, 0xbd, 0x3f, 0xc2, 0xca, 0x93, 0x50, 0xf0, 0x19, 0x48, 0x54, 0x83, 0xec,
+	0x00, 0x3b, 0x8e, 0x7e, 0x8c, 0xa9, 0x35, 0xcd, 0xab, 0xa2, 0xa9, 0x94, 0xa1, 0xe8, 0x37, 0x8b,
+	0xca, 0x00, 0x0a, 0x3e, 0x93, 0x47, 0x04, 0x4f, 0xb0, 0x4d, 0x67, 0xce, 0x05, 0x79, 0x13, 0x5d,
+	0x86, 0x12, 0xbd, 0x84, 0x9a, 0xe8, 0x27, 0x36, 0x37, 0xad, 0x16, 0x29, 0xf1, 0x80, 0x33, 0xad,
+	0x41, 0xc1, 0xba, 0x6e, 0x79, 0x2c, 0x29, 0xca, 0x02, 0xd6, 0x75, 0x8b, 0x33, 0x28, 0x2f, 0x43,
+	0x35, 0x6c, 0x29, 0x51, 0x15, 0x52, 0x0f, 0xf0, 0x19, 0xff, 0x1f, 0xf9, 0x44, 0xcb, 0x7c, 0x5a,
+	0xf4, 0x1f, 0x79, 0x95, 0xcf, 0xf1, 0xd7, 0x49, 0xa8, 0x86, 0x8d, 0x24, 0xfa, 0x17, 0x48, 0x13,
+	0x4f, 0xe3, 0x39, 0x0d, 0xe6, 0x86, 0xd6, 0x85, 0x1b, 0x5a, 0x6f, 0x0b, 0x37, 0xb4, 0x99, 0xfb,
+	0xe4, 0xb3, 0xb5, 0xc4, 0x47, 0xbf, 0x5f, 0x93, 0x54, 0x2a, 0x81, 0x2e, 0x12, 0xbb, 0xa6, 0x1b,
+	0x43, 0xcd, 0xe8, 0xf2, 0xff, 0x64, 0x69, 0x7b, 0xab, 0x8b, 0xb6, 0xa0, 0xda, 0x31, 0x87, 0x0e,
+	0x1e, 0x3a, 0x23, 0x47, 0x63, 0x6e, 0xae, 0x96, 0x8a, 0xb4, 0x3d, 0x0d, 0xc1, 0xb6, 0x47, 0xb9,
+	0xd4, 0x4a, 0x27, 0x48, 0x40, 0xb7, 0x00, 0x4e, 0xf4, 0xbe, 0xd1, 0xd5, 0x5d, 0xd3, 0x76, 0x6a,
+	0xe9, 0x4b, 0xa9, 0x08, 0x25, 0x07, 0x82, 0xe1, 0x9e, 0xd5, 0xd5, 0x5d, 0xbc, 0x99, 0x26, 0x23,
+	0x55, 0x7d, 0x72, 0xe8, 0x09, 0xa8, 0xe8, 0x96, 0xa5, 0x39, 0xae, 0xee, 0x62, 0xed, 0xf0, 0xcc,
+	0xc5, 0x0e, 0x75, 0x22, 0x45, 0xb5, 0xa4, 0x5b, 0xd6, 0x3e, 0xa1, 0x6e, 0x12, 0x22, 0x7a, 0x1c,
+	0xca, 0xc4, 0x65, 0x18, 0x7a, 0x5f, 0xeb, 0x61, 0xe3, 0xb8, 0xe7, 0x52, 0x77, 0x91, 0x52, 0x4b,
+	0x9c, 0xda, 0xa2, 0x44, 0xa5, 0x0b, 0x45, 0xbf, 0xc3, 0x40, 0x08, 0xd2, 0x5d, 0xdd, 0xd5, 0xe9,
+	0x22, 0x16, 0x55, 0xfa, 0x4d, 0x68, 0x96, 0xee, 0xf6, 0xf8, 0xd2, 0xd0, 0x6f, 0x74, 0x1e, 0x16,
+	0xb8, 0xda, 0x14, 0x55, 0xcb, 0x5b, 0x64, 0xbf, 0x2c, 0xdb, 0x3c, 0xc1, 0xd4, 0x37, 0xe6, 0x54,
+	0xd6, 0x50, 0xfe, 0x2a, 0xc1, 0xe2, 0x84, 0x73, 0x21, 0x7a, 0x7b, 0xba, 0xd3, 0x13, 0xff, 0x22,
+	0xdf, 0xe8, 0x39, 0xa2, 0x57, 0xef, 0x62, 0x9b, 0x3b, 0xf2, 0xf3, 0xe3, 0x05, 0x62, 0xc1, 0x49,
+	0x8b, 0xf6, 0xf2, 0x85, 0xe1, 0xbc, 0xe8, 0x2e, 0x54, 0xfb, 0xba, 0xe3, 0x6a, 0xcc, 0x64, 0x6b,
+	0x3e, 0xa7, 0x1e, 0x76, 0x50, 0xdb, 0xba, 0x30, 0xf1, 0xe4, 0x90, 0x73, 0x35, 0xe5, 0x7e, 0x80,
+	0x8a, 0xf6, 0x60, 0xf9, 0xf0, 0xec, 0xbf, 0xf4, 0xa1, 0x6b, 0x0c, 0xb1, 0x36, 0xb1, 0x67, 0x17,
+	0x42, 0x2a, 0x9b, 0x27, 0x46, 0x17, 0x0f, 0x3b, 0x62, 0xb3, 0x96, 0x3c, 0x51, 0x6f, 0x33, 0x1d,
+	0x65, 0x0f, 0xca, 0x41, 0xd7, 0x88, 0xca, 0x90, 0x74, 0x4f, 0xf9, 0xd4, 0x93, 0xee, 0x29, 0x5a,
+	0x87, 0x34, 0x99, 0x20, 0x9d, 0x76, 0x79, 0x22, 0x16, 0xe1, 0x52, 0xed, 0x33, 0x0b, 0xab, 0x94,
+	0x4f, 0x51, 0xa0, 0x1a, 0x76, 0x97, 0x61, 0x9d, 0xca, 0x55, 0xa8, 0x84, 0x3c, 0xa2, 0x6f, 0xdf,
+	0x24, 0xff, 0xbe, 0x29, 0x15, 0x28, 0x05, 0x1c, 0xa0, 0x72, 0x1e, 0x96, 0xa3, 0x3c, 0x9a, 0x72,
+	0x04, 0xcb, 0x51, 0xbe, 0x09, 0xdd, 0x80, 0x9c, 0xe7, 0xd2, 0xd8, 0x0d, 0x0c, 0xaf, 0x93, 0x60,
+	0x55, 0x3d, 0x46, 0x72, 0xf1, 0xc8, 0x61, 0xa6, 0xa7, 0x20, 0x49, 0x87, 0x9d, 0xd5, 0x2d, 0xab,
+	0xa5, 0x3b, 0x3d, 0xe5, 0x3e, 0xd4, 0xe2, 0x1c, 0x56, 0x68, 0x12, 0x69, 0xef, 0xf0, 0x9d, 0x87,
+	0x85, 0x23, 0xd3, 0x1e, 0xe8, 0x2e, 0x55, 0x56, 0x52, 0x79, 0x8b, 0x1c, 0x4a, 0xe6, 0xbc, 0x52,
+	0x94, 0xcc, 0x1a, 0x8a, 0x06, 0x17, 0x63, 0xdd, 0x16, 0x11, 0x31, 0x86, 0x5d, 0xcc, 0x56, 0xb3,
+	0xa4, 0xb2, 0xc6, 0x58, 0x11, 0x1b, 0x2c, 0x6b, 0x90, 0xdf, 0x3a, 0x78, 0x48, 0xce, 0x6c, 0x8a,
+	0xde, 0x10, 0xde, 0x52, 0xfe, 0x28, 0xc1, 0xf9, 0x68, 0xef, 0x85, 0x2e, 0x41, 0x71, 0xa0, 0x9f,
+	0x6a, 0xee, 0x29, 0xbf, 0xc2, 0x6c, 0x33, 0x60, 0xa0, 0x9f, 0xb6, 0x4f, 0xd9, 0xfd, 0xad, 0x42,
+	0xca, 0x3d, 0x75, 0x6a, 0xc9, 0x4b, 0xa9, 0x2b, 0x45, 0x95, 0x7c, 0xa2, 0x7d, 0x58, 0xec, 0x9b,
+	0x1d, 0xbd, 0xaf, 0xf9, 0x8e, 0x3a, 0x3f, 0xe5, 0x8f, 0x85, 0x8f, 0x24, 0x75, 0x67, 0xb8, 0x3b,
+	0x71, 0xd2, 0x2b, 0x54, 0xc3, 0xf8, 0x12, 0xf8, 0x96, 0x32, 0x1d, 0xb8, 0xc7, 0x57, 0xa9, 0x8f,
+	0xb2, 0x4c, 0x07, 0xdb, 0x9a, 0xde, 0xed, 0xda, 0xd8, 0x11, 0x76, 0xa6, 0x22, 0xe8, 0x75, 0x46,
+	0x56, 0x5e, 0xf3, 0xee, 0xf6, 0xd8, 0xa7, 0x46, 0xde, 0xed, 0xf1, 0xbf, 0x92, 0x81, 0xb3, 0xf7,
+	0x23, 0x09, 0xe4, 0x78, 0x2f, 0x1a, 0xa9, 0xea, 0x29, 0x58, 0xf4, 0xee, 0xa5, 0x37, 0x3e, 0xb6,
+	0x29, 0x55, 0xaf, 0x83, 0x0f, 0x30, 0xd6, 0x56, 0x3d, 0x0e, 0xe5, 0x90, 0x8b, 0x4f, 0x33, 0x4b,
+	0x7a, 0xe2, 0xff, 0xbf, 0xf2, 0x71, 0xd2, 0xb7, 0x8d, 0x01, 0xa7, 0xfb, 0x1d, 0x5a, 0x30, 0xbe,
+	0xdd, 0xa9, 0xf1, 0x76, 0xdf, 0x83, 0x65, 0xbe, 0xd2, 0xdd, 0xc0, 0x8e, 0xa7, 0xe7, 0xb7, 0x6b,
+	0x48, 0x28, 0x18, 0xf7, 0xc6, 0xda, 0xb6, 0xcc, 0x37, 0xb6, 0x6d, 0x3f, 0x2c, 0x40, 0x4e, 0xc5,
+	0x8e, 0x45, 0xdc, 0x1d, 0x7a, 0x1d, 0xf2, 0xf8, 0xb4, 0x83, 0x19, 0x3e, 0x92, 0x62, 0x02, 0x30,
+	0xc6, 0xdb, 0x14, 0x7c, 0x24, 0xcc, 0xf7, 0x84, 0xd0, 0xb3, 0x1c, 0xfb, 0xc5, 0x01, 0x39, 0x2e,
+	0xec, 0x07, 0x7f, 0xcf, 0x09, 0xf0, 0x97, 0x8a, 0x89, 0xec, 0x99, 0x4c, 0x08, 0xfd, 0x3d, 0xcb,
+	0xd1, 0x5f, 0x7a, 0xea, 0x8f, 0x02, 0xf0, 0xaf, 0x1e, 0x80, 0x7f, 0x99, 0xa9, 0xd3, 0x8b, 0xc1,
+	0x7f, 0xf5, 0x00, 0xfe, 0x5b, 0x98, 0xaa, 0x22, 0x06, 0x00, 0x3e, 0x27, 0x00, 0x60, 0x76, 0xea,
+	0x74, 0x43, 0x08, 0xf0, 0x56, 0x10, 0x01, 0xe6, 0x22, 0x0d, 0x87, 0x90, 0x8d, 0x85, 0x80, 0x37,
+	0x7d, 0x10, 0x30, 0x1f, 0x83, 0xc1, 0x98, 0x8a, 0x08, 0x0c, 0x58, 0x0f, 0x60, 0x40, 0x98, 0x3a,
+	0xf7, 0x18, 0x10, 0xf8, 0xaa, 0x1f, 0x04, 0x16, 0x62, 0x50, 0x24, 0x3f, 0x22, 0x51, 0x28, 0xf0,
+	0x45, 0x0f, 0x05, 0x16, 0x63, 0x00, 0x2c, 0x1f, 0x7d, 0x18, 0x06, 0xde, 0x9d, 0x80, 0x81, 0x0c,
+	0xb8, 0xfd, 0x53, 0x8c, 0x82, 0x19, 0x38, 0xf0, 0xee, 0x04, 0x0e, 0x2c, 0x4f, 0x55, 0x37, 0x03,
+	0x08, 0xbe, 0x13, 0x0d, 0x04, 0xe3, 0xc0, 0x1a, 0x1f, 0xe2, 0x7c, 0x48, 0xf0, 0xdf, 0x63, 0x90,
+	0x60, 0x35, 0x06, 0x0e, 0x31, 0xe5, 0x73, 0x43, 0xc1, 0x76, 0x3c, 0x14, 0x7c, 0x22, 0x46, 0xf7,
+	0x1c, 0x58, 0xb0, 0x19, 0x89, 0x05, 0x1f, 0x8b, 0x35, 0x45, 0x31, 0x60, 0x50, 0x9f, 0x01, 0x06,
+	0xaf, 0xc5, 0x28, 0x7c, 0x08, 0x34, 0xd8, 0x8e, 0x47, 0x83, 0xf1, 0xf3, 0x9f, 0x1f, 0x0e, 0x5e,
+	0x85, 0x45, 0x21, 0xe6, 0x19, 0x5c, 0x12, 0xc7, 0x60, 0xdb, 0x36, 0x6d, 0x8e, 0xb4, 0x58, 0x43,
+	0xb9, 0x02, 0x45, 0x8f, 0x75, 0x3a, 0x74, 0xa4, 0xd1, 0xa2, 0xcf, 0xa8, 0x2a, 0x3f, 0x93, 0xa0,
+	0xe8, 0xb7, 0x98, 0x01, 0x1c, 0x91, 0xe7, 0x38, 0xc2, 0x87, 0x28, 0x93, 0x41, 0x44, 0xb9, 0x06,
+	0x05, 0x12, 0x07, 0x86, 0xc0, 0xa2, 0x6e, 0x09, 0xb0, 0x88, 0xae, 0xc1, 0x22, 0xf5, 0x81, 0x0c,
+	0x77, 0x06, 0x22, 0x96, 0x0a, 0xe9, 0x60, 0x17, 0x9d, 0x92, 0xd1, 0x3f, 0xc3, 0x92, 0x8f, 0xd7,
+	0x8b, 0x2f, 0x59, 0xf4, 0x52, 0xf5, 0xb8, 0xeb, 0x3c, 0xd0, 0xbc, 0x0b, 0x8b, 0x13, 0x26, 0x9b,
+	0x0c, 0xbf, 0x63, 0x76, 0x31, 0x8f, 0xfe, 0xe8, 0x37, 0x71, 0xd1, 0x7d, 0xf3, 0x98, 0xc7, 0x78,
+	0xe4, 0x93, 0x70, 0x79, 0x1e, 0x24, 0xcf, 0x5c, 0x84, 0xf2, 0x0b, 0x09, 0x16, 0x27, 0xec, 0x77,
+	0x24, 0x8c, 0x94, 0xbe, 0x0b, 0x18, 0x99, 0xfc, 0x86, 0x30, 0xd2, 0x1f, 0x79, 0xa7, 0x82, 0x91,
+	0xf7, 0x9f, 0x25, 0x28, 0x05, 0x7c, 0xc8, 0x37, 0x5f, 0x8d, 0x71, 0x18, 0x9d, 0xa1, 0x7b, 0xc5,
+	0x1a, 0x02, 0xe6, 0x2f, 0xd0, 0xff, 0x06, 0x61, 0x7e, 0x96, 0xd2, 0x58, 0x03, 0xbd, 0x00, 0x79,
+	0x9a, 0xad, 0xd6, 0x4c, 0xcb, 0xe1, 0x0e, 0xeb, 0xe2, 0x78, 0xa6, 0x2c, 0x2d, 0xbd, 0xbe, 0x47,
+	0x38, 0x76, 0x2d, 0x47, 0xcd, 0x59, 0xfc, 0xcb, 0x17, 0xf0, 0xe5, 0x03, 0x01, 0xdf, 0x23, 0x90,
+	0x27, 0x63, 0x77, 0x2c, 0xbd, 0x83, 0xa9, 0xfb, 0xc9, 0xab, 0x63, 0x82, 0xf2, 0x1e, 0xa0, 0x49,
+	0xf7, 0x87, 0x6e, 0xc3, 0x02, 0x3e, 0xc1, 0x43, 0x97, 0xec, 0x17, 0x59, 0xea, 0xe5, 0x89, 0x08,
+	0x09, 0x0f, 0xdd, 0xcd, 0x1a, 0x59, 0xe0, 0xaf, 0x3f, 0x5b, 0xab, 0x32, 0xde, 0xa7, 0xcd, 0x81,
+	0xe1, 0xe2, 0x81, 0xe5, 0x9e, 0xa9, 0x5c, 0x5a, 0xf9, 0x69, 0x12, 0x2a, 0x42, 0xbd, 0xc0, 0x80,
+	0x51, 0xeb, 0x2a, 0x2e, 0x4e, 0xd2, 0x07, 0xc0, 0xe7, 0x5b, 0xeb, 0x55, 0x80, 0x63, 0xdd, 0xd1,
+	0x3e, 0xd0, 0x87, 0x2e, 0xee, 0xf2, 0x05, 0xf7, 0x51, 0x90, 0x0c, 0x39, 0xd2, 0x1a, 0x39, 0xb8,
+	0xcb, 0x73, 0x01, 0x5e, 0xdb, 0x37, 0xcb, 0xec, 0xb7, 0x99, 0x65, 0x70, 0x85, 0x73, 0xa1, 0x15,
+	0x46, 0x2b, 0x90, 0xb3, 0x6c, 0xc3, 0xb4, 0x0d, 0xf7, 0x8c, 0x99, 0xf8, 0x94, 0xea, 0x11, 0xd0,
+	0x05, 0x0f, 0x45, 0x7d, 0x95, 0x0d, 0xc0, 0xa8, 0xff, 0x4d, 0xc2, 0xe2, 0x44, 0x54, 0xf0, 0x8f,
+	0xb6, 0x76, 0xca, 0xff, 0xd1, 0x94, 0x57, 0x30, 0xb2, 0x41, 0x6f, 0xfa, 0x61, 0xd0, 0x88, 0xde,
+	0x75, 0x71, 0x4e, 0xe7, 0x33, 0x09, 0xd5, 0x93, 0x20, 0xd9, 0x41, 0x07, 0x70, 0x21, 0x64, 0xa9,
+	0x3c, 0xc5, 0xc9, 0xb9, 0x0c, 0xd6, 0xb9, 0xa0, 0xc1, 0x12, 0x7a, 0xc7, 0xab, 0x94, 0xfa, 0x56,
+	0xf7, 0x68, 0x0b, 0xca, 0x62, 0x19, 0x38, 0xa2, 0x89, 0xda, 0xf5, 0xcb, 0x50, 0xb2, 0xb1, 0x4b,
+	0x52, 0x7a, 0x01, 0xe4, 0x57, 0x64, 0x44, 0x9e, 0xfb, 0xda, 0x81, 0x73, 0x91, 0xd1, 0x1a, 0x7a,
+	0x1e, 0xf2, 0xe3, 0x30, 0x4f, 0x8a, 0x04, 0x46, 0x82, 0x59, 0x1d, 0x73, 0x2a, 0x3f, 0x97, 0xe0,
+	0x5c, 0x64, 0xbc, 0x86, 0x1a, 0xb0, 0x60, 0x63, 0x67, 0xd4, 0x67, 0x09, 0x8b, 0xf2, 0xf5, 0xa7,
+	0xe6, 0x89, 0xf2, 0x08, 0x75, 0xd4, 0x77, 0x55, 0x2e, 0xaa, 0xfc, 0x07, 0x2c, 0x30, 0x0a, 0x2a,
+	0x40, 0xf6, 0xde, 0xce, 0x9d, 0x9d, 0xdd, 0xb7, 0x76, 0xaa, 0x09, 0x04, 0xb0, 0x50, 0x6f, 0x34,
+	0x9a, 0x7b, 0xed, 0xaa, 0x84, 0xf2, 0x90, 0xa9, 0x6f, 0xee, 0xaa, 0xed, 0x6a, 0x92, 0x90, 0xd5,
+	0xe6, 0xbf, 0x35, 0x1b, 0xed, 0x6a, 0x0a, 0x2d, 0x42, 0x89, 0x7d, 0x6b, 0xb7, 0x77, 0xd5, 0xbb,
+	0xf5, 0x76, 0x35, 0xed, 0x23, 0xed, 0x37, 0x77, 0x6e, 0x35, 0xd5, 0x6a, 0x46, 0x79, 0x16, 0x2e,
+	0x8a, 0x71, 0x4c, 0xa6, 0x5c, 0xbc, 0xcc, 0x87, 0xe4, 0xcb, 0x7c, 0x28, 0xdf, 0x4f, 0x82, 0x2c,
+	0x64, 0x22, 0x92, 0x28, 0xad, 0xd0, 0xb4, 0x9f, 0x99, 0x3b, 0x56, 0x0c, 0xcd, 0x9d, 0x40, 0x75,
+	0x1b, 0x1f, 0x61, 0xb7, 0xd3, 0x63, 0xc1, 0x27, 0x73, 0x7c, 0x25, 0xb5, 0xc4, 0xa9, 0x54, 0xc8,
+	0x61, 0x6c, 0xff, 0x89, 0x3b, 0xae, 0xc6, 0x6c, 0x07, 0x3b, 0x6c, 0x79, 0xb5, 0xc4, 0xa8, 0xfb,
+	0x8c, 0xa8, 0xdc, 0x7f, 0xa8, 0x95, 0xcc, 0x43, 0x46, 0x6d, 0xb6, 0xd5, 0xb7, 0xab, 0x29, 0x84,
+	0xa0, 0x4c, 0x3f, 0xb5, 0xfd, 0x9d, 0xfa, 0xde, 0x7e, 0x6b, 0x97, 0xac, 0xe4, 0x12, 0x54, 0xc4,
+	0x4a, 0x0a, 0x62, 0x46, 0x79, 0x0a, 0x2e, 0xc4, 0x04, 0xab, 0x02, 0xe9, 0x4b, 0x1e, 0xd2, 0x57,
+	0x6e, 0x8e, 0x1d, 0x8f, 0x2f, 0x83, 0x32, 0x99, 0x9d, 0x90, 0xa2, 0xb2, 0x13, 0x3f, 0x91, 0x60,
+	0x65, 0x4a, 0xd4, 0x89, 0x76, 0x61, 0xc1, 0x71, 0x75, 0x77, 0xe4, 0xf0, 0x3d, 0x78, 0x71, 0xfe,
+	0x88, 0x75, 0x9d, 0xd1, 0xf6, 0xa9, 0xb8, 0xca, 0xd5, 0x28, 0x37, 0xa0, 0xe8, 0xa7, 0xc7, 0x2f,
+	0xe1, 0xf8, 0x04, 0x26, 0x95, 0x1f, 0x4b, 0xfe, 0x05, 0x09, 0x26, 0x51, 0xee, 0x86, 0x46, 0xf8,
+	0xfc, 0x7c, 0x51, 0xef, 0xba, 0xf8, 0x08, 0x8d, 0xef, 0x79, 0x28, 0x07, 0x7b, 0xe6, 0x1b, 0xe1,
+	0x6f, 0x53, 0x50, 0x09, 0x99, 0x32, 0xf4, 0x0c, 0x64, 0x18, 0xd0, 0x8c, 0xae, 0x43, 0x53, 0x1b,
+	0xcc, 0x58, 0xd5, 0xcc, 0xa1, 0xa8, 0x90, 0x62, 0x9e, 0x32, 0x99, 0x34, 0x97, 0x2c, 0xfd, 0x23,
+	0x52, 0x2a, 0x5c, 0xd0, 0xe3, 0x27, 0xf5, 0x4d, 0xcf, 0x1a, 0xd7, 0x52, 0x61, 0x68, 0xcb, 0x84,
+	0x3d, 0x2b, 0xce, 0xa5, 0xc7, 0x12, 0xe8, 0xc5, 0x71, 0x74, 0x3d, 0x91, 0x22, 0xe2, 0xc2, 0xac,
+	0x9b, 0x8b, 0x0a, 0x6e, 0x74, 0x1d, 0x32, 0xe4, 0x48, 0xd9, 0x02, 0x75, 0xad, 0x4c, 0xc8, 0x91,
+	0x5e, 0x31, 0x4f, 0xca, 0x4a, 0x60, 0xb8, 0x57, 0xd5, 0x17, 0xe0, 0x6a, 0x62, 0xb0, 0x5e, 0xc1,
+	0x45, 0x0c, 0xd6, 0x13, 0x41, 0x2f, 0x41, 0xce, 0xd2, 0x6d, 0x72, 0x4d, 0x5d, 0x01, 0xa5, 0x26,
+	0x86, 0xbb, 0xa7, 0xdb, 0xa4, 0x34, 0x24, 0x86, 0x6b, 0xb1, 0x26, 0xda, 0x80, 0x34, 0x59, 0x7d,
+	0x81, 0x91, 0xe4, 0xb0, 0x58, 0x7d, 0xb3, 0xb1, 0xc5, 0x65, 0x28, 0xa3, 0xd2, 0x80, 0x82, 0x6f,
+	0xa7, 0xd0, 0x0a, 0xe4, 0x07, 0x7a, 0x30, 0xef, 0x9a, 0x1b, 0xe8, 0x3c, 0xeb, 0x7a, 0x01, 0xb2,
+	0xa4, 0xf3, 0x58, 0x77, 0x44, 0x8e, 0x72, 0xa0, 0x9f, 0xbe, 0xa1, 0x3b, 0xca, 0xbb, 0x50, 0x0e,
+	0xa6, 0xd8, 0x88, 0x45, 0xb4, 0xcd, 0xd1, 0xb0, 0x4b, 0x75, 0x64, 0x54, 0xd6, 0x20, 0xc5, 0x79,
+	0xb2, 0x42, 0x22, 0x30, 0x0f, 0xbb, 0x0d, 0xb2, 0x94, 0xbe, 0x04, 0x1d, 0xe3, 0x55, 0x8e, 0x01,
+	0x4d, 0x66, 0x6c, 0x63, 0x7e, 0x70, 0x33, 0xf8, 0x83, 0xb5, 0x98, 0xcc, 0x6f, 0xf4, 0x8f, 0x4e,
+	0x21, 0x43, 0xfd, 0x2c, 0xf1, 0x99, 0xb4, 0xda, 0xc0, 0xe1, 0x19, 0xf9, 0x46, 0xef, 0x02, 0xe8,
+	0xae, 0x6b, 0x1b, 0x87, 0xa3, 0xb1, 0xfa, 0x47, 0xa3, 0xbc, 0x74, 0x5d, 0x70, 0x6d, 0x3e, 0xc2,
+	0xdd, 0xf5, 0xf2, 0x58, 0xd0, 0xe7, 0xb2, 0x7d, 0xea, 0x94, 0x1d, 0x28, 0x07, 0x65, 0xfd, 0xb5,
+	0xbe, 0x62, 0x44, 0xad, 0xcf, 0x03, 0x01, 0x1e, 0x84, 0x48, 0xb1, 0x8a, 0x12, 0x6d, 0x28, 0xff,
+	0x2f, 0x41, 0xae, 0x7d, 0xca, 0xad, 0x78, 0x4c, 0x51, 0x63, 0x2c, 0x9a, 0xf4, 0x27, 0xf1, 0x59,
+	0x95, 0x24, 0xe5, 0x55, 0x5e, 0x5e, 0xf5, 0xbc, 0x54, 0x7a, 0xbe, 0x8c, 0x94, 0x48, 0xdd, 0x72,
+	0xbf, 0x7c, 0x13, 0xf2, 0xde, 0xb5, 0x24, 0x18, 0x57, 0xa4, 0xa3, 0x25, 0x0e, 0xab, 0x58, 0x93,
+	0x0c, 0xc6, 0x32, 0x3f, 0xe0, 0x45, 0x82, 0x94, 0xca, 0x1a, 0xca, 0xf7, 0x24, 0xa8, 0x84, 0x42,
+	0x33, 0xf4, 0x12, 0x64, 0xad, 0xd1, 0xa1, 0x26, 0x56, 0x27, 0x70, 0xc6, 0x05, 0xe8, 0x19, 0x1d,
+	0xf6, 0x8d, 0xce, 0x1d, 0x7c, 0x26, 0xc6, 0x62, 0x8d, 0x0e, 0xef, 0xb0, 0x25, 0x64, 0x3f, 0x49,
+	0xfa, 0x7e, 0x82, 0x36, 0x60, 0x89, 0xe3, 0xa8, 0x23, 0xcd, 0x32, 0x1d, 0x07, 0x3b, 0xd4, 0x4c,
+	0x7c, 0xc9, 0xc0, 0xd6, 0x22, 0xc3, 0x4d, 0x47, 0x7b, 0x5e, 0x8f, 0xf2, 0x03, 0x09, 0x72, 0xe2,
+	0x04, 0xa1, 0x7f, 0xf5, 0xdb, 0x25, 0x36, 0xa0, 0x5a, 0x5c, 0x70, 0xc9, 0x87, 0x33, 0x16, 0x20,
+	0xc8, 0xdd, 0x31, 0x8e, 0x87, 0x22, 0x89, 0xcd, 0xec, 0x69, 0x92, 0x6e, 0x65, 0x85, 0x75, 0x6c,
+	0x0b, 0x44, 0x8e, 0x14, 0x28, 0x9e, 0x98, 0xae, 0x31, 0x3c, 0xd6, 0xd8, 0x24, 0x38, 0x46, 0x28,
+	0x30, 0xe2, 0x1e, 0x5d, 0xb0, 0x5f, 0x49, 0x50, 0x0d, 0x1f, 0xf2, 0xbf, 0xe3, 0x10, 0x27, 0xbd,
+	0x72, 0x2a, 0xc2, 0x2b, 0xa3, 0xc7, 0x42, 0x33, 0x49, 0x4f, 0x4e, 0xe4, 0x2f, 0x12, 0xe4, 0x84,
+	0x2f, 0x20, 0x46, 0xcd, 0xbb, 0x8f, 0xe5, 0x89, 0x5c, 0xb4, 0x60, 0x1b, 0x97, 0xff, 0x82, 0x33,
+	0x4e, 0x3e, 0xec, 0x8c, 0xe3, 0x2a, 0x22, 0xa2, 0x84, 0x9e, 0x7e, 0xe8, 0x12, 0xfa, 0xd3, 0x80,
+	0x5c, 0xd3, 0xd5, 0xfb, 0x5a, 0x60, 0xda, 0x0c, 0x48, 0x55, 0x69, 0xcf, 0x81, 0x6f, 0xee, 0xff,
+	0x23, 0x41, 0xce, 0x0b, 0x8e, 0x1f, 0xb6, 0x9a, 0x77, 0x1e, 0x16, 0x78, 0x0c, 0xc8, 0xca, 0x79,
+	0xbc, 0xe5, 0x15, 0x63, 0xd2, 0xbe, 0x62, 0x8c, 0x0c, 0xb9, 0x01, 0x76, 0x75, 0x8a, 0x0f, 0x58,
+	0x02, 0xc8, 0x6b, 0x5f, 0x7b, 0x09, 0x0a, 0xbe, 0xb2, 0x2a, 0xb1, 0x47, 0x3b, 0xcd, 0xb7, 0xaa,
+	0x09, 0x39, 0xfb, 0xe1, 0xc7, 0x97, 0x52, 0x3b, 0xf8, 0x03, 0x72, 0x97, 0xd5, 0x66, 0xa3, 0xd5,
+	0x6c, 0xdc, 0xa9, 0x4a, 0x72, 0xe1, 0xc3, 0x8f, 0x2f, 0x65, 0x55, 0x4c, 0x53, 0xd9, 0xd7, 0xfe,
+	0x5b, 0x82, 0xa2, 0x7f, 0x53, 0x82, 0x21, 0x06, 0x82, 0xf2, 0xad, 0x7b, 0x7b, 0xdb, 0x5b, 0x8d,
+	0x7a, 0xbb, 0xa9, 0x1d, 0xec, 0xb6, 0x9b, 0x55, 0x09, 0x5d, 0x80, 0xa5, 0xed, 0xad, 0x37, 0x5a,
+	0x6d, 0xad, 0xb1, 0xbd, 0xd5, 0xdc, 0x69, 0x6b, 0xf5, 0x76, 0xbb, 0xde, 0xb8, 0x53, 0x4d, 0xa2,
+	0x8b, 0xb0, 0xdc, 0xd8, 0xdd, 0xb9, 0xbd, 0xbd, 0xd5, 0x68, 0x6f, 0xed, 0xbc, 0xa1, 0xed, 0xa9,
+	0xbb, 0x7b, 0xbb, 0xfb, 0xf5, 0xed, 0xea, 0x97, 0x59, 0xb4, 0x02, 0xe7, 0xb7, 0x76, 0x0e, 0xea,
+	0xdb, 0x5b, 0xb7, 0x38, 0xb9, 0xa9, 0x92, 0x8f, 0xdd, 0xdb, 0xd5, 0xaf, 0xb2, 0xd7, 0x7f, 0x53,
+	0x84, 0x0a, 0x71, 0x75, 0x24, 0x76, 0x36, 0x3a, 0x3a, 0xcd, 0x5a, 0xbd, 0x06, 0x69, 0x9a, 0xb8,
+	0x9b, 0xf2, 0x60, 0x4e, 0x9e, 0x56, 0x50, 0x41, 0x9b, 0x90, 0xa1, 0xf9, 0x3c, 0x34, 0xed, 0xfd,
+	0x9c, 0x3c, 0xb5, 0xbe, 0x42, 0x06, 0x41, 0xef, 0xe4, 0x94, 0xe7, 0x74, 0xf2, 0xb4, 0x62, 0x0b,
+	0xda, 0x81, 0xfc, 0x38, 0x11, 0x37, 0xeb, 0x71, 0x9d, 0x3c, 0xb3, 0xfc, 0x42, 0xf4, 0x8d, 0xd3,
+	0x06, 0xb3, 0x9e, 0x9c, 0xc9, 0x33, 0xad, 0x3f, 0x6a, 0x41, 0x56, 0x24, 0x70, 0xa6, 0x3f, 0x7f,
+	0x93, 0x67, 0x94, 0x46, 0xc8, 0x72, 0xb3, 0x04, 0xdb, 0xb4, 0x37, 0x7c, 0xf2, 0xd4, 0xfa, 0x0e,
+	0x6a, 0xc2, 0x02, 0xc7, 0xc1, 0x53, 0x1f, 0xb4, 0xc9, 0xd3, 0x0b, 0x1d, 0x64, 0x91, 0xc6, 0xd9,
+	0xca, 0x59, 0xef, 0x11, 0xe5, 0x99, 0x05, 0x2b, 0xf4, 0x26, 0x80, 0x2f, 0x89, 0x36, 0xf3, 0xa1,
+	0xa1, 0x3c, 0xbb, 0x10, 0x85, 0xee, 0x40, 0xce, 0x4b, 0x7c, 0xcc, 0x78, 0xf8, 0x27, 0xcf, 0xaa,
+	0x09, 0xa1, 0x77, 0xa0, 0x14, 0xc4, 0xfc, 0xf3, 0x3c, 0xe7, 0x93, 0xe7, 0x2a, 0xf6, 0x10, 0xdd,
+	0x41, 0xf8, 0x3f, 0xcf, 0xe3, 0x3e, 0x79, 0xae, 0xca, 0x0f, 0x3a, 0x82, 0xc5, 0x49, 0x70, 0x3e,
+	0xef, 0x4b, 0x3f, 0x79, 0xee, 0x4a, 0x10, 0x32, 0x00, 0x45, 0x00, 0xfa, 0xb9, 0x9f, 0xfd, 0xc9,
+	0xf3, 0x97, 0x85, 0xd0, 0x7d, 0xa8, 0x84, 0x31, 0xf2, 0x7c, 0x6f, 0x00, 0xe5, 0x39, 0xeb, 0x43,
+	0xe4, 0x30, 0xfa, 0x80, 0xf5, 0xcc, 0x07, 0x81, 0xf2, 0xec, 0x32, 0x11, 0xea, 0xc3, 0x52, 0x14,
+	0xda, 0x9e, 0xff, 0x71, 0xa0, 0xfc, 0x10, 0xa5, 0x23, 0xb6, 0x44, 0x41, 0xd4, 0x3c, 0xdf, 0x4b,
+	0x41, 0x79, 0xce, 0x12, 0xd2, 0xe6, 0x2b, 0x9f, 0x7c, 0xbe, 0x2a, 0x7d, 0xfa, 0xf9, 0xaa, 0xf4,
+	0x87, 0xcf, 0x57, 0xa5, 0x8f, 0xbe, 0x58, 0x4d, 0x7c, 0xfa, 0xc5, 0x6a, 0xe2, 0x77, 0x5f, 0xac,
+	0x26, 0xde, 0xb9, 0x7c, 0x6c, 0xb8, 0xbd, 0xd1, 0xe1, 0x7a, 0xc7, 0x1c, 0x6c, 0xf4, 0x8d, 0x21,
+	0xde, 0x88, 0x78, 0x7a, 0x7e, 0xb8, 0x40, 0x43, 0x84, 0x1b, 0x7f, 0x1b, 0x00, 0xa9, 0x25, 0x98,
+	0xfa, 0x98, 0x2e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3411,6 +4268,10 @@ type ABCIApplicationClient interface {
 	OfferSnapshot(ctx context.Context, in *RequestOfferSnapshot, opts ...grpc.CallOption) (*ResponseOfferSnapshot, error)
 	LoadSnapshotChunk(ctx context.Context, in *RequestLoadSnapshotChunk, opts ...grpc.CallOption) (*ResponseLoadSnapshotChunk, error)
 	ApplySnapshotChunk(ctx context.Context, in *RequestApplySnapshotChunk, opts ...grpc.CallOption) (*ResponseApplySnapshotChunk, error)
+	PrepareProposal(ctx context.Context, in *RequestPrepareProposal, opts ...grpc.CallOption) (*ResponsePrepareProposal, error)
+	ExtendVote(ctx context.Context, in *RequestExtendVote, opts ...grpc.CallOption) (*ResponseExtendVote, error)
+	VerifyVoteExtension(ctx context.Context, in *RequestVerifyVoteExtension, opts ...grpc.CallOption) (*ResponseVerifyVoteExtension, error)
+	ProcessProposal(ctx context.Context, in *RequestProcessProposal, opts ...grpc.CallOption) (*ResponseProcessProposal, error)
 }
 
 type aBCIApplicationClient struct {
@@ -3556,6 +4417,42 @@ func (c *aBCIApplicationClient) ApplySnapshotChunk(ctx context.Context, in *Requ
 	return out, nil
 }
 
+func (c *aBCIApplicationClient) PrepareProposal(ctx context.Context, in *RequestPrepareProposal, opts ...grpc.CallOption) (*ResponsePrepareProposal, error) {
+	out := new(ResponsePrepareProposal)
+	err := c.cc.Invoke(ctx, "/ostracon.abci.ABCIApplication/PrepareProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aBCIApplicationClient) ExtendVote(ctx context.Context, in *RequestExtendVote, opts ...grpc.CallOption) (*ResponseExtendVote, error) {
+	out := new(ResponseExtendVote)
+	err := c.cc.Invoke(ctx, "/ostracon.abci.ABCIApplication/ExtendVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aBCIApplicationClient) VerifyVoteExtension(ctx context.Context, in *RequestVerifyVoteExtension, opts ...grpc.CallOption) (*ResponseVerifyVoteExtension, error) {
+	out := new(ResponseVerifyVoteExtension)
+	err := c.cc.Invoke(ctx, "/ostracon.abci.ABCIApplication/VerifyVoteExtension", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aBCIApplicationClient) ProcessProposal(ctx context.Context, in *RequestProcessProposal, opts ...grpc.CallOption) (*ResponseProcessProposal, error) {
+	out := new(ResponseProcessProposal)
+	err := c.cc.Invoke(ctx, "/ostracon.abci.ABCIApplication/ProcessProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ABCIApplicationServer is the server API for ABCIApplication service.
 type ABCIApplicationServer interface {
 	Echo(context.Context, *RequestEcho) (*ResponseEcho, error)
@@ -3573,6 +4470,10 @@ type ABCIApplicationServer interface {
 	OfferSnapshot(context.Context, *RequestOfferSnapshot) (*ResponseOfferSnapshot, error)
 	LoadSnapshotChunk(context.Context, *RequestLoadSnapshotChunk) (*ResponseLoadSnapshotChunk, error)
 	ApplySnapshotChunk(context.Context, *RequestApplySnapshotChunk) (*ResponseApplySnapshotChunk, error)
+	PrepareProposal(context.Context, *RequestPrepareProposal) (*ResponsePrepareProposal, error)
+	ExtendVote(context.Context, *RequestExtendVote) (*ResponseExtendVote, error)
+	VerifyVoteExtension(context.Context, *RequestVerifyVoteExtension) (*ResponseVerifyVoteExtension, error)
+	ProcessProposal(context.Context, *RequestProcessProposal) (*ResponseProcessProposal, error)
 }
 
 // UnimplementedABCIApplicationServer can be embedded to have forward compatible implementations.
@@ -3624,6 +4525,18 @@ func (*UnimplementedABCIApplicationServer) LoadSnapshotChunk(ctx context.Context
 func (*UnimplementedABCIApplicationServer) ApplySnapshotChunk(ctx context.Context, req *RequestApplySnapshotChunk) (*ResponseApplySnapshotChunk, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplySnapshotChunk not implemented")
 }
+func (*UnimplementedABCIApplicationServer) PrepareProposal(ctx context.Context, req *RequestPrepareProposal) (*ResponsePrepareProposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareProposal not implemented")
+}
+func (*UnimplementedABCIApplicationServer) ExtendVote(ctx context.Context, req *RequestExtendVote) (*ResponseExtendVote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendVote not implemented")
+}
+func (*UnimplementedABCIApplicationServer) VerifyVoteExtension(ctx context.Context, req *RequestVerifyVoteExtension) (*ResponseVerifyVoteExtension, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyVoteExtension not implemented")
+}
+func (*UnimplementedABCIApplicationServer) ProcessProposal(ctx context.Context, req *RequestProcessProposal) (*ResponseProcessProposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessProposal not implemented")
+}
 
 func RegisterABCIApplicationServer(s *grpc.Server, srv ABCIApplicationServer) {
 	s.RegisterService(&_ABCIApplication_serviceDesc, srv)
@@ -3899,6 +4812,78 @@ func _ABCIApplication_ApplySnapshotChunk_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ABCIApplication_PrepareProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPrepareProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).PrepareProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ostracon.abci.ABCIApplication/PrepareProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).PrepareProposal(ctx, req.(*RequestPrepareProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ABCIApplication_ExtendVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestExtendVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).ExtendVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ostracon.abci.ABCIApplication/ExtendVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).ExtendVote(ctx, req.(*RequestExtendVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ABCIApplication_VerifyVoteExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestVerifyVoteExtension)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).VerifyVoteExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ostracon.abci.ABCIApplication/VerifyVoteExtension",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).VerifyVoteExtension(ctx, req.(*RequestVerifyVoteExtension))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ABCIApplication_ProcessProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestProcessProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).ProcessProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ostracon.abci.ABCIApplication/ProcessProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).ProcessProposal(ctx, req.(*RequestProcessProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ABCIApplication_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ostracon.abci.ABCIApplication",
 	HandlerType: (*ABCIApplicationServer)(nil),
@@ -3963,6 +4948,22 @@ var _ABCIApplication_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ApplySnapshotChunk",
 			Handler:    _ABCIApplication_ApplySnapshotChunk_Handler,
 		},
+		{
+			MethodName: "PrepareProposal",
+			Handler:    _ABCIApplication_PrepareProposal_Handler,
+		},
+		{
+			MethodName: "ExtendVote",
+			Handler:    _ABCIApplication_ExtendVote_Handler,
+		},
+		{
+			MethodName: "VerifyVoteExtension",
+			Handler:    _ABCIApplication_VerifyVoteExtension_Handler,
+		},
+		{
+			MethodName: "ProcessProposal",
+			Handler:    _ABCIApplication_ProcessProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ostracon/abci/types.proto",
@@ -4315,6 +5316,98 @@ func (m *Request_ApplySnapshotChunk) MarshalToSizedBuffer(dAtA []byte) (int, err
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Request_PrepareProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Request_PrepareProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PrepareProposal != nil {
+		{
+			size, err := m.PrepareProposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Request_ExtendVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Request_ExtendVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ExtendVote != nil {
+		{
+			size, err := m.ExtendVote.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xca
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Request_VerifyVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Request_VerifyVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.VerifyVoteExtension != nil {
+		{
+			size, err := m.VerifyVoteExtension.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xd2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Request_ProcessProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Request_ProcessProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ProcessProposal != nil {
+		{
+			size, err := m.ProcessProposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xda
+	}
+	return len(dAtA) - i, nil
+}
 func (m *RequestEcho) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4510,12 +5603,12 @@ func (m *RequestInitChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err17 != nil {
-		return 0, err17
+	n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err21 != nil {
+		return 0, err21
 	}
-	i -= n17
-	i = encodeVarintTypes(dAtA, i, uint64(n17))
+	i -= n21
+	i = encodeVarintTypes(dAtA, i, uint64(n21))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -4898,7 +5991,7 @@ func (m *RequestApplySnapshotChunk) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *Response) Marshal() (dAtA []byte, err error) {
+func (m *RequestPrepareProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4908,82 +6001,298 @@ func (m *Response) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Response) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestPrepareProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RequestPrepareProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Value != nil {
-		{
-			size := m.Value.Size()
-			i -= size
-			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.LocalLastCommit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Txs[iNdEx])
+			copy(dAtA[i:], m.Txs[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Txs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
+	if m.MaxTxBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxTxBytes))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Response_Exception) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestExtendVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestExtendVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Response_Exception) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RequestExtendVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Exception != nil {
-		{
-			size, err := m.Exception.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Response_Echo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Response_Echo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Echo != nil {
-		{
-			size, err := m.Echo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *RequestVerifyVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *Response_Flush) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *RequestVerifyVoteExtension) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Response_Flush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RequestVerifyVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Flush != nil {
-		{
-			size, err := m.Flush.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoteExtension) > 0 {
+		i -= len(m.VoteExtension)
+		copy(dAtA[i:], m.VoteExtension)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VoteExtension)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestProcessProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestProcessProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestProcessProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ByzantineValidators) > 0 {
+		for iNdEx := len(m.ByzantineValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ByzantineValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.ProposedLastCommit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Txs[iNdEx])
+			copy(dAtA[i:], m.Txs[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Txs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size := m.Value.Size()
+			i -= size
+			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Response_Exception) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Response_Exception) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Exception != nil {
+		{
+			size, err := m.Exception.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Response_Echo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Response_Echo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Echo != nil {
+		{
+			size, err := m.Echo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Response_Flush) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Response_Flush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Flush != nil {
+		{
+			size, err := m.Flush.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
 			i -= size
 			i = encodeVarintTypes(dAtA, i, uint64(size))
@@ -5268,6 +6577,98 @@ func (m *Response_ApplySnapshotChunk) MarshalToSizedBuffer(dAtA []byte) (int, er
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Response_PrepareProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Response_PrepareProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PrepareProposal != nil {
+		{
+			size, err := m.PrepareProposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Response_ExtendVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Response_ExtendVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ExtendVote != nil {
+		{
+			size, err := m.ExtendVote.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xca
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Response_VerifyVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Response_VerifyVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.VerifyVoteExtension != nil {
+		{
+			size, err := m.VerifyVoteExtension.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xd2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Response_ProcessProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Response_ProcessProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ProcessProposal != nil {
+		{
+			size, err := m.ProcessProposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xda
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ResponseException) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5645,12 +7046,28 @@ func (m *ResponseCheckTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Codespace) > 0 {
-		i -= len(m.Codespace)
-		copy(dAtA[i:], m.Codespace)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Codespace)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.Priority != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x42
 	}
 	if len(m.Events) > 0 {
 		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
@@ -6008,20 +7425,20 @@ func (m *ResponseApplySnapshotChunk) MarshalToSizedBuffer(dAtA []byte) (int, err
 		}
 	}
 	if len(m.RefetchChunks) > 0 {
-		dAtA41 := make([]byte, len(m.RefetchChunks)*10)
-		var j40 int
+		dAtA52 := make([]byte, len(m.RefetchChunks)*10)
+		var j51 int
 		for _, num := range m.RefetchChunks {
 			for num >= 1<<7 {
-				dAtA41[j40] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA52[j51] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j40++
+				j51++
 			}
-			dAtA41[j40] = uint8(num)
-			j40++
+			dAtA52[j51] = uint8(num)
+			j51++
 		}
-		i -= j40
-		copy(dAtA[i:], dAtA41[:j40])
-		i = encodeVarintTypes(dAtA, i, uint64(j40))
+		i -= j51
+		copy(dAtA[i:], dAtA52[:j51])
+		i = encodeVarintTypes(dAtA, i, uint64(j51))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6033,6 +7450,124 @@ func (m *ResponseApplySnapshotChunk) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *ResponsePrepareProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponsePrepareProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponsePrepareProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Txs[iNdEx])
+			copy(dAtA[i:], m.Txs[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Txs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseExtendVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseExtendVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseExtendVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoteExtension) > 0 {
+		i -= len(m.VoteExtension)
+		copy(dAtA[i:], m.VoteExtension)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VoteExtension)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseVerifyVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseVerifyVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseVerifyVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseProcessProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseProcessProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseProcessProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ConsensusParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6053,6 +7588,62 @@ func (m *ConsensusParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Abci != nil {
+		{
+			size, err := m.Abci.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.PartSet != nil {
+		{
+			size, err := m.PartSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.Voter != nil {
+		{
+			size, err := m.Voter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc2
+	}
 	if m.Version != nil {
 		{
 			size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
@@ -6179,6 +7770,48 @@ func (m *LastCommitInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExtendedCommitInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtendedCommitInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtendedCommitInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Event) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6375,6 +8008,15 @@ func (m *ValidatorUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProofOfPossession) > 0 {
+		i -= len(m.ProofOfPossession)
+		copy(dAtA[i:], m.ProofOfPossession)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProofOfPossession)))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc2
+	}
 	if m.Power != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Power))
 		i--
@@ -6443,6 +8085,61 @@ func (m *VoteInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExtendedVoteInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtendedVoteInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtendedVoteInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.VoteExtension) > 0 {
+		i -= len(m.VoteExtension)
+		copy(dAtA[i:], m.VoteExtension)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VoteExtension)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SignedLastBlock {
+		i--
+		if m.SignedLastBlock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Evidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6468,12 +8165,12 @@ func (m *Evidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n49, err49 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err49 != nil {
-		return 0, err49
+	n65, err65 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err65 != nil {
+		return 0, err65
 	}
-	i -= n49
-	i = encodeVarintTypes(dAtA, i, uint64(n49))
+	i -= n65
+	i = encodeVarintTypes(dAtA, i, uint64(n65))
 	i--
 	dAtA[i] = 0x22
 	if m.Height != 0 {
@@ -6754,39 +8451,87 @@ func (m *Request_ApplySnapshotChunk) Size() (n int) {
 	}
 	return n
 }
-func (m *RequestEcho) Size() (n int) {
+func (m *Request_PrepareProposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.PrepareProposal != nil {
+		l = m.PrepareProposal.Size()
+		n += 2 + l + sovTypes(uint64(l))
 	}
 	return n
 }
-
-func (m *RequestFlush) Size() (n int) {
+func (m *Request_ExtendVote) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.ExtendVote != nil {
+		l = m.ExtendVote.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
 	return n
 }
-
-func (m *RequestInfo) Size() (n int) {
+func (m *Request_VerifyVoteExtension) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.VerifyVoteExtension != nil {
+		l = m.VerifyVoteExtension.Size()
+		n += 2 + l + sovTypes(uint64(l))
 	}
-	if m.BlockVersion != 0 {
+	return n
+}
+func (m *Request_ProcessProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProcessProposal != nil {
+		l = m.ProcessProposal.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *RequestEcho) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *RequestFlush) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RequestInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.BlockVersion != 0 {
 		n += 1 + sovTypes(uint64(m.BlockVersion))
 	}
 	if m.P2PVersion != 0 {
@@ -7004,6 +8749,102 @@ func (m *RequestApplySnapshotChunk) Size() (n int) {
 	return n
 }
 
+func (m *RequestPrepareProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxTxBytes != 0 {
+		n += 1 + sovTypes(uint64(m.MaxTxBytes))
+	}
+	if len(m.Txs) > 0 {
+		for _, b := range m.Txs {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.LocalLastCommit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *RequestExtendVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *RequestVerifyVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = len(m.VoteExtension)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *RequestProcessProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Header.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Txs) > 0 {
+		for _, b := range m.Txs {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.ProposedLastCommit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.ByzantineValidators) > 0 {
+		for _, e := range m.ByzantineValidators {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Response) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7208,6 +9049,54 @@ func (m *Response_ApplySnapshotChunk) Size() (n int) {
 	}
 	return n
 }
+func (m *Response_PrepareProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PrepareProposal != nil {
+		l = m.PrepareProposal.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Response_ExtendVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtendVote != nil {
+		l = m.ExtendVote.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Response_VerifyVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VerifyVoteExtension != nil {
+		l = m.VerifyVoteExtension.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Response_ProcessProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProcessProposal != nil {
+		l = m.ProcessProposal.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 func (m *ResponseException) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7407,6 +9296,13 @@ func (m *ResponseCheckTx) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 2 + sovTypes(uint64(m.Priority))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -7556,6 +9452,58 @@ func (m *ResponseApplySnapshotChunk) Size() (n int) {
 	return n
 }
 
+func (m *ResponsePrepareProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, b := range m.Txs {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResponseExtendVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoteExtension)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ResponseVerifyVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *ResponseProcessProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	return n
+}
+
 func (m *ConsensusParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7578,6 +9526,22 @@ func (m *ConsensusParams) Size() (n int) {
 		l = m.Version.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Voter != nil {
+		l = m.Voter.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.PartSet != nil {
+		l = m.PartSet.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.Abci != nil {
+		l = m.Abci.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -7614,6 +9578,24 @@ func (m *LastCommitInfo) Size() (n int) {
 	return n
 }
 
+func (m *ExtendedCommitInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Event) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7701,6 +9683,10 @@ func (m *ValidatorUpdate) Size() (n int) {
 	if m.Power != 0 {
 		n += 1 + sovTypes(uint64(m.Power))
 	}
+	l = len(m.ProofOfPossession)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -7721,6 +9707,27 @@ func (m *VoteInfo) Size() (n int) {
 	return n
 }
 
+func (m *ExtendedVoteInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Validator.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.SignedLastBlock {
+		n += 2
+	}
+	l = len(m.VoteExtension)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovTypes(uint64(m.VotingPower))
+	}
+	return n
+}
+
 func (m *Evidence) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8329,12 +10336,152 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &Request_ApplySnapshotChunk{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
+		case 1000:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareProposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestPrepareProposal{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_PrepareProposal{v}
+			iNdEx = postIndex
+		case 1001:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestExtendVote{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_ExtendVote{v}
+			iNdEx = postIndex
+		case 1002:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyVoteExtension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestVerifyVoteExtension{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_VerifyVoteExtension{v}
+			iNdEx = postIndex
+		case 1003:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessProposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestProcessProposal{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_ProcessProposal{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
@@ -10011,7 +12158,7 @@ func (m *RequestApplySnapshotChunk) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Response) Unmarshal(dAtA []byte) error {
+func (m *RequestPrepareProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10034,17 +12181,17 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestPrepareProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestPrepareProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxBytes", wireType)
 			}
-			var msglen int
+			m.MaxTxBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10054,32 +12201,16 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxTxBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &ResponseException{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Value = &Response_Exception{v}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Echo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10089,30 +12220,27 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ResponseEcho{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Value = &Response_Echo{v}
+			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
+			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flush", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalLastCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10139,17 +12267,15 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ResponseFlush{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LocalLastCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &Response_Flush{v}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10159,32 +12285,16 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &ResponseInfo{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Value = &Response_Info{v}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetOption", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10194,71 +12304,785 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ResponseSetOption{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
 			}
-			m.Value = &Response_SetOption{v}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitChain", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ResponseInitChain{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Value = &Response_InitChain{v}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestExtendVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestExtendVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestExtendVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestVerifyVoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestVerifyVoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestVerifyVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteExtension = append(m.VoteExtension[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtension == nil {
+				m.VoteExtension = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestProcessProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestProcessProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestProcessProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
+			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedLastCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposedLastCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByzantineValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ByzantineValidators = append(m.ByzantineValidators, Evidence{})
+			if err := m.ByzantineValidators[len(m.ByzantineValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResponseException{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Response_Exception{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Echo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResponseEcho{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Response_Echo{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flush", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResponseFlush{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Response_Flush{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResponseInfo{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Response_Info{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetOption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResponseSetOption{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Response_SetOption{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitChain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResponseInitChain{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Response_InitChain{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
@@ -10600,6 +13424,146 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &Response_ApplySnapshotChunk{v}
 			iNdEx = postIndex
+		case 1000:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareProposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResponsePrepareProposal{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Response_PrepareProposal{v}
+			iNdEx = postIndex
+		case 1001:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResponseExtendVote{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Response_ExtendVote{v}
+			iNdEx = postIndex
+		case 1002:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyVoteExtension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResponseVerifyVoteExtension{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Response_VerifyVoteExtension{v}
+			iNdEx = postIndex
+		case 1003:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessProposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResponseProcessProposal{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Response_ProcessProposal{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -11883,11 +14847,77 @@ func (m *ResponseCheckTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 1000:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			var msglen int
+			m.Priority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11897,29 +14927,14 @@ func (m *ResponseCheckTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Priority |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Events = append(m.Events, Event{})
-			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 1001:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11947,7 +14962,7 @@ func (m *ResponseCheckTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Codespace = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12498,7 +15513,244 @@ func (m *ResponseCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseListSnapshots) Unmarshal(dAtA []byte) error {
+func (m *ResponseListSnapshots) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseListSnapshots: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseListSnapshots: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshots = append(m.Snapshots, &Snapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseOfferSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseOfferSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseOfferSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ResponseOfferSnapshot_Result(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseLoadSnapshotChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseLoadSnapshotChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseLoadSnapshotChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseApplySnapshotChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12521,17 +15773,112 @@ func (m *ResponseListSnapshots) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseListSnapshots: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseApplySnapshotChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseListSnapshots: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseApplySnapshotChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ResponseApplySnapshotChunk_Result(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RefetchChunks = append(m.RefetchChunks, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RefetchChunks) == 0 {
+					m.RefetchChunks = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RefetchChunks = append(m.RefetchChunks, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefetchChunks", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectSenders", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12541,25 +15888,23 @@ func (m *ResponseListSnapshots) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Snapshots = append(m.Snapshots, &Snapshot{})
-			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RejectSenders = append(m.RejectSenders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12582,7 +15927,7 @@ func (m *ResponseListSnapshots) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseOfferSnapshot) Unmarshal(dAtA []byte) error {
+func (m *ResponsePrepareProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12605,17 +15950,17 @@ func (m *ResponseOfferSnapshot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseOfferSnapshot: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponsePrepareProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseOfferSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponsePrepareProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
 			}
-			m.Result = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12625,11 +15970,24 @@ func (m *ResponseOfferSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= ResponseOfferSnapshot_Result(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
+			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -12651,7 +16009,7 @@ func (m *ResponseOfferSnapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseLoadSnapshotChunk) Unmarshal(dAtA []byte) error {
+func (m *ResponseExtendVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12674,15 +16032,15 @@ func (m *ResponseLoadSnapshotChunk) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseLoadSnapshotChunk: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseExtendVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseLoadSnapshotChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseExtendVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtension", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -12709,9 +16067,9 @@ func (m *ResponseLoadSnapshotChunk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
-			if m.Chunk == nil {
-				m.Chunk = []byte{}
+			m.VoteExtension = append(m.VoteExtension[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtension == nil {
+				m.VoteExtension = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -12735,7 +16093,7 @@ func (m *ResponseLoadSnapshotChunk) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseApplySnapshotChunk) Unmarshal(dAtA []byte) error {
+func (m *ResponseVerifyVoteExtension) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12758,17 +16116,17 @@ func (m *ResponseApplySnapshotChunk) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseApplySnapshotChunk: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseVerifyVoteExtension: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseApplySnapshotChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseVerifyVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Result = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12778,92 +16136,66 @@ func (m *ResponseApplySnapshotChunk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= ResponseApplySnapshotChunk_Result(b&0x7F) << shift
+				m.Status |= ResponseVerifyVoteExtension_VerifyStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.RefetchChunks = append(m.RefetchChunks, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTypes
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTypes
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RefetchChunks) == 0 {
-					m.RefetchChunks = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTypes
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RefetchChunks = append(m.RefetchChunks, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefetchChunks", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseProcessProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectSenders", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseProcessProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseProcessProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12873,24 +16205,11 @@ func (m *ResponseApplySnapshotChunk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= ResponseProcessProposal_ProposalStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RejectSenders = append(m.RejectSenders, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -13053,7 +16372,237 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Version == nil {
+				m.Version = &types1.VersionParams{}
+			}
+			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1000:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Voter == nil {
+				m.Voter = &types1.VoterParams{}
+			}
+			if err := m.Voter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1001:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types1.TimestampParams{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1002:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartSet == nil {
+				m.PartSet = &types1.PartSetParams{}
+			}
+			if err := m.PartSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1003:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abci", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Abci == nil {
+				m.Abci = &types1.ABCIParams{}
+			}
+			if err := m.Abci.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13063,28 +16612,30 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Version == nil {
-				m.Version = &types1.VersionParams{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
 			}
-			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -13106,7 +16657,7 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockParams) Unmarshal(dAtA []byte) error {
+func (m *LastCommitInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13129,17 +16680,17 @@ func (m *BlockParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: LastCommitInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LastCommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
 			}
-			m.MaxBytes = 0
+			m.Round = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13149,16 +16700,16 @@ func (m *BlockParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxBytes |= int64(b&0x7F) << shift
+				m.Round |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			m.MaxGas = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13168,11 +16719,26 @@ func (m *BlockParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxGas |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, VoteInfo{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -13194,7 +16760,7 @@ func (m *BlockParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastCommitInfo) Unmarshal(dAtA []byte) error {
+func (m *ExtendedCommitInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13217,10 +16783,10 @@ func (m *LastCommitInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastCommitInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExtendedCommitInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastCommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExtendedCommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13271,7 +16837,7 @@ func (m *LastCommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, VoteInfo{})
+			m.Votes = append(m.Votes, ExtendedVoteInfo{})
 			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -13890,6 +17456,40 @@ func (m *ValidatorUpdate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 1000:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofOfPossession", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofOfPossession = append(m.ProofOfPossession[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofOfPossession == nil {
+				m.ProofOfPossession = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -14033,6 +17633,162 @@ func (m *VoteInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExtendedVoteInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtendedVoteInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtendedVoteInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedLastBlock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SignedLastBlock = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteExtension = append(m.VoteExtension[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtension == nil {
+				m.VoteExtension = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Evidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
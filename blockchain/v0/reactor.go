@@ -12,6 +12,7 @@ import (
 	sm "github.com/line/ostracon/state"
 	"github.com/line/ostracon/store"
 	"github.com/line/ostracon/types"
+	"github.com/line/ostracon/version"
 )
 
 const (
@@ -363,15 +364,17 @@ FOR_LOOP:
 				didProcessCh <- struct{}{}
 			}
 
-			firstParts := first.MakePartSet(types.BlockPartSizeBytes)
+			firstParts := state.MakePartSet(first)
 			firstPartSetHeader := firstParts.Header()
 			firstID := types.BlockID{Hash: first.Hash(), PartSetHeader: firstPartSetHeader}
 			// Finally, verify the first block using the second's commit
 			// NOTE: we can probably make this more efficient, but note that calling
 			// first.Hash() doesn't verify the tx contents, so MakePartSet() is
 			// currently necessary.
+			// The commit is verified under the rules of the block protocol of the
+			// first block, which the state expects of it.
 			err := state.Voters.VerifyCommitLight(
-				chainID, firstID, first.Height, second.LastCommit)
+				chainID, firstID, first.Height, second.LastCommit, state.Version.Consensus.Block)
 			if err != nil {
 				bcR.Logger.Error("Error in validation", "err", err)
 				peerID := bcR.pool.RedoRequest(first.Height)
@@ -405,6 +408,21 @@ FOR_LOOP:
 				}
 				blocksSynced++
 
+				// We can't sync the blocks of a block protocol we don't support, so
+				// we switch to the consensus reactor, which halts the node.
+				if blockProtocol := state.Version.Consensus.Block; !version.IsBlockProtocolSupported(blockProtocol) {
+					bcR.Logger.Error("Stopping fast sync", "err", sm.ErrUnsupportedBlockProtocol{
+						Height: state.LastBlockHeight + 1, BlockProtocol: blockProtocol})
+					if err := bcR.pool.Stop(); err != nil {
+						bcR.Logger.Error("Error stopping pool", "err", err)
+					}
+					conR, ok := bcR.Switch.Reactor("CONSENSUS").(consensusReactor)
+					if ok {
+						conR.SwitchToConsensus(state, true)
+					}
+					break FOR_LOOP
+				}
+
 				if blocksSynced%100 == 0 {
 					lastRate = 0.9*lastRate + 0.1*(100/time.Since(lastHundred).Seconds())
 					bcR.Logger.Info("Fast Sync Rate", "height", bcR.pool.height,
@@ -32,8 +32,9 @@ func randGenesisDoc(numValidators int, randPower bool, minPower int64) (*types.G
 	for i := 0; i < numValidators; i++ {
 		val, privVal := types.RandValidator(randPower, minPower)
 		validators[i] = types.GenesisValidator{
-			PubKey: val.PubKey,
-			Power:  val.StakingPower,
+			PubKey:            val.PubKey,
+			Power:             val.StakingPower,
+			ProofOfPossession: val.ProofOfPossession,
 		}
 		privValidators[i] = privVal
 	}
@@ -302,7 +303,7 @@ func makeTxs(height int64) (txs []types.Tx) {
 
 func makeBlock(privVal types.PrivValidator, height int64, state sm.State, lastCommit *types.Commit) *types.Block {
 	message := state.MakeHashMessage(0)
-	proof, err := privVal.GenerateVRFProof(message)
+	proof, err := privVal.GenerateVRFProof(message, "")
 	if err != nil {
 		panic(err)
 	}
@@ -13,6 +13,7 @@ import (
 	sm "github.com/line/ostracon/state"
 	"github.com/line/ostracon/store"
 	"github.com/line/ostracon/types"
+	"github.com/line/ostracon/version"
 )
 
 const (
@@ -375,6 +376,15 @@ ForLoop:
 						"max_peer_height", maxPeerHeight, "blocks/s", lastRate)
 					lastHundred = time.Now()
 				}
+
+				// We can't sync the blocks of a block protocol we don't support, so
+				// we switch to the consensus reactor, which halts the node.
+				if blockProtocol := bcR.state.Version.Consensus.Block; !version.IsBlockProtocolSupported(blockProtocol) {
+					bcR.Logger.Error("stopping fast sync", "err", sm.ErrUnsupportedBlockProtocol{
+						Height: bcR.state.LastBlockHeight + 1, BlockProtocol: blockProtocol})
+					bcR.switchToConsensus()
+					break ForLoop
+				}
 			}
 		}
 	}
@@ -468,14 +478,17 @@ func (bcR *BlockchainReactor) processBlock() error {
 
 	chainID := bcR.initialState.ChainID
 
-	firstParts := first.MakePartSet(types.BlockPartSizeBytes)
+	firstParts := bcR.state.MakePartSet(first)
 	firstPartSetHeader := firstParts.Header()
 	firstID := types.BlockID{Hash: first.Hash(), PartSetHeader: firstPartSetHeader}
 	// Finally, verify the first block using the second's commit
 	// NOTE: we can probably make this more efficient, but note that calling
 	// first.Hash() doesn't verify the tx contents, so MakePartSet() is
 	// currently necessary.
-	err = bcR.state.Voters.VerifyCommitLight(chainID, firstID, first.Height, second.LastCommit)
+	// The commit is verified under the rules of the block protocol of the first
+	// block, which the state expects of it.
+	err = bcR.state.Voters.VerifyCommitLight(chainID, firstID, first.Height, second.LastCommit,
+		bcR.state.Version.Consensus.Block)
 	if err != nil {
 		bcR.Logger.Error("error during commit verification", "err", err,
 			"first", first.Height, "second", second.Height)
@@ -34,8 +34,9 @@ func randGenesisDoc(numValidators int, randPower bool, minPower int64) (*types.G
 	for i := 0; i < numValidators; i++ {
 		val, privVal := types.RandValidator(randPower, minPower)
 		validators[i] = types.GenesisValidator{
-			PubKey: val.PubKey,
-			Power:  val.StakingPower,
+			PubKey:            val.PubKey,
+			Power:             val.StakingPower,
+			ProofOfPossession: val.ProofOfPossession,
 		}
 		privValidators[i] = privVal
 	}
@@ -360,7 +361,7 @@ func makeTxs(height int64) (txs []types.Tx) {
 
 func makeBlock(privVal types.PrivValidator, height int64, state sm.State, lastCommit *types.Commit) *types.Block {
 	message := state.MakeHashMessage(0)
-	proof, _ := privVal.GenerateVRFProof(message)
+	proof, _ := privVal.GenerateVRFProof(message, "")
 	block, _ := state.MakeBlock(height, makeTxs(height), lastCommit, nil,
 		state.Validators.SelectProposer(state.LastProofHash, height, 0).Address, 0, proof)
 	return block
@@ -6,6 +6,7 @@ import (
 	"github.com/line/ostracon/p2p"
 	tmState "github.com/line/ostracon/state"
 	"github.com/line/ostracon/types"
+	"github.com/line/ostracon/version"
 )
 
 // Events generated by the processor:
@@ -160,12 +161,14 @@ func (state *pcState) handle(event Event) (Event, error) {
 
 		var (
 			first, second = firstItem.block, secondItem.block
-			firstParts    = first.MakePartSet(types.BlockPartSizeBytes)
+			firstParts    = tmState.MakePartSet(first)
 			firstID       = types.BlockID{Hash: first.Hash(), PartSetHeader: firstParts.Header()}
 		)
 
-		// verify if +second+ last commit "confirms" +first+ block
-		err = state.context.verifyCommit(tmState.ChainID, firstID, first.Height, second.LastCommit)
+		// verify if +second+ last commit "confirms" +first+ block, under the rules
+		// of the block protocol the state expects of +first+
+		err = state.context.verifyCommit(tmState.ChainID, firstID, first.Height, second.LastCommit,
+			tmState.Version.Consensus.Block)
 		if err != nil {
 			state.purgePeer(firstItem.peerID)
 			if firstItem.peerID != secondItem.peerID {
@@ -185,6 +188,12 @@ func (state *pcState) handle(event Event) (Event, error) {
 		delete(state.queue, first.Height)
 		state.blocksSynced++
 
+		// We can't sync the blocks of a block protocol we don't support, so we
+		// finish and switch to the consensus reactor, which halts the node.
+		if newState := state.context.tmState(); !version.IsBlockProtocolSupported(newState.Version.Consensus.Block) {
+			return pcFinished{tmState: newState, blocksSynced: state.blocksSynced}, nil
+		}
+
 		return pcBlockProcessed{height: first.Height, peerID: firstItem.peerID}, nil
 	}
 
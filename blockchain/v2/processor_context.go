@@ -9,7 +9,7 @@ import (
 
 type processorContext interface {
 	applyBlock(blockID types.BlockID, block *types.Block) error
-	verifyCommit(chainID string, blockID types.BlockID, height int64, commit *types.Commit) error
+	verifyCommit(chainID string, blockID types.BlockID, height int64, commit *types.Commit, blockVersion uint64) error
 	saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit)
 	tmState() state.State
 	setState(state.State)
@@ -43,8 +43,9 @@ func (pc *pContext) setState(state state.State) {
 	pc.state = state
 }
 
-func (pc pContext) verifyCommit(chainID string, blockID types.BlockID, height int64, commit *types.Commit) error {
-	return pc.state.Voters.VerifyCommitLight(chainID, blockID, height, commit)
+func (pc pContext) verifyCommit(chainID string, blockID types.BlockID, height int64, commit *types.Commit,
+	blockVersion uint64) error {
+	return pc.state.Voters.VerifyCommitLight(chainID, blockID, height, commit, blockVersion)
 }
 
 func (pc *pContext) saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
@@ -78,7 +79,8 @@ func (mpc *mockPContext) applyBlock(blockID types.BlockID, block *types.Block) e
 	return nil
 }
 
-func (mpc *mockPContext) verifyCommit(chainID string, blockID types.BlockID, height int64, commit *types.Commit) error {
+func (mpc *mockPContext) verifyCommit(chainID string, blockID types.BlockID, height int64, commit *types.Commit,
+	blockVersion uint64) error {
 	for _, h := range mpc.verificationBL {
 		if h == height {
 			return fmt.Errorf("generic verification error")
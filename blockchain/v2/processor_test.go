@@ -8,6 +8,7 @@ import (
 	"github.com/line/ostracon/p2p"
 	tmState "github.com/line/ostracon/state"
 	"github.com/line/ostracon/types"
+	"github.com/line/ostracon/version"
 )
 
 // pcBlock is a test helper structure with simple types. Its purpose is to help with test readability.
@@ -24,6 +25,8 @@ type params struct {
 	verBL        []int64
 	appBL        []int64
 	draining     bool
+	// blockProtocol of the state, the one of the software if zero
+	blockProtocol uint64
 }
 
 // makePcBlock makes an empty block.
@@ -31,10 +34,21 @@ func makePcBlock(height int64) *types.Block {
 	return &types.Block{Header: types.Header{Height: height}}
 }
 
+// makeTmState makes a state at the height with the block protocol, which is
+// the one of the software if zero.
+func makeTmState(height int64, blockProtocol uint64) tmState.State {
+	if blockProtocol == 0 {
+		blockProtocol = version.BlockProtocol
+	}
+	state := tmState.State{LastBlockHeight: height}
+	state.Version.Consensus.Block = blockProtocol
+	return state
+}
+
 // makeState takes test parameters and creates a specific processor state.
 func makeState(p *params) *pcState {
 	var (
-		tmState = tmState.State{LastBlockHeight: p.height}
+		tmState = makeTmState(p.height, p.blockProtocol)
 		context = newMockProcessorContext(tmState, p.verBL, p.appBL)
 	)
 	state := newPcState(context)
@@ -191,6 +205,18 @@ func TestRProcessBlockSuccess(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "blocks H+1 and H+2 present, H+2 has an unsupported block protocol",
+			steps: []pcFsmMakeStateValues{
+				{
+					currentState: &params{items: []pcBlock{{"P1", 1}, {"P2", 2}},
+						blockProtocol: version.BlockProtocol + 1}, event: rProcessBlock{},
+					wantState: &params{height: 1, items: []pcBlock{{"P2", 2}}, blocksSynced: 1,
+						blockProtocol: version.BlockProtocol + 1},
+					wantNextEvent: pcFinished{tmState: makeTmState(1, version.BlockProtocol+1), blocksSynced: 1},
+				},
+			},
+		},
 		{
 			name: "blocks H+1 and H+2 present after draining",
 			steps: []pcFsmMakeStateValues{
@@ -208,7 +234,7 @@ func TestRProcessBlockSuccess(t *testing.T) {
 				{ // finish when H+1 or/and H+2 are missing
 					event:         rProcessBlock{},
 					wantState:     &params{height: 1, items: []pcBlock{{"P2", 2}, {"P1", 4}}, blocksSynced: 1, draining: true},
-					wantNextEvent: pcFinished{tmState: tmState.State{LastBlockHeight: 1}, blocksSynced: 1},
+					wantNextEvent: pcFinished{tmState: makeTmState(1, 0), blocksSynced: 1},
 				},
 			},
 		},
@@ -272,7 +298,7 @@ func TestScFinishedEv(t *testing.T) {
 				{
 					currentState: &params{height: 100, items: []pcBlock{}, blocksSynced: 100}, event: scFinishedEv{},
 					wantState:     &params{height: 100, items: []pcBlock{}, blocksSynced: 100},
-					wantNextEvent: pcFinished{tmState: tmState.State{LastBlockHeight: 100}, blocksSynced: 100},
+					wantNextEvent: pcFinished{tmState: makeTmState(100, 0), blocksSynced: 100},
 				},
 			},
 		},
@@ -283,7 +309,7 @@ func TestScFinishedEv(t *testing.T) {
 					currentState: &params{height: 100, items: []pcBlock{
 						{"P1", 101}}, blocksSynced: 100}, event: scFinishedEv{},
 					wantState:     &params{height: 100, items: []pcBlock{{"P1", 101}}, blocksSynced: 100},
-					wantNextEvent: pcFinished{tmState: tmState.State{LastBlockHeight: 100}, blocksSynced: 100},
+					wantNextEvent: pcFinished{tmState: makeTmState(100, 0), blocksSynced: 100},
 				},
 			},
 		},
@@ -13,6 +13,7 @@ import (
 	bcproto "github.com/line/ostracon/proto/ostracon/blockchain"
 	"github.com/line/ostracon/state"
 	"github.com/line/ostracon/types"
+	"github.com/line/ostracon/version"
 )
 
 const (
@@ -414,7 +415,12 @@ func (r *BlockchainReactor) demux(events <-chan Event) {
 			case pcBlockVerificationFailure:
 				r.scheduler.send(event)
 			case pcFinished:
-				r.logger.Info("Fast sync complete, switching to consensus")
+				if blockProtocol := event.tmState.Version.Consensus.Block; !version.IsBlockProtocolSupported(blockProtocol) {
+					r.logger.Error("Stopping fast sync, switching to consensus", "err", state.ErrUnsupportedBlockProtocol{
+						Height: event.tmState.LastBlockHeight + 1, BlockProtocol: blockProtocol})
+				} else {
+					r.logger.Info("Fast sync complete, switching to consensus")
+				}
 				if !r.io.trySwitchToConsensus(event.tmState, event.blocksSynced > 0 || r.stateSynced) {
 					r.logger.Error("Failed to switch to consensus reactor")
 				}
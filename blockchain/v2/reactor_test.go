@@ -455,7 +455,7 @@ func makeTxs(height int64) (txs []types.Tx) {
 
 func makeBlock(privVal types.PrivValidator, height int64, state sm.State, lastCommit *types.Commit) *types.Block {
 	message := state.MakeHashMessage(0)
-	proof, _ := privVal.GenerateVRFProof(message)
+	proof, _ := privVal.GenerateVRFProof(message, "")
 	proposerAddr := state.Validators.SelectProposer(state.LastProofHash, height, 0).Address
 	block, _ := state.MakeBlock(height, makeTxs(height), lastCommit, nil, proposerAddr, 0, proof)
 	return block
@@ -472,8 +472,9 @@ func randGenesisDoc(chainID string, numValidators int, randPower bool, minPower
 	for i := 0; i < numValidators; i++ {
 		val, privVal := types.RandValidator(randPower, minPower)
 		validators[i] = types.GenesisValidator{
-			PubKey: val.PubKey,
-			Power:  val.StakingPower,
+			PubKey:            val.PubKey,
+			Power:             val.StakingPower,
+			ProofOfPossession: val.ProofOfPossession,
 		}
 		privValidators[i] = privVal
 	}
@@ -21,7 +21,7 @@ var GenValidatorCmd = &cobra.Command{
 
 func init() {
 	GenValidatorCmd.Flags().String("priv_key_type", config.PrivKeyType,
-		"Specify validator's private key type (ed25519 | composite)")
+		"Specify validator's private key type (ed25519 | composite | secp256k1)")
 }
 
 func genValidator(cmd *cobra.Command, args []string) {
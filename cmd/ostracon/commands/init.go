@@ -27,7 +27,7 @@ func NewInitCmd() *cobra.Command {
 
 func AddInitFlags(cmd *cobra.Command) {
 	cmd.Flags().String("priv_key_type", config.PrivKeyType,
-		"Specify validator's private key type (ed25519 | composite)")
+		"Specify validator's private key type (ed25519 | composite | secp256k1)")
 }
 
 func initFiles(cmd *cobra.Command, args []string) error {
@@ -82,10 +82,15 @@ func initFilesWithConfig(config *cfg.Config) error {
 		if err != nil {
 			return fmt.Errorf("can't get pubkey: %w", err)
 		}
+		pop, err := types.ProofOfPossession(pv.Key.PrivKey)
+		if err != nil {
+			return fmt.Errorf("can't generate proof-of-possession: %w", err)
+		}
 		genDoc.Validators = []types.GenesisValidator{{
-			Address: pubKey.Address(),
-			PubKey:  pubKey,
-			Power:   10,
+			Address:           pubKey.Address(),
+			PubKey:            pubKey,
+			Power:             10,
+			ProofOfPossession: pop,
 		}}
 
 		if err := genDoc.SaveAs(genFile); err != nil {
@@ -26,6 +26,7 @@ import (
 	dbs "github.com/line/ostracon/light/store/db"
 	rpchttp "github.com/line/ostracon/rpc/client/http"
 	rpcserver "github.com/line/ostracon/rpc/jsonrpc/server"
+	"github.com/line/ostracon/types"
 )
 
 // LightCmd represents the base command when called without any subcommands
@@ -188,7 +189,9 @@ func runProxy(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	voterParams := genDocResult.Genesis.VoterParams
+	genParams := genDocResult.Genesis.ConsensusParams
+	options = append(options, light.GenesisParams(types.VoterParamsFromProto(&genParams.Voter),
+		genParams.Validator.VrfSuite))
 
 	var c *light.Client
 	if trustedHeight > 0 && len(trustedHash) > 0 { // fresh installation
@@ -203,7 +206,6 @@ func runProxy(cmd *cobra.Command, args []string) error {
 			primaryAddr,
 			witnessesAddrs,
 			dbs.New(db, chainID),
-			voterParams,
 			options...,
 		)
 	} else { // continue from latest state
@@ -213,7 +215,6 @@ func runProxy(cmd *cobra.Command, args []string) error {
 			primaryAddr,
 			witnessesAddrs,
 			dbs.New(db, chainID),
-			voterParams,
 			options...,
 		)
 	}
@@ -25,9 +25,9 @@ var keepAddrBook bool
 func init() {
 	ResetAllCmd.Flags().BoolVar(&keepAddrBook, "keep-addr-book", false, "keep the address book intact")
 	ResetAllCmd.Flags().String("priv_key_type", config.PrivKeyType,
-		"Specify validator's private key type (ed25519 | composite)")
+		"Specify validator's private key type (ed25519 | composite | secp256k1)")
 	ResetPrivValidatorCmd.Flags().String("priv_key_type", config.PrivKeyType,
-		"Specify validator's private key type (ed25519 | composite)")
+		"Specify validator's private key type (ed25519 | composite | secp256k1)")
 }
 
 // ResetPrivValidatorCmd resets the private validator files.
@@ -83,6 +83,14 @@ func AddNodeFlags(cmd *cobra.Command) {
 		"consensus.create_empty_blocks_interval",
 		config.Consensus.CreateEmptyBlocksInterval.String(),
 		"the possible interval between empty blocks")
+	cmd.Flags().Int64(
+		"consensus.halt_height",
+		config.Consensus.HaltHeight,
+		"halt the node after committing the block at this height (0 disables it)")
+	cmd.Flags().Int64(
+		"consensus.halt_time",
+		config.Consensus.HaltTime,
+		"halt the node after committing the first block at or after this UNIX time in seconds (0 disables it)")
 
 	// db flags
 	cmd.Flags().String(
@@ -95,6 +103,10 @@ func AddNodeFlags(cmd *cobra.Command) {
 		"database directory")
 }
 
+// HaltExitCode is the exit code of the node, when it halts at the halt height
+// or time of the consensus config.
+const HaltExitCode = 3
+
 // NewRunNodeCmd returns the command that allows the CLI to start a node.
 // It can be used with a custom PrivValidator and in-process ABCI application.
 func NewRunNodeCmd(nodeProvider nm.Provider) *cobra.Command {
@@ -127,8 +139,14 @@ func NewRunNodeCmd(nodeProvider nm.Provider) *cobra.Command {
 				}
 			})
 
-			// Run forever.
-			select {}
+			// Run until the consensus halts at the halt height or time of the config.
+			<-n.ConsensusState().Halted()
+			logger.Info("Halted the node", "reason", n.ConsensusState().HaltReason())
+			if err := n.Stop(); err != nil {
+				logger.Error("unable to stop the node", "error", err)
+			}
+			os.Exit(HaltExitCode)
+			return nil
 		},
 	}
 
@@ -76,7 +76,7 @@ func init() {
 	TestnetFilesCmd.Flags().BoolVar(&randomMonikers, "random-monikers", false,
 		"randomize the moniker for each generated node")
 	TestnetFilesCmd.Flags().StringVar(&privKeyType, "priv-key-type", privval.PrivKeyTypeEd25519,
-		"specify validator's private key type (ed25519 | composite)")
+		"specify validator's private key type (ed25519 | composite | secp256k1)")
 }
 
 // TestnetFilesCmd allows initialisation of files for an Ostracon testnet.
@@ -152,11 +152,16 @@ func testnetFiles(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return fmt.Errorf("can't get pubkey: %w", err)
 		}
+		pop, err := types.ProofOfPossession(pv.Key.PrivKey)
+		if err != nil {
+			return fmt.Errorf("can't generate proof-of-possession: %w", err)
+		}
 		genVals[i] = types.GenesisValidator{
-			Address: pubKey.Address(),
-			PubKey:  pubKey,
-			Power:   1,
-			Name:    nodeDirName,
+			Address:           pubKey.Address(),
+			PubKey:            pubKey,
+			Power:             1,
+			Name:              nodeDirName,
+			ProofOfPossession: pop,
 		}
 	}
 
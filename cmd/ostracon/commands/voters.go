@@ -0,0 +1,333 @@
+package commands
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/ostracon/crypto/tmhash"
+	tmjson "github.com/line/ostracon/libs/json"
+	ctypes "github.com/line/ostracon/rpc/core/types"
+	rpctypes "github.com/line/ostracon/rpc/jsonrpc/types"
+	"github.com/line/ostracon/types"
+)
+
+var (
+	simulateGenesisFile             string
+	simulateValidatorsFile          string
+	simulateSeeds                   int
+	simulateOutput                  string
+	voterElectionThreshold          int32
+	maxTolerableByzantinePercentage int32
+
+	flagVoterElectionThreshold          = "voter-election-threshold"
+	flagMaxTolerableByzantinePercentage = "max-tolerable-byzantine-percentage"
+)
+
+// VotersCmd defines the root command containing subcommands that assist in
+// analyzing the voter election.
+var VotersCmd = &cobra.Command{
+	Use:   "voters",
+	Short: "Utilities for analyzing the voter election",
+}
+
+var votersSimulateCmd = &cobra.Command{
+	Use:   "simulate",
+	Short: "Simulate the voter election of a validator set with many seeds",
+	Long: `Simulate the voter election of a validator set with many seeds and report the distribution
+of the voter count, the share of the staking power elected, the probability of each validator
+being a voter and the worst-case share of the voting power that the validators holding
+max-tolerable-byzantine-percentage of the staking power can get.
+
+The validator set is read from the genesis file by default, or from a dump of the /validators
+RPC endpoint. The voter params are read from the genesis file unless they are given as flags.`,
+	Example: `ostracon voters simulate --validators validators.json --max-tolerable-byzantine-percentage 20`,
+	RunE:    simulateVoters,
+}
+
+func init() {
+	votersSimulateCmd.Flags().StringVar(&simulateGenesisFile, "genesis", "",
+		"genesis file to read the validators and the voter params from (defaults to the node's genesis file)")
+	votersSimulateCmd.Flags().StringVar(&simulateValidatorsFile, "validators", "",
+		"dump of the /validators RPC endpoint to read the validators from, which must include all the validators")
+	votersSimulateCmd.Flags().IntVar(&simulateSeeds, "seeds", 10000,
+		"number of seeds to run the election with")
+	votersSimulateCmd.Flags().StringVar(&simulateOutput, "output", "table",
+		"output format (table | json)")
+	votersSimulateCmd.Flags().Int32Var(&voterElectionThreshold, flagVoterElectionThreshold,
+		types.DefaultVoterElectionThreshold,
+		"minimum number of voters, all validators are voters if there are no more validators than this")
+	votersSimulateCmd.Flags().Int32Var(&maxTolerableByzantinePercentage, flagMaxTolerableByzantinePercentage,
+		types.DefaultMaxTolerableByzantinePercentage,
+		"max percentage of the staking power that can be byzantine")
+
+	VotersCmd.AddCommand(votersSimulateCmd)
+}
+
+func simulateVoters(cmd *cobra.Command, args []string) error {
+	if simulateSeeds <= 0 {
+		return fmt.Errorf("seeds must be greater than 0. Got %d", simulateSeeds)
+	}
+	if simulateOutput != "table" && simulateOutput != "json" {
+		return fmt.Errorf("unknown output format: %s", simulateOutput)
+	}
+
+	var (
+		vals        *types.ValidatorSet
+		voterParams = types.DefaultVoterParams()
+		err         error
+	)
+	if simulateValidatorsFile != "" {
+		vals, err = loadValidatorsDump(simulateValidatorsFile)
+	} else {
+		genesisFile := simulateGenesisFile
+		if genesisFile == "" {
+			genesisFile = config.GenesisFile()
+		}
+		vals, voterParams, err = loadGenesisValidators(genesisFile)
+	}
+	if err != nil {
+		return err
+	}
+
+	if cmd.Flags().Changed(flagVoterElectionThreshold) {
+		voterParams.VoterElectionThreshold = voterElectionThreshold
+	}
+	if cmd.Flags().Changed(flagMaxTolerableByzantinePercentage) {
+		voterParams.MaxTolerableByzantinePercentage = maxTolerableByzantinePercentage
+	}
+	if err := voterParams.Validate(); err != nil {
+		return err
+	}
+
+	result, err := simulateElection(vals, voterParams, simulateSeeds)
+	if err != nil {
+		return err
+	}
+
+	if simulateOutput == "json" {
+		bz, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal the simulation result: %w", err)
+		}
+		fmt.Println(string(bz))
+		return nil
+	}
+	return result.writeTable(os.Stdout)
+}
+
+func loadGenesisValidators(genesisFile string) (*types.ValidatorSet, *types.VoterParams, error) {
+	genDoc, err := types.GenesisDocFromFile(genesisFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	validators := make([]*types.Validator, len(genDoc.Validators))
+	for i, val := range genDoc.Validators {
+		validators[i] = types.NewValidator(val.PubKey, val.Power)
+	}
+	vals, err := makeSimulationValidatorSet(validators)
+	if err != nil {
+		return nil, nil, err
+	}
+	return vals, types.VoterParamsFromProto(&genDoc.ConsensusParams.Voter), nil
+}
+
+// loadValidatorsDump reads the result of the /validators RPC endpoint, with or without the JSON-RPC
+// response around it.
+func loadValidatorsDump(validatorsFile string) (*types.ValidatorSet, error) {
+	bz, err := ioutil.ReadFile(validatorsFile)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read the validators file: %w", err)
+	}
+
+	var response rpctypes.RPCResponse
+	if err := json.Unmarshal(bz, &response); err == nil {
+		if response.Error != nil {
+			return nil, fmt.Errorf("the validators file contains an error response: %w", response.Error)
+		}
+		if len(response.Result) > 0 {
+			bz = response.Result
+		}
+	}
+
+	var result ctypes.ResultValidators
+	if err := tmjson.Unmarshal(bz, &result); err != nil {
+		return nil, fmt.Errorf("couldn't parse the validators file: %w", err)
+	}
+	if result.Total > len(result.Validators) {
+		return nil, fmt.Errorf("the validators file contains %d of %d validators",
+			len(result.Validators), result.Total)
+	}
+	return makeSimulationValidatorSet(result.Validators)
+}
+
+func makeSimulationValidatorSet(validators []*types.Validator) (*types.ValidatorSet, error) {
+	vals := &types.ValidatorSet{Validators: validators}
+	if err := vals.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	totalStakingPower := int64(0)
+	for _, val := range validators {
+		if val.StakingPower < 0 {
+			return nil, fmt.Errorf("validator %X has a negative staking power", val.Address)
+		}
+		totalStakingPower += val.StakingPower
+	}
+	if totalStakingPower == 0 {
+		return nil, errors.New("the total staking power of the validators must be greater than 0")
+	}
+	return vals, nil
+}
+
+type voterSimulationStats struct {
+	Min  float64 `json:"min"`
+	Mean float64 `json:"mean"`
+	Max  float64 `json:"max"`
+}
+
+func (s *voterSimulationStats) add(i int, value float64) {
+	if i == 0 || value < s.Min {
+		s.Min = value
+	}
+	if i == 0 || value > s.Max {
+		s.Max = value
+	}
+	s.Mean += (value - s.Mean) / float64(i+1)
+}
+
+type validatorSimulation struct {
+	Address          types.Address `json:"address"`
+	StakingPower     int64         `json:"staking_power"`
+	VoterProbability float64       `json:"voter_probability"`
+}
+
+type voterSimulation struct {
+	Seeds             int               `json:"seeds"`
+	VoterParams       types.VoterParams `json:"voter_params"`
+	TotalStakingPower int64             `json:"total_staking_power"`
+
+	// VoterCounts maps the number of voters to the number of elections electing that many voters
+	VoterCounts map[int]int `json:"voter_counts"`
+	// ElectedStakeShare is the share of the total staking power held by the voters
+	ElectedStakeShare voterSimulationStats `json:"elected_stake_share"`
+	// ByzantineVotingPowerShare is the share of the voting power of the voters with the highest voting power
+	// per staking power, which hold MaxTolerableByzantinePercentage of the total staking power. The election
+	// is safe while it is less than 1/3.
+	ByzantineVotingPowerShare voterSimulationStats `json:"byzantine_voting_power_share"`
+
+	Validators []validatorSimulation `json:"validators"`
+}
+
+// simulateElection runs the voter election with the proof hashes of the given number of seeds.
+func simulateElection(vals *types.ValidatorSet, voterParams *types.VoterParams, seeds int) (
+	*voterSimulation, error) {
+	if seeds <= 0 {
+		return nil, fmt.Errorf("seeds must be greater than 0. Got %d", seeds)
+	}
+
+	totalStakingPower := vals.TotalStakingPower()
+	result := &voterSimulation{
+		Seeds:             seeds,
+		VoterParams:       *voterParams,
+		TotalStakingPower: totalStakingPower,
+		VoterCounts:       make(map[int]int),
+	}
+	elected := make(map[string]int, vals.Size())
+	seed := make([]byte, 8)
+	for i := 0; i < seeds; i++ {
+		binary.LittleEndian.PutUint64(seed, uint64(i))
+		voters := types.SelectVoter(vals, tmhash.Sum(seed), voterParams)
+
+		electedStakingPower := int64(0)
+		for _, voter := range voters.Voters {
+			elected[string(voter.Address)]++
+			electedStakingPower += voter.StakingPower
+		}
+		result.VoterCounts[voters.Size()]++
+		result.ElectedStakeShare.add(i, float64(electedStakingPower)/float64(totalStakingPower))
+		result.ByzantineVotingPowerShare.add(i, byzantineVotingPowerShare(voters, totalStakingPower,
+			voterParams.MaxTolerableByzantinePercentage))
+	}
+
+	result.Validators = make([]validatorSimulation, vals.Size())
+	for i, val := range vals.Validators {
+		result.Validators[i] = validatorSimulation{
+			Address:          val.Address,
+			StakingPower:     val.StakingPower,
+			VoterProbability: float64(elected[string(val.Address)]) / float64(seeds),
+		}
+	}
+	sort.SliceStable(result.Validators, func(i, j int) bool {
+		return result.Validators[i].StakingPower > result.Validators[j].StakingPower
+	})
+	return result, nil
+}
+
+// byzantineVotingPowerShare returns the share of the voting power that the voters with the highest voting
+// power per staking power get, when they hold maxTolerableByzantinePercentage of the total staking power.
+func byzantineVotingPowerShare(voters *types.VoterSet, totalStakingPower int64,
+	maxTolerableByzantinePercentage int32) float64 {
+	sorted := make([]*types.Validator, len(voters.Voters))
+	copy(sorted, voters.Voters)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a := new(big.Int).Mul(big.NewInt(sorted[i].VotingPower), big.NewInt(sorted[j].StakingPower))
+		b := new(big.Int).Mul(big.NewInt(sorted[j].VotingPower), big.NewInt(sorted[i].StakingPower))
+		return a.Cmp(b) > 0
+	})
+
+	tolerableStakingPower := new(big.Int).Mul(big.NewInt(totalStakingPower),
+		big.NewInt(int64(maxTolerableByzantinePercentage)))
+	byzantineStakingPower := int64(0)
+	byzantineVotingPower := int64(0)
+	for _, voter := range sorted {
+		if new(big.Int).Mul(big.NewInt(byzantineStakingPower), big.NewInt(100)).Cmp(tolerableStakingPower) >= 0 {
+			break
+		}
+		byzantineStakingPower += voter.StakingPower
+		byzantineVotingPower += voter.VotingPower
+	}
+	return float64(byzantineVotingPower) / float64(voters.TotalVotingPower())
+}
+
+func (result *voterSimulation) writeTable(out io.Writer) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "seeds\t%d\n", result.Seeds)
+	fmt.Fprintf(w, "voter election threshold\t%d\n", result.VoterParams.VoterElectionThreshold)
+	fmt.Fprintf(w, "max tolerable byzantine percentage\t%d\n", result.VoterParams.MaxTolerableByzantinePercentage)
+	fmt.Fprintf(w, "total staking power\t%d\n", result.TotalStakingPower)
+	fmt.Fprintln(w)
+
+	fmt.Fprintf(w, "\tmin\tmean\tmax\n")
+	fmt.Fprintf(w, "elected stake share\t%.4f\t%.4f\t%.4f\n", result.ElectedStakeShare.Min,
+		result.ElectedStakeShare.Mean, result.ElectedStakeShare.Max)
+	fmt.Fprintf(w, "byzantine voting power share\t%.4f\t%.4f\t%.4f\n", result.ByzantineVotingPowerShare.Min,
+		result.ByzantineVotingPowerShare.Mean, result.ByzantineVotingPowerShare.Max)
+	fmt.Fprintln(w)
+
+	counts := make([]int, 0, len(result.VoterCounts))
+	for count := range result.VoterCounts {
+		counts = append(counts, count)
+	}
+	sort.Ints(counts)
+	fmt.Fprintf(w, "voters\telections\tprobability\n")
+	for _, count := range counts {
+		fmt.Fprintf(w, "%d\t%d\t%.4f\n", count, result.VoterCounts[count],
+			float64(result.VoterCounts[count])/float64(result.Seeds))
+	}
+	fmt.Fprintln(w)
+
+	fmt.Fprintf(w, "validator\tstaking power\tvoter probability\n")
+	for _, val := range result.Validators {
+		fmt.Fprintf(w, "%X\t%d\t%.4f\n", val.Address, val.StakingPower, val.VoterProbability)
+	}
+	return w.Flush()
+}
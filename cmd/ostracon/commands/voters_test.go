@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ctypes "github.com/line/ostracon/rpc/core/types"
+	rpctypes "github.com/line/ostracon/rpc/jsonrpc/types"
+	"github.com/line/ostracon/types"
+)
+
+func TestSimulateElection(t *testing.T) {
+	const seeds = 100
+	validators := make([]*types.Validator, 30)
+	for i := range validators {
+		validators[i], _ = types.RandValidator(false, int64((i+1)*(i+1)*10))
+	}
+	vals, err := makeSimulationValidatorSet(validators)
+	require.NoError(t, err)
+
+	// all the validators are voters
+	result, err := simulateElection(vals, &types.VoterParams{VoterElectionThreshold: 30,
+		MaxTolerableByzantinePercentage: 20}, seeds)
+	require.NoError(t, err)
+	assert.Equal(t, map[int]int{30: seeds}, result.VoterCounts)
+	assert.Equal(t, voterSimulationStats{1, 1, 1}, result.ElectedStakeShare)
+	for _, val := range result.Validators {
+		assert.Equal(t, float64(1), val.VoterProbability)
+	}
+
+	// the voters are elected
+	result, err = simulateElection(vals, &types.VoterParams{VoterElectionThreshold: 5,
+		MaxTolerableByzantinePercentage: 20}, seeds)
+	require.NoError(t, err)
+	elections := 0
+	for count, n := range result.VoterCounts {
+		assert.True(t, count >= 5 && count <= 30)
+		elections += n
+	}
+	assert.Equal(t, seeds, elections)
+	assert.True(t, result.ElectedStakeShare.Max <= 1)
+	assert.True(t, result.ByzantineVotingPowerShare.Max < float64(1)/3)
+	require.Len(t, result.Validators, 30)
+	for i := 1; i < len(result.Validators); i++ {
+		assert.True(t, result.Validators[i-1].StakingPower >= result.Validators[i].StakingPower)
+	}
+
+	_, err = simulateElection(vals, types.DefaultVoterParams(), 0)
+	assert.Error(t, err)
+}
+
+func TestLoadValidatorsDump(t *testing.T) {
+	vals, _ := types.RandValidatorSet(3, 10)
+	dir := t.TempDir()
+
+	result := &ctypes.ResultValidators{Validators: vals.Validators, Count: 3, Total: 3}
+	bz, err := json.Marshal(rpctypes.NewRPCSuccessResponse(rpctypes.JSONRPCIntID(-1), result))
+	require.NoError(t, err)
+	file := filepath.Join(dir, "validators.json")
+	require.NoError(t, ioutil.WriteFile(file, bz, 0600))
+
+	loaded, err := loadValidatorsDump(file)
+	require.NoError(t, err)
+	assert.Equal(t, vals.Hash(), loaded.Hash())
+
+	// a partial dump of a paginated result
+	result = &ctypes.ResultValidators{Validators: vals.Validators[:2], Count: 2, Total: 3}
+	bz, err = json.Marshal(rpctypes.NewRPCSuccessResponse(rpctypes.JSONRPCIntID(-1), result))
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(file, bz, 0600))
+
+	_, err = loadValidatorsDump(file)
+	assert.Error(t, err)
+}
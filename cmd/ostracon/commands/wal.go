@@ -0,0 +1,164 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	cs "github.com/line/ostracon/consensus"
+)
+
+var (
+	walFile     string
+	dumpHeight  int64
+	dumpRound   int32
+	dumpMsgType []string
+)
+
+// WALCmd groups the commands to inspect and repair the consensus WAL.
+var WALCmd = &cobra.Command{
+	Use:   "wal",
+	Short: "Verify, repair and dump the consensus WAL",
+}
+
+// WALVerifyCmd reports the state of every file of the consensus WAL.
+var WALVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify the consensus WAL and report the corrupted files",
+	Long: `
+Verify decodes every record of the files of the consensus WAL, from the oldest to
+the head, and reports the number of records, the range of the heights ended in
+each file and the offset of the first corrupted record, if any. It fails if a
+file is corrupted.
+`,
+	RunE: verifyWAL,
+}
+
+// WALRepairCmd truncates the consensus WAL at its first corruption.
+var WALRepairCmd = &cobra.Command{
+	Use:   "repair",
+	Short: "Truncate the consensus WAL to the last good record before its first corruption",
+	Long: `
+Repair truncates the first corrupted file of the consensus WAL to its last good
+record, and moves the later files of the WAL, whose records do not follow it,
+to <file>.CORRUPTED. A copy of the corrupted file is first saved to
+<file>.CORRUPTED. The node must be stopped while the WAL is repaired.
+`,
+	RunE: repairWAL,
+}
+
+// WALDumpCmd prints the messages of the consensus WAL as JSON.
+var WALDumpCmd = &cobra.Command{
+	Use:   "dump",
+	Short: "Dump the messages of the consensus WAL as JSON",
+	Long: `
+Dump prints the messages of the consensus WAL as JSON, one per line, optionally
+filtered by height, round and message type. Each end of height is also marked
+by an "ENDHEIGHT <height>" line, so that the output can be converted back into
+a WAL by scripts/json2wal.
+`,
+	RunE: dumpWAL,
+}
+
+func init() {
+	WALCmd.PersistentFlags().StringVar(&walFile, "wal", "",
+		"path to the WAL file (defaults to the WAL of the node)")
+
+	WALDumpCmd.Flags().Int64Var(&dumpHeight, "height", 0, "only dump the messages of this height (0 for all)")
+	WALDumpCmd.Flags().Int32Var(&dumpRound, "round", -1, "only dump the messages of this round (-1 for all)")
+	WALDumpCmd.Flags().StringSliceVar(&dumpMsgType, "type", nil,
+		fmt.Sprintf("only dump the messages of these types (%s)", strings.Join(cs.WALMsgTypes, ", ")))
+
+	WALCmd.AddCommand(WALVerifyCmd, WALRepairCmd, WALDumpCmd)
+}
+
+func walFilePath() string {
+	if walFile != "" {
+		return walFile
+	}
+	return config.Consensus.WalFile()
+}
+
+func verifyWAL(cmd *cobra.Command, args []string) error {
+	reports, err := cs.ScanWAL(walFilePath(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to scan WAL: %w", err)
+	}
+
+	corrupted := false
+	for _, r := range reports {
+		fmt.Printf("%s: %d records, %d bytes", r.Path, r.Records, r.Size)
+		if r.MaxEndHeight > 0 {
+			fmt.Printf(", end heights %d to %d", r.MinEndHeight, r.MaxEndHeight)
+		}
+		if r.IsCorrupted() {
+			corrupted = true
+			fmt.Printf(", corrupted at offset %d: %s\n", r.CorruptedOffset, r.Corruption)
+		} else {
+			fmt.Println(", ok")
+		}
+	}
+
+	if corrupted {
+		return errors.New("the WAL is corrupted, run `ostracon wal repair` to truncate it")
+	}
+	return nil
+}
+
+func repairWAL(cmd *cobra.Command, args []string) error {
+	repair, err := cs.RepairWAL(walFilePath())
+	if repair != nil {
+		r := repair.Truncated
+		fmt.Printf("%s: truncated from %d to %d bytes, backed up to %s.CORRUPTED\n",
+			r.Path, r.Size, r.CorruptedOffset, r.Path)
+		for _, r := range repair.MovedAside {
+			fmt.Printf("%s: %d records after the corruption, moved to %s.CORRUPTED\n", r.Path, r.Records, r.Path)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to repair WAL: %w", err)
+	}
+
+	if repair == nil {
+		fmt.Println("The WAL is not corrupted")
+	}
+	return nil
+}
+
+func dumpWAL(cmd *cobra.Command, args []string) error {
+	filter := cs.WALMessageFilter{
+		Height: dumpHeight,
+		Round:  dumpRound,
+		Types:  dumpMsgType,
+	}
+	for _, t := range filter.Types {
+		if !containsString(cs.WALMsgTypes, t) {
+			return fmt.Errorf("unknown message type %q, want one of %s", t, strings.Join(cs.WALMsgTypes, ", "))
+		}
+	}
+
+	reports, err := cs.DumpWAL(walFilePath(), filter, os.Stdout)
+	if err != nil {
+		return fmt.Errorf("failed to dump WAL: %w", err)
+	}
+
+	for _, r := range reports {
+		if r.IsCorrupted() {
+			fmt.Fprintf(os.Stderr, "%s: skipped the records from offset %d: %s\n",
+				r.Path, r.CorruptedOffset, r.Corruption)
+		}
+	}
+	return nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
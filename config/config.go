@@ -662,6 +662,11 @@ func DefaultFuzzConnConfig() *FuzzConnConfig {
 
 // MempoolConfig defines the configuration options for the Tendermint mempool
 type MempoolConfig struct {
+	// Mempool version to use:
+	//  1) "v0" - FIFO mempool.
+	//  2) "v1" - prioritized mempool. It checks every new tx with the app
+	//     even when it's full, since the tx may outrank the lowest priority.
+	Version   string `mapstructure:"version"`
 	RootDir   string `mapstructure:"home"`
 	Recheck   bool   `mapstructure:"recheck"`
 	Broadcast bool   `mapstructure:"broadcast"`
@@ -681,15 +686,32 @@ type MempoolConfig struct {
 	// Maximum size of a single transaction
 	// NOTE: the max size of a tx transmitted over the network is {max_tx_bytes}.
 	MaxTxBytes int `mapstructure:"max_tx_bytes"`
-	// Maximum size of a batch of transactions to send to a peer
-	// Including space needed by encoding (one varint per transaction).
-	// XXX: Unused due to https://github.com/line/ostracon/issues/5796
+	// Announce the hashes of the txs to the peers supporting it, which request
+	// the txs they don't have, instead of sending the txs to them
+	Announce bool `mapstructure:"announce"`
+	// Maximum size of a batch of tx hashes announced to a peer or requested
+	// from it, including space needed by encoding (0: up to 1MB)
 	MaxBatchBytes int `mapstructure:"max_batch_bytes"`
+	// Time after which a tx requested from a peer is requested from another
+	// peer which announced it
+	TxRequestTimeout time.Duration `mapstructure:"tx_request_timeout"`
+	// Maximum number of txs requested from a peer at once (0: unlimited)
+	MaxTxRequestsPerPeer int `mapstructure:"max_tx_requests_per_peer"`
+	// Maximum number of txs announced by a peer, which haven't been received
+	// yet (0: unlimited)
+	MaxTxAnnouncementsPerPeer int `mapstructure:"max_tx_announcements_per_peer"`
+	// Number of blocks after which a tx is purged from the mempool, unless it is
+	// committed earlier (0: disabled)
+	TTLNumBlocks int64 `mapstructure:"ttl_num_blocks"`
+	// Time after which a tx is purged from the mempool, unless it is committed
+	// earlier (0: disabled)
+	TTLDuration time.Duration `mapstructure:"ttl_duration"`
 }
 
 // DefaultMempoolConfig returns a default configuration for the Tendermint mempool
 func DefaultMempoolConfig() *MempoolConfig {
 	return &MempoolConfig{
+		Version:   "v0",
 		Recheck:   true,
 		Broadcast: true,
 		WalPath:   "",
@@ -699,6 +721,12 @@ func DefaultMempoolConfig() *MempoolConfig {
 		MaxTxsBytes: 1024 * 1024 * 1024, // 1GB
 		CacheSize:   10000,
 		MaxTxBytes:  1024 * 1024, // 1MB
+
+		Announce:                  true,
+		MaxBatchBytes:             64 * 1024, // 64KB
+		TxRequestTimeout:          1 * time.Second,
+		MaxTxRequestsPerPeer:      1000,
+		MaxTxAnnouncementsPerPeer: 2000,
 	}
 }
 
@@ -722,6 +750,11 @@ func (cfg *MempoolConfig) WalEnabled() bool {
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *MempoolConfig) ValidateBasic() error {
+	switch cfg.Version {
+	case "v0", "v1":
+	default:
+		return fmt.Errorf("unknown mempool version %s", cfg.Version)
+	}
 	if cfg.Size < 0 {
 		return errors.New("size can't be negative")
 	}
@@ -734,6 +767,24 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.MaxTxBytes < 0 {
 		return errors.New("max_tx_bytes can't be negative")
 	}
+	if cfg.MaxBatchBytes < 0 {
+		return errors.New("max_batch_bytes can't be negative")
+	}
+	if cfg.TxRequestTimeout <= 0 {
+		return errors.New("tx_request_timeout must be positive")
+	}
+	if cfg.MaxTxRequestsPerPeer < 0 {
+		return errors.New("max_tx_requests_per_peer can't be negative")
+	}
+	if cfg.MaxTxAnnouncementsPerPeer < 0 {
+		return errors.New("max_tx_announcements_per_peer can't be negative")
+	}
+	if cfg.TTLNumBlocks < 0 {
+		return errors.New("ttl_num_blocks can't be negative")
+	}
+	if cfg.TTLDuration < 0 {
+		return errors.New("ttl_duration can't be negative")
+	}
 	return nil
 }
 
@@ -869,6 +920,14 @@ type ConsensusConfig struct {
 	// Make progress as soon as we have all the precommits (as if TimeoutCommit = 0)
 	SkipTimeoutCommit bool `mapstructure:"skip_timeout_commit"`
 
+	// Adapt the timeouts of the propose, prevote and precommit steps to the latencies
+	// observed in the past heights, instead of using TimeoutPropose, TimeoutPrevote and
+	// TimeoutPrecommit. The deltas are still added for each round
+	AdaptiveTimeouts bool `mapstructure:"adaptive_timeouts"`
+	// Bounds of the adaptive timeouts
+	AdaptiveTimeoutMin time.Duration `mapstructure:"adaptive_timeout_min"`
+	AdaptiveTimeoutMax time.Duration `mapstructure:"adaptive_timeout_max"`
+
 	// EmptyBlocks mode and possible interval between empty blocks
 	CreateEmptyBlocks         bool          `mapstructure:"create_empty_blocks"`
 	CreateEmptyBlocksInterval time.Duration `mapstructure:"create_empty_blocks_interval"`
@@ -878,6 +937,12 @@ type ConsensusConfig struct {
 	PeerQueryMaj23SleepDuration time.Duration `mapstructure:"peer_query_maj23_sleep_duration"`
 
 	DoubleSignCheckHeight int64 `mapstructure:"double_sign_check_height"`
+
+	// Halt after committing the block at this height (0 disables it)
+	HaltHeight int64 `mapstructure:"halt_height"`
+	// Halt after committing the first block whose time is at or after this
+	// UNIX time in seconds (0 disables it)
+	HaltTime int64 `mapstructure:"halt_time"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service
@@ -892,11 +957,16 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		TimeoutPrecommitDelta:       500 * time.Millisecond,
 		TimeoutCommit:               1000 * time.Millisecond,
 		SkipTimeoutCommit:           false,
+		AdaptiveTimeouts:            false,
+		AdaptiveTimeoutMin:          500 * time.Millisecond,
+		AdaptiveTimeoutMax:          10000 * time.Millisecond,
 		CreateEmptyBlocks:           true,
 		CreateEmptyBlocksInterval:   0 * time.Second,
 		PeerGossipSleepDuration:     100 * time.Millisecond,
 		PeerQueryMaj23SleepDuration: 2000 * time.Millisecond,
 		DoubleSignCheckHeight:       int64(0),
+		HaltHeight:                  int64(0),
+		HaltTime:                    int64(0),
 	}
 }
 
@@ -912,6 +982,8 @@ func TestConsensusConfig() *ConsensusConfig {
 	// NOTE: when modifying, make sure to update time_iota_ms (testGenesisFmt) in toml.go
 	cfg.TimeoutCommit = 10 * time.Millisecond
 	cfg.SkipTimeoutCommit = true
+	cfg.AdaptiveTimeoutMin = 10 * time.Millisecond
+	cfg.AdaptiveTimeoutMax = 100 * time.Millisecond
 	cfg.PeerGossipSleepDuration = 5 * time.Millisecond
 	cfg.PeerQueryMaj23SleepDuration = 250 * time.Millisecond
 	cfg.DoubleSignCheckHeight = int64(0)
@@ -987,6 +1059,12 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.TimeoutCommit < 0 {
 		return errors.New("timeout_commit can't be negative")
 	}
+	if cfg.AdaptiveTimeoutMin < 0 {
+		return errors.New("adaptive_timeout_min can't be negative")
+	}
+	if cfg.AdaptiveTimeoutMax < cfg.AdaptiveTimeoutMin {
+		return errors.New("adaptive_timeout_max can't be less than adaptive_timeout_min")
+	}
 	if cfg.CreateEmptyBlocksInterval < 0 {
 		return errors.New("create_empty_blocks_interval can't be negative")
 	}
@@ -999,6 +1077,12 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.DoubleSignCheckHeight < 0 {
 		return errors.New("double_sign_check_height can't be negative")
 	}
+	if cfg.HaltHeight < 0 {
+		return errors.New("halt_height can't be negative")
+	}
+	if cfg.HaltTime < 0 {
+		return errors.New("halt_time can't be negative")
+	}
 	return nil
 }
 
@@ -114,6 +114,11 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		"MaxTxsBytes",
 		"CacheSize",
 		"MaxTxBytes",
+		"MaxBatchBytes",
+		"MaxTxRequestsPerPeer",
+		"MaxTxAnnouncementsPerPeer",
+		"TTLNumBlocks",
+		"TTLDuration",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -121,6 +126,15 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	cfg.Version = "v1"
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.Version = "v2"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.Version = "v0"
+
+	cfg.TxRequestTimeout = 0
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestStateSyncConfigValidateBasic(t *testing.T) {
@@ -160,11 +174,18 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 		"TimeoutPrecommitDelta negative":       {func(c *ConsensusConfig) { c.TimeoutPrecommitDelta = -1 }, true},
 		"TimeoutCommit":                        {func(c *ConsensusConfig) { c.TimeoutCommit = time.Second }, false},
 		"TimeoutCommit negative":               {func(c *ConsensusConfig) { c.TimeoutCommit = -1 }, true},
+		"AdaptiveTimeoutMin":                   {func(c *ConsensusConfig) { c.AdaptiveTimeoutMin = time.Second }, false},
+		"AdaptiveTimeoutMin negative":          {func(c *ConsensusConfig) { c.AdaptiveTimeoutMin = -1 }, true},
+		"AdaptiveTimeoutMax less than min":     {func(c *ConsensusConfig) { c.AdaptiveTimeoutMax = c.AdaptiveTimeoutMin - 1 }, true},
 		"PeerGossipSleepDuration":              {func(c *ConsensusConfig) { c.PeerGossipSleepDuration = time.Second }, false},
 		"PeerGossipSleepDuration negative":     {func(c *ConsensusConfig) { c.PeerGossipSleepDuration = -1 }, true},
 		"PeerQueryMaj23SleepDuration":          {func(c *ConsensusConfig) { c.PeerQueryMaj23SleepDuration = time.Second }, false},
 		"PeerQueryMaj23SleepDuration negative": {func(c *ConsensusConfig) { c.PeerQueryMaj23SleepDuration = -1 }, true},
 		"DoubleSignCheckHeight negative":       {func(c *ConsensusConfig) { c.DoubleSignCheckHeight = -1 }, true},
+		"HaltHeight":                           {func(c *ConsensusConfig) { c.HaltHeight = 100 }, false},
+		"HaltHeight negative":                  {func(c *ConsensusConfig) { c.HaltHeight = -1 }, true},
+		"HaltTime":                             {func(c *ConsensusConfig) { c.HaltTime = 1600000000 }, false},
+		"HaltTime negative":                    {func(c *ConsensusConfig) { c.HaltTime = -1 }, true},
 	}
 	for desc, tc := range testcases {
 		tc := tc // appease linter
@@ -324,6 +324,15 @@ blockchain_recv_buf_size = {{ .P2P.BlockchainRecvBufSize }}
 #######################################################
 [mempool]
 
+# Mempool version to use:
+#   1) "v0" (default) - FIFO mempool, which proposes txs in the order they arrived
+#   2) "v1" - prioritized mempool, which proposes txs in the order of the priority
+#      the app returns in ResponseCheckTx, keeping the order of the txs of each
+#      sender, and evicts the txs of the lowest priority when it is full. The
+#      priority of a tx is only known from CheckTx, so a full "v1" mempool still
+#      sends every new tx to the app's CheckTx, where "v0" rejects it up front.
+version = "{{ .Mempool.Version }}"
+
 recheck = {{ .Mempool.Recheck }}
 broadcast = {{ .Mempool.Broadcast }}
 wal_dir = "{{ js .Mempool.WalPath }}"
@@ -348,11 +357,40 @@ keep-invalid-txs-in-cache = {{ .Mempool.KeepInvalidTxsInCache }}
 # NOTE: the max size of a tx transmitted over the network is {max_tx_bytes}.
 max_tx_bytes = {{ .Mempool.MaxTxBytes }}
 
-# Maximum size of a batch of transactions to send to a peer
-# Including space needed by encoding (one varint per transaction).
-# XXX: Unused due to https://github.com/line/ostracon/issues/5796
+# Announce the hashes of the txs to the peers supporting it, which request the
+# txs they don't have, instead of sending the txs to them. The txs are still
+# sent to the peers of older versions.
+announce = {{ .Mempool.Announce }}
+
+# Maximum size of a batch of tx hashes announced to a peer or requested from it.
+# Including space needed by encoding. 0 or values above 1MB are treated as 1MB.
 max_batch_bytes = {{ .Mempool.MaxBatchBytes }}
 
+# Time after which a tx requested from a peer, which announced it, is requested
+# from another peer which also announced it.
+tx_request_timeout = "{{ .Mempool.TxRequestTimeout }}"
+
+# Maximum number of txs requested from a peer at once. The other txs announced
+# by the peer are requested after some of the requested txs arrive.
+# 0 means unlimited.
+max_tx_requests_per_peer = {{ .Mempool.MaxTxRequestsPerPeer }}
+
+# Maximum number of txs announced by a peer, which haven't been received yet.
+# The further announcements of the peer are ignored until some of the announced
+# txs arrive, or their requests time out.
+# 0 means unlimited.
+max_tx_announcements_per_peer = {{ .Mempool.MaxTxAnnouncementsPerPeer }}
+
+# Number of blocks after which a tx is purged from the mempool, unless it is
+# committed earlier. Expired txs are reported with the ExpiredTx event.
+# 0 disables the purge by the number of blocks.
+ttl_num_blocks = {{ .Mempool.TTLNumBlocks }}
+
+# Time after which a tx is purged from the mempool, unless it is committed
+# earlier. Expired txs are reported with the ExpiredTx event.
+# 0 disables the purge by time.
+ttl_duration = "{{ .Mempool.TTLDuration }}"
+
 #######################################################
 ###         State Sync Configuration Options        ###
 #######################################################
@@ -423,9 +461,27 @@ timeout_commit = "{{ .Consensus.TimeoutCommit }}"
 # So, validators should stop the state machine, wait for some blocks, and then restart the state machine to avoid panic.
 double_sign_check_height = {{ .Consensus.DoubleSignCheckHeight }}
 
+# Halt the node after committing the block at this height, e.g. for a coordinated upgrade.
+# The node exits with the code 3 and continues from this height when it is restarted.
+# 0 disables it.
+halt_height = {{ .Consensus.HaltHeight }}
+
+# Halt the node after committing the first block whose time is at or after this UNIX time in seconds.
+# The node exits with the code 3 and continues from this height when it is restarted.
+# 0 disables it.
+halt_time = {{ .Consensus.HaltTime }}
+
 # Make progress as soon as we have all the precommits (as if TimeoutCommit = 0)
 skip_timeout_commit = {{ .Consensus.SkipTimeoutCommit }}
 
+# Adapt the timeouts of the propose, prevote and precommit steps to the latencies of the proposals
+# and the votes observed in the past heights, instead of using timeout_propose, timeout_prevote and
+# timeout_precommit. The deltas are still added for each round.
+adaptive_timeouts = {{ .Consensus.AdaptiveTimeouts }}
+# Bounds of the adaptive timeouts
+adaptive_timeout_min = "{{ .Consensus.AdaptiveTimeoutMin }}"
+adaptive_timeout_max = "{{ .Consensus.AdaptiveTimeoutMax }}"
+
 # EmptyBlocks mode and possible interval between empty blocks
 create_empty_blocks = {{ .Consensus.CreateEmptyBlocks }}
 create_empty_blocks_interval = "{{ .Consensus.CreateEmptyBlocksInterval }}"
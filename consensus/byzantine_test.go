@@ -171,15 +171,15 @@ func TestByzantinePrevoteEquivocation(t *testing.T) {
 			panic("entered createProposalBlock with privValidator being nil")
 		}
 
-		var commit *types.Commit
+		var extCommit *types.ExtendedCommit
 		switch {
 		case lazyProposer.Height == lazyProposer.state.InitialHeight:
 			// We're creating a proposal for the first block.
 			// The commit is empty, but not nil.
-			commit = types.NewCommit(0, 0, types.BlockID{}, nil)
+			extCommit = types.NewExtendedCommit(types.NewCommit(0, 0, types.BlockID{}, nil), nil)
 		case lazyProposer.LastCommit.HasTwoThirdsMajority():
 			// Make the commit from LastCommit
-			commit = lazyProposer.LastCommit.MakeCommit()
+			extCommit = lazyProposer.LastCommit.MakeExtendedCommit()
 		default: // This shouldn't happen.
 			lazyProposer.Logger.Error("enterPropose: Cannot propose anything: No commit for the previous block")
 			return
@@ -187,8 +187,9 @@ func TestByzantinePrevoteEquivocation(t *testing.T) {
 
 		// omit the last signature in the commit
 		// except a proposal for the first block
-		if commit.Signatures != nil {
-			commit.Signatures[len(commit.Signatures)-1] = types.NewCommitSigAbsent()
+		if extCommit.Signatures != nil {
+			extCommit.Signatures[len(extCommit.Signatures)-1] = types.NewCommitSigAbsent()
+			extCommit.Extensions[len(extCommit.Extensions)-1] = types.VoteExtension{}
 		}
 
 		if lazyProposer.privValidatorPubKey == nil {
@@ -200,10 +201,12 @@ func TestByzantinePrevoteEquivocation(t *testing.T) {
 		proposerAddr := lazyProposer.privValidatorPubKey.Address()
 
 		message := lazyProposer.state.MakeHashMessage(lazyProposer.Round)
-		proof, _ := lazyProposer.privValidator.GenerateVRFProof(message)
-		block, blockParts := lazyProposer.blockExec.CreateProposalBlock(
-			lazyProposer.Height, lazyProposer.state, commit, proposerAddr, lazyProposer.Round, proof,
+		proof, _ := lazyProposer.privValidator.GenerateVRFProof(message, "")
+		block, blockParts, err := lazyProposer.blockExec.CreateProposalBlock(
+			lazyProposer.Height, lazyProposer.state, extCommit, proposerAddr,
+			lazyProposer.Round, proof,
 		)
+		require.NoError(t, err)
 
 		// Flush the WAL. Otherwise, we may not recompute the same proposal to sign,
 		// and the privValidator will refuse to sign anything.
@@ -209,6 +209,7 @@ func decideProposal(
 	cs1.privValidatorPubKey = oldPrivValidatorPubKey
 	validRound := cs1.ValidRound
 	chainID := cs1.state.ChainID
+	proposerBased := cs1.state.ConsensusParams.Timestamp.ProposerBased
 	cs1.mtx.Unlock()
 	if block == nil {
 		panic("Failed to createProposalBlock. Did you forget to add commit for previous block?")
@@ -217,6 +218,9 @@ func decideProposal(
 	// Make proposal
 	polRound, propBlockID := validRound, types.BlockID{Hash: block.Hash(), PartSetHeader: blockParts.Header()}
 	proposal = types.NewProposal(height, round, polRound, propBlockID)
+	if proposerBased {
+		proposal.Timestamp = block.Time
+	}
 	p := proposal.ToProto()
 	if err := vs.SignProposal(chainID, p); err != nil {
 		panic(err)
@@ -515,7 +519,7 @@ func forceProposer(cs *State, vals []*validatorStub, index []int, height []int64
 			}
 			if j+1 < len(height) && height[j+1] > height[j] {
 				message := types.MakeRoundHash(currentHash, height[j]-1, round[j])
-				proof, _ := curVal.PrivValidator.GenerateVRFProof(message)
+				proof, _ := curVal.PrivValidator.GenerateVRFProof(message, "")
 				pubKey, _ := curVal.PrivValidator.GetPubKey()
 				currentHash, _ = pubKey.VRFVerify(proof, message)
 			}
@@ -915,16 +919,18 @@ func genesisDoc(
 	for i := 0; i < nValsWithComposite; i++ {
 		val, privVal = createTestValidator(minPower, types.PrivKeyComposite)
 		validators[i] = types.GenesisValidator{
-			PubKey: val.PubKey,
-			Power:  val.StakingPower,
+			PubKey:            val.PubKey,
+			Power:             val.StakingPower,
+			ProofOfPossession: val.ProofOfPossession,
 		}
 		privValidators[i] = privVal
 	}
 	for i := nValsWithComposite; i < numValidators; i++ {
 		val, privVal = createTestValidator(minPower, types.PrivKeyEd25519)
 		validators[i] = types.GenesisValidator{
-			PubKey: val.PubKey,
-			Power:  val.StakingPower,
+			PubKey:            val.PubKey,
+			Power:             val.StakingPower,
+			ProofOfPossession: val.ProofOfPossession,
 		}
 		privValidators[i] = privVal
 	}
@@ -949,8 +955,9 @@ func randGenesisDoc(
 	for i := 0; i < numValidators; i++ {
 		val, privVal := types.RandValidator(randPower, minPower)
 		validators[i] = types.GenesisValidator{
-			PubKey: val.PubKey,
-			Power:  val.StakingPower,
+			PubKey:            val.PubKey,
+			Power:             val.StakingPower,
+			ProofOfPossession: val.ProofOfPossession,
 		}
 		privValidators[i] = privVal
 	}
@@ -1050,5 +1057,9 @@ func createTestValidator(minPower int64, keytype types.PrivKeyType) (*types.Vali
 		panic(fmt.Errorf("could not retrieve pubkey %w", err))
 	}
 	val := types.NewValidator(pubKey, stakingPower)
+	val.ProofOfPossession, err = types.ProofOfPossession(privVal.PrivKey)
+	if err != nil {
+		panic(fmt.Errorf("could not generate proof-of-possession %w", err))
+	}
 	return val, privVal
 }
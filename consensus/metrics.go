@@ -72,6 +72,12 @@ type Metrics struct {
 	// Number of rounds turned over.
 	RoundFailures metrics.Histogram
 
+	// Timeouts of the first round of the next height, which adapt to the observed
+	// latencies if adaptive timeouts are enabled.
+	TimeoutPropose   metrics.Gauge
+	TimeoutPrevote   metrics.Gauge
+	TimeoutPrecommit metrics.Gauge
+
 	// Execution time profiling of each step
 	ProposalCreating        metrics.Histogram
 	ProposalWaiting         metrics.Histogram
@@ -234,6 +240,24 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Help:      "Number of rounds failed on consensus",
 			Buckets:   stdprometheus.LinearBuckets(0, 1, 5),
 		}, labels).With(labelsAndValues...),
+		TimeoutPropose: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "timeout_propose_seconds",
+			Help:      "Timeout of the propose step of the first round",
+		}, labels).With(labelsAndValues...),
+		TimeoutPrevote: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "timeout_prevote_seconds",
+			Help:      "Timeout of the prevote wait step of the first round",
+		}, labels).With(labelsAndValues...),
+		TimeoutPrecommit: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "timeout_precommit_seconds",
+			Help:      "Timeout of the precommit wait step of the first round",
+		}, labels).With(labelsAndValues...),
 		ProposalCreating: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -340,6 +364,10 @@ func NopMetrics() *Metrics {
 		MissingProposal: discard.NewGauge(),
 		RoundFailures:   discard.NewHistogram(),
 
+		TimeoutPropose:   discard.NewGauge(),
+		TimeoutPrevote:   discard.NewGauge(),
+		TimeoutPrecommit: discard.NewGauge(),
+
 		ProposalCreating:        discard.NewHistogram(),
 		ProposalWaiting:         discard.NewHistogram(),
 		ProposalVerifying:       discard.NewHistogram(),
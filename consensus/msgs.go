@@ -92,6 +92,14 @@ func MsgToProto(msg Message) (*tmcons.Message, error) {
 				},
 			},
 		}
+	case *AggregatedVoteMessage:
+		pb = tmcons.Message{
+			Sum: &tmcons.Message_AggregatedVote{
+				AggregatedVote: &tmcons.AggregatedVote{
+					AggregatedVote: msg.AggregatedVote.ToProto(),
+				},
+			},
+		}
 	case *HasVoteMessage:
 		pb = tmcons.Message{
 			Sum: &tmcons.Message_HasVote{
@@ -216,6 +224,15 @@ func MsgFromProto(msg *tmcons.Message) (Message, error) {
 		pb = &VoteMessage{
 			Vote: vote,
 		}
+	case *tmcons.Message_AggregatedVote:
+		av, err := types.AggregatedVoteFromProto(msg.AggregatedVote.AggregatedVote)
+		if err != nil {
+			return nil, fmt.Errorf("aggregated vote msg to proto error: %w", err)
+		}
+
+		pb = &AggregatedVoteMessage{
+			AggregatedVote: av,
+		}
 	case *tmcons.Message_HasVote:
 		pb = &HasVoteMessage{
 			Height: msg.HasVote.Height,
@@ -68,6 +68,10 @@ func TestMsgToProto(t *testing.T) {
 	require.NoError(t, err)
 	pbVote := vote.ToProto()
 
+	aggregatedVote, err := types.NewAggregatedVote(1, []*types.Vote{vote})
+	require.NoError(t, err)
+	pbAggregatedVote := aggregatedVote.ToProto()
+
 	testsCases := []struct {
 		testName string
 		msg      Message
@@ -152,6 +156,15 @@ func TestMsgToProto(t *testing.T) {
 				},
 			},
 		}, false},
+		{"successful AggregatedVoteMessage", &AggregatedVoteMessage{
+			AggregatedVote: aggregatedVote,
+		}, &tmcons.Message{
+			Sum: &tmcons.Message_AggregatedVote{
+				AggregatedVote: &tmcons.AggregatedVote{
+					AggregatedVote: pbAggregatedVote,
+				},
+			},
+		}, false},
 		{"successful VoteSetMaj23", &VoteSetMaj23Message{
 			Height:  1,
 			Round:   1,
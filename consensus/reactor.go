@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"sync"
 	"time"
 
@@ -21,6 +22,7 @@ import (
 	sm "github.com/line/ostracon/state"
 	"github.com/line/ostracon/types"
 	tmtime "github.com/line/ostracon/types/time"
+	"github.com/line/ostracon/version"
 )
 
 const (
@@ -337,6 +339,16 @@ func (conR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 			ps.EnsureVoteBitArrays(height-1, lastCommitSize)
 			ps.SetHasVote(msg.Vote)
 
+			cs.peerMsgQueue <- msgInfo{msg, src.ID()}
+		case *AggregatedVoteMessage:
+			cs := conR.conS
+			cs.mtx.RLock()
+			height, voterSize, lastCommitSize := cs.Height, cs.Voters.Size(), cs.LastCommit.Size()
+			cs.mtx.RUnlock()
+			ps.EnsureVoteBitArrays(height, voterSize)
+			ps.EnsureVoteBitArrays(height-1, lastCommitSize)
+			ps.SetHasAggregatedVote(msg.AggregatedVote)
+
 			cs.peerMsgQueue <- msgInfo{msg, src.ID()}
 
 		default:
@@ -505,7 +517,9 @@ OUTER_LOOP:
 
 		// Send proposal Block parts?
 		if rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) {
-			if index, ok := rs.ProposalBlockParts.BitArray().Sub(prs.ProposalBlockParts.Copy()).PickRandom(); ok {
+			missing := rs.ProposalBlockParts.BitArray().Sub(prs.ProposalBlockParts.Copy())
+			if index, ok := conR.pickBlockPartToSend(peer, prs.ProposalBlockPartSetHeader, prs.ProposalBlockParts,
+				missing); ok {
 				part := rs.ProposalBlockParts.GetPart(index)
 				msg := &BlockPartMessage{
 					Height: rs.Height, // This tells peer that this part applies to us.
@@ -591,7 +605,8 @@ OUTER_LOOP:
 func (conR *Reactor) gossipDataForCatchup(logger log.Logger, rs *cstypes.RoundState,
 	prs *cstypes.PeerRoundState, ps *PeerState, peer p2p.Peer) {
 
-	if index, ok := prs.ProposalBlockParts.Not().PickRandom(); ok {
+	if index, ok := conR.pickBlockPartToSend(peer, prs.ProposalBlockPartSetHeader, prs.ProposalBlockParts,
+		prs.ProposalBlockParts.Not()); ok {
 		// Ensure that the peer's PartSetHeader is correct
 		blockMeta := conR.conS.blockStore.LoadBlockMeta(prs.Height)
 		if blockMeta == nil {
@@ -631,6 +646,52 @@ func (conR *Reactor) gossipDataForCatchup(logger log.Logger, rs *cstypes.RoundSt
 	time.Sleep(conR.conS.config.PeerGossipSleepDuration)
 }
 
+// pickBlockPartToSend picks one of the missing parts of the peer to send.
+// The peer is not sent the parts of an erasure-coded part set once it has enough parts to
+// rebuild the block. Otherwise, the parts are shared among our peers, and the parts of the
+// peer's share are sent first, so that different peers receive different parts, and relay
+// them to each other.
+func (conR *Reactor) pickBlockPartToSend(peer p2p.Peer, header types.PartSetHeader,
+	peerParts, missing *bits.BitArray) (int, bool) {
+	if !header.IsErasureCoded() {
+		return missing.PickRandom()
+	}
+	if countBits(peerParts) >= int(header.DataTotal) {
+		return 0, false
+	}
+
+	// sort a copy, the list is shared by the peer set
+	peers := append([]p2p.Peer{}, conR.Switch.Peers().List()...)
+	sort.Slice(peers, func(i, j int) bool { return peers[i].ID() < peers[j].ID() })
+	for slot, p := range peers {
+		if p.ID() != peer.ID() {
+			continue
+		}
+		share := missing.Copy()
+		for i := 0; i < share.Size(); i++ {
+			if i%len(peers) != slot {
+				share.SetIndex(i, false)
+			}
+		}
+		if index, ok := share.PickRandom(); ok {
+			return index, true
+		}
+		break
+	}
+	return missing.PickRandom()
+}
+
+// countBits returns the number of the set bits.
+func countBits(bA *bits.BitArray) int {
+	count := 0
+	for i := 0; i < bA.Size(); i++ {
+		if bA.GetIndex(i) {
+			count++
+		}
+	}
+	return count
+}
+
 func (conR *Reactor) gossipVotesRoutine(peer p2p.Peer, ps *PeerState) {
 	logger := conR.Logger.With("peer", peer)
 
@@ -877,7 +938,7 @@ func (conR *Reactor) peerStatsRoutine() {
 				panic(fmt.Sprintf("Peer %v has no state", peer))
 			}
 			switch msg.Msg.(type) {
-			case *VoteMessage:
+			case *VoteMessage, *AggregatedVoteMessage:
 				if numVotes := ps.RecordVote(); numVotes%votesToContributeToBecomeGoodPeer == 0 {
 					conR.Switch.MarkPeerAsGood(peer)
 				}
@@ -938,6 +999,9 @@ type PeerState struct {
 	peer   p2p.Peer
 	logger log.Logger
 
+	// aggregatedVotes is true if the peer accepts aggregated votes.
+	aggregatedVotes bool
+
 	mtx   sync.Mutex             // NOTE: Modify below using setters, never directly.
 	PRS   cstypes.PeerRoundState `json:"round_state"` // Exposed.
 	Stats *peerStateStats        `json:"stats"`       // Exposed.
@@ -957,8 +1021,9 @@ func (pss peerStateStats) String() string {
 // NewPeerState returns a new PeerState for the given Peer
 func NewPeerState(peer p2p.Peer) *PeerState {
 	return &PeerState{
-		peer:   peer,
-		logger: log.NewNopLogger(),
+		peer:            peer,
+		logger:          log.NewNopLogger(),
+		aggregatedVotes: acceptsAggregatedVotes(peer),
 		PRS: cstypes.PeerRoundState{
 			Round:              -1,
 			ProposalPOLRound:   -1,
@@ -969,6 +1034,13 @@ func NewPeerState(peer p2p.Peer) *PeerState {
 	}
 }
 
+// acceptsAggregatedVotes returns true if the peer advertises a p2p protocol
+// that accepts aggregated votes.
+func acceptsAggregatedVotes(peer p2p.Peer) bool {
+	nodeInfo, ok := peer.NodeInfo().(p2p.DefaultNodeInfo)
+	return ok && nodeInfo.ProtocolVersion.P2P >= version.P2PProtocolAggregatedVote
+}
+
 // SetLogger allows to set a logger on the peer state. Returns the peer state
 // itself.
 func (ps *PeerState) SetLogger(logger log.Logger) *PeerState {
@@ -1054,8 +1126,19 @@ func (ps *PeerState) SetHasProposalBlockPart(height int64, round int32, index in
 }
 
 // PickSendVote picks a vote and sends it to the peer.
+// The BLS votes the peer doesn't have are sent in an aggregated vote if there
+// are more than one of them and the peer accepts aggregated votes.
 // Returns true if vote was sent.
 func (ps *PeerState) PickSendVote(votes types.VoteSetReader) bool {
+	if av, ok := ps.PickAggregatedVoteToSend(votes); ok {
+		msg := &AggregatedVoteMessage{av}
+		ps.logger.Debug("Sending aggregated vote message", "ps", ps, "aggregatedVote", av)
+		if ps.peer.Send(VoteChannel, MustEncode(msg)) {
+			ps.SetHasAggregatedVote(av)
+			return true
+		}
+		return false
+	}
 	if vote, ok := ps.PickVoteToSend(votes); ok {
 		msg := &VoteMessage{vote}
 		ps.logger.Debug("Sending vote message", "ps", ps, "vote", vote)
@@ -1075,9 +1158,53 @@ func (ps *PeerState) PickVoteToSend(votes types.VoteSetReader) (vote *types.Vote
 	ps.mtx.Lock()
 	defer ps.mtx.Unlock()
 
-	if votes.Size() == 0 {
+	missing := ps.missingVotes(votes)
+	if missing == nil {
 		return nil, false
 	}
+	// The votes we have only in an aggregated signature can't be sent individually.
+	for i := 0; i < missing.Size(); i++ {
+		if missing.GetIndex(i) && votes.GetByIndex(int32(i)).Signature == nil {
+			missing.SetIndex(i, false)
+		}
+	}
+	if index, ok := missing.PickRandom(); ok {
+		return votes.GetByIndex(int32(index)), true
+	}
+	return nil, false
+}
+
+// PickAggregatedVoteToSend aggregates the votes the peer doesn't have.
+// Returns true if an aggregated vote was made.
+// NOTE: `votes` must be the correct Size() for the Height().
+func (ps *PeerState) PickAggregatedVoteToSend(votes types.VoteSetReader) (av *types.AggregatedVote, ok bool) {
+	if !ps.aggregatedVotes {
+		return nil, false
+	}
+	voteSet, ok := votes.(*types.VoteSet)
+	if !ok {
+		return nil, false
+	}
+
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	missing := ps.missingVotes(voteSet)
+	if missing == nil {
+		return nil, false
+	}
+	if av = voteSet.MakeAggregatedVote(missing); av != nil {
+		return av, true
+	}
+	return nil, false
+}
+
+// missingVotes returns the bit array of the votes the peer doesn't have, or nil
+// if the votes are not something worth sending.
+func (ps *PeerState) missingVotes(votes types.VoteSetReader) *bits.BitArray {
+	if votes.Size() == 0 {
+		return nil
+	}
 
 	height, round, votesType, size :=
 		votes.GetHeight(), votes.GetRound(), tmproto.SignedMsgType(votes.Type()), votes.Size()
@@ -1090,12 +1217,9 @@ func (ps *PeerState) PickVoteToSend(votes types.VoteSetReader) (vote *types.Vote
 
 	psVotes := ps.getVoteBitArray(height, round, votesType)
 	if psVotes == nil {
-		return nil, false // Not something worth sending
-	}
-	if index, ok := votes.BitArray().Sub(psVotes).PickRandom(); ok {
-		return votes.GetByIndex(int32(index)), true
+		return nil // Not something worth sending
 	}
-	return nil, false
+	return votes.BitArray().Sub(psVotes)
 }
 
 func (ps *PeerState) getVoteBitArray(height int64, round int32, votesType tmproto.SignedMsgType) *bits.BitArray {
@@ -1250,6 +1374,18 @@ func (ps *PeerState) SetHasVote(vote *types.Vote) {
 	ps.setHasVote(vote.Height, vote.Round, vote.Type, vote.ValidatorIndex)
 }
 
+// SetHasAggregatedVote sets the votes of the given aggregated vote as known by the peer
+func (ps *PeerState) SetHasAggregatedVote(av *types.AggregatedVote) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	for i := 0; i < av.Voters.Size(); i++ {
+		if av.Voters.GetIndex(i) {
+			ps.setHasVote(av.Height, av.Round, av.Type, int32(i))
+		}
+	}
+}
+
 func (ps *PeerState) setHasVote(height int64, round int32, voteType tmproto.SignedMsgType, index int32) {
 	logger := ps.logger.With(
 		"peerH/R",
@@ -1420,6 +1556,7 @@ func init() {
 	tmjson.RegisterType(&ProposalPOLMessage{}, "tendermint/ProposalPOL")
 	tmjson.RegisterType(&BlockPartMessage{}, "tendermint/BlockPart")
 	tmjson.RegisterType(&VoteMessage{}, "tendermint/Vote")
+	tmjson.RegisterType(&AggregatedVoteMessage{}, "ostracon/AggregatedVote")
 	tmjson.RegisterType(&HasVoteMessage{}, "tendermint/HasVote")
 	tmjson.RegisterType(&VoteSetMaj23Message{}, "tendermint/VoteSetMaj23")
 	tmjson.RegisterType(&VoteSetBitsMessage{}, "tendermint/VoteSetBits")
@@ -1632,6 +1769,24 @@ func (m *VoteMessage) String() string {
 
 //-------------------------------------
 
+// AggregatedVoteMessage is sent when gossiping the votes whose BLS signatures
+// are aggregated.
+type AggregatedVoteMessage struct {
+	AggregatedVote *types.AggregatedVote
+}
+
+// ValidateBasic performs basic validation.
+func (m *AggregatedVoteMessage) ValidateBasic() error {
+	return m.AggregatedVote.ValidateBasic()
+}
+
+// String returns a string representation.
+func (m *AggregatedVoteMessage) String() string {
+	return fmt.Sprintf("[AggregatedVote %v]", m.AggregatedVote)
+}
+
+//-------------------------------------
+
 // HasVoteMessage is sent to indicate that a particular vote has been received.
 type HasVoteMessage struct {
 	Height int64
@@ -27,6 +27,7 @@ import (
 	"github.com/line/ostracon/libs/bits"
 	"github.com/line/ostracon/libs/bytes"
 	"github.com/line/ostracon/libs/log"
+	tmrand "github.com/line/ostracon/libs/rand"
 	tmsync "github.com/line/ostracon/libs/sync"
 	mempl "github.com/line/ostracon/mempool"
 	"github.com/line/ostracon/p2p"
@@ -36,6 +37,8 @@ import (
 	statemocks "github.com/line/ostracon/state/mocks"
 	"github.com/line/ostracon/store"
 	"github.com/line/ostracon/types"
+	tmtime "github.com/line/ostracon/types/time"
+	"github.com/line/ostracon/version"
 )
 
 //----------------------------------------------
@@ -120,6 +123,28 @@ func TestReactorBasic(t *testing.T) {
 	}, css)
 }
 
+func TestReactorErasureCodedBlockParts(t *testing.T) {
+	N := 4
+	css, cleanup := randConsensusNet(N, "consensus_reactor_test", newMockTickerFunc(true), newCounter)
+	defer cleanup()
+	for i := 0; i < N; i++ {
+		css[i].state.ConsensusParams.PartSet.ParityPercentage = 50
+	}
+	reactors, blocksSubs, eventBuses := startConsensusNet(t, css, N)
+	defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+	// wait till everyone makes the first new block
+	timeoutWaitGroup(t, N, func(j int) {
+		<-blocksSubs[j].Out()
+	}, css)
+
+	for i := 0; i < N; i++ {
+		blockMeta := css[i].blockStore.LoadBlockMeta(1)
+		require.NotNil(t, blockMeta)
+		assert.True(t, blockMeta.BlockID.PartSetHeader.IsErasureCoded())
+		assert.NotNil(t, css[i].blockStore.LoadBlock(1))
+	}
+}
+
 // Ensure we can process blocks with evidence
 func TestReactorWithEvidence(t *testing.T) {
 	nValidators := 4
@@ -276,6 +301,73 @@ func TestReactorReceivePanicsIfInitPeerHasntBeenCalledYet(t *testing.T) {
 	})
 }
 
+// aggregatedVotePeer is a mock peer that advertises the p2p protocol of the aggregated votes.
+type aggregatedVotePeer struct {
+	*p2pmock.Peer
+}
+
+func (p aggregatedVotePeer) NodeInfo() p2p.NodeInfo {
+	nodeInfo := p.Peer.NodeInfo().(p2p.DefaultNodeInfo)
+	nodeInfo.ProtocolVersion = p2p.NewProtocolVersion(version.P2PProtocolAggregatedVote, version.BlockProtocol, 0)
+	return nodeInfo
+}
+
+func TestPeerStatePickAggregatedVoteToSend(t *testing.T) {
+	const height, round = int64(1), int32(0)
+	validators := make([]*types.Validator, 3)
+	privVals := make([]types.PrivValidator, len(validators))
+	for i := range validators {
+		validators[i], privVals[i] = createTestValidator(10, types.PrivKeyBLS)
+	}
+	voterSet := types.ToVoterAll(validators)
+	voteSet := types.NewVoteSet(config.ChainID(), height, round, tmproto.PrecommitType, voterSet, version.BlockProtocol)
+	blockID := types.BlockID{
+		Hash:          tmrand.Bytes(tmhash.Size),
+		PartSetHeader: types.PartSetHeader{Total: 1, Hash: tmrand.Bytes(tmhash.Size)},
+	}
+	for _, privVal := range privVals {
+		pubKey, err := privVal.GetPubKey()
+		require.NoError(t, err)
+		idx, _ := voterSet.GetByAddress(pubKey.Address())
+		vote := &types.Vote{
+			ValidatorAddress: pubKey.Address(),
+			ValidatorIndex:   idx,
+			Height:           height,
+			Round:            round,
+			Type:             tmproto.PrecommitType,
+			Timestamp:        tmtime.Now(),
+			BlockID:          blockID,
+		}
+		v := vote.ToProto()
+		require.NoError(t, privVal.SignVote(config.ChainID(), v))
+		vote.Signature = v.Signature
+		added, err := voteSet.AddVote(vote)
+		require.NoError(t, err)
+		require.True(t, added)
+	}
+
+	newPeerState := func(peer p2p.Peer) *PeerState {
+		ps := NewPeerState(peer)
+		ps.PRS.Height = height
+		ps.PRS.Round = round
+		return ps
+	}
+
+	// a peer of the current p2p protocol gets an aggregated vote
+	ps := newPeerState(aggregatedVotePeer{p2pmock.NewPeer(nil)})
+	av, ok := ps.PickAggregatedVoteToSend(voteSet)
+	require.True(t, ok)
+	assert.Equal(t, len(privVals), av.Size())
+
+	// a peer of an older p2p protocol gets individual votes only
+	ps = newPeerState(p2pmock.NewPeer(nil))
+	_, ok = ps.PickAggregatedVoteToSend(voteSet)
+	assert.False(t, ok)
+	vote, ok := ps.PickVoteToSend(voteSet)
+	require.True(t, ok)
+	assert.NotNil(t, vote.Signature)
+}
+
 // Test we record stats about votes and block parts from other peers.
 func TestReactorRecordsVotesAndBlockParts(t *testing.T) {
 	N := 4
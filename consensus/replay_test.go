@@ -332,21 +332,26 @@ func getProposerIdx(state *State, height int64, round int32) (int32, *types.Vali
 }
 
 func createProposalBlock(cs *State, proposerState *State, round int32) (*types.Block, *types.PartSet) {
-	var commit *types.Commit
+	var extCommit *types.ExtendedCommit
 	if cs.Height == 1 {
-		commit = types.NewCommit(0, 0, types.BlockID{}, nil)
+		extCommit = types.NewExtendedCommit(types.NewCommit(0, 0, types.BlockID{}, nil), nil)
 	} else {
-		commit = cs.LastCommit.MakeCommit()
+		extCommit = cs.LastCommit.MakeExtendedCommit()
 	}
 	pubKey, _ := proposerState.privValidator.GetPubKey()
 	proposerAddr := pubKey.Address()
 	message := cs.state.MakeHashMessage(round)
-	proof, err := proposerState.privValidator.GenerateVRFProof(message)
+	proof, err := proposerState.privValidator.GenerateVRFProof(message, "")
 	if err != nil {
 		cs.Logger.Error("enterPropose: Cannot generate vrf proof: %s", err.Error())
 		return nil, nil
 	}
-	return cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, proposerAddr, round, proof)
+	block, blockParts, err := cs.blockExec.CreateProposalBlock(cs.Height, cs.state, extCommit, proposerAddr, round, proof)
+	if err != nil {
+		cs.Logger.Error("enterPropose: Cannot create proposal block: %s", err.Error())
+		return nil, nil
+	}
+	return block, blockParts
 }
 
 func consensusNewBlock(t *testing.T, height int64, vss []*validatorStub, css []*State, selfIndex int,
@@ -1020,7 +1025,7 @@ func makeBlock(state sm.State, lastBlock *types.Block, lastBlockMeta *types.Bloc
 	}
 
 	message := state.MakeHashMessage(0)
-	proof, _ := privVal.GenerateVRFProof(message)
+	proof, _ := privVal.GenerateVRFProof(message, "")
 	return state.MakeBlock(height, []types.Tx{}, lastCommit, nil,
 		state.Validators.SelectProposer(state.LastProofHash, height, 0).Address, 0, proof)
 }
@@ -1224,12 +1229,18 @@ func (bs *mockBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
 func (bs *mockBlockStore) LoadBlockPart(height int64, index int) *types.Part { return nil }
 func (bs *mockBlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
 }
+func (bs *mockBlockStore) SaveBlockWithExtendedCommit(block *types.Block, blockParts *types.PartSet,
+	extCommit *types.ExtendedCommit) {
+}
 func (bs *mockBlockStore) LoadBlockCommit(height int64) *types.Commit {
 	return bs.commits[height-1]
 }
 func (bs *mockBlockStore) LoadSeenCommit(height int64) *types.Commit {
 	return bs.commits[height-1]
 }
+func (bs *mockBlockStore) LoadBlockExtendedCommit(height int64) *types.ExtendedCommit {
+	return nil
+}
 
 func (bs *mockBlockStore) PruneBlocks(height int64) (uint64, error) {
 	pruned := uint64(0)
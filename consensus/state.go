@@ -27,6 +27,7 @@ import (
 	sm "github.com/line/ostracon/state"
 	"github.com/line/ostracon/types"
 	tmtime "github.com/line/ostracon/types/time"
+	"github.com/line/ostracon/version"
 )
 
 // Consensus sentinel errors
@@ -68,6 +69,10 @@ type txNotifier interface {
 type evidencePool interface {
 	// reports conflicting votes to the evidence pool to be processed into evidence
 	ReportConflictingVotes(voteA, voteB *types.Vote)
+	// reports conflicting proposals to the evidence pool to be processed into evidence
+	ReportConflictingProposals(proposalA, proposalB *types.Proposal)
+	// reports a proposal of a block with an invalid VRF proof to the evidence pool to be processed into evidence
+	ReportInvalidProposerProof(proposal *types.Proposal, header *types.Header)
 }
 
 type StepDuration struct {
@@ -110,6 +115,11 @@ type StepTimes struct {
 	PrecommitReceiving      StepDuration
 	CommitBlockVerifying    StepDuration
 	CommitBlockApplying     StepDuration
+
+	// whether the timeouts of the steps expired in the height
+	ProposeTimedOut   bool
+	PrevoteTimedOut   bool
+	PrecommitTimedOut bool
 }
 
 // State handles execution of the consensus algorithm.
@@ -161,8 +171,9 @@ type State struct {
 	// a Write-Ahead Log ensures we can recover from any kind of crash
 	// and helps us avoid signing conflicting votes
 	wal          WAL
-	replayMode   bool // so we don't log signing errors during replay
-	doWALCatchup bool // determines if we even try to do the catchup
+	replayMode   bool      // so we don't log signing errors during replay
+	replayTime   time.Time // the time the message being replayed from the WAL was received at
+	doWALCatchup bool      // determines if we even try to do the catchup
 
 	// for tests where we want to limit the number of transitions the state makes
 	nSteps int
@@ -175,6 +186,10 @@ type State struct {
 	// closed when we finish shutting down
 	done chan struct{}
 
+	// why we halted at the halt height or time of the config, and closed when we halt
+	haltReason string
+	halted     chan struct{}
+
 	// synchronous pubsub between consensus state and reactor.
 	// state only emits EventNewRoundStep and EventVote
 	evsw tmevents.EventSwitch
@@ -184,6 +199,9 @@ type State struct {
 
 	// times of each step
 	stepTimes StepTimes
+
+	// timeouts of the steps, which may adapt to the times of the steps
+	timeouts *adaptiveTimeouts
 }
 
 // StateOption sets an optional parameter on the State.
@@ -209,11 +227,13 @@ func NewState(
 		timeoutTicker:    NewTimeoutTicker(),
 		statsMsgQueue:    make(chan msgInfo, msgQueueSize),
 		done:             make(chan struct{}),
+		halted:           make(chan struct{}),
 		doWALCatchup:     true,
 		wal:              nilWAL{},
 		evpool:           evpool,
 		evsw:             tmevents.NewEventSwitch(),
 		metrics:          NopMetrics(),
+		timeouts:         newAdaptiveTimeouts(config),
 	}
 
 	// set function defaults (may be overwritten before calling Start)
@@ -298,6 +318,21 @@ func (cs *State) GetRoundStateSimpleJSON() ([]byte, error) {
 	return tmjson.Marshal(cs.RoundState.RoundStateSimple())
 }
 
+// HaltReason returns why the consensus has halted, or an empty string if it
+// has not halted.
+func (cs *State) HaltReason() string {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	return cs.haltReason
+}
+
+// Halted returns a channel, which is closed when the consensus halts after
+// committing the block at the halt height or time of the config, or when the
+// software does not support the block protocol of the next height.
+func (cs *State) Halted() <-chan struct{} {
+	return cs.halted
+}
+
 // GetValidators returns a copy of the current validators.
 // ValidatorOrVoter: validator
 func (cs *State) GetValidators() (int64, []*types.Validator) {
@@ -350,6 +385,13 @@ func (cs *State) OnStart() error {
 		}
 	}
 
+	// We may have been handed over a state, e.g. by the fast sync, whose next
+	// height needs a block protocol the software does not support. We halt
+	// right away, instead of failing on the first block of that height.
+	if blockProtocol := cs.state.Version.Consensus.Block; !version.IsBlockProtocolSupported(blockProtocol) {
+		cs.halt(sm.ErrUnsupportedBlockProtocol{Height: cs.state.LastBlockHeight + 1, BlockProtocol: blockProtocol}.Error())
+	}
+
 	// We may have lost some votes if the process crashed reload from consensus
 	// log to catchup.
 	if cs.doWALCatchup {
@@ -601,14 +643,21 @@ func (cs *State) sendInternalMessage(mi msgInfo) {
 // Reconstruct LastCommit from SeenCommit, which we saved along with the block,
 // (which happens even before saving the state)
 func (cs *State) reconstructLastCommit(state sm.State) {
-	seenCommit := cs.blockStore.LoadSeenCommit(state.LastBlockHeight)
-	if seenCommit == nil {
-		panic(fmt.Sprintf(
-			"failed to reconstruct last commit; seen commit for height %v not found",
-			state.LastBlockHeight,
-		))
+	// Reconstruct with the vote extensions if we have them, so that they can be
+	// delivered to the app when we propose.
+	var lastPrecommits *types.VoteSet
+	if extCommit := cs.blockStore.LoadBlockExtendedCommit(state.LastBlockHeight); extCommit != nil {
+		lastPrecommits = extCommit.ToVoteSet(state.ChainID, state.LastVoters, state.LastBlockVersion)
+	} else {
+		seenCommit := cs.blockStore.LoadSeenCommit(state.LastBlockHeight)
+		if seenCommit == nil {
+			panic(fmt.Sprintf(
+				"failed to reconstruct last commit; seen commit for height %v not found",
+				state.LastBlockHeight,
+			))
+		}
+		lastPrecommits = types.CommitToVoteSet(state.ChainID, seenCommit, state.LastVoters, state.LastBlockVersion)
 	}
-	lastPrecommits := types.CommitToVoteSet(state.ChainID, seenCommit, state.LastVoters)
 	if !lastPrecommits.HasTwoThirdsMajority() {
 		panic("failed to reconstruct last commit; does not have +2/3 maj")
 	}
@@ -708,6 +757,7 @@ func (cs *State) updateToState(state sm.State) {
 	cs.Validators = state.Validators.Copy()
 	cs.Voters = state.Voters.Copy()
 	cs.Proposal = nil
+	cs.ProposalReceiveTime = time.Time{}
 	cs.ProposalBlock = nil
 	cs.ProposalBlockParts = nil
 	cs.LockedRound = -1
@@ -716,7 +766,7 @@ func (cs *State) updateToState(state sm.State) {
 	cs.ValidRound = -1
 	cs.ValidBlock = nil
 	cs.ValidBlockParts = nil
-	cs.Votes = cstypes.NewHeightVoteSet(state.ChainID, height, voters)
+	cs.Votes = cstypes.NewHeightVoteSet(state.ChainID, height, voters, state.Version.Consensus.Block)
 	cs.CommitRound = -1
 	cs.LastVoters = state.LastVoters
 	cs.TriggeredTimeoutPrecommit = false
@@ -850,6 +900,11 @@ func (cs *State) handleMsg(mi msgInfo) {
 	cs.mtx.Lock()
 	defer cs.mtx.Unlock()
 
+	// we must not make any progress once we have halted
+	if cs.haltReason != "" {
+		return
+	}
+
 	var (
 		added bool
 		err   error
@@ -903,6 +958,14 @@ func (cs *State) handleMsg(mi msgInfo) {
 		// the peer is sending us CatchupCommit precommits.
 		// We could make note of this and help filter in broadcastHasVoteMessage().
 
+	case *AggregatedVoteMessage:
+		// attempt to add the votes of the aggregated vote
+		// if the votes give us a 2/3-any or 2/3-one, we transition
+		added, err = cs.tryAddAggregatedVote(msg.AggregatedVote, peerID)
+		if added {
+			cs.statsMsgQueue <- mi
+		}
+
 	default:
 		cs.Logger.Error("unknown msg type", "type", fmt.Sprintf("%T", msg))
 		return
@@ -933,6 +996,10 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 	cs.mtx.Lock()
 	defer cs.mtx.Unlock()
 
+	if cs.haltReason != "" {
+		return
+	}
+
 	switch ti.Step {
 	case cstypes.RoundStepNewHeight:
 		// NewRound event fired from enterNewRound.
@@ -947,6 +1014,7 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 			cs.Logger.Error("failed publishing timeout propose", "err", err)
 		}
 
+		cs.stepTimes.ProposeTimedOut = true
 		cs.enterPrevote(ti.Height, ti.Round)
 
 	case cstypes.RoundStepPrevoteWait:
@@ -954,6 +1022,7 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 			cs.Logger.Error("failed publishing timeout wait", "err", err)
 		}
 
+		cs.stepTimes.PrevoteTimedOut = true
 		cs.enterPrecommit(ti.Height, ti.Round)
 
 	case cstypes.RoundStepPrecommitWait:
@@ -961,6 +1030,7 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 			cs.Logger.Error("failed publishing timeout wait", "err", err)
 		}
 
+		cs.stepTimes.PrecommitTimedOut = true
 		cs.enterPrecommit(ti.Height, ti.Round)
 		cs.enterNewRound(ti.Height, ti.Round+1)
 
@@ -974,6 +1044,10 @@ func (cs *State) handleTxsAvailable() {
 	cs.mtx.Lock()
 	defer cs.mtx.Unlock()
 
+	if cs.haltReason != "" {
+		return
+	}
+
 	// We only need to do this for round 0.
 	if cs.Round != 0 {
 		return
@@ -1037,6 +1111,7 @@ func (cs *State) enterNewRound(height int64, round int32) {
 	} else {
 		logger.Debug("resetting proposal info")
 		cs.Proposal = nil
+		cs.ProposalReceiveTime = time.Time{}
 		cs.ProposalBlock = nil
 		cs.ProposalBlockParts = nil
 	}
@@ -1110,7 +1185,7 @@ func (cs *State) enterPropose(height int64, round int32) {
 	}()
 
 	// If we don't get the proposal and all block parts quick enough, enterPrevote
-	cs.scheduleTimeout(cs.config.Propose(round), height, round, cstypes.RoundStepPropose)
+	cs.scheduleTimeout(cs.timeouts.Propose(round), height, round, cstypes.RoundStepPropose)
 
 	// Nothing more to do if we're not a validator
 	if cs.privValidator == nil {
@@ -1180,6 +1255,10 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 	// Make proposal
 	propBlockID := types.BlockID{Hash: block.Hash(), PartSetHeader: blockParts.Header()}
 	proposal := types.NewProposal(height, round, cs.ValidRound, propBlockID)
+	if cs.state.ConsensusParams.Timestamp.ProposerBased {
+		// the proposal carries the block time so that the others can check if it is timely
+		proposal.Timestamp = block.Time
+	}
 	p := proposal.ToProto()
 	if err := cs.privValidator.SignProposal(cs.state.ChainID, p); err == nil {
 		cs.stepTimes.ProposalCreating.SetEnd()
@@ -1229,16 +1308,16 @@ func (cs *State) createProposalBlock(round int32) (block *types.Block, blockPart
 		panic("entered createProposalBlock with privValidator being nil")
 	}
 
-	var commit *types.Commit
+	var extCommit *types.ExtendedCommit
 	switch {
 	case cs.Height == cs.state.InitialHeight:
 		// We're creating a proposal for the first block.
 		// The commit is empty, but not nil.
-		commit = types.NewCommit(0, 0, types.BlockID{}, nil)
+		extCommit = types.NewExtendedCommit(types.NewCommit(0, 0, types.BlockID{}, nil), nil)
 
 	case cs.LastCommit.HasTwoThirdsMajority():
-		// Make the commit from LastCommit
-		commit = cs.LastCommit.MakeCommit()
+		// Make the commit from LastCommit along with the vote extensions
+		extCommit = cs.LastCommit.MakeExtendedCommit()
 	default: // This shouldn't happen.
 		cs.Logger.Error("propose step; cannot propose anything without commit for the previous block")
 		return
@@ -1255,13 +1334,18 @@ func (cs *State) createProposalBlock(round int32) (block *types.Block, blockPart
 
 	message := cs.state.MakeHashMessage(round)
 
-	proof, err := cs.privValidator.GenerateVRFProof(message)
+	proof, err := cs.privValidator.GenerateVRFProof(message, cs.state.ConsensusParams.Validator.VrfSuite)
 	if err != nil {
 		cs.Logger.Error(fmt.Sprintf("enterPropose: Cannot generate vrf proof: %s", err.Error()))
 		return
 	}
 
-	return cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, proposerAddr, round, proof)
+	block, blockParts, err = cs.blockExec.CreateProposalBlock(cs.Height, cs.state, extCommit, proposerAddr, round, proof)
+	if err != nil {
+		cs.Logger.Error("propose step; failed to create proposal block", "err", err)
+		return nil, nil
+	}
+	return block, blockParts
 }
 
 // Enter: `timeoutPropose` after entering Propose.
@@ -1287,6 +1371,14 @@ func (cs *State) enterPrevote(height int64, round int32) {
 
 	logger.Debug("entering prevote step", "current", fmt.Sprintf("%v/%v/%v", cs.Height, cs.Round, cs.Step))
 
+	// Under the proposer-based timestamps, prevote nil if a new proposal did not arrive in time.
+	if cs.state.ConsensusParams.Timestamp.ProposerBased && !cs.isProposalTimely() {
+		logger.Debug("prevote step: Proposal is not timely; prevoting nil",
+			"timestamp", cs.Proposal.Timestamp, "received", cs.ProposalReceiveTime)
+		cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
 	// Sign and broadcast vote as necessary
 	cs.doPrevote(height, round)
 
@@ -1326,6 +1418,26 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 		cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
 		return
 	}
+
+	// The proposal block parts must be erasure coded as the consensus params say.
+	err = cs.ProposalBlockParts.Header().ValidateErasureCoding(cs.state.ConsensusParams.PartSet.ParityPercentage)
+	if err != nil {
+		// ProposalBlockParts are invalid, prevote nil.
+		logger.Error("prevote step: ProposalBlockParts are invalid", "err", err)
+		cs.stepTimes.PrevoteBlockVerifying.SetEnd()
+		cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
+	// Let the app decide whether the proposal block is acceptable
+	accepted, err := cs.blockExec.ProcessProposal(cs.ProposalBlock, cs.state)
+	if err != nil || !accepted {
+		// ProposalBlock is rejected by the app, prevote nil.
+		logger.Error("prevote step: ProposalBlock is rejected by the app", "err", err)
+		cs.stepTimes.PrevoteBlockVerifying.SetEnd()
+		cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
 	cs.stepTimes.PrevoteBlockVerifying.SetEnd()
 
 	// Prevote cs.ProposalBlock
@@ -1336,6 +1448,19 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 	cs.stepTimes.PrevoteReceiving.SetStart()
 }
 
+// isProposalTimely returns false only if a new proposal, which re-proposes no block of a POL round, was
+// not received within the synchrony bounds or does not carry the time of the proposal block. The other
+// cases are left to doPrevote.
+func (cs *State) isProposalTimely() bool {
+	if cs.Proposal == nil || cs.ProposalBlock == nil || cs.LockedBlock != nil || cs.Proposal.POLRound != -1 {
+		return true
+	}
+	if !cs.Proposal.Timestamp.Equal(cs.ProposalBlock.Time) {
+		return false
+	}
+	return cs.Proposal.IsTimely(cs.ProposalReceiveTime, cs.state.ConsensusParams.Timestamp)
+}
+
 // Enter: any +2/3 prevotes at next round.
 func (cs *State) enterPrevoteWait(height int64, round int32) {
 	logger := cs.Logger.With("height", height, "round", round)
@@ -1364,7 +1489,7 @@ func (cs *State) enterPrevoteWait(height int64, round int32) {
 	}()
 
 	// Wait for some more prevotes; enterPrecommit
-	cs.scheduleTimeout(cs.config.Prevote(round), height, round, cstypes.RoundStepPrevoteWait)
+	cs.scheduleTimeout(cs.timeouts.Prevote(round), height, round, cstypes.RoundStepPrevoteWait)
 }
 
 // Enter: `timeoutPrevote` after any +2/3 prevotes.
@@ -1528,7 +1653,7 @@ func (cs *State) enterPrecommitWait(height int64, round int32) {
 	}()
 
 	// wait for some more precommits; enterNewRound
-	cs.scheduleTimeout(cs.config.Precommit(round), height, round, cstypes.RoundStepPrecommitWait)
+	cs.scheduleTimeout(cs.timeouts.Precommit(round), height, round, cstypes.RoundStepPrecommitWait)
 }
 
 // Enter: +2/3 precommits for block
@@ -1667,8 +1792,8 @@ func (cs *State) finalizeCommit(height int64) {
 		// NOTE: the seenCommit is local justification to commit this block,
 		// but may differ from the LastCommit included in the next block
 		precommits := cs.Votes.Precommits(cs.CommitRound)
-		seenCommit := precommits.MakeCommit()
-		cs.blockStore.SaveBlock(block, blockParts, seenCommit)
+		seenExtCommit := precommits.MakeExtendedCommit()
+		cs.blockStore.SaveBlockWithExtendedCommit(block, blockParts, seenExtCommit)
 	} else {
 		// Happens during replay if we already saved the block but didn't commit
 		logger.Debug("calling finalizeCommit on already stored block", "height", block.Height)
@@ -1738,6 +1863,9 @@ func (cs *State) finalizeCommit(height int64) {
 
 	// must be called before we update state
 	cs.recordMetrics(height, block)
+	cs.updateTimeouts()
+
+	lastBlockTime := cs.state.LastBlockTime
 
 	// NewHeightStep!
 	cs.updateToState(stateCopy)
@@ -1748,6 +1876,13 @@ func (cs *State) finalizeCommit(height int64) {
 		logger.Error("failed to get private validator pubkey", "err", err)
 	}
 
+	// Halt instead of starting the next height, e.g. for a coordinated upgrade.
+	// The next height is started when we are restarted.
+	if reason := cs.haltReasonFor(block, lastBlockTime); reason != "" {
+		cs.halt(reason)
+		return
+	}
+
 	// cs.StartTime is already set.
 	// Schedule Round0 to start soon.
 	cs.scheduleRound0(&cs.RoundState)
@@ -1758,6 +1893,39 @@ func (cs *State) finalizeCommit(height int64) {
 	// * cs.StartTime is set to when we will start round0.
 }
 
+// haltReasonFor returns why we should halt after committing the block, or an
+// empty string if we should not. We halt if the software does not support the
+// block protocol of the next height, which may have been switched by an upgrade.
+// The halt time is reached by the first block at or after it, so that we do not
+// halt again when we are restarted.
+func (cs *State) haltReasonFor(block *types.Block, lastBlockTime time.Time) string {
+	if blockProtocol := cs.state.Version.Consensus.Block; !version.IsBlockProtocolSupported(blockProtocol) {
+		return sm.ErrUnsupportedBlockProtocol{Height: block.Height + 1, BlockProtocol: blockProtocol}.Error()
+	}
+	if cs.config.HaltHeight > 0 && block.Height == cs.config.HaltHeight {
+		return fmt.Sprintf("reached halt height %d", cs.config.HaltHeight)
+	}
+	if cs.config.HaltTime > 0 {
+		haltTime := time.Unix(cs.config.HaltTime, 0)
+		if !block.Time.Before(haltTime) && lastBlockTime.Before(haltTime) {
+			return fmt.Sprintf("reached halt time %v at height %d", haltTime.UTC(), block.Height)
+		}
+	}
+	return ""
+}
+
+func (cs *State) halt(reason string) {
+	cs.Logger.Info("halting consensus", "height", cs.state.LastBlockHeight, "reason", reason)
+	cs.haltReason = reason
+
+	// everything up to the halt must be on disk, since we are going to be stopped
+	if err := cs.wal.FlushAndSync(); err != nil {
+		cs.Logger.Error("failed to flush WAL", "err", err)
+	}
+
+	close(cs.halted)
+}
+
 func (cs *State) pruneBlocks(retainHeight int64) (uint64, error) {
 	base := cs.blockStore.Base()
 	if retainHeight <= base {
@@ -1903,12 +2071,29 @@ func (cs *State) recordMetrics(height int64, block *types.Block) {
 	cs.metrics.CommitBlockApplying.Observe(cs.stepTimes.CommitBlockApplying.GetDuration())
 }
 
+// updateTimeouts feeds the times of the steps of the committed height, and the steps
+// which timed out, to the timeouts, and records the timeouts of the next height.
+func (cs *State) updateTimeouts() {
+	// the latency of the proposal is only meaningful if it was received from another
+	// validator in the commit round
+	observeProposal := !cs.stepTimes.ProposalCreatedByMyself &&
+		cs.Proposal != nil && cs.Proposal.Round == cs.CommitRound
+	cs.timeouts.observe(&cs.stepTimes, observeProposal, cs.CommitRound)
+	cs.stepTimes.ProposeTimedOut = false
+	cs.stepTimes.PrevoteTimedOut = false
+	cs.stepTimes.PrecommitTimedOut = false
+
+	cs.metrics.TimeoutPropose.Set(cs.timeouts.Propose(0).Seconds())
+	cs.metrics.TimeoutPrevote.Set(cs.timeouts.Prevote(0).Seconds())
+	cs.metrics.TimeoutPrecommit.Set(cs.timeouts.Precommit(0).Seconds())
+}
+
 //-----------------------------------------------------------------------------
 
 func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 	// Already have one
-	// TODO: possibly catch double proposals
 	if cs.Proposal != nil {
+		cs.reportConflictingProposal(proposal)
 		return nil
 	}
 
@@ -1943,6 +2128,11 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 
 	proposal.Signature = p.Signature
 	cs.Proposal = proposal
+	cs.ProposalReceiveTime = tmtime.Now()
+	if !cs.replayTime.IsZero() {
+		// a replayed proposal is as timely as when it was received
+		cs.ProposalReceiveTime = cs.replayTime
+	}
 	// We don't update cs.ProposalBlockParts if it is already set.
 	// This happens if we're already in cstypes.RoundStepCommit or if there is a valid block in the current round.
 	// TODO: We can check if Proposal is for a different block as this is a sign of misbehavior!
@@ -1955,6 +2145,46 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 	return nil
 }
 
+// reportConflictingProposal reports the proposal to the evidence pool if it was signed by the proposer
+// for a different block than the proposal we already have at the same height and round.
+func (cs *State) reportConflictingProposal(proposal *types.Proposal) {
+	if proposal.Height != cs.Proposal.Height || proposal.Round != cs.Proposal.Round ||
+		proposal.BlockID.Equals(cs.Proposal.BlockID) {
+		return
+	}
+
+	proposer := cs.Validators.SelectProposer(cs.state.LastProofHash, proposal.Height, proposal.Round)
+	if !proposer.PubKey.VerifySignature(
+		types.ProposalSignBytes(cs.state.ChainID, proposal.ToProto()), proposal.Signature,
+	) {
+		return
+	}
+
+	cs.Logger.Info("found conflicting proposal", "proposal", proposal, "existing", cs.Proposal)
+	cs.evpool.ReportConflictingProposals(cs.Proposal, proposal)
+}
+
+// reportInvalidProposerProof reports the proposal to the evidence pool if the block proposed in
+// its round has an invalid VRF proof of the proposer.
+func (cs *State) reportInvalidProposerProof(block *types.Block) {
+	if cs.Proposal == nil || block.Round != cs.Proposal.Round || !block.HashesTo(cs.Proposal.BlockID.Hash) {
+		return
+	}
+
+	proposer := cs.Validators.SelectProposer(cs.state.LastProofHash, block.Height, block.Round)
+	if !bytes.Equal(block.ProposerAddress, proposer.Address) {
+		return
+	}
+	message := cs.state.MakeHashMessage(block.Round)
+	if _, err := types.VRFVerify(proposer.PubKey, crypto.Proof(block.Proof), message,
+		cs.state.ConsensusParams.Validator.VrfSuite); err == nil {
+		return
+	}
+
+	cs.Logger.Info("found proposal of a block with an invalid proof", "proposal", cs.Proposal, "proof", block.Proof)
+	cs.evpool.ReportInvalidProposerProof(cs.Proposal, &block.Header)
+}
+
 // NOTE: block is not necessarily valid.
 // Asynchronously triggers either enterPrevote (before we timeout of propose) or tryFinalizeCommit,
 // once we have the full block.
@@ -2009,6 +2239,7 @@ func (cs *State) addProposalBlockPart(msg *BlockPartMessage, peerID p2p.ID) (add
 
 		cs.ProposalBlock = block
 		cs.stepTimes.ProposalBlockReceiving.SetEnd()
+		cs.reportInvalidProposerProof(block)
 
 		// NOTE: it's possible to receive complete proposal blocks for future rounds without having the proposal
 		cs.Logger.Info("received complete proposal block", "height", cs.ProposalBlock.Height, "hash", cs.ProposalBlock.Hash())
@@ -2114,6 +2345,13 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		"cs_height", cs.Height,
 	)
 
+	// A precommit for a block has a signed extension from the height the vote
+	// extensions are enabled, and no vote has an extension below it.
+	extEnabled := types.VoteExtensionsEnabled(cs.state.ConsensusParams.Abci, vote.Height)
+	if err := vote.ValidateExtension(extEnabled); err != nil {
+		return false, err
+	}
+
 	// A precommit for the previous height?
 	// These come in while we wait timeoutCommit
 	if vote.Height+1 == cs.Height && vote.Type == tmproto.PrecommitType {
@@ -2128,20 +2366,10 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 			return
 		}
 
-		cs.Logger.Debug("added vote to last precommits", "last_commit", cs.LastCommit.StringShort())
-		if err := cs.eventBus.PublishEventVote(types.EventDataVote{Vote: vote}); err != nil {
+		if err := cs.handleLastCommitVote(vote); err != nil {
 			return added, err
 		}
 
-		cs.evsw.FireEvent(types.EventVote, vote)
-
-		// if we can skip timeoutCommit and have all the votes now,
-		if cs.config.SkipTimeoutCommit && cs.LastCommit.HasAll() {
-			// go straight to new round (skip timeout commit)
-			// cs.scheduleTimeout(time.Duration(0), cs.Height, 0, cstypes.RoundStepNewHeight)
-			cs.enterNewRound(cs.Height, 0)
-		}
-
 		return
 	}
 
@@ -2152,16 +2380,148 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		return
 	}
 
-	height := cs.Height
+	// Let the app verify the extension of a precommit from another validator,
+	// after verifying the signatures of the vote and of the extension. A duplicate
+	// of a precommit we have is ignored without asking the app again.
+	if len(vote.ExtensionSignature) > 0 &&
+		(cs.privValidatorPubKey == nil || !bytes.Equal(vote.ValidatorAddress, cs.privValidatorPubKey.Address())) {
+		_, voter := cs.Voters.GetByIndex(vote.ValidatorIndex)
+		if voter == nil {
+			return false, fmt.Errorf("cannot find voter %d in voterSet of size %d: %w",
+				vote.ValidatorIndex, cs.Voters.Size(), types.ErrVoteInvalidValidatorIndex)
+		}
+		if existing := cs.Votes.Precommits(vote.Round).GetByIndex(vote.ValidatorIndex); existing != nil &&
+			bytes.Equal(existing.Signature, vote.Signature) && bytes.Equal(existing.Extension, vote.Extension) {
+			return false, nil
+		}
+		blockVersion := cs.state.Version.Consensus.Block
+		if err := vote.Verify(cs.state.ChainID, voter.PubKey, blockVersion); err != nil {
+			return false, err
+		}
+		if err := vote.VerifyExtension(cs.state.ChainID, voter.PubKey, blockVersion); err != nil {
+			return false, err
+		}
+		if err := cs.blockExec.VerifyVoteExtension(vote); err != nil {
+			return false, err
+		}
+	}
+
 	added, err = cs.Votes.AddVote(vote, peerID)
 	if !added {
 		// Either duplicate, or error upon cs.Votes.AddByIndex()
 		return
 	}
 
-	if err := cs.eventBus.PublishEventVote(types.EventDataVote{Vote: vote}); err != nil {
+	if err := cs.handleVote(vote); err != nil {
 		return added, err
 	}
+
+	return
+}
+
+// Attempt to add the votes of the aggregated vote.
+func (cs *State) tryAddAggregatedVote(av *types.AggregatedVote, peerID p2p.ID) (bool, error) {
+	added, err := cs.addAggregatedVote(av, peerID)
+	if err != nil {
+		// Aggregated votes overlapping with the ones we have in a way that can't be
+		// taken apart can come from typical peers, and the votes without their own
+		// signatures can't be evidence.
+		if errors.Is(err, types.ErrAggregatedVoteOverlapping) ||
+			errors.Is(err, types.ErrVoteNonDeterministicSignature) {
+			cs.Logger.Debug("aggregated vote has not been added", "err", err)
+			return added, nil
+		}
+		cs.Logger.Info("failed attempting to add aggregated vote", "err", err)
+		return added, ErrAddingVote
+	}
+
+	return added, nil
+}
+
+func (cs *State) addAggregatedVote(av *types.AggregatedVote, peerID p2p.ID) (added bool, err error) {
+	cs.Logger.Debug(
+		"adding aggregated vote",
+		"vote_height", av.Height,
+		"vote_type", av.Type,
+		"voters", av.Voters,
+		"cs_height", cs.Height,
+	)
+
+	// The aggregated signature doesn't cover the extensions of the precommits.
+	if av.Type == tmproto.PrecommitType && !av.BlockID.IsZero() &&
+		types.VoteExtensionsEnabled(cs.state.ConsensusParams.Abci, av.Height) {
+		return false, errors.New("aggregated precommits for a block can't have the vote extensions")
+	}
+
+	// Precommits for the previous height?
+	// These come in while we wait timeoutCommit
+	if av.Height+1 == cs.Height && av.Type == tmproto.PrecommitType {
+		if cs.Step != cstypes.RoundStepNewHeight {
+			// Late precommits at prior height are ignored
+			cs.Logger.Debug("aggregated precommit vote came in after commit timeout and has been ignored", "vote", av)
+			return
+		}
+
+		votes, err := cs.LastCommit.AddAggregatedVotes(av)
+		for _, vote := range votes {
+			if err := cs.handleLastCommitVote(vote); err != nil {
+				return true, err
+			}
+		}
+
+		return len(votes) > 0, err
+	}
+
+	// Height mismatch is ignored.
+	// Not necessarily a bad peer, but not favourable behaviour.
+	if av.Height != cs.Height {
+		cs.Logger.Debug("aggregated vote ignored and not added", "vote_height", av.Height, "cs_height", cs.Height,
+			"peer", peerID)
+		return
+	}
+
+	height := cs.Height
+	votes, err := cs.Votes.AddAggregatedVotes(av, peerID)
+	for _, vote := range votes {
+		// The rest of the votes don't matter once they made us commit the block.
+		if cs.Height != height {
+			break
+		}
+		if err := cs.handleVote(vote); err != nil {
+			return true, err
+		}
+	}
+
+	return len(votes) > 0, err
+}
+
+// handleLastCommitVote publishes the vote added to the last precommits, and
+// moves on to the new height if we have all of them.
+func (cs *State) handleLastCommitVote(vote *types.Vote) error {
+	cs.Logger.Debug("added vote to last precommits", "last_commit", cs.LastCommit.StringShort())
+	if err := cs.eventBus.PublishEventVote(types.EventDataVote{Vote: vote}); err != nil {
+		return err
+	}
+
+	cs.evsw.FireEvent(types.EventVote, vote)
+
+	// if we can skip timeoutCommit and have all the votes now,
+	if cs.config.SkipTimeoutCommit && cs.LastCommit.HasAll() {
+		// go straight to new round (skip timeout commit)
+		// cs.scheduleTimeout(time.Duration(0), cs.Height, 0, cstypes.RoundStepNewHeight)
+		cs.enterNewRound(cs.Height, 0)
+	}
+
+	return nil
+}
+
+// handleVote publishes the vote added to the votes of the current height, and
+// makes the transition the vote gives us.
+func (cs *State) handleVote(vote *types.Vote) error {
+	height := cs.Height
+	if err := cs.eventBus.PublishEventVote(types.EventDataVote{Vote: vote}); err != nil {
+		return err
+	}
 	cs.evsw.FireEvent(types.EventVote, vote)
 
 	switch vote.Type {
@@ -2191,7 +2551,7 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 				cs.LockedBlockParts = nil
 
 				if err := cs.eventBus.PublishEventUnlock(cs.RoundStateEvent()); err != nil {
-					return added, err
+					return err
 				}
 			}
 
@@ -2220,7 +2580,7 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 
 				cs.evsw.FireEvent(types.EventValidBlock, &cs.RoundState)
 				if err := cs.eventBus.PublishEventValidBlock(cs.RoundStateEvent()); err != nil {
-					return added, err
+					return err
 				}
 			}
 		}
@@ -2275,7 +2635,7 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		panic(fmt.Sprintf("unexpected vote type %v", vote.Type))
 	}
 
-	return added, err
+	return nil
 }
 
 // CONTRACT: cs.privValidator is not nil.
@@ -2307,15 +2667,33 @@ func (cs *State) signVote(
 		BlockID:          types.BlockID{Hash: hash, PartSetHeader: header},
 	}
 
+	// Only the precommits for a block are extended by the app, once the vote
+	// extensions are enabled. The extension is signed even if it is empty.
+	extEnabled := types.VoteExtensionsEnabled(cs.state.ConsensusParams.Abci, vote.Height)
+	if extEnabled && types.IsVoteExtendable(vote.ToProto()) {
+		ext, err := cs.blockExec.ExtendVote(vote)
+		if err != nil {
+			return nil, err
+		}
+		vote.Extension = ext
+	}
+
 	v := vote.ToProto()
 	err := cs.privValidator.SignVote(cs.state.ChainID, v)
 	vote.Signature = v.Signature
+	if extEnabled {
+		vote.ExtensionSignature = v.ExtensionSignature
+	}
 
 	return vote, err
 }
 
 func (cs *State) voteTime() time.Time {
 	now := tmtime.Now()
+	if cs.state.ConsensusParams.Timestamp.ProposerBased {
+		// the vote time has nothing to do with the block time
+		return now
+	}
 	minVoteTime := now
 	// TODO: We should remove next line in case we don't vote for v in case cs.ProposalBlock == nil,
 	// even if cs.LockedBlock != nil. See https://docs.tendermint.com/master/spec/.
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"os"
+	"sync"
 	"testing"
 	"time"
 
@@ -13,6 +15,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/line/ostracon/abci/example/counter"
+	abci "github.com/line/ostracon/abci/types"
+	cfg "github.com/line/ostracon/config"
 	cstypes "github.com/line/ostracon/consensus/types"
 	"github.com/line/ostracon/crypto/tmhash"
 	"github.com/line/ostracon/libs/log"
@@ -21,7 +25,10 @@ import (
 	mempl "github.com/line/ostracon/mempool"
 	p2pmock "github.com/line/ostracon/p2p/mock"
 	tmproto "github.com/line/ostracon/proto/ostracon/types"
+	sm "github.com/line/ostracon/state"
 	"github.com/line/ostracon/types"
+	tmtime "github.com/line/ostracon/types/time"
+	"github.com/line/ostracon/version"
 )
 
 /*
@@ -2346,7 +2353,7 @@ func addValidator(cs *State, vssMap map[string]*validatorStub, height int64) {
 	if err != nil {
 		panic("failed to convert newVal to protobuf")
 	}
-	newValidatorTx := kvstore.MakeValSetChangeTx(val.PubKey, 10)
+	newValidatorTx := kvstore.MakeValSetChangeTxWithProofOfPossession(val.PubKey, 10, newVal.ProofOfPossession)
 	_ = assertMempool(cs.txNotifier).CheckTx(newValidatorTx, nil, mempl.TxInfo{})
 	vssMap[newVal.PubKey.Address().String()] = newValidatorStub(privVal, int32(len(vssMap)+1))
 	vssMap[newVal.PubKey.Address().String()].Height = height
@@ -2448,3 +2455,489 @@ func TestStateAllVoterToSelectedVoter(t *testing.T) {
 		ensureNewRound(newRoundCh, height+1, 0)
 	}
 }
+
+// proposerEvidenceRecorder records the misbehavior of proposers reported by consensus
+type proposerEvidenceRecorder struct {
+	conflictingProposals [][2]*types.Proposal
+	invalidProofs        []*types.Header
+}
+
+func (r *proposerEvidenceRecorder) ReportConflictingVotes(voteA, voteB *types.Vote) {}
+
+func (r *proposerEvidenceRecorder) ReportConflictingProposals(proposalA, proposalB *types.Proposal) {
+	r.conflictingProposals = append(r.conflictingProposals, [2]*types.Proposal{proposalA, proposalB})
+}
+
+func (r *proposerEvidenceRecorder) ReportInvalidProposerProof(proposal *types.Proposal, header *types.Header) {
+	r.invalidProofs = append(r.invalidProofs, header)
+}
+
+func TestStateReportProposerMisbehavior(t *testing.T) {
+	cs1, vss := randState(1)
+	evpool := &proposerEvidenceRecorder{}
+	cs1.evpool = evpool
+	height, round := cs1.Height, cs1.Round
+	chainID := cs1.state.ChainID
+
+	proposal, block := decideProposal(cs1, vss[0], height, round)
+	require.NoError(t, cs1.defaultSetProposal(proposal))
+
+	// the same proposal is not reported
+	require.NoError(t, cs1.defaultSetProposal(proposal))
+	assert.Empty(t, evpool.conflictingProposals)
+
+	// a proposal signed by another key is not reported
+	conflicting := types.NewProposal(height, round, -1, types.BlockID{
+		Hash:          tmhash.Sum([]byte("conflicting")),
+		PartSetHeader: types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))},
+	})
+	p := conflicting.ToProto()
+	require.NoError(t, newValidatorStub(types.NewMockPV(types.PrivKeyEd25519), 0).SignProposal(chainID, p))
+	conflicting.Signature = p.Signature
+	require.NoError(t, cs1.defaultSetProposal(conflicting))
+	assert.Empty(t, evpool.conflictingProposals)
+
+	// a conflicting proposal signed by the proposer is reported
+	p = conflicting.ToProto()
+	require.NoError(t, vss[0].SignProposal(chainID, p))
+	conflicting.Signature = p.Signature
+	require.NoError(t, cs1.defaultSetProposal(conflicting))
+	require.Len(t, evpool.conflictingProposals, 1)
+	assert.Equal(t, [2]*types.Proposal{proposal, conflicting}, evpool.conflictingProposals[0])
+
+	// the block with a valid proof is not reported
+	cs1.reportInvalidProposerProof(block)
+	assert.Empty(t, evpool.invalidProofs)
+
+	// the block with an invalid proof is reported
+	block.Proof = tmrand.Bytes(len(block.Proof))
+	invalidProposal := types.NewProposal(height, round, -1, types.BlockID{
+		Hash:          block.Hash(),
+		PartSetHeader: block.MakePartSet(types.BlockPartSizeBytes).Header(),
+	})
+	p = invalidProposal.ToProto()
+	require.NoError(t, vss[0].SignProposal(chainID, p))
+	invalidProposal.Signature = p.Signature
+	cs1.Proposal = invalidProposal
+	cs1.reportInvalidProposerProof(block)
+	require.Len(t, evpool.invalidProofs, 1)
+	assert.Equal(t, &block.Header, evpool.invalidProofs[0])
+}
+
+func TestStateProposerBasedTimestamps(t *testing.T) {
+	testCases := []struct {
+		name     string
+		shift    time.Duration
+		prevoted bool
+	}{
+		{"timely proposal", 0, true},
+		{"too early proposal", time.Hour, false},
+		{"too late proposal", -time.Hour, false},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cs1, vss := randState(2)
+			cs1.state.ConsensusParams.Timestamp = tmproto.TimestampParams{
+				ProposerBased: true,
+				Precision:     500 * time.Millisecond,
+				MessageDelay:  2 * time.Second,
+			}
+			height, round := cs1.Height, cs1.Round
+			vs2 := vss[1]
+			chainID := cs1.state.ChainID
+
+			forceProposer(cs1, vss, []int{1}, []int64{height}, []int32{round})
+			voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+
+			_, propBlock := decideProposal(cs1, vs2, height, round)
+			if tc.shift < 0 {
+				// the block time must not be before the genesis time anyway
+				cs1.state.LastBlockTime = cs1.state.LastBlockTime.Add(tc.shift)
+			}
+			propBlock.Time = tmtime.Now().Add(tc.shift)
+			propBlockParts := propBlock.MakePartSet(types.BlockPartSizeBytes)
+			blockID := types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
+			proposal := types.NewProposal(height, round, -1, blockID)
+			proposal.Timestamp = propBlock.Time
+			p := proposal.ToProto()
+			require.NoError(t, vs2.SignProposal(chainID, p))
+			proposal.Signature = p.Signature
+			require.NoError(t, cs1.SetProposalAndBlock(proposal, propBlock, propBlockParts, "some peer"))
+
+			startTestRound(cs1, height, round)
+
+			ensurePrevote(voteCh, height, round)
+			if tc.prevoted {
+				validatePrevote(t, cs1, round, vss[0], propBlock.Hash())
+			} else {
+				validatePrevote(t, cs1, round, vss[0], nil)
+			}
+		})
+	}
+}
+
+func TestStateProposerBasedTimestampsReplay(t *testing.T) {
+	cs1, vss := randState(2)
+	cs1.state.ConsensusParams.Timestamp = tmproto.TimestampParams{
+		ProposerBased: true,
+		Precision:     500 * time.Millisecond,
+		MessageDelay:  2 * time.Second,
+	}
+	height, round := cs1.Height, cs1.Round
+	vs2 := vss[1]
+	chainID := cs1.state.ChainID
+
+	forceProposer(cs1, vss, []int{1}, []int64{height}, []int32{round})
+
+	// the proposal was received in time an hour ago, and is replayed from the WAL now
+	receiveTime := tmtime.Now().Add(-time.Hour)
+	_, propBlock := decideProposal(cs1, vs2, height, round)
+	cs1.state.LastBlockTime = cs1.state.LastBlockTime.Add(-2 * time.Hour)
+	propBlock.Time = receiveTime
+	propBlockParts := propBlock.MakePartSet(types.BlockPartSizeBytes)
+	blockID := types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
+	proposal := types.NewProposal(height, round, -1, blockID)
+	proposal.Timestamp = propBlock.Time
+	p := proposal.ToProto()
+	require.NoError(t, vs2.SignProposal(chainID, p))
+	proposal.Signature = p.Signature
+
+	msgs := []Message{&ProposalMessage{proposal}}
+	for i := 0; i < int(propBlockParts.Total()); i++ {
+		msgs = append(msgs, &BlockPartMessage{Height: height, Round: round, Part: propBlockParts.GetPart(i)})
+	}
+	for _, msg := range msgs {
+		require.NoError(t, cs1.readReplayMessage(&TimedWALMessage{
+			Time: receiveTime,
+			Msg:  msgInfo{Msg: msg, PeerID: "some peer"},
+		}, nil))
+	}
+	assert.Equal(t, receiveTime, cs1.ProposalReceiveTime)
+
+	// the replayed proposal is prevoted
+	mi := <-cs1.internalMsgQueue
+	vote, ok := mi.Msg.(*VoteMessage)
+	require.True(t, ok)
+	assert.Equal(t, tmproto.PrevoteType, vote.Vote.Type)
+	assert.Equal(t, blockID, vote.Vote.BlockID)
+}
+
+type voteExtensionApp struct {
+	*kvstore.Application
+
+	mtx             sync.Mutex
+	localLastCommit abci.ExtendedCommitInfo
+	verified        int
+}
+
+func (app *voteExtensionApp) ExtendVote(req abci.RequestExtendVote) abci.ResponseExtendVote {
+	return abci.ResponseExtendVote{VoteExtension: []byte(fmt.Sprintf("extension-%d", req.Height))}
+}
+
+func (app *voteExtensionApp) VerifyVoteExtension(
+	req abci.RequestVerifyVoteExtension) abci.ResponseVerifyVoteExtension {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	app.verified++
+	if bytes.Equal(req.VoteExtension, []byte("bad")) {
+		return abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}
+	}
+	return abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}
+}
+
+func (app *voteExtensionApp) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	app.localLastCommit = req.LocalLastCommit
+	return abci.ResponsePrepareProposal{Txs: req.Txs}
+}
+
+// 2 vals precommit with extensions, and the extensions are delivered to the
+// proposer of the next height
+func TestStateVoteExtensions(t *testing.T) {
+	state, privVals := randGenesisState(2, false, 10, types.DefaultVoterParams())
+	state.ConsensusParams.Abci.VoteExtensionsEnableHeight = 1
+	app := &voteExtensionApp{Application: kvstore.NewApplication()}
+	cs1 := newState(state, privVals[0], app)
+	vss := []*validatorStub{newValidatorStub(privVals[0], 0), newValidatorStub(privVals[1], 1)}
+	incrementHeight(vss[1])
+	vs2 := vss[1]
+	height, round := cs1.Height, cs1.Round
+	chainID := cs1.state.ChainID
+
+	forceProposer(cs1, vss, []int{0, 0}, []int64{height, height + 1}, []int32{round, 0})
+
+	voteCh := subscribeUnBuffered(cs1.eventBus, types.EventQueryVote)
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+
+	startTestRound(cs1, height, round)
+	ensureNewProposal(proposalCh, height, round)
+	ensurePrevote(voteCh, height, round)
+
+	rs := cs1.GetRoundState()
+	propBlockHash, propPartSetHeader := rs.ProposalBlock.Hash(), rs.ProposalBlockParts.Header()
+	signAddVotes(cs1, tmproto.PrevoteType, propBlockHash, propPartSetHeader, vs2)
+	ensurePrevote(voteCh, height, round)
+
+	// our precommit is extended by the app
+	ensurePrecommit(voteCh, height, round)
+	ownPrecommit := cs1.GetRoundState().Votes.Precommits(round).GetByIndex(0)
+	assert.Equal(t, []byte("extension-1"), ownPrecommit.Extension)
+	assert.NotEmpty(t, ownPrecommit.ExtensionSignature)
+
+	extendedPrecommit := func(ext []byte) *types.Vote {
+		vote := signVote(vs2, tmproto.PrecommitType, propBlockHash, propPartSetHeader)
+		vote.Extension = ext
+		v := vote.ToProto()
+		require.NoError(t, vs2.SignVote(chainID, v))
+		vote.Signature = v.Signature
+		vote.ExtensionSignature = v.ExtensionSignature
+		return vote
+	}
+
+	// the precommit with the extension rejected by the app is not added
+	addVotes(cs1, extendedPrecommit([]byte("bad")))
+	// the precommit with the tampered extension is not added
+	tampered := extendedPrecommit([]byte("good"))
+	tampered.Extension = []byte("tampered")
+	addVotes(cs1, tampered)
+	// the precommit without the signature of the extension is not added
+	addVotes(cs1, signVote(vs2, tmproto.PrecommitType, propBlockHash, propPartSetHeader))
+
+	addVotes(cs1, extendedPrecommit([]byte("good")))
+	ensurePrecommit(voteCh, height, round)
+	ensureNewBlock(newBlockCh, height)
+
+	// the extensions are delivered to the proposer of the next height
+	ensureNewProposal(proposalCh, height+1, 0)
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	require.Len(t, app.localLastCommit.Votes, 2)
+	assert.Equal(t, []byte("extension-1"), app.localLastCommit.Votes[0].VoteExtension)
+	assert.Equal(t, []byte("good"), app.localLastCommit.Votes[1].VoteExtension)
+
+	// and the extended commit is kept by the block store
+	extCommit := cs1.blockStore.LoadBlockExtendedCommit(height)
+	require.NotNil(t, extCommit)
+	assert.Equal(t, []byte("good"), extCommit.Extensions[1].Extension)
+}
+
+// a duplicate of an extended precommit is ignored without asking the app to
+// verify the extension again
+func TestStateVoteExtensionDuplicate(t *testing.T) {
+	state, privVals := randGenesisState(4, false, 10, types.DefaultVoterParams())
+	state.ConsensusParams.Abci.VoteExtensionsEnableHeight = 1
+	app := &voteExtensionApp{Application: kvstore.NewApplication()}
+	cs1 := newState(state, privVals[0], app)
+	vs2 := newValidatorStub(privVals[1], 1)
+	incrementHeight(vs2)
+	chainID := cs1.state.ChainID
+
+	blockID := types.BlockID{
+		Hash:          tmrand.Bytes(tmhash.Size),
+		PartSetHeader: types.PartSetHeader{Total: 1, Hash: tmrand.Bytes(tmhash.Size)},
+	}
+	vote := signVote(vs2, tmproto.PrecommitType, blockID.Hash, blockID.PartSetHeader)
+	vote.Extension = []byte("good")
+	v := vote.ToProto()
+	require.NoError(t, vs2.SignVote(chainID, v))
+	vote.Signature = v.Signature
+	vote.ExtensionSignature = v.ExtensionSignature
+
+	added, err := cs1.addVote(vote, "some peer")
+	require.NoError(t, err)
+	require.True(t, added)
+
+	added, err = cs1.addVote(vote.Copy(), "other peer")
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	assert.Equal(t, 1, app.verified)
+}
+
+// the app isn't asked to verify the extension of a precommit with a wrong
+// signature
+func TestStateVoteExtensionWrongSignature(t *testing.T) {
+	state, privVals := randGenesisState(4, false, 10, types.DefaultVoterParams())
+	state.ConsensusParams.Abci.VoteExtensionsEnableHeight = 1
+	app := &voteExtensionApp{Application: kvstore.NewApplication()}
+	cs1 := newState(state, privVals[0], app)
+	vs2 := newValidatorStub(privVals[1], 1)
+	incrementHeight(vs2)
+
+	blockID := types.BlockID{
+		Hash:          tmrand.Bytes(tmhash.Size),
+		PartSetHeader: types.PartSetHeader{Total: 1, Hash: tmrand.Bytes(tmhash.Size)},
+	}
+	vote := signVote(vs2, tmproto.PrecommitType, blockID.Hash, blockID.PartSetHeader)
+	vote.Extension = []byte("good")
+	v := vote.ToProto()
+	require.NoError(t, vs2.SignVote(cs1.state.ChainID, v))
+	vote.ExtensionSignature = v.ExtensionSignature
+	vote.Timestamp = vote.Timestamp.Add(time.Millisecond)
+
+	added, err := cs1.addVote(vote, "some peer")
+	assert.ErrorIs(t, err, types.ErrVoteInvalidSignature)
+	assert.False(t, added)
+
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	assert.Zero(t, app.verified)
+}
+
+// the precommits are not extended before the vote extensions are enabled, and
+// the extended precommits are not added
+func TestStateVoteExtensionsDisabled(t *testing.T) {
+	state, privVals := randGenesisState(2, false, 10, types.DefaultVoterParams())
+	state.ConsensusParams.Abci.VoteExtensionsEnableHeight = 2
+	app := &voteExtensionApp{Application: kvstore.NewApplication()}
+	cs1 := newState(state, privVals[0], app)
+	vss := []*validatorStub{newValidatorStub(privVals[0], 0), newValidatorStub(privVals[1], 1)}
+	incrementHeight(vss[1])
+	vs2 := vss[1]
+	height, round := cs1.Height, cs1.Round
+
+	forceProposer(cs1, vss, []int{0}, []int64{height}, []int32{round})
+
+	voteCh := subscribeUnBuffered(cs1.eventBus, types.EventQueryVote)
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+
+	startTestRound(cs1, height, round)
+	ensureNewProposal(proposalCh, height, round)
+	ensurePrevote(voteCh, height, round)
+
+	rs := cs1.GetRoundState()
+	propBlockHash, propPartSetHeader := rs.ProposalBlock.Hash(), rs.ProposalBlockParts.Header()
+	signAddVotes(cs1, tmproto.PrevoteType, propBlockHash, propPartSetHeader, vs2)
+	ensurePrevote(voteCh, height, round)
+
+	ensurePrecommit(voteCh, height, round)
+	ownPrecommit := cs1.GetRoundState().Votes.Precommits(round).GetByIndex(0)
+	assert.Empty(t, ownPrecommit.Extension)
+	assert.Empty(t, ownPrecommit.ExtensionSignature)
+
+	vote := signVote(vs2, tmproto.PrecommitType, propBlockHash, propPartSetHeader)
+	v := vote.ToProto()
+	require.NoError(t, vs2.SignVote(cs1.state.ChainID, v))
+	vote.ExtensionSignature = v.ExtensionSignature
+	_, err := cs1.addVote(vote, "some peer")
+	assert.Error(t, err)
+}
+
+type rejectProposalApp struct {
+	*kvstore.Application
+}
+
+func (app *rejectProposalApp) ProcessProposal(req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+	return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+}
+
+// the app rejects the proposal, so we prevote nil
+func TestStateProcessProposalRejected(t *testing.T) {
+	state, privVals := randGenesisState(1, false, 10, types.DefaultVoterParams())
+	state.ConsensusParams.Abci.VoteExtensionsEnableHeight = 1
+	cs1 := newState(state, privVals[0], &rejectProposalApp{Application: kvstore.NewApplication()})
+	vs1 := newValidatorStub(privVals[0], 0)
+	height, round := cs1.Height, cs1.Round
+
+	voteCh := subscribeUnBuffered(cs1.eventBus, types.EventQueryVote)
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+
+	startTestRound(cs1, height, round)
+	ensureNewProposal(proposalCh, height, round)
+	ensurePrevote(voteCh, height, round)
+	validatePrevote(t, cs1, round, vs1, nil)
+}
+
+// the node halts after committing the block at the halt height, and makes no more progress
+func TestStateHaltHeight(t *testing.T) {
+	config := ResetConfig("consensus_state_halt_height_test")
+	defer os.RemoveAll(config.RootDir)
+	config.Consensus.HaltHeight = 2
+	state, privVals := randGenesisState(1, false, 10, nil)
+	cs1 := newStateWithConfig(config, state, privVals[0], kvstore.NewApplication())
+	height, round := cs1.Height, cs1.Round
+
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+	startTestRound(cs1, height, round)
+
+	ensureNewBlock(newBlockCh, height)
+	ensureNewBlock(newBlockCh, height+1)
+	select {
+	case <-cs1.Halted():
+	case <-time.After(ensureTimeout):
+		t.Fatal("timed out waiting for the halt")
+	}
+	assert.Equal(t, "reached halt height 2", cs1.HaltReason())
+	ensureNoNewEventOnChannel(newBlockCh)
+	assert.Equal(t, int64(2), cs1.GetState().LastBlockHeight)
+}
+
+func TestStateHaltReasonFor(t *testing.T) {
+	haltTime := time.Unix(1600000000, 0)
+	testCases := []struct {
+		name          string
+		haltHeight    int64
+		haltTime      int64
+		height        int64
+		blockTime     time.Time
+		lastBlockTime time.Time
+		expected      string
+	}{
+		{"disabled", 0, 0, 10, haltTime, haltTime.Add(-time.Second), ""},
+		{"before halt height", 10, 0, 9, haltTime, haltTime, ""},
+		{"at halt height", 10, 0, 10, haltTime, haltTime, "reached halt height 10"},
+		{"after halt height", 10, 0, 11, haltTime, haltTime, ""},
+		{"before halt time", 0, haltTime.Unix(), 10, haltTime.Add(-time.Second), haltTime.Add(-2 * time.Second), ""},
+		{"at halt time", 0, haltTime.Unix(), 10, haltTime, haltTime.Add(-time.Second),
+			"reached halt time 2020-09-13 12:26:40 +0000 UTC at height 10"},
+		{"crossing halt time", 0, haltTime.Unix(), 10, haltTime.Add(time.Second), haltTime.Add(-time.Second),
+			"reached halt time 2020-09-13 12:26:40 +0000 UTC at height 10"},
+		{"after halt time", 0, haltTime.Unix(), 10, haltTime.Add(2 * time.Second), haltTime, ""},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cs := &State{
+				config: &cfg.ConsensusConfig{HaltHeight: tc.haltHeight, HaltTime: tc.haltTime},
+				state:  sm.State{Version: sm.InitStateVersion},
+			}
+			block := &types.Block{Header: types.Header{Height: tc.height, Time: tc.blockTime}}
+			assert.Equal(t, tc.expected, cs.haltReasonFor(block, tc.lastBlockTime))
+		})
+	}
+
+	// the software does not support the block protocol of the next height switched by an upgrade
+	cs := &State{config: &cfg.ConsensusConfig{}, state: sm.State{Version: sm.InitStateVersion}}
+	cs.state.Version.Consensus.Block = version.BlockProtocol + 1
+	block := &types.Block{Header: types.Header{Height: 10, Time: haltTime}}
+	assert.Equal(t, sm.ErrUnsupportedBlockProtocol{Height: 11, BlockProtocol: version.BlockProtocol + 1}.Error(),
+		cs.haltReasonFor(block, haltTime))
+}
+
+// the node halts right away when it starts with a block protocol it does not support, e.g. after fast sync
+func TestStateHaltUnsupportedBlockProtocolOnStart(t *testing.T) {
+	config := ResetConfig("consensus_state_halt_unsupported_test")
+	defer os.RemoveAll(config.RootDir)
+	state, privVals := randGenesisState(1, false, 10, nil)
+	state.Version.Consensus.Block = version.BlockProtocol + 1
+	cs1 := newStateWithConfig(config, state, privVals[0], kvstore.NewApplication())
+
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+	err := cs1.Start()
+	require.NoError(t, err)
+	defer cs1.Stop() //nolint:errcheck // ignore for tests
+
+	select {
+	case <-cs1.Halted():
+	case <-time.After(ensureTimeout):
+		t.Fatal("timed out waiting for the halt")
+	}
+	assert.Equal(t, sm.ErrUnsupportedBlockProtocol{Height: 1, BlockProtocol: version.BlockProtocol + 1}.Error(),
+		cs1.HaltReason())
+	ensureNoNewEventOnChannel(newBlockCh)
+}
@@ -0,0 +1,144 @@
+package consensus
+
+import (
+	"time"
+
+	cfg "github.com/line/ostracon/config"
+)
+
+// The smoothing factors of the latency estimators, as for the TCP retransmission
+// timer (RFC 6298).
+const (
+	latencyAlpha = 0.125
+	latencyBeta  = 0.25
+	// how many deviations of the latency the timeouts allow for
+	latencyDeviations = 4
+)
+
+// latencyEstimator tracks the smoothed mean and deviation of a latency.
+type latencyEstimator struct {
+	mean      time.Duration
+	deviation time.Duration
+}
+
+func (le *latencyEstimator) observe(latency time.Duration) {
+	if latency <= 0 {
+		return
+	}
+	if le.mean == 0 {
+		le.mean = latency
+		le.deviation = latency / 2
+		return
+	}
+	diff := le.mean - latency
+	if diff < 0 {
+		diff = -diff
+	}
+	le.deviation += time.Duration(latencyBeta * float64(diff-le.deviation))
+	le.mean += time.Duration(latencyAlpha * float64(latency-le.mean))
+}
+
+// backOff doubles the timeout, up to max, after it expired, as the retransmission timer
+// does. The mean and the deviation are scaled alike, so that the next latencies observed
+// bring the timeout down again gradually.
+func (le *latencyEstimator) backOff(max time.Duration) {
+	timeout := le.timeout()
+	if timeout == 0 || timeout >= max {
+		return
+	}
+	backedOff := 2 * timeout
+	if backedOff > max {
+		backedOff = max
+	}
+	le.deviation = time.Duration(float64(le.deviation) * float64(backedOff) / float64(timeout))
+	le.mean = backedOff - latencyDeviations*le.deviation
+}
+
+// timeout returns the estimated timeout, or 0 if no latency has been observed yet.
+func (le *latencyEstimator) timeout() time.Duration {
+	if le.mean == 0 {
+		return 0
+	}
+	return le.mean + latencyDeviations*le.deviation
+}
+
+// adaptiveTimeouts chooses the timeouts of the propose, prevote and precommit steps.
+// If adaptive timeouts are enabled in the config, the timeouts of the first round
+// follow the latencies observed in the past heights, within the configured bounds;
+// otherwise, or until a latency has been observed, they are the configured ones.
+// The configured deltas are added for each round in any case.
+type adaptiveTimeouts struct {
+	config *cfg.ConsensusConfig
+
+	propose   latencyEstimator
+	prevote   latencyEstimator
+	precommit latencyEstimator
+}
+
+func newAdaptiveTimeouts(config *cfg.ConsensusConfig) *adaptiveTimeouts {
+	return &adaptiveTimeouts{config: config}
+}
+
+// observe feeds the latencies of the steps of a height committed in the commit round.
+// The latency of the proposal is from the start of the round to the complete proposal
+// block, and it is only observed if the proposal was made by another validator.
+// A step whose timeout expired has no latency to observe, so its timeout is backed off
+// instead, and so are the timeouts of all the steps if the height took more than a round.
+func (at *adaptiveTimeouts) observe(st *StepTimes, observeProposal bool, commitRound int32) {
+	if observeProposal {
+		at.propose.observe(msToDuration(st.ProposalWaiting.GetDuration() +
+			st.ProposalVerifying.GetDuration() + st.ProposalBlockReceiving.GetDuration()))
+	}
+	at.prevote.observe(msToDuration(st.PrevoteReceiving.GetDuration()))
+	at.precommit.observe(msToDuration(st.PrecommitReceiving.GetDuration()))
+
+	if st.ProposeTimedOut || commitRound > 0 {
+		at.propose.backOff(at.config.AdaptiveTimeoutMax)
+	}
+	if st.PrevoteTimedOut || commitRound > 0 {
+		at.prevote.backOff(at.config.AdaptiveTimeoutMax)
+	}
+	if st.PrecommitTimedOut || commitRound > 0 {
+		at.precommit.backOff(at.config.AdaptiveTimeoutMax)
+	}
+}
+
+// Propose returns the amount of time to wait for a proposal
+func (at *adaptiveTimeouts) Propose(round int32) time.Duration {
+	return at.timeout(&at.propose, at.config.Propose(0), at.config.TimeoutProposeDelta, round)
+}
+
+// Prevote returns the amount of time to wait for straggler votes after receiving any +2/3 prevotes
+func (at *adaptiveTimeouts) Prevote(round int32) time.Duration {
+	return at.timeout(&at.prevote, at.config.Prevote(0), at.config.TimeoutPrevoteDelta, round)
+}
+
+// Precommit returns the amount of time to wait for straggler votes after receiving any +2/3 precommits
+func (at *adaptiveTimeouts) Precommit(round int32) time.Duration {
+	return at.timeout(&at.precommit, at.config.Precommit(0), at.config.TimeoutPrecommitDelta, round)
+}
+
+func (at *adaptiveTimeouts) timeout(
+	le *latencyEstimator,
+	base time.Duration,
+	delta time.Duration,
+	round int32,
+) time.Duration {
+	if at.config.AdaptiveTimeouts {
+		if estimated := le.timeout(); estimated > 0 {
+			base = estimated
+			if base < at.config.AdaptiveTimeoutMin {
+				base = at.config.AdaptiveTimeoutMin
+			}
+			if base > at.config.AdaptiveTimeoutMax {
+				base = at.config.AdaptiveTimeoutMax
+			}
+		}
+	}
+	return base + delta*time.Duration(round)
+}
+
+// msToDuration converts the milliseconds of a StepDuration into a time.Duration.
+func msToDuration(ms float64) time.Duration {
+	return time.Duration(ms * float64(time.Millisecond))
+}
@@ -0,0 +1,124 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	cfg "github.com/line/ostracon/config"
+)
+
+func TestLatencyEstimator(t *testing.T) {
+	le := latencyEstimator{}
+	assert.Zero(t, le.timeout())
+
+	// no latency is ignored
+	le.observe(0)
+	assert.Zero(t, le.timeout())
+
+	le.observe(100 * time.Millisecond)
+	assert.Equal(t, 100*time.Millisecond, le.mean)
+	assert.Equal(t, 50*time.Millisecond, le.deviation)
+	assert.Equal(t, 300*time.Millisecond, le.timeout())
+
+	// a steady latency reduces the deviation
+	for i := 0; i < 50; i++ {
+		le.observe(100 * time.Millisecond)
+	}
+	assert.Equal(t, 100*time.Millisecond, le.mean)
+	assert.InDelta(t, 100*time.Millisecond, le.timeout(), float64(time.Millisecond))
+
+	// a higher latency raises the timeout
+	prev := le.timeout()
+	le.observe(500 * time.Millisecond)
+	assert.Equal(t, 150*time.Millisecond, le.mean)
+	assert.Greater(t, int64(le.timeout()), int64(prev))
+}
+
+func TestAdaptiveTimeouts(t *testing.T) {
+	config := cfg.DefaultConsensusConfig()
+	config.AdaptiveTimeoutMin = 200 * time.Millisecond
+	config.AdaptiveTimeoutMax = 2 * time.Second
+	at := newAdaptiveTimeouts(config)
+
+	observe := func(proposal, prevote, precommit time.Duration, observeProposal bool) {
+		now := time.Now()
+		st := StepTimes{
+			ProposalWaiting:        StepDuration{start: now, end: now.Add(proposal)},
+			ProposalBlockReceiving: StepDuration{start: now, end: now},
+			PrevoteReceiving:       StepDuration{start: now, end: now.Add(prevote)},
+			PrecommitReceiving:     StepDuration{start: now, end: now.Add(precommit)},
+		}
+		at.observe(&st, observeProposal, 0)
+	}
+
+	// disabled, the configured timeouts are used
+	observe(100*time.Millisecond, 100*time.Millisecond, 100*time.Millisecond, true)
+	assert.Equal(t, config.Propose(0), at.Propose(0))
+	assert.Equal(t, config.Prevote(2), at.Prevote(2))
+	assert.Equal(t, config.Precommit(1), at.Precommit(1))
+
+	config.AdaptiveTimeouts = true
+	// propose: 100ms + 4 * 50ms
+	assert.Equal(t, 300*time.Millisecond, at.Propose(0))
+	assert.Equal(t, 300*time.Millisecond+2*config.TimeoutProposeDelta, at.Propose(2))
+
+	// the timeouts stay within the bounds
+	at = newAdaptiveTimeouts(config)
+	observe(10*time.Millisecond, 10*time.Second, 0, true)
+	assert.Equal(t, config.AdaptiveTimeoutMin, at.Propose(0))
+	assert.Equal(t, config.AdaptiveTimeoutMax, at.Prevote(0))
+	// no latency observed yet
+	assert.Equal(t, config.Precommit(0), at.Precommit(0))
+
+	// the proposal latency is not observed if the block was proposed by this node
+	at = newAdaptiveTimeouts(config)
+	observe(time.Second, 0, 0, false)
+	assert.Equal(t, config.Propose(0), at.Propose(0))
+}
+
+func TestAdaptiveTimeoutsBackOff(t *testing.T) {
+	config := cfg.DefaultConsensusConfig()
+	config.AdaptiveTimeouts = true
+	config.AdaptiveTimeoutMin = 100 * time.Millisecond
+	config.AdaptiveTimeoutMax = 2 * time.Second
+	at := newAdaptiveTimeouts(config)
+
+	// propose: 100ms + 4 * 50ms
+	now := time.Now()
+	st := StepTimes{
+		ProposalWaiting:        StepDuration{start: now, end: now.Add(100 * time.Millisecond)},
+		ProposalBlockReceiving: StepDuration{start: now, end: now},
+		PrevoteReceiving:       StepDuration{start: now, end: now.Add(100 * time.Millisecond)},
+		PrecommitReceiving:     StepDuration{start: now, end: now.Add(100 * time.Millisecond)},
+	}
+	at.observe(&st, true, 0)
+	assert.Equal(t, 300*time.Millisecond, at.Propose(0))
+	assert.Equal(t, 300*time.Millisecond, at.Prevote(0))
+	assert.Equal(t, 300*time.Millisecond, at.Precommit(0))
+
+	// a step which timed out has no latency, and its timeout is doubled
+	at.observe(&StepTimes{ProposeTimedOut: true}, false, 0)
+	assert.Equal(t, 600*time.Millisecond, at.Propose(0))
+	assert.Equal(t, 300*time.Millisecond, at.Prevote(0))
+	assert.Equal(t, 300*time.Millisecond, at.Precommit(0))
+
+	// all the timeouts are doubled if the height took more than a round
+	at.observe(&StepTimes{}, false, 1)
+	assert.Equal(t, 1200*time.Millisecond, at.Propose(0))
+	assert.Equal(t, 600*time.Millisecond, at.Prevote(0))
+	assert.Equal(t, 600*time.Millisecond, at.Precommit(0))
+
+	// up to the max
+	at.observe(&StepTimes{ProposeTimedOut: true}, false, 0)
+	assert.Equal(t, config.AdaptiveTimeoutMax, at.Propose(0))
+	at.observe(&StepTimes{ProposeTimedOut: true}, false, 0)
+	assert.Equal(t, config.AdaptiveTimeoutMax, at.Propose(0))
+
+	// and it comes down again with the latencies observed
+	for i := 0; i < 10; i++ {
+		at.observe(&st, true, 0)
+	}
+	assert.Less(t, int64(at.Propose(0)), int64(config.AdaptiveTimeoutMax))
+}
@@ -39,9 +39,10 @@ We let each peer provide us with up to 2 unexpected "catchup" rounds.
 One for their LastCommit round, and another for the official commit round.
 */
 type HeightVoteSet struct {
-	chainID  string
-	height   int64
-	voterSet *types.VoterSet
+	chainID      string
+	height       int64
+	voterSet     *types.VoterSet
+	blockVersion uint64 // the block protocol of the height, whose rules the votes are verified under
 
 	mtx               sync.Mutex
 	round             int32                  // max tracked round
@@ -49,20 +50,22 @@ type HeightVoteSet struct {
 	peerCatchupRounds map[p2p.ID][]int32     // keys: peer.ID; values: at most 2 rounds
 }
 
-func NewHeightVoteSet(chainID string, height int64, voterSet *types.VoterSet) *HeightVoteSet {
+func NewHeightVoteSet(chainID string, height int64, voterSet *types.VoterSet,
+	blockVersion uint64) *HeightVoteSet {
 	hvs := &HeightVoteSet{
 		chainID: chainID,
 	}
-	hvs.Reset(height, voterSet)
+	hvs.Reset(height, voterSet, blockVersion)
 	return hvs
 }
 
-func (hvs *HeightVoteSet) Reset(height int64, voterSet *types.VoterSet) {
+func (hvs *HeightVoteSet) Reset(height int64, voterSet *types.VoterSet, blockVersion uint64) {
 	hvs.mtx.Lock()
 	defer hvs.mtx.Unlock()
 
 	hvs.height = height
 	hvs.voterSet = voterSet
+	hvs.blockVersion = blockVersion
 	hvs.roundVoteSets = make(map[int32]RoundVoteSet)
 	hvs.peerCatchupRounds = make(map[p2p.ID][]int32)
 
@@ -104,8 +107,9 @@ func (hvs *HeightVoteSet) addRound(round int32) {
 		panic("addRound() for an existing round")
 	}
 	// log.Debug("addRound(round)", "round", round)
-	prevotes := types.NewVoteSet(hvs.chainID, hvs.height, round, tmproto.PrevoteType, hvs.voterSet)
-	precommits := types.NewVoteSet(hvs.chainID, hvs.height, round, tmproto.PrecommitType, hvs.voterSet)
+	prevotes := types.NewVoteSet(hvs.chainID, hvs.height, round, tmproto.PrevoteType, hvs.voterSet, hvs.blockVersion)
+	precommits := types.NewVoteSet(hvs.chainID, hvs.height, round, tmproto.PrecommitType, hvs.voterSet,
+		hvs.blockVersion)
 	hvs.roundVoteSets[round] = RoundVoteSet{
 		Prevotes:   prevotes,
 		Precommits: precommits,
@@ -136,6 +140,30 @@ func (hvs *HeightVoteSet) AddVote(vote *types.Vote, peerID p2p.ID) (added bool,
 	return
 }
 
+// AddAggregatedVotes adds the votes of the aggregated vote, and returns the
+// votes that were added.
+func (hvs *HeightVoteSet) AddAggregatedVotes(av *types.AggregatedVote, peerID p2p.ID) (added []*types.Vote, err error) {
+	hvs.mtx.Lock()
+	defer hvs.mtx.Unlock()
+	if !types.IsVoteTypeValid(av.Type) {
+		return
+	}
+	voteSet := hvs.getVoteSet(av.Round, av.Type)
+	if voteSet == nil {
+		if rndz := hvs.peerCatchupRounds[peerID]; len(rndz) < 2 {
+			hvs.addRound(av.Round)
+			voteSet = hvs.getVoteSet(av.Round, av.Type)
+			hvs.peerCatchupRounds[peerID] = append(rndz, av.Round)
+		} else {
+			// punish peer
+			err = ErrGotVoteFromUnwantedRound
+			return
+		}
+	}
+	added, err = voteSet.AddAggregatedVotes(av)
+	return
+}
+
 func (hvs *HeightVoteSet) Prevotes(round int32) *types.VoteSet {
 	hvs.mtx.Lock()
 	defer hvs.mtx.Unlock()
@@ -38,7 +38,7 @@ type TimedWALMessage struct {
 }
 
 // EndHeightMessage marks the end of the given height inside WAL.
-// @internal used by scripts/wal2json util.
+// @internal used by the wal dump command.
 type EndHeightMessage struct {
 	Height int64 `json:"height"`
 }
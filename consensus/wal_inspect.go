@@ -0,0 +1,312 @@
+package consensus
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	auto "github.com/line/ostracon/libs/autofile"
+	tmjson "github.com/line/ostracon/libs/json"
+	tmos "github.com/line/ostracon/libs/os"
+	"github.com/line/ostracon/types"
+)
+
+// Types of WAL messages, used to filter the dumped messages.
+const (
+	WALMsgTypeRoundState = "round_state"
+	WALMsgTypeProposal   = "proposal"
+	WALMsgTypeBlockPart  = "block_part"
+	WALMsgTypeVote       = "vote"
+	WALMsgTypeMsgInfo    = "msg_info"
+	WALMsgTypeTimeout    = "timeout"
+	WALMsgTypeEndHeight  = "end_height"
+)
+
+// WALMsgTypes lists all the types of WAL messages.
+var WALMsgTypes = []string{
+	WALMsgTypeRoundState,
+	WALMsgTypeProposal,
+	WALMsgTypeBlockPart,
+	WALMsgTypeVote,
+	WALMsgTypeMsgInfo,
+	WALMsgTypeTimeout,
+	WALMsgTypeEndHeight,
+}
+
+// WALFileReport is the result of scanning a single file of the WAL group.
+type WALFileReport struct {
+	Path    string `json:"path"`
+	Size    int64  `json:"size"`
+	Records int    `json:"records"`
+	// lowest and highest heights of the EndHeightMessages in the file, 0 if there are none
+	MinEndHeight int64 `json:"min_end_height"`
+	MaxEndHeight int64 `json:"max_end_height"`
+	// offset of the first corrupted record, which is also the size of the valid part of the file.
+	// Only meaningful if Corruption is set
+	CorruptedOffset int64  `json:"corrupted_offset"`
+	Corruption      string `json:"corruption,omitempty"`
+}
+
+// IsCorrupted returns true if the file has a record which can not be decoded.
+func (r WALFileReport) IsCorrupted() bool {
+	return r.Corruption != ""
+}
+
+// WALMessageFilter selects WAL messages by their height, round and type.
+type WALMessageFilter struct {
+	Height int64    // 0 matches any height
+	Round  int32    // negative matches any round
+	Types  []string // empty matches any type
+}
+
+// Matches returns true if the message passes the filter. The messages without
+// a height or a round, like an EndHeightMessage without a round, are only
+// filtered by the fields they have.
+func (f WALMessageFilter) Matches(msg WALMessage) bool {
+	if len(f.Types) > 0 {
+		msgType := WALMessageType(msg)
+		found := false
+		for _, t := range f.Types {
+			if t == msgType {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	height, round, ok := walMessageHeightRound(msg)
+	if !ok {
+		return true
+	}
+	if f.Height > 0 && height != f.Height {
+		return false
+	}
+	if f.Round >= 0 && round >= 0 && round != f.Round {
+		return false
+	}
+	return true
+}
+
+// WALMessageType returns the type of the WAL message, which is one of WALMsgTypes.
+func WALMessageType(msg WALMessage) string {
+	switch m := msg.(type) {
+	case types.EventDataRoundState:
+		return WALMsgTypeRoundState
+	case msgInfo:
+		switch m.Msg.(type) {
+		case *ProposalMessage:
+			return WALMsgTypeProposal
+		case *BlockPartMessage:
+			return WALMsgTypeBlockPart
+		case *VoteMessage:
+			return WALMsgTypeVote
+		default:
+			return WALMsgTypeMsgInfo
+		}
+	case timeoutInfo:
+		return WALMsgTypeTimeout
+	case EndHeightMessage:
+		return WALMsgTypeEndHeight
+	default:
+		return ""
+	}
+}
+
+// walMessageHeightRound returns the height and the round of the message, where the round
+// is -1 if the message has none. ok is false if the message has no height either.
+func walMessageHeightRound(msg WALMessage) (height int64, round int32, ok bool) {
+	switch m := msg.(type) {
+	case types.EventDataRoundState:
+		return m.Height, m.Round, true
+	case msgInfo:
+		switch mi := m.Msg.(type) {
+		case *ProposalMessage:
+			return mi.Proposal.Height, mi.Proposal.Round, true
+		case *BlockPartMessage:
+			return mi.Height, mi.Round, true
+		case *VoteMessage:
+			return mi.Vote.Height, mi.Vote.Round, true
+		}
+	case timeoutInfo:
+		return m.Height, m.Round, true
+	case EndHeightMessage:
+		return m.Height, -1, true
+	}
+	return 0, -1, false
+}
+
+// ScanWAL decodes the records of all the files of the WAL group whose head is walFile, from
+// the oldest to the head, and calls fn with each of them if fn is not nil. The scan of a file
+// stops at its first corrupted record and goes on with the next file. It returns a report for
+// each file.
+func ScanWAL(walFile string, fn func(*TimedWALMessage) error) ([]WALFileReport, error) {
+	// opening a group creates its head, so make sure there is one first
+	if _, err := os.Stat(walFile); err != nil {
+		return nil, err
+	}
+	group, err := auto.OpenGroup(walFile)
+	if err != nil {
+		return nil, err
+	}
+	defer group.Close()
+
+	reports := make([]WALFileReport, 0, group.MaxIndex()-group.MinIndex()+1)
+	for index := group.MinIndex(); index <= group.MaxIndex(); index++ {
+		report, err := scanWALFile(group.FilePathForIndex(index), fn)
+		if err != nil {
+			return reports, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+func scanWALFile(path string, fn func(*TimedWALMessage) error) (WALFileReport, error) {
+	report := WALFileReport{Path: path}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return report, err
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return report, err
+	}
+	report.Size = stat.Size()
+
+	// NOTE: the decoder expects full reads, so the file must not be buffered
+	rd := &countingReader{rd: f}
+	dec := NewWALDecoder(rd)
+	for {
+		offset := rd.n
+		msg, err := dec.Decode()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			report.CorruptedOffset = offset
+			report.Corruption = err.Error()
+			break
+		}
+
+		report.Records++
+		if m, ok := msg.Msg.(EndHeightMessage); ok {
+			if report.MinEndHeight == 0 || m.Height < report.MinEndHeight {
+				report.MinEndHeight = m.Height
+			}
+			if m.Height > report.MaxEndHeight {
+				report.MaxEndHeight = m.Height
+			}
+		}
+
+		if fn != nil {
+			if err := fn(msg); err != nil {
+				return report, err
+			}
+		}
+	}
+	return report, nil
+}
+
+// WALRepair describes how RepairWAL repaired the WAL group.
+type WALRepair struct {
+	// Truncated is the report of the first corrupted file, taken before it was truncated
+	Truncated WALFileReport
+	// MovedAside are the reports of the files after the first corrupted one
+	MovedAside []WALFileReport
+}
+
+// RepairWAL repairs the WAL group whose head is walFile, if it is corrupted. The first
+// corrupted file, in the order of the group, is backed up to <file>.CORRUPTED and truncated
+// to its last good record. The files after it are moved to <file>.CORRUPTED, since the
+// records they have do not follow the last good one, and an empty head is created in their
+// place. It returns nil if the WAL is not corrupted.
+func RepairWAL(walFile string) (*WALRepair, error) {
+	reports, err := ScanWAL(walFile, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	first := -1
+	for i, report := range reports {
+		if report.IsCorrupted() {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		return nil, nil
+	}
+
+	repair := &WALRepair{Truncated: reports[first]}
+	path := repair.Truncated.Path
+	if err := tmos.CopyFile(path, path+".CORRUPTED"); err != nil {
+		return nil, fmt.Errorf("failed to back up %s: %w", path, err)
+	}
+	if err := os.Truncate(path, repair.Truncated.CorruptedOffset); err != nil {
+		return nil, fmt.Errorf("failed to truncate %s: %w", path, err)
+	}
+
+	for _, report := range reports[first+1:] {
+		if err := os.Rename(report.Path, report.Path+".CORRUPTED"); err != nil {
+			return repair, fmt.Errorf("failed to move aside %s: %w", report.Path, err)
+		}
+		repair.MovedAside = append(repair.MovedAside, report)
+	}
+
+	// the head, which is the last file of the group, has been moved aside if any file
+	// was, so start a new one
+	if len(repair.MovedAside) > 0 {
+		if err := ioutil.WriteFile(walFile, nil, 0600); err != nil {
+			return repair, fmt.Errorf("failed to create %s: %w", walFile, err)
+		}
+	}
+	return repair, nil
+}
+
+// DumpWAL writes the messages of the WAL group whose head is walFile which pass the filter
+// to w as JSON, one per line. An EndHeightMessage is followed by an "ENDHEIGHT <height>"
+// line, which scripts/json2wal skips when it converts the output back into a WAL. It returns
+// the reports of the scanned files, so that the caller can tell whether some records could
+// not be read.
+func DumpWAL(walFile string, filter WALMessageFilter, w io.Writer) ([]WALFileReport, error) {
+	return ScanWAL(walFile, func(msg *TimedWALMessage) error {
+		if !filter.Matches(msg.Msg) {
+			return nil
+		}
+
+		bz, err := tmjson.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("failed to marshal msg: %w", err)
+		}
+		if _, err := fmt.Fprintf(w, "%s\n", bz); err != nil {
+			return err
+		}
+
+		if m, ok := msg.Msg.(EndHeightMessage); ok {
+			if _, err := fmt.Fprintf(w, "ENDHEIGHT %d\n", m.Height); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// countingReader counts the bytes read, to locate the records of a WAL file.
+type countingReader struct {
+	rd io.Reader
+	n  int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.rd.Read(p)
+	r.n += int64(n)
+	return n, err
+}
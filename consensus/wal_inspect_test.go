@@ -0,0 +1,212 @@
+package consensus
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/ostracon/consensus/types"
+	"github.com/line/ostracon/crypto"
+	tmrand "github.com/line/ostracon/libs/rand"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
+	tmtypes "github.com/line/ostracon/types"
+	tmtime "github.com/line/ostracon/types/time"
+)
+
+func writeTestWAL(t *testing.T, corrupted bool) (walFile string, validSize int64) {
+	walFile = filepath.Join(testWALDir(t), "wal")
+	validSize = writeTestWALFile(t, walFile, corrupted)
+	return walFile, validSize
+}
+
+func testWALDir(t *testing.T) string {
+	walDir, err := ioutil.TempDir("", "wal")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(walDir) })
+	return walDir
+}
+
+// writeTestWALFile writes a file of 6 records to the path, followed by a corrupted one if
+// corrupted is set, and returns the size of the valid records.
+func writeTestWALFile(t *testing.T, path string, corrupted bool) (validSize int64) {
+	now := tmtime.Now()
+	vote := &tmtypes.Vote{
+		Type:             tmproto.PrevoteType,
+		Height:           1,
+		Round:            0,
+		Timestamp:        now,
+		ValidatorAddress: tmrand.Bytes(crypto.AddressSize),
+		Signature:        tmrand.Bytes(64),
+	}
+	msgs := []WALMessage{
+		tmtypes.EventDataRoundState{Height: 1, Round: 0, Step: types.RoundStepPropose.String()},
+		msgInfo{Msg: &VoteMessage{Vote: vote}},
+		timeoutInfo{Duration: time.Second, Height: 1, Round: 1, Step: types.RoundStepPropose},
+		EndHeightMessage{Height: 1},
+		tmtypes.EventDataRoundState{Height: 2, Round: 0, Step: types.RoundStepPropose.String()},
+		EndHeightMessage{Height: 2},
+	}
+
+	buf := new(bytes.Buffer)
+	enc := NewWALEncoder(buf)
+	for _, msg := range msgs {
+		require.NoError(t, enc.Encode(&TimedWALMessage{Time: now, Msg: msg}))
+	}
+	validSize = int64(buf.Len())
+	if corrupted {
+		// a record with a wrong checksum
+		buf.Write([]byte{0, 0, 0, 1, 0, 0, 0, 4, 1, 2, 3, 4})
+	}
+	require.NoError(t, ioutil.WriteFile(path, buf.Bytes(), 0600))
+
+	return validSize
+}
+
+func TestScanWAL(t *testing.T) {
+	walFile, validSize := writeTestWAL(t, false)
+
+	var scanned []WALMessage
+	reports, err := ScanWAL(walFile, func(msg *TimedWALMessage) error {
+		scanned = append(scanned, msg.Msg)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.Len(t, scanned, 6)
+	assert.False(t, reports[0].IsCorrupted())
+	assert.Equal(t, walFile, reports[0].Path)
+	assert.Equal(t, validSize, reports[0].Size)
+	assert.Equal(t, 6, reports[0].Records)
+	assert.EqualValues(t, 1, reports[0].MinEndHeight)
+	assert.EqualValues(t, 2, reports[0].MaxEndHeight)
+
+	_, err = ScanWAL(filepath.Join(filepath.Dir(walFile), "none"), nil)
+	assert.Error(t, err)
+}
+
+func TestRepairWAL(t *testing.T) {
+	walFile, validSize := writeTestWAL(t, true)
+
+	reports, err := ScanWAL(walFile, nil)
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.True(t, reports[0].IsCorrupted())
+	assert.Equal(t, validSize, reports[0].CorruptedOffset)
+	assert.Equal(t, 6, reports[0].Records)
+
+	repair, err := RepairWAL(walFile)
+	require.NoError(t, err)
+	require.NotNil(t, repair)
+	assert.Equal(t, validSize, repair.Truncated.CorruptedOffset)
+	assert.Empty(t, repair.MovedAside)
+
+	// the corrupted file is backed up as it was
+	backup, err := os.Stat(walFile + ".CORRUPTED")
+	require.NoError(t, err)
+	assert.Equal(t, validSize+12, backup.Size())
+
+	reports, err = ScanWAL(walFile, nil)
+	require.NoError(t, err)
+	assert.False(t, reports[0].IsCorrupted())
+	assert.Equal(t, validSize, reports[0].Size)
+	assert.Equal(t, 6, reports[0].Records)
+
+	// nothing left to repair
+	repair, err = RepairWAL(walFile)
+	require.NoError(t, err)
+	assert.Nil(t, repair)
+}
+
+func TestRepairWALGroup(t *testing.T) {
+	walFile := filepath.Join(testWALDir(t), "wal")
+	validSize := writeTestWALFile(t, walFile+".000", false)
+	writeTestWALFile(t, walFile+".001", true)
+	writeTestWALFile(t, walFile+".002", true)
+	writeTestWALFile(t, walFile, false)
+
+	reports, err := ScanWAL(walFile, nil)
+	require.NoError(t, err)
+	require.Len(t, reports, 4)
+
+	// the WAL is truncated at the first corruption, and the later files are moved aside
+	repair, err := RepairWAL(walFile)
+	require.NoError(t, err)
+	require.NotNil(t, repair)
+	assert.Equal(t, walFile+".001", repair.Truncated.Path)
+	assert.Equal(t, validSize, repair.Truncated.CorruptedOffset)
+	require.Len(t, repair.MovedAside, 2)
+	assert.Equal(t, walFile+".002", repair.MovedAside[0].Path)
+	assert.Equal(t, walFile, repair.MovedAside[1].Path)
+	backups := map[string]int64{
+		walFile + ".001": validSize + 12,
+		walFile + ".002": validSize + 12,
+		walFile:          validSize,
+	}
+	for path, size := range backups {
+		backup, err := os.Stat(path + ".CORRUPTED")
+		require.NoError(t, err)
+		assert.Equal(t, size, backup.Size(), path)
+	}
+
+	// the records of the WAL end with the last good one, in a new empty head
+	var records int
+	reports, err = ScanWAL(walFile, func(*TimedWALMessage) error {
+		records++
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, reports, 3)
+	for _, report := range reports {
+		assert.False(t, report.IsCorrupted(), report.Path)
+	}
+	assert.Equal(t, walFile, reports[2].Path)
+	assert.Zero(t, reports[2].Size)
+	assert.Equal(t, 12, records)
+}
+
+func TestDumpWAL(t *testing.T) {
+	walFile, _ := writeTestWAL(t, true)
+
+	testCases := []struct {
+		name   string
+		filter WALMessageFilter
+		lines  int
+		types  []string
+	}{
+		{"all", WALMessageFilter{Round: -1}, 8, nil},
+		{"height", WALMessageFilter{Height: 2, Round: -1}, 3, []string{"RoundState", "EndHeightMessage"}},
+		{"round", WALMessageFilter{Height: 1, Round: 1}, 3, []string{"TimeoutInfo", "EndHeightMessage"}},
+		{"type", WALMessageFilter{Round: -1, Types: []string{WALMsgTypeVote}}, 1, []string{"MsgInfo"}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			reports, err := DumpWAL(walFile, tc.filter, buf)
+			require.NoError(t, err)
+			require.Len(t, reports, 1)
+			assert.True(t, reports[0].IsCorrupted())
+
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			assert.Len(t, lines, tc.lines)
+			for _, typ := range tc.types {
+				assert.Contains(t, buf.String(), typ)
+			}
+		})
+	}
+}
+
+func TestWALMessageType(t *testing.T) {
+	assert.Equal(t, WALMsgTypeProposal, WALMessageType(msgInfo{Msg: &ProposalMessage{}}))
+	assert.Equal(t, WALMsgTypeBlockPart, WALMessageType(msgInfo{Msg: &BlockPartMessage{}}))
+	assert.Equal(t, WALMsgTypeMsgInfo, WALMessageType(msgInfo{Msg: &HasVoteMessage{}}))
+	assert.Equal(t, WALMsgTypeEndHeight, WALMessageType(EndHeightMessage{}))
+	assert.Equal(t, "", WALMessageType(nil))
+}
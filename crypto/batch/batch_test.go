@@ -0,0 +1,37 @@
+package batch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/line/ostracon/crypto/bls"
+	"github.com/line/ostracon/crypto/ed25519"
+	"github.com/line/ostracon/crypto/secp256k1"
+	"github.com/line/ostracon/crypto/sr25519"
+)
+
+func TestCreateBatchVerifier(t *testing.T) {
+	v, ok := CreateBatchVerifier(ed25519.GenPrivKey().PubKey())
+	assert.True(t, ok)
+	assert.IsType(t, &ed25519.BatchVerifier{}, v)
+
+	v, ok = CreateBatchVerifier(sr25519.GenPrivKey().PubKey())
+	assert.True(t, ok)
+	assert.IsType(t, &sr25519.BatchVerifier{}, v)
+
+	v, ok = CreateBatchVerifier(secp256k1.GenPrivKey().PubKey())
+	assert.False(t, ok)
+	assert.Nil(t, v)
+
+	v, ok = CreateBatchVerifier(bls.GenPrivKey().PubKey())
+	assert.False(t, ok)
+	assert.Nil(t, v)
+}
+
+func TestSupportsBatchVerifier(t *testing.T) {
+	assert.True(t, SupportsBatchVerifier(ed25519.GenPrivKey().PubKey()))
+	assert.True(t, SupportsBatchVerifier(sr25519.GenPrivKey().PubKey()))
+	assert.False(t, SupportsBatchVerifier(secp256k1.GenPrivKey().PubKey()))
+	assert.False(t, SupportsBatchVerifier(bls.GenPrivKey().PubKey()))
+}
@@ -23,8 +23,15 @@ const (
 	PubKeySize    = 48
 	SignatureSize = 96
 	KeyType       = "bls12-381"
+
+	// ProofOfPossessionSize is the size of the proof-of-possession, which is a signature over the public key.
+	ProofOfPossessionSize = SignatureSize
 )
 
+// popDomain separates proof-of-possession signatures from signatures over any other message, so that a
+// proof-of-possession can't be substituted by a signature which the key owner has made for another purpose.
+var popDomain = []byte("OSTRACON-BLS12381-POP-")
+
 func init() {
 	tmjson.RegisterType(PubKey{}, PubKeyName)
 	tmjson.RegisterType(PrivKey{}, PrivKeyName)
@@ -73,6 +80,34 @@ func AddSignature(init []byte, signature []byte) (aggrSign []byte, err error) {
 	return
 }
 
+// SubSignature takes a BLS signature, which has been added to the aggregate signature, out of it.
+func SubSignature(aggrSign []byte, signature []byte) (rest []byte, err error) {
+	if len(aggrSign) != SignatureSize {
+		err = fmt.Errorf("invalid BLS signature: aggregated signature size %d is not valid size %d",
+			len(aggrSign), SignatureSize)
+		return
+	}
+	if len(signature) != SignatureSize {
+		err = fmt.Errorf("invalid BLS signature: signature size %d is not valid size %d",
+			len(signature), SignatureSize)
+		return
+	}
+	blsSign := bls.Sign{}
+	err = blsSign.Deserialize(signature)
+	if err != nil {
+		return
+	}
+	aggrBLSSign := bls.Sign{}
+	err = aggrBLSSign.Deserialize(aggrSign)
+	if err != nil {
+		return
+	}
+	restBLSSign := bls.Sign{}
+	bls.G2Sub(bls.CastFromSign(&restBLSSign), bls.CastFromSign(&aggrBLSSign), bls.CastFromSign(&blsSign))
+	rest = restBLSSign.Serialize()
+	return
+}
+
 func VerifyAggregatedSignature(aggregatedSignature []byte, pubKeys []PubKey, msgs [][]byte) error {
 	if len(pubKeys) != len(msgs) {
 		return fmt.Errorf("the number of public keys %d doesn't match the one of messages %d",
@@ -140,6 +175,19 @@ func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
 	return sign.Serialize(), nil
 }
 
+// ProofOfPossession generates a proof that the owner of this private key has the corresponding public key. The
+// proof has to be verified before the public key takes part in signature aggregation to prevent rogue-key attacks.
+func (privKey PrivKey) ProofOfPossession() ([]byte, error) {
+	blsKey := bls.SecretKey{}
+	err := blsKey.Deserialize(privKey[:])
+	if err != nil {
+		return nil, err
+	}
+	hash := popHash(blsKey.GetPublicKey().Serialize())
+	sign := blsKey.SignHash(hash[:])
+	return sign.Serialize(), nil
+}
+
 // VRFProve is not supported in BLS12.
 func (privKey PrivKey) VRFProve(seed []byte) (crypto.Proof, error) {
 	return nil, fmt.Errorf("VRF prove is not supported by the BLS12")
@@ -211,6 +259,32 @@ func (pubKey PubKey) VerifySignature(msg []byte, sig []byte) bool {
 	return blsSign.VerifyHash(&blsPubKey, hash[:])
 }
 
+// VerifyProofOfPossession verifies that the proof-of-possession was generated by the owner of this public key.
+func (pubKey PubKey) VerifyProofOfPossession(pop []byte) bool {
+	if len(pop) != ProofOfPossessionSize {
+		return false
+	}
+	blsPubKey := bls.PublicKey{}
+	err := blsPubKey.Deserialize(pubKey[:])
+	if err != nil {
+		return false
+	}
+	blsSign := bls.Sign{}
+	err = blsSign.Deserialize(pop)
+	if err != nil {
+		return false
+	}
+	hash := popHash(pubKey[:])
+	return blsSign.VerifyHash(&blsPubKey, hash[:])
+}
+
+func popHash(pubKey []byte) [sha512.Size256]byte {
+	msg := make([]byte, 0, len(popDomain)+len(pubKey))
+	msg = append(msg, popDomain...)
+	msg = append(msg, pubKey...)
+	return sha512.Sum512_256(msg)
+}
+
 // VRFVerify is not supported in BLS12.
 func (pubKey PubKey) VRFVerify(proof crypto.Proof, seed []byte) (crypto.Output, error) {
 	return nil, fmt.Errorf("VRF verify is not supported by the BLS12")
@@ -238,6 +238,17 @@ func TestAggregatedSignature(t *testing.T) {
 		t.Errorf("fail to verify aggregated signature: %s", err)
 	}
 
+	// the signatures taken out of the aggregated signature leave the aggregate of the rest
+	t.Run("Take Signatures Out", func(t *testing.T) {
+		restSig := aggrSig
+		for _, sig := range sigs[:10] {
+			restSig, err = bls.SubSignature(restSig, sig)
+			require.NoError(t, err)
+		}
+		assert.NoError(t, bls.VerifyAggregatedSignature(restSig, pubKeys[10:], msgs[10:]))
+		assert.Error(t, bls.VerifyAggregatedSignature(restSig, pubKeys, msgs))
+	})
+
 	// validate with the public keys and messages pair in random order
 	t.Run("Doesn't Depend on the Order of PublicKey-Message Pairs", func(t *testing.T) {
 		shuffledPubKeys := make([]bls.PubKey, len(pubKeys))
@@ -371,3 +382,27 @@ func TestSignAndValidateBLS12(t *testing.T) {
 
 	assert.False(t, pubKey.VerifySignature(msg, sig))
 }
+
+func TestProofOfPossession(t *testing.T) {
+	privKey := bls.GenPrivKey()
+	pubKey := privKey.PubKey().(bls.PubKey)
+
+	pop, err := privKey.ProofOfPossession()
+	require.NoError(t, err)
+	require.Len(t, pop, bls.ProofOfPossessionSize)
+	assert.True(t, pubKey.VerifyProofOfPossession(pop))
+
+	// a proof-of-possession of another key
+	otherPop, err := bls.GenPrivKey().ProofOfPossession()
+	require.NoError(t, err)
+	assert.False(t, pubKey.VerifyProofOfPossession(otherPop))
+
+	// a plain signature over the public key is not a proof-of-possession
+	sig, err := privKey.Sign(pubKey.Bytes())
+	require.NoError(t, err)
+	assert.False(t, pubKey.VerifyProofOfPossession(sig))
+
+	// empty or truncated proofs
+	assert.False(t, pubKey.VerifyProofOfPossession(nil))
+	assert.False(t, pubKey.VerifyProofOfPossession(pop[:len(pop)-1]))
+}
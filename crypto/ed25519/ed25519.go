@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"io"
 
-	"golang.org/x/crypto/ed25519"
+	"github.com/oasisprotocol/curve25519-voi/primitives/ed25519"
 
 	"github.com/line/ostracon/crypto"
 	"github.com/line/ostracon/crypto/tmhash"
@@ -16,7 +16,21 @@ import (
 
 //-------------------------------------
 
-var _ crypto.PrivKey = PrivKey{}
+var (
+	_ crypto.PrivKey = PrivKey{}
+
+	// verifyOptions are the verification rules of crypto/ed25519, which single
+	// signatures have always been verified with.
+	verifyOptions = &ed25519.Options{
+		Verify: ed25519.VerifyOptionsStdLib,
+	}
+
+	// zip215VerifyOptions are the ZIP-215 verification rules, which the batches
+	// are verified with. The rules of crypto/ed25519 can't be batch verified.
+	zip215VerifyOptions = &ed25519.Options{
+		Verify: ed25519.VerifyOptionsZIP_215,
+	}
+)
 
 const (
 	PrivKeyName = "tendermint/PrivKeyEd25519"
@@ -61,8 +75,18 @@ func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
 }
 
 // VRFProve generates a VRF Proof for given seed to generate a verifiable random.
+// It uses the build-time default VRF suite.
 func (privKey PrivKey) VRFProve(seed []byte) (crypto.Proof, error) {
-	proof, err := vrf.Prove(privKey[:], seed)
+	return privKey.VRFProveWithSuite("", seed)
+}
+
+// VRFProveWithSuite is VRFProve with the VRF suite of the given name, see vrf.GetSuite.
+func (privKey PrivKey) VRFProveWithSuite(suite string, seed []byte) (crypto.Proof, error) {
+	impl, err := vrf.GetSuite(suite)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := impl.Prove(privKey[:], seed)
 	if err != nil {
 		return nil, err
 	}
@@ -161,7 +185,17 @@ func (pubKey PubKey) VerifySignature(msg []byte, sig []byte) bool {
 		return false
 	}
 
-	return ed25519.Verify(ed25519.PublicKey(pubKey), msg, sig)
+	return ed25519.VerifyWithOptions(ed25519.PublicKey(pubKey), msg, sig, verifyOptions)
+}
+
+// VerifySignatureZIP215 verifies the signature under the ZIP-215 rules, which
+// the batches are verified with, instead of the rules of crypto/ed25519.
+func (pubKey PubKey) VerifySignatureZIP215(msg []byte, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+
+	return ed25519.VerifyWithOptions(ed25519.PublicKey(pubKey), msg, sig, zip215VerifyOptions)
 }
 
 func (pubKey PubKey) String() string {
@@ -173,15 +207,25 @@ func (pubKey PubKey) Type() string {
 }
 
 // VRFVerify verifies that the given VRF Proof was generated from the seed by the owner of this public key.
+// It uses the build-time default VRF suite.
 func (pubKey PubKey) VRFVerify(proof crypto.Proof, seed []byte) (crypto.Output, error) {
-	valid, err := vrf.Verify(pubKey[:], vrf.Proof(proof), seed)
+	return pubKey.VRFVerifyWithSuite("", proof, seed)
+}
+
+// VRFVerifyWithSuite is VRFVerify with the VRF suite of the given name, see vrf.GetSuite.
+func (pubKey PubKey) VRFVerifyWithSuite(suite string, proof crypto.Proof, seed []byte) (crypto.Output, error) {
+	impl, err := vrf.GetSuite(suite)
+	if err != nil {
+		return nil, err
+	}
+	valid, err := impl.Verify(pubKey[:], vrf.Proof(proof), seed)
 	if err != nil {
 		return nil, fmt.Errorf("the specified proof is not a valid ed25519 proof: %v", proof)
 	}
 	if !valid {
 		return nil, fmt.Errorf("the specified Proof is not generated with this pair-key: %v", proof)
 	}
-	output, err := vrf.ProofToHash(vrf.Proof(proof))
+	output, err := impl.ProofToHash(vrf.Proof(proof))
 	if err != nil {
 		return nil, err
 	}
@@ -195,3 +239,71 @@ func (pubKey PubKey) Equals(other crypto.PubKey) bool {
 
 	return false
 }
+
+//-------------------------------------
+
+var _ crypto.BatchVerifier = &BatchVerifier{}
+
+// BatchVerifier implements batch verification for ed25519.
+//
+// The batch is verified under the ZIP-215 rules, which accept a few signatures
+// VerifySignature rejects, so it must only be used where those rules apply. If
+// the batch fails, every signature is verified again with VerifySignatureZIP215.
+type BatchVerifier struct {
+	*ed25519.BatchVerifier
+	entries []batchEntry
+}
+
+type batchEntry struct {
+	pubKey    PubKey
+	msg       []byte
+	signature []byte
+}
+
+func NewBatchVerifier() crypto.BatchVerifier {
+	return &BatchVerifier{BatchVerifier: ed25519.NewBatchVerifier()}
+}
+
+func (b *BatchVerifier) Add(key crypto.PubKey, msg, signature []byte) error {
+	pkEd, ok := key.(PubKey)
+	if !ok {
+		return fmt.Errorf("pubkey is not Ed25519")
+	}
+
+	pkBytes := pkEd.Bytes()
+
+	if l := len(pkBytes); l != PubKeySize {
+		return fmt.Errorf("pubkey size is incorrect; expected: %d, got %d", PubKeySize, l)
+	}
+
+	// check that the signature is the correct length
+	if len(signature) != SignatureSize {
+		return fmt.Errorf("signature size is incorrect; expected: %d, got %d", SignatureSize, len(signature))
+	}
+
+	b.BatchVerifier.AddWithOptions(ed25519.PublicKey(pkBytes), msg, signature, zip215VerifyOptions)
+	b.entries = append(b.entries, batchEntry{pubKey: pkEd, msg: msg, signature: signature})
+
+	return nil
+}
+
+func (b *BatchVerifier) Verify() (bool, []bool) {
+	if len(b.entries) == 0 {
+		return false, nil
+	}
+	if b.BatchVerifier.VerifyBatchOnly(crypto.CReader()) {
+		valid := make([]bool, len(b.entries))
+		for i := range valid {
+			valid[i] = true
+		}
+		return true, valid
+	}
+
+	allValid := true
+	valid := make([]bool, len(b.entries))
+	for i, e := range b.entries {
+		valid[i] = e.pubKey.VerifySignatureZIP215(e.msg, e.signature)
+		allValid = allValid && valid[i]
+	}
+	return allValid, valid
+}
@@ -1,6 +1,7 @@
 package ed25519_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -28,3 +29,81 @@ func TestSignAndValidateEd25519(t *testing.T) {
 
 	assert.False(t, pubKey.VerifySignature(msg, sig))
 }
+
+func TestBatchSafe(t *testing.T) {
+	v := ed25519.NewBatchVerifier()
+
+	for i := 0; i <= 38; i++ {
+		priv := ed25519.GenPrivKey()
+		pub := priv.PubKey()
+
+		var msg []byte
+		if i%2 == 0 {
+			msg = []byte("easter")
+		} else {
+			msg = []byte("egg")
+		}
+
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+
+		err = v.Add(pub, msg, sig)
+		require.NoError(t, err)
+	}
+
+	ok, valid := v.Verify()
+	require.True(t, ok)
+	for _, b := range valid {
+		require.True(t, b)
+	}
+}
+
+func TestBatchPinpointsWrongSignature(t *testing.T) {
+	v := ed25519.NewBatchVerifier()
+
+	msg := []byte("egg")
+	for i := 0; i < 4; i++ {
+		priv := ed25519.GenPrivKey()
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+		if i == 2 {
+			sig[7] ^= byte(0x01)
+		}
+		require.NoError(t, v.Add(priv.PubKey(), msg, sig))
+	}
+
+	ok, valid := v.Verify()
+	require.False(t, ok)
+	require.Equal(t, []bool{true, true, false, true}, valid)
+}
+
+func TestBatchVerificationRules(t *testing.T) {
+	// A is the identity and R a point of order 2, so the signature is only
+	// valid under the cofactored equation of ZIP-215.
+	pubKey := ed25519.PubKey(append([]byte{0x01}, make([]byte, 31)...))
+	r := append([]byte{0xec}, bytes.Repeat([]byte{0xff}, 30)...)
+	sig := append(append(r, 0x7f), make([]byte, 32)...)
+	msg := []byte("egg")
+
+	// the single verification keeps the rules of crypto/ed25519
+	assert.False(t, pubKey.VerifySignature(msg, sig))
+	assert.True(t, pubKey.VerifySignatureZIP215(msg, sig))
+
+	v := ed25519.NewBatchVerifier()
+	require.NoError(t, v.Add(pubKey, msg, sig))
+	ok, valid := v.Verify()
+	assert.True(t, ok)
+	assert.Equal(t, []bool{true}, valid)
+
+	// a failed batch is verified again under the same rules
+	v = ed25519.NewBatchVerifier()
+	require.NoError(t, v.Add(pubKey, msg, sig))
+	priv := ed25519.GenPrivKey()
+	wrongSig, err := priv.Sign(msg)
+	require.NoError(t, err)
+	wrongSig[7] ^= byte(0x01)
+	require.NoError(t, v.Add(priv.PubKey(), msg, wrongSig))
+	ok, valid = v.Verify()
+	assert.False(t, ok)
+	assert.Equal(t, []bool{true, false}, valid)
+}
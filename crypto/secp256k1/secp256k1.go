@@ -39,11 +39,6 @@ func (privKey PrivKey) Bytes() []byte {
 	return []byte(privKey)
 }
 
-// VRFProve is not supported in Secp256k1.
-func (privKey PrivKey) VRFProve(seed []byte) (crypto.Proof, error) {
-	return nil, fmt.Errorf("VRF prove is not supported by the secp256k1")
-}
-
 // PubKey performs the point-scalar multiplication from the privKey on the
 // generator point to get the pubkey.
 func (privKey PrivKey) PubKey() crypto.PubKey {
@@ -166,11 +161,6 @@ func (pubKey PubKey) String() string {
 	return fmt.Sprintf("PubKeySecp256k1{%X}", []byte(pubKey))
 }
 
-// VRFVerify is not supported in Secp256k1.
-func (pubKey PubKey) VRFVerify(proof crypto.Proof, seed []byte) (crypto.Output, error) {
-	return nil, fmt.Errorf("VRF verify is not supported by the secp256k1")
-}
-
 func (pubKey PubKey) Equals(other crypto.PubKey) bool {
 	if otherSecp, ok := other.(PubKey); ok {
 		return bytes.Equal(pubKey[:], otherSecp[:])
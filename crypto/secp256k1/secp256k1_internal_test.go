@@ -72,3 +72,10 @@ func TestSignatureVerificationAndRejectUpperS(t *testing.T) {
 		)
 	}
 }
+
+func TestVRFNonceRFC6979(t *testing.T) {
+	// the well-known secp256k1 RFC 6979 test vector: private key 1 and message "Satoshi Nakamoto"
+	k := vrfNonceRFC6979(big.NewInt(1), []byte("Satoshi Nakamoto"))
+	expected, _ := new(big.Int).SetString("8F8A276C19F4149656B280621E358CCE24F5F52542772691EE69063B74F15D15", 16)
+	require.Equal(t, expected, k)
+}
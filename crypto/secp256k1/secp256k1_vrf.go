@@ -0,0 +1,230 @@
+package secp256k1
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"math/big"
+
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+
+	"github.com/line/ostracon/crypto"
+)
+
+// This file implements ECVRF-SECP256K1-SHA256-TAI, the ECVRF construction of RFC 9381 instantiated with the
+// secp256k1 curve in the same way as the ECVRF-P256-SHA256-TAI suite: SHA-256, try-and-increment encoding to
+// the curve, RFC 6979 nonces and compressed SEC1 point encoding.
+
+const (
+	// vrfSuite is the suite_string of ECVRF-SECP256K1-SHA256-TAI
+	vrfSuite = byte(0xfe)
+
+	vrfPointLen     = PubKeySize
+	vrfChallengeLen = 16
+	vrfScalarLen    = 32
+
+	// VRFProofSize is the size, in bytes, of VRF proofs generated by secp256k1 keys.
+	VRFProofSize = vrfPointLen + vrfChallengeLen + vrfScalarLen
+	// VRFOutputSize is the size, in bytes, of VRF outputs of secp256k1 keys.
+	VRFOutputSize = sha256.Size
+)
+
+var (
+	ErrVRFInvalidPublicKey = errors.New("ECVRF: invalid secp256k1 public key")
+	ErrVRFInvalidProof     = errors.New("ECVRF: invalid secp256k1 proof")
+)
+
+// VRFProve generates an ECVRF-SECP256K1-SHA256-TAI proof for given seed to generate a verifiable random.
+func (privKey PrivKey) VRFProve(seed []byte) (crypto.Proof, error) {
+	if len(privKey) != PrivKeySize {
+		return nil, errors.New("private key size is invalid")
+	}
+	curve := secp256k1.S256()
+	x := new(big.Int).SetBytes(privKey)
+	if x.Sign() == 0 || x.Cmp(curve.N) >= 0 {
+		return nil, errors.New("private key is out of range")
+	}
+	yx, yy := curve.ScalarBaseMult(privKey)
+	pk := vrfPointToString(yx, yy)
+
+	hx, hy, err := vrfEncodeToCurve(pk, seed)
+	if err != nil {
+		return nil, err
+	}
+	hString := vrfPointToString(hx, hy)
+	gx, gy := curve.ScalarMult(hx, hy, privKey)
+
+	k := vrfNonceRFC6979(x, hString)
+	kBytes := vrfIntToString(k, vrfScalarLen)
+	ux, uy := curve.ScalarBaseMult(kBytes)
+	vx, vy := curve.ScalarMult(hx, hy, kBytes)
+
+	c := vrfChallenge(pk, hString, vrfPointToString(gx, gy), vrfPointToString(ux, uy), vrfPointToString(vx, vy))
+
+	// s = (k + c*x) mod q
+	s := new(big.Int).Mul(new(big.Int).SetBytes(c), x)
+	s.Add(s, k)
+	s.Mod(s, curve.N)
+
+	pi := make([]byte, 0, VRFProofSize)
+	pi = append(pi, vrfPointToString(gx, gy)...)
+	pi = append(pi, c...)
+	pi = append(pi, vrfIntToString(s, vrfScalarLen)...)
+	return pi, nil
+}
+
+// VRFVerify verifies that the given VRF Proof was generated from the seed by the owner of this public key.
+func (pubKey PubKey) VRFVerify(proof crypto.Proof, seed []byte) (crypto.Output, error) {
+	curve := secp256k1.S256()
+	yx, yy, err := vrfStringToPoint(pubKey)
+	if err != nil {
+		return nil, ErrVRFInvalidPublicKey
+	}
+	gx, gy, c, s, err := vrfDecodeProof(proof)
+	if err != nil {
+		return nil, err
+	}
+	hx, hy, err := vrfEncodeToCurve(pubKey, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	negC := vrfIntToString(new(big.Int).Sub(curve.N, c), vrfScalarLen)
+	sBytes := vrfIntToString(s, vrfScalarLen)
+
+	// U = s*B - c*Y
+	sbx, sby := curve.ScalarBaseMult(sBytes)
+	cyx, cyy := curve.ScalarMult(yx, yy, negC)
+	ux, uy := curve.Add(sbx, sby, cyx, cyy)
+	// V = s*H - c*Gamma
+	shx, shy := curve.ScalarMult(hx, hy, sBytes)
+	cgx, cgy := curve.ScalarMult(gx, gy, negC)
+	vx, vy := curve.Add(shx, shy, cgx, cgy)
+
+	c2 := vrfChallenge(pubKey, vrfPointToString(hx, hy), proof[:vrfPointLen],
+		vrfPointToString(ux, uy), vrfPointToString(vx, vy))
+	if subtle.ConstantTimeCompare(proof[vrfPointLen:vrfPointLen+vrfChallengeLen], c2) != 1 {
+		return nil, errors.New("the specified Proof is not generated with this pair-key")
+	}
+	return VRFProofToHash(proof)
+}
+
+// VRFProofToHash returns the VRF output of the given proof without verifying it.
+func VRFProofToHash(proof crypto.Proof) (crypto.Output, error) {
+	gx, gy, _, _, err := vrfDecodeProof(proof)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.New()
+	hash.Write([]byte{vrfSuite, 0x03})
+	hash.Write(vrfPointToString(gx, gy))
+	hash.Write([]byte{0x00})
+	return hash.Sum(nil), nil
+}
+
+// vrfEncodeToCurve implements ECVRF_encode_to_curve_try_and_increment.
+func vrfEncodeToCurve(pk []byte, alpha []byte) (x, y *big.Int, err error) {
+	hash := sha256.New()
+	for ctr := 0; ctr < 256; ctr++ {
+		hash.Reset()
+		hash.Write([]byte{vrfSuite, 0x01})
+		hash.Write(pk)
+		hash.Write(alpha)
+		hash.Write([]byte{byte(ctr), 0x00})
+		if x, y, err = vrfStringToPoint(append([]byte{0x02}, hash.Sum(nil)...)); err == nil {
+			return x, y, nil
+		}
+	}
+	return nil, nil, errors.New("ECVRF: try-and-increment could not find a valid point")
+}
+
+// vrfChallenge implements ECVRF_challenge_generation.
+func vrfChallenge(points ...[]byte) []byte {
+	hash := sha256.New()
+	hash.Write([]byte{vrfSuite, 0x02})
+	for _, p := range points {
+		hash.Write(p)
+	}
+	hash.Write([]byte{0x00})
+	return hash.Sum(nil)[:vrfChallengeLen]
+}
+
+// vrfNonceRFC6979 generates the nonce deterministically from the secret scalar and h_string as specified in
+// Section 3.2 of RFC 6979.
+func vrfNonceRFC6979(x *big.Int, hString []byte) *big.Int {
+	q := secp256k1.S256().N
+	h1 := sha256.Sum256(hString)
+	h := new(big.Int).SetBytes(h1[:])
+	h.Mod(h, q)
+
+	bx := append(vrfIntToString(x, vrfScalarLen), vrfIntToString(h, vrfScalarLen)...)
+	v := make([]byte, sha256.Size)
+	k := make([]byte, sha256.Size)
+	for i := range v {
+		v[i] = 0x01
+	}
+	mac := func(key []byte, data ...[]byte) []byte {
+		m := hmac.New(sha256.New, key)
+		for _, d := range data {
+			m.Write(d)
+		}
+		return m.Sum(nil)
+	}
+	k = mac(k, v, []byte{0x00}, bx)
+	v = mac(k, v)
+	k = mac(k, v, []byte{0x01}, bx)
+	v = mac(k, v)
+	for {
+		v = mac(k, v)
+		nonce := new(big.Int).SetBytes(v)
+		if nonce.Sign() > 0 && nonce.Cmp(q) < 0 {
+			return nonce
+		}
+		k = mac(k, v, []byte{0x00})
+		v = mac(k, v)
+	}
+}
+
+// vrfDecodeProof decodes pi into Gamma, c and s.
+func vrfDecodeProof(proof crypto.Proof) (gx, gy, c, s *big.Int, err error) {
+	if len(proof) != VRFProofSize {
+		return nil, nil, nil, nil, ErrVRFInvalidProof
+	}
+	gx, gy, err = vrfStringToPoint(proof[:vrfPointLen])
+	if err != nil {
+		return nil, nil, nil, nil, ErrVRFInvalidProof
+	}
+	c = new(big.Int).SetBytes(proof[vrfPointLen : vrfPointLen+vrfChallengeLen])
+	s = new(big.Int).SetBytes(proof[vrfPointLen+vrfChallengeLen:])
+	if s.Cmp(secp256k1.S256().N) >= 0 {
+		return nil, nil, nil, nil, ErrVRFInvalidProof
+	}
+	return gx, gy, c, s, nil
+}
+
+// vrfStringToPoint decodes a point in the compressed SEC1 format.
+func vrfStringToPoint(s []byte) (x, y *big.Int, err error) {
+	if !secp256k1.IsCompressedPubKey(s) {
+		return nil, nil, errors.New("ECVRF: not a compressed point")
+	}
+	if new(big.Int).SetBytes(s[1:]).Cmp(secp256k1.S256().P) >= 0 {
+		return nil, nil, errors.New("ECVRF: non-canonical point encoding")
+	}
+	pk, err := secp256k1.ParsePubKey(s, secp256k1.S256())
+	if err != nil {
+		return nil, nil, err
+	}
+	return pk.X, pk.Y, nil
+}
+
+func vrfPointToString(x, y *big.Int) []byte {
+	return (&secp256k1.PublicKey{Curve: secp256k1.S256(), X: x, Y: y}).SerializeCompressed()
+}
+
+func vrfIntToString(i *big.Int, size int) []byte {
+	bz := make([]byte, size)
+	b := i.Bytes()
+	copy(bz[size-len(b):], b)
+	return bz
+}
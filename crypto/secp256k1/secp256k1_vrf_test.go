@@ -0,0 +1,66 @@
+package secp256k1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/ostracon/crypto"
+	"github.com/line/ostracon/crypto/secp256k1"
+)
+
+func TestVRFProveAndVerifySecp256k1(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	pubKey := privKey.PubKey()
+	message := []byte("hello, world")
+
+	proof, err := privKey.VRFProve(message)
+	require.NoError(t, err)
+	require.Len(t, proof, secp256k1.VRFProofSize)
+
+	// proofs are deterministic
+	proof2, err := privKey.VRFProve(message)
+	require.NoError(t, err)
+	require.Equal(t, proof, proof2)
+
+	output, err := pubKey.VRFVerify(proof, message)
+	require.NoError(t, err)
+	require.Len(t, output, secp256k1.VRFOutputSize)
+
+	hash, err := secp256k1.VRFProofToHash(proof)
+	require.NoError(t, err)
+	require.Equal(t, output, hash)
+
+	// another message
+	_, err = pubKey.VRFVerify(proof, []byte("hello, world!"))
+	require.Error(t, err)
+
+	// another key
+	_, err = secp256k1.GenPrivKey().PubKey().VRFVerify(proof, message)
+	require.Error(t, err)
+
+	// tampered proofs
+	for _, i := range []int{1, secp256k1.PubKeySize, secp256k1.VRFProofSize - 1} {
+		tampered := append(crypto.Proof{}, proof...)
+		tampered[i] ^= 0x01
+		_, err = pubKey.VRFVerify(tampered, message)
+		require.Error(t, err)
+	}
+	_, err = pubKey.VRFVerify(proof[:len(proof)-1], message)
+	require.Equal(t, secp256k1.ErrVRFInvalidProof, err)
+	_, err = secp256k1.VRFProofToHash(proof[:len(proof)-1])
+	require.Equal(t, secp256k1.ErrVRFInvalidProof, err)
+}
+
+func TestVRFOutputDiffersBySeedSecp256k1(t *testing.T) {
+	privKey := secp256k1.GenPrivKeySecp256k1([]byte("secret"))
+	outputs := map[string]bool{}
+	for _, seed := range []string{"", "a", "b", "ab"} {
+		proof, err := privKey.VRFProve([]byte(seed))
+		require.NoError(t, err)
+		output, err := privKey.PubKey().VRFVerify(proof, []byte(seed))
+		require.NoError(t, err)
+		require.False(t, outputs[string(output)])
+		outputs[string(output)] = true
+	}
+}
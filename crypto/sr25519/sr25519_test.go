@@ -29,3 +29,50 @@ func TestSignAndValidateSr25519(t *testing.T) {
 
 	assert.False(t, pubKey.VerifySignature(msg, sig))
 }
+
+func TestBatchSafe(t *testing.T) {
+	v := sr25519.NewBatchVerifier()
+
+	for i := 0; i <= 38; i++ {
+		priv := sr25519.GenPrivKey()
+		pub := priv.PubKey()
+
+		var msg []byte
+		if i%2 == 0 {
+			msg = []byte("easter")
+		} else {
+			msg = []byte("egg")
+		}
+
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+
+		err = v.Add(pub, msg, sig)
+		require.NoError(t, err)
+	}
+
+	ok, valid := v.Verify()
+	require.True(t, ok)
+	for _, b := range valid {
+		require.True(t, b)
+	}
+}
+
+func TestBatchPinpointsWrongSignature(t *testing.T) {
+	v := sr25519.NewBatchVerifier()
+
+	msg := []byte("egg")
+	for i := 0; i < 4; i++ {
+		priv := sr25519.GenPrivKey()
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+		if i == 2 {
+			sig[7] ^= byte(0x01)
+		}
+		require.NoError(t, v.Add(priv.PubKey(), msg, sig))
+	}
+
+	ok, valid := v.Verify()
+	require.False(t, ok)
+	require.Equal(t, []bool{true, true, false, true}, valid)
+}
@@ -1,30 +1,111 @@
 package vrf
 
 import (
+	"fmt"
 	"math/big"
+	"sort"
+)
+
+// Names of the registered VRF suites, one of which a chain pins in the
+// Validator.VrfSuite of its consensus params.
+const (
+	// SuiteR2ishiguro is the pre-standard ECVRF implementation of github.com/r2ishiguro/vrf.
+	// It is the default suite unless ostracon is built with the libsodium build tag.
+	SuiteR2ishiguro = "r2ishiguro"
+	// SuiteLibsodium is the draft-03 ECVRF implementation of Algorand's libsodium fork.
+	// It is only available if ostracon is built with `make build LIBSODIUM=1`.
+	SuiteLibsodium = "libsodium"
+	// SuiteEdwards25519SHA512TAI is ECVRF-EDWARDS25519-SHA512-TAI of RFC 9381.
+	SuiteEdwards25519SHA512TAI = "ECVRF-EDWARDS25519-SHA512-TAI"
+	// SuiteEdwards25519SHA512ELL2 is ECVRF-EDWARDS25519-SHA512-ELL2 of RFC 9381.
+	SuiteEdwards25519SHA512ELL2 = "ECVRF-EDWARDS25519-SHA512-ELL2"
 )
 
 // defaultVrf is assigned to vrfEd25519r2ishiguro by init() of vrf_r2ishguro.go
 // If you want to use libsodium for vrf implementation, then you should put build option like this
 // `make build LIBSODIUM=1`
 // Please refer https://github.com/line/ostracon/pull/41 for more detail
-var defaultVrf vrfEd25519
+// The other suites are only used through GetSuite(), e.g. for the one pinned in the consensus params.
+var (
+	defaultVrf   Suite
+	defaultSuite string
+
+	suites = map[string]Suite{}
+)
 
 type Proof []byte
 type Output []byte
 
-type vrfEd25519 interface {
+// Suite is an ed25519 VRF implementation.
+type Suite interface {
 	Prove(privateKey []byte, message []byte) (Proof, error)
 	Verify(publicKey []byte, proof Proof, message []byte) (bool, error)
 	ProofToHash(proof Proof) (Output, error)
 }
 
+func init() {
+	register(SuiteEdwards25519SHA512TAI, newVrfEd25519RFC9381(suiteEdwards25519SHA512TAI))
+	register(SuiteEdwards25519SHA512ELL2, newVrfEd25519RFC9381(suiteEdwards25519SHA512ELL2))
+}
+
+// register makes the VRF implementation available under the given suite name.
+// It must only be called from init().
+func register(name string, impl Suite) {
+	if _, ok := suites[name]; ok {
+		panic(fmt.Sprintf("vrf suite %q is already registered", name))
+	}
+	suites[name] = impl
+}
+
+// registerDefault registers the VRF implementation and selects it as the build-time default.
+func registerDefault(name string, impl Suite) {
+	register(name, impl)
+	defaultVrf = impl
+	defaultSuite = name
+}
+
+// GetSuite returns the VRF suite registered with the given name, or the
+// build-time default suite if the name is empty.
+func GetSuite(name string) (Suite, error) {
+	if name == "" {
+		return defaultVrf, nil
+	}
+	impl, ok := suites[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown vrf suite %q", name)
+	}
+	return impl, nil
+}
+
+// IsSupported returns true if a VRF suite is registered with the given name.
+func IsSupported(name string) bool {
+	_, ok := suites[name]
+	return ok
+}
+
+// DefaultSuite returns the name of the build-time default VRF suite.
+func DefaultSuite() string {
+	return defaultSuite
+}
+
+// Suites returns the names of all registered VRF suites in sorted order.
+func Suites() []string {
+	names := make([]string, 0, len(suites))
+	for name := range suites {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 func (op Output) ToInt() *big.Int {
 	i := big.Int{}
 	i.SetBytes(op)
 	return &i
 }
 
+// Prove, Verify and ProofToHash use the build-time default suite.
+
 func Prove(privateKey []byte, message []byte) (Proof, error) {
 	return defaultVrf.Prove(privateKey, message)
 }
@@ -14,12 +14,12 @@ import (
 type vrfImplLibsodium struct {
 }
 
-func newVrfEd25519ImplLibsodium() vrfEd25519 {
+func newVrfEd25519ImplLibsodium() Suite {
 	return vrfImplLibsodium{}
 }
 
 func init() {
-	defaultVrf = newVrfEd25519ImplLibsodium()
+	registerDefault(SuiteLibsodium, newVrfEd25519ImplLibsodium())
 }
 
 func (base vrfImplLibsodium) Prove(privateKey []byte, message []byte) (Proof, error) {
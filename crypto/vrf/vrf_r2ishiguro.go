@@ -12,7 +12,7 @@ type vrfEd25519r2ishiguro struct {
 }
 
 func init() {
-	defaultVrf = newVrfEd25519r2ishiguro()
+	registerDefault(SuiteR2ishiguro, newVrfEd25519r2ishiguro())
 }
 
 func newVrfEd25519r2ishiguro() vrfEd25519r2ishiguro {
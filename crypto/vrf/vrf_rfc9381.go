@@ -0,0 +1,389 @@
+package vrf
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"crypto/subtle"
+	"errors"
+	"math/big"
+
+	r2ishiguro "github.com/r2ishiguro/vrf/go/vrf_ed25519"
+	"github.com/r2ishiguro/vrf/go/vrf_ed25519/edwards25519"
+)
+
+// This file implements ECVRF-EDWARDS25519-SHA512-TAI and ECVRF-EDWARDS25519-SHA512-ELL2
+// as specified in RFC 9381 (https://www.rfc-editor.org/rfc/rfc9381) in pure Go.
+// Private keys are ed25519 private keys in the golang format (seed || public key).
+
+const (
+	suiteEdwards25519SHA512TAI  = byte(0x03)
+	suiteEdwards25519SHA512ELL2 = byte(0x04)
+
+	// ptLen, cLen and qLen of the RFC 9381 edwards25519 suites
+	rfc9381PointLen     = 32
+	rfc9381ChallengeLen = 16
+	rfc9381ScalarLen    = 32
+
+	// ProofSizeRFC9381 is the size, in bytes, of proofs generated by the RFC 9381 suites.
+	ProofSizeRFC9381 = rfc9381PointLen + rfc9381ChallengeLen + rfc9381ScalarLen
+	// OutputSizeRFC9381 is the size, in bytes, of outputs of the RFC 9381 suites.
+	OutputSizeRFC9381 = sha512.Size
+
+	// h2c_suite_ID_string of ECVRF-EDWARDS25519-SHA512-ELL2
+	rfc9381H2CSuiteID = "edwards25519_XMD:SHA-512_ELL2_NU_"
+)
+
+var (
+	ErrRFC9381InvalidPublicKey = errors.New("ECVRF: invalid public key")
+	ErrRFC9381InvalidProof     = errors.New("ECVRF: invalid proof")
+
+	// the prime of GF(2^255-19) and the order of the edwards25519 prime-order subgroup
+	rfc9381P, _ = new(big.Int).SetString("7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffed", 16)
+	rfc9381Q, _ = new(big.Int).SetString("1000000000000000000000000000000014def9dea2f79cd65812631a5cf5d3ed", 16)
+
+	// constants of the elligator2 map to curve25519 and the rational map to edwards25519
+	rfc9381J           = big.NewInt(486662)
+	rfc9381SqrtM486664 = rfc9381ModSqrt(new(big.Int).Sub(rfc9381P, big.NewInt(486664)), 0)
+)
+
+type vrfEd25519RFC9381 struct {
+	suite byte
+}
+
+func newVrfEd25519RFC9381(suite byte) vrfEd25519RFC9381 {
+	return vrfEd25519RFC9381{suite}
+}
+
+func (base vrfEd25519RFC9381) Prove(privateKey []byte, message []byte) (Proof, error) {
+	if len(privateKey) != 64 {
+		return nil, errors.New("private key size is invalid")
+	}
+	digest := sha512.Sum512(privateKey[:32])
+	var x [32]byte
+	copy(x[:], digest[:32])
+	x[0] &= 248
+	x[31] &= 127
+	x[31] |= 64
+
+	var y edwards25519.ExtendedGroupElement
+	edwards25519.GeScalarMultBase(&y, &x)
+	var pk [32]byte
+	y.ToBytes(&pk)
+
+	h, err := base.encodeToCurve(pk[:], message)
+	if err != nil {
+		return nil, err
+	}
+	hString := rfc9381PointToString(h)
+	gamma := r2ishiguro.GeScalarMult(h, &x)
+
+	// nonce generation of RFC 8032
+	hash := sha512.New()
+	hash.Write(digest[32:])
+	hash.Write(hString)
+	var kDigest [64]byte
+	copy(kDigest[:], hash.Sum(nil))
+	var k [32]byte
+	edwards25519.ScReduce(&k, &kDigest)
+
+	var kB edwards25519.ExtendedGroupElement
+	edwards25519.GeScalarMultBase(&kB, &k)
+	kH := r2ishiguro.GeScalarMult(h, &k)
+
+	c := base.challenge(&y, h, gamma, rfc9381PointToString(&kB), kH)
+
+	// s = (k + c*x) mod q
+	var cScalar [32]byte
+	copy(cScalar[:], c)
+	var s [32]byte
+	edwards25519.ScMulAdd(&s, &cScalar, rfc9381ReduceScalar(&x), &k)
+
+	pi := make([]byte, 0, ProofSizeRFC9381)
+	pi = append(pi, rfc9381PointToString(gamma)...)
+	pi = append(pi, c...)
+	pi = append(pi, s[:]...)
+	return pi, nil
+}
+
+func (base vrfEd25519RFC9381) Verify(publicKey []byte, proof Proof, message []byte) (bool, error) {
+	y, err := rfc9381StringToPoint(publicKey)
+	if err != nil {
+		return false, ErrRFC9381InvalidPublicKey
+	}
+	// validate_key: reject public keys of small order
+	if rfc9381IsIdentity(rfc9381ClearCofactor(y)) {
+		return false, ErrRFC9381InvalidPublicKey
+	}
+	gamma, c, s, err := rfc9381DecodeProof(proof)
+	if err != nil {
+		return false, err
+	}
+	h, err := base.encodeToCurve(publicKey, message)
+	if err != nil {
+		return false, err
+	}
+
+	// U = s*B - c*Y
+	negC := rfc9381NegateScalar(c)
+	var u edwards25519.ProjectiveGroupElement
+	edwards25519.GeDoubleScalarMultVartime(&u, negC, y, s)
+	var uString [32]byte
+	u.ToBytes(&uString)
+
+	// V = s*H - c*Gamma
+	v := r2ishiguro.GeAdd(r2ishiguro.GeScalarMult(h, s), r2ishiguro.GeScalarMult(gamma, negC))
+
+	c2 := base.challenge(y, h, gamma, uString[:], v)
+	return subtle.ConstantTimeCompare(c[:rfc9381ChallengeLen], c2) == 1, nil
+}
+
+func (base vrfEd25519RFC9381) ProofToHash(proof Proof) (Output, error) {
+	gamma, _, _, err := rfc9381DecodeProof(proof)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha512.New()
+	hash.Write([]byte{base.suite, 0x03})
+	hash.Write(rfc9381PointToString(rfc9381ClearCofactor(gamma)))
+	hash.Write([]byte{0x00})
+	return hash.Sum(nil), nil
+}
+
+// challenge implements ECVRF_challenge_generation and returns c as a cLen-byte little-endian string.
+func (base vrfEd25519RFC9381) challenge(y, h, gamma *edwards25519.ExtendedGroupElement, u []byte,
+	v *edwards25519.ExtendedGroupElement) []byte {
+	hash := sha512.New()
+	hash.Write([]byte{base.suite, 0x02})
+	hash.Write(rfc9381PointToString(y))
+	hash.Write(rfc9381PointToString(h))
+	hash.Write(rfc9381PointToString(gamma))
+	hash.Write(u)
+	hash.Write(rfc9381PointToString(v))
+	hash.Write([]byte{0x00})
+	return hash.Sum(nil)[:rfc9381ChallengeLen]
+}
+
+func (base vrfEd25519RFC9381) encodeToCurve(publicKey []byte, message []byte) (*edwards25519.ExtendedGroupElement,
+	error) {
+	switch base.suite {
+	case suiteEdwards25519SHA512TAI:
+		return base.encodeToCurveTAI(publicKey, message)
+	case suiteEdwards25519SHA512ELL2:
+		return base.encodeToCurveELL2(publicKey, message)
+	}
+	return nil, errors.New("ECVRF: unknown suite")
+}
+
+// encodeToCurveTAI implements ECVRF_encode_to_curve_try_and_increment.
+func (base vrfEd25519RFC9381) encodeToCurveTAI(publicKey []byte, message []byte) (
+	*edwards25519.ExtendedGroupElement, error) {
+	hash := sha512.New()
+	for ctr := 0; ctr < 256; ctr++ {
+		hash.Reset()
+		hash.Write([]byte{base.suite, 0x01})
+		hash.Write(publicKey)
+		hash.Write(message)
+		hash.Write([]byte{byte(ctr), 0x00})
+		if h, err := rfc9381StringToPoint(hash.Sum(nil)[:rfc9381PointLen]); err == nil {
+			return rfc9381ClearCofactor(h), nil
+		}
+	}
+	return nil, errors.New("ECVRF: try-and-increment could not find a valid point")
+}
+
+// encodeToCurveELL2 implements the edwards25519_XMD:SHA-512_ELL2_NU_ encoding of RFC 9380
+// with the encode_to_curve_salt (the public key) prepended to the message.
+func (base vrfEd25519RFC9381) encodeToCurveELL2(publicKey []byte, message []byte) (
+	*edwards25519.ExtendedGroupElement, error) {
+	dst := append([]byte("ECVRF_"+rfc9381H2CSuiteID), base.suite)
+	msg := make([]byte, 0, len(publicKey)+len(message))
+	msg = append(msg, publicKey...)
+	msg = append(msg, message...)
+
+	// hash_to_field with count=1, L=48
+	u := new(big.Int).SetBytes(rfc9381ExpandMessageXMD(msg, dst, 48))
+	u.Mod(u, rfc9381P)
+
+	s, t := rfc9381Elligator2Curve25519(u)
+
+	// rational map from curve25519 to edwards25519; (0, 1) for the exceptional cases
+	p := rfc9381P
+	one := big.NewInt(1)
+	sPlusOne := new(big.Int).Add(s, one)
+	sPlusOne.Mod(sPlusOne, p)
+	v, w := new(big.Int), new(big.Int).Set(one)
+	if t.Sign() != 0 && sPlusOne.Sign() != 0 {
+		v.Mul(rfc9381SqrtM486664, s)
+		v.Mul(v, new(big.Int).ModInverse(t, p))
+		v.Mod(v, p)
+		w.Sub(s, one)
+		w.Mul(w, new(big.Int).ModInverse(sPlusOne, p))
+		w.Mod(w, p)
+	}
+
+	var enc [32]byte
+	wBytes := w.Bytes()
+	for i, b := range wBytes {
+		enc[len(wBytes)-1-i] = b
+	}
+	enc[31] |= byte(v.Bit(0) << 7)
+	q, err := rfc9381StringToPoint(enc[:])
+	if err != nil {
+		return nil, err
+	}
+	return rfc9381ClearCofactor(q), nil
+}
+
+// rfc9381Elligator2Curve25519 implements map_to_curve_elligator2 of RFC 9380 for curve25519 (J=486662, K=1, Z=2).
+func rfc9381Elligator2Curve25519(u *big.Int) (s, t *big.Int) {
+	p := rfc9381P
+	one := big.NewInt(1)
+	negJ := new(big.Int).Sub(p, rfc9381J)
+
+	// x1 = -J * inv0(1 + Z * u^2)
+	tv := new(big.Int).Mul(u, u)
+	tv.Lsh(tv, 1)
+	tv.Add(tv, one)
+	tv.Mod(tv, p)
+	x1 := new(big.Int)
+	if tv.Sign() != 0 {
+		x1.Mul(negJ, new(big.Int).ModInverse(tv, p))
+		x1.Mod(x1, p)
+	}
+	if x1.Sign() == 0 {
+		x1.Set(negJ)
+	}
+	// x2 = -x1 - J
+	x2 := new(big.Int).Sub(negJ, x1)
+	x2.Mod(x2, p)
+
+	if gx1 := rfc9381MontgomeryRHS(x1); big.Jacobi(gx1, p) >= 0 {
+		return x1, rfc9381ModSqrt(gx1, 1)
+	}
+	return x2, rfc9381ModSqrt(rfc9381MontgomeryRHS(x2), 0)
+}
+
+// rfc9381MontgomeryRHS returns x^3 + J*x^2 + x mod p.
+func rfc9381MontgomeryRHS(x *big.Int) *big.Int {
+	gx := new(big.Int).Add(x, rfc9381J)
+	gx.Mul(gx, x)
+	gx.Add(gx, big.NewInt(1))
+	gx.Mul(gx, x)
+	return gx.Mod(gx, rfc9381P)
+}
+
+// rfc9381ModSqrt returns the square root of a square a in GF(2^255-19) whose sgn0 equals to sign.
+func rfc9381ModSqrt(a *big.Int, sign uint) *big.Int {
+	r := new(big.Int).ModSqrt(a, rfc9381P)
+	if r.Bit(0) != sign {
+		r.Sub(rfc9381P, r)
+		r.Mod(r, rfc9381P)
+	}
+	return r
+}
+
+// rfc9381ExpandMessageXMD implements expand_message_xmd of RFC 9380 with SHA-512.
+func rfc9381ExpandMessageXMD(msg, dst []byte, length int) []byte {
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+	ell := (length + sha512.Size - 1) / sha512.Size
+
+	hash := sha512.New()
+	hash.Write(make([]byte, sha512.BlockSize))
+	hash.Write(msg)
+	hash.Write([]byte{byte(length >> 8), byte(length), 0x00})
+	hash.Write(dstPrime)
+	b0 := hash.Sum(nil)
+
+	out := make([]byte, 0, ell*sha512.Size)
+	bi := make([]byte, sha512.Size)
+	for i := 1; i <= ell; i++ {
+		for j := range bi {
+			bi[j] ^= b0[j]
+		}
+		hash.Reset()
+		hash.Write(bi)
+		hash.Write([]byte{byte(i)})
+		hash.Write(dstPrime)
+		bi = hash.Sum(nil)
+		out = append(out, bi...)
+	}
+	return out[:length]
+}
+
+// rfc9381DecodeProof decodes pi into Gamma, c and s. c and s are returned as 32-byte little-endian scalars.
+func rfc9381DecodeProof(proof Proof) (gamma *edwards25519.ExtendedGroupElement, c, s *[32]byte, err error) {
+	if len(proof) != ProofSizeRFC9381 {
+		return nil, nil, nil, ErrRFC9381InvalidProof
+	}
+	gamma, err = rfc9381StringToPoint(proof[:rfc9381PointLen])
+	if err != nil {
+		return nil, nil, nil, ErrRFC9381InvalidProof
+	}
+	c, s = new([32]byte), new([32]byte)
+	copy(c[:], proof[rfc9381PointLen:rfc9381PointLen+rfc9381ChallengeLen])
+	copy(s[:], proof[rfc9381PointLen+rfc9381ChallengeLen:])
+	if rfc9381ScalarToInt(s).Cmp(rfc9381Q) >= 0 {
+		return nil, nil, nil, ErrRFC9381InvalidProof
+	}
+	return gamma, c, s, nil
+}
+
+// rfc9381StringToPoint decodes a point as specified in Section 5.1.3 of RFC 8032. Unlike FromBytes, it rejects
+// non-canonical encodings.
+func rfc9381StringToPoint(s []byte) (*edwards25519.ExtendedGroupElement, error) {
+	if len(s) != rfc9381PointLen {
+		return nil, errors.New("ECVRF: invalid point length")
+	}
+	var enc [32]byte
+	copy(enc[:], s)
+	p := new(edwards25519.ExtendedGroupElement)
+	if !p.FromBytes(&enc) {
+		return nil, errors.New("ECVRF: not a point on the curve")
+	}
+	if !bytes.Equal(rfc9381PointToString(p), s) {
+		return nil, errors.New("ECVRF: non-canonical point encoding")
+	}
+	return p, nil
+}
+
+func rfc9381PointToString(p *edwards25519.ExtendedGroupElement) []byte {
+	var s [32]byte
+	p.ToBytes(&s)
+	return s[:]
+}
+
+func rfc9381ClearCofactor(p *edwards25519.ExtendedGroupElement) *edwards25519.ExtendedGroupElement {
+	return r2ishiguro.GeDouble(r2ishiguro.GeDouble(r2ishiguro.GeDouble(p)))
+}
+
+func rfc9381IsIdentity(p *edwards25519.ExtendedGroupElement) bool {
+	var identity edwards25519.ExtendedGroupElement
+	identity.Zero()
+	return bytes.Equal(rfc9381PointToString(p), rfc9381PointToString(&identity))
+}
+
+func rfc9381ScalarToInt(s *[32]byte) *big.Int {
+	var be [32]byte
+	for i := range s {
+		be[31-i] = s[i]
+	}
+	return new(big.Int).SetBytes(be[:])
+}
+
+func rfc9381IntToScalar(i *big.Int) *[32]byte {
+	be := i.Bytes()
+	s := new([32]byte)
+	for j := range be {
+		s[j] = be[len(be)-1-j]
+	}
+	return s
+}
+
+func rfc9381ReduceScalar(s *[32]byte) *[32]byte {
+	return rfc9381IntToScalar(new(big.Int).Mod(rfc9381ScalarToInt(s), rfc9381Q))
+}
+
+func rfc9381NegateScalar(s *[32]byte) *[32]byte {
+	i := new(big.Int).Sub(rfc9381Q, rfc9381ScalarToInt(s))
+	return rfc9381IntToScalar(i.Mod(i, rfc9381Q))
+}
@@ -0,0 +1,199 @@
+package vrf
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// test vectors of Appendix B.3 and B.4 of RFC 9381
+var rfc9381TestVectors = []struct {
+	suite byte
+	sk    string
+	pk    string
+	alpha string
+	pi    string
+	beta  string
+}{
+	// Example 16
+	{
+		suiteEdwards25519SHA512TAI,
+		"9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60",
+		"d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+		"",
+		"8657106690b5526245a92b003bb079ccd1a92130477671f6fc01ad16f26f723f26f8a57ccaed74ee1b190bed1f479d9727d2d0f9b005" +
+			"a6e456a35d4fb0daab1268a1b0db10836d9826a528ca76567805",
+		"90cf1df3b703cce59e2a35b925d411164068269d7b2d29f3301c03dd757876ff66b71dda49d2de59d03450451af026798e8f81cd2e33" +
+			"3de5cdf4f3e140fdd8ae",
+	},
+	// Example 17
+	{
+		suiteEdwards25519SHA512TAI,
+		"4ccd089b28ff96da9db6c346ec114e0f5b8a319f35aba624da8cf6ed4fb8a6fb",
+		"3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c",
+		"72",
+		"f3141cd382dc42909d19ec5110469e4feae18300e94f304590abdced48aed5933bf0864a62558b3ed7f2fea45c92a465301b3bbf5e3e" +
+			"54ddf2d935be3b67926da3ef39226bbc355bdc9850112c8f4b02",
+		"eb4440665d3891d668e7e0fcaf587f1b4bd7fbfe99d0eb2211ccec90496310eb5e33821bc613efb94db5e5b54c70a848a0bef4553a41" +
+			"befc57663b56373a5031",
+	},
+	// Example 18
+	{
+		suiteEdwards25519SHA512TAI,
+		"c5aa8df43f9f837bedb7442f31dcb7b166d38535076f094b85ce3a2e0b4458f7",
+		"fc51cd8e6218a1a38da47ed00230f0580816ed13ba3303ac5deb911548908025",
+		"af82",
+		"9bc0f79119cc5604bf02d23b4caede71393cedfbb191434dd016d30177ccbf8096bb474e53895c362d8628ee9f9ea3c0e52c7a5c691b" +
+			"6c18c9979866568add7a2d41b00b05081ed0f58ee5e31b3a970e",
+		"645427e5d00c62a23fb703732fa5d892940935942101e456ecca7bb217c61c452118fec1219202a0edcf038bb6373241578be7217ba8" +
+			"5a2687f7a0310b2df19f",
+	},
+	// Example 19
+	{
+		suiteEdwards25519SHA512ELL2,
+		"9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60",
+		"d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+		"",
+		"7d9c633ffeee27349264cf5c667579fc583b4bda63ab71d001f89c10003ab46f14adf9a3cd8b8412d9038531e865c341cafa73589b02" +
+			"3d14311c331a9ad15ff2fb37831e00f0acaa6d73bc9997b06501",
+		"9d574bf9b8302ec0fc1e21c3ec5368269527b87b462ce36dab2d14ccf80c53cccf6758f058c5b1c856b116388152bbe509ee3b9ecfe6" +
+			"3d93c3b4346c1fbc6c54",
+	},
+	// Example 20
+	{
+		suiteEdwards25519SHA512ELL2,
+		"4ccd089b28ff96da9db6c346ec114e0f5b8a319f35aba624da8cf6ed4fb8a6fb",
+		"3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c",
+		"72",
+		"47b327393ff2dd81336f8a2ef10339112401253b3c714eeda879f12c509072ef055b48372bb82efbdce8e10c8cb9a2f9d60e93908f93" +
+			"df1623ad78a86a028d6bc064dbfc75a6a57379ef855dc6733801",
+		"38561d6b77b71d30eb97a062168ae12b667ce5c28caccdf76bc88e093e4635987cd96814ce55b4689b3dd2947f80e59aac7b7675f808" +
+			"3865b46c89b2ce9cc735",
+	},
+	// Example 21
+	{
+		suiteEdwards25519SHA512ELL2,
+		"c5aa8df43f9f837bedb7442f31dcb7b166d38535076f094b85ce3a2e0b4458f7",
+		"fc51cd8e6218a1a38da47ed00230f0580816ed13ba3303ac5deb911548908025",
+		"af82",
+		"926e895d308f5e328e7aa159c06eddbe56d06846abf5d98c2512235eaa57fdce35b46edfc655bc828d44ad09d1150f31374e7ef73027" +
+			"e14760d42e77341fe05467bb286cc2c9d7fde29120a0b2320d04",
+		"121b7f9b9aaaa29099fc04a94ba52784d44eac976dd1a3cca458733be5cd090a7b5fbd148444f17f8daf1fb55cb04b1ae85a626e30a5" +
+			"4b4b0f8abf4a43314a58",
+	},
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestRFC9381TestVectors(t *testing.T) {
+	for i, tv := range rfc9381TestVectors {
+		impl := newVrfEd25519RFC9381(tv.suite)
+		privateKey := ed25519.NewKeyFromSeed(decodeHex(t, tv.sk))
+		publicKey := decodeHex(t, tv.pk)
+		require.Equal(t, publicKey, []byte(privateKey.Public().(ed25519.PublicKey)), "#%d", i)
+		alpha := decodeHex(t, tv.alpha)
+
+		proof, err := impl.Prove(privateKey, alpha)
+		require.NoError(t, err, "#%d", i)
+		require.Equal(t, tv.pi, enc(proof), "#%d", i)
+
+		valid, err := impl.Verify(publicKey, proof, alpha)
+		require.NoError(t, err, "#%d", i)
+		require.True(t, valid, "#%d", i)
+
+		output, err := impl.ProofToHash(proof)
+		require.NoError(t, err, "#%d", i)
+		require.Equal(t, tv.beta, enc(output), "#%d", i)
+	}
+}
+
+func TestRFC9381VerifyRejectsInvalidInput(t *testing.T) {
+	for _, suite := range []byte{suiteEdwards25519SHA512TAI, suiteEdwards25519SHA512ELL2} {
+		impl := newVrfEd25519RFC9381(suite)
+		secret := [SEEDBYTES]byte{}
+		privateKey := ed25519.NewKeyFromSeed(secret[:])
+		publicKey := privateKey.Public().(ed25519.PublicKey)
+		message := []byte("hello, world")
+
+		proof, err := impl.Prove(privateKey, message)
+		require.NoError(t, err)
+
+		// another message
+		valid, err := impl.Verify(publicKey, proof, []byte("hello, world!"))
+		require.NoError(t, err)
+		require.False(t, valid)
+
+		// tampered proof
+		for _, i := range []int{0, rfc9381PointLen, ProofSizeRFC9381 - 2} {
+			tampered := append(Proof{}, proof...)
+			tampered[i] ^= 0x01
+			valid, _ = impl.Verify(publicKey, tampered, message)
+			require.False(t, valid)
+		}
+
+		// s >= q
+		tampered := append(Proof{}, proof...)
+		for i := rfc9381PointLen + rfc9381ChallengeLen; i < len(tampered); i++ {
+			tampered[i] = 0xff
+		}
+		_, err = impl.Verify(publicKey, tampered, message)
+		require.Equal(t, ErrRFC9381InvalidProof, err)
+		_, err = impl.ProofToHash(tampered)
+		require.Equal(t, ErrRFC9381InvalidProof, err)
+
+		// truncated proof
+		_, err = impl.Verify(publicKey, proof[:len(proof)-1], message)
+		require.Equal(t, ErrRFC9381InvalidProof, err)
+
+		// small order public key (the identity)
+		identity := make([]byte, 32)
+		identity[0] = 0x01
+		_, err = impl.Verify(identity, proof, message)
+		require.Equal(t, ErrRFC9381InvalidPublicKey, err)
+	}
+}
+
+func TestSuiteRegistry(t *testing.T) {
+	require.Contains(t, Suites(), SuiteEdwards25519SHA512TAI)
+	require.Contains(t, Suites(), SuiteEdwards25519SHA512ELL2)
+	require.True(t, IsSupported(DefaultSuite()))
+	require.False(t, IsSupported("unknown"))
+	_, err := GetSuite("unknown")
+	require.Error(t, err)
+
+	// an empty suite name is the build-time default suite
+	impl, err := GetSuite("")
+	require.NoError(t, err)
+	def, err := GetSuite(DefaultSuite())
+	require.NoError(t, err)
+	require.Equal(t, def, impl)
+
+	secret := [SEEDBYTES]byte{}
+	privateKey := ed25519.NewKeyFromSeed(secret[:])
+	publicKey := privateKey.Public().(ed25519.PublicKey)
+	message := []byte("hello, world")
+
+	impl, err = GetSuite(SuiteEdwards25519SHA512ELL2)
+	require.NoError(t, err)
+	proof, err := impl.Prove(privateKey, message)
+	require.NoError(t, err)
+	require.Len(t, proof, ProofSizeRFC9381)
+	valid, err := impl.Verify(publicKey, proof, message)
+	require.NoError(t, err)
+	require.True(t, valid)
+	output, err := impl.ProofToHash(proof)
+	require.NoError(t, err)
+	require.Len(t, output, OutputSizeRFC9381)
+
+	// the proof doesn't verify with another suite
+	impl, err = GetSuite(SuiteEdwards25519SHA512TAI)
+	require.NoError(t, err)
+	valid, _ = impl.Verify(publicKey, proof, message)
+	require.False(t, valid)
+}
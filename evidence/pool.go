@@ -45,6 +45,9 @@ type Pool struct {
 	// before being flushed to the pool. This prevents broadcasting and proposing of
 	// evidence before the height with which the evidence happened is finished.
 	consensusBuffer []duplicateVoteSet
+	// misbehavior of proposers from consensus is buffered in the same way
+	conflictingProposalBuffer  []conflictingProposalSet
+	invalidProposerProofBuffer []invalidProposerProof
 
 	pruningHeight int64
 	pruningTime   time.Time
@@ -67,6 +70,9 @@ func NewPool(evidenceDB dbm.DB, stateDB sm.Store, blockStore BlockStore) (*Pool,
 		evidenceStore:   evidenceDB,
 		evidenceList:    clist.New(),
 		consensusBuffer: make([]duplicateVoteSet, 0),
+
+		conflictingProposalBuffer:  make([]conflictingProposalSet, 0),
+		invalidProposerProofBuffer: make([]invalidProposerProof, 0),
 	}
 
 	// if pending evidence already in db, in event of prior failure, then check for expiration,
@@ -98,8 +104,9 @@ func (evpool *Pool) PendingEvidence(maxBytes int64) ([]types.Evidence, int64) {
 
 // Update takes both the new state and the evidence committed at that height and performs
 // the following operations:
-// 1. Take any conflicting votes from consensus and use the state's LastBlockTime to form
-//    DuplicateVoteEvidence and add it to the pool.
+// 1. Take any conflicting votes and misbehavior of proposers from consensus and use the state's
+//    LastBlockTime to form DuplicateVoteEvidence, ConflictingProposalEvidence and
+//    InvalidProposerProofEvidence and add it to the pool.
 // 2. Update the pool's state which contains evidence params relating to expiry.
 // 3. Moves pending evidence that has now been committed into the committed pool.
 // 4. Removes any expired evidence based on both height and time.
@@ -118,6 +125,8 @@ func (evpool *Pool) Update(state sm.State, ev types.EvidenceList) {
 	// flush conflicting vote pairs from the buffer, producing DuplicateVoteEvidence and
 	// adding it to the pool
 	evpool.processConsensusBuffer(state)
+	// flush misbehavior of proposers from the buffers in the same way
+	evpool.processProposerBuffers(state)
 	// update state
 	evpool.updateState(state)
 
@@ -186,6 +195,39 @@ func (evpool *Pool) ReportConflictingVotes(voteA, voteB *types.Vote) {
 	})
 }
 
+// ReportConflictingProposals takes two conflicting proposals of the same height and round and
+// forms conflicting proposal evidence, adding it eventually to the evidence pool.
+//
+// As with ReportConflictingVotes, the evidence is formed once consensus at that height has
+// been reached and `Update()` with the new state called.
+//
+// Proposals are not verified.
+func (evpool *Pool) ReportConflictingProposals(proposalA, proposalB *types.Proposal) {
+	evpool.mtx.Lock()
+	defer evpool.mtx.Unlock()
+	evpool.conflictingProposalBuffer = append(evpool.conflictingProposalBuffer, conflictingProposalSet{
+		ProposalA: proposalA,
+		ProposalB: proposalB,
+	})
+}
+
+// ReportInvalidProposerProof takes a proposal and the header of the proposed block whose VRF
+// proof is invalid and forms invalid proposer proof evidence, adding it eventually to the
+// evidence pool.
+//
+// As with ReportConflictingVotes, the evidence is formed once consensus at that height has
+// been reached and `Update()` with the new state called.
+//
+// The proposal and the proof are not verified.
+func (evpool *Pool) ReportInvalidProposerProof(proposal *types.Proposal, header *types.Header) {
+	evpool.mtx.Lock()
+	defer evpool.mtx.Unlock()
+	evpool.invalidProposerProofBuffer = append(evpool.invalidProposerProofBuffer, invalidProposerProof{
+		Proposal: proposal,
+		Header:   header,
+	})
+}
+
 // CheckEvidence takes an array of evidence from a block and verifies all the evidence there.
 // If it has already verified the evidence then it jumps to the next one. It ensures that no
 // evidence has already been committed or is being proposed twice. It also adds any
@@ -560,34 +602,150 @@ func (evpool *Pool) processConsensusBuffer(state sm.State) {
 			continue
 		}
 
-		// check if we already have this evidence
-		if evpool.isPending(dve) {
-			evpool.logger.Debug("evidence already pending; ignoring", "evidence", dve)
+		evpool.addEvidenceFromConsensus(dve)
+	}
+	// reset consensus buffer
+	evpool.consensusBuffer = make([]duplicateVoteSet, 0)
+}
+
+type duplicateVoteSet struct {
+	VoteA *types.Vote
+	VoteB *types.Vote
+}
+
+// processProposerBuffers converts all the conflicting proposals and the proposals of blocks with
+// an invalid VRF proof witnessed from consensus into ConflictingProposalEvidence and
+// InvalidProposerProofEvidence. Unlike the votes, the proposals are verified before the evidence
+// is added to the pool, since a proposal doesn't carry the address of its proposer.
+func (evpool *Pool) processProposerBuffers(state sm.State) {
+	evpool.mtx.Lock()
+	defer evpool.mtx.Unlock()
+	for _, proposalSet := range evpool.conflictingProposalBuffer {
+		height := proposalSet.ProposalA.Height
+		blockTime, err := evpool.blockTime(state, height)
+		if err != nil {
+			evpool.logger.Error("failed to load block time for conflicting proposals", "height", height, "err", err)
 			continue
 		}
-
-		// check that the evidence is not already committed on chain
-		if evpool.isCommitted(dve) {
-			evpool.logger.Debug("evidence already committed; ignoring", "evidence", dve)
+		vals, voterSet, proofHash, err := evpool.loadElection(height, state.VoterParams)
+		if err != nil {
+			evpool.logger.Error("failed to load the election for conflicting proposals", "height", height, "err", err)
 			continue
 		}
-
-		if err := evpool.addPendingEvidence(dve); err != nil {
-			evpool.logger.Error("failed to flush evidence from consensus buffer to pending list: %w", err)
+		proposer := vals.SelectProposer(proofHash, height, proposalSet.ProposalA.Round)
+		cpe := types.NewConflictingProposalEvidence(proposalSet.ProposalA, proposalSet.ProposalB,
+			proposer.Address, blockTime, vals, voterSet)
+		if err := cpe.ValidateBasic(); err != nil {
+			evpool.logger.Error("invalid conflicting proposals from consensus", "height", height, "err", err)
+			continue
+		}
+		if err := VerifyConflictingProposals(cpe, state.ChainID, vals, voterSet, proofHash); err != nil {
+			evpool.logger.Error("invalid conflicting proposals from consensus", "height", height, "err", err)
+			continue
+		}
+		evpool.addEvidenceFromConsensus(cpe)
+	}
+	for _, invalidProof := range evpool.invalidProposerProofBuffer {
+		height := invalidProof.Proposal.Height
+		blockTime, err := evpool.blockTime(state, height)
+		if err != nil {
+			evpool.logger.Error("failed to load block time for an invalid proposer proof", "height", height, "err", err)
 			continue
 		}
+		vals, voterSet, proofHash, err := evpool.loadElection(height, state.VoterParams)
+		if err != nil {
+			evpool.logger.Error("failed to load the election for an invalid proposer proof", "height", height, "err", err)
+			continue
+		}
+		ippe := types.NewInvalidProposerProofEvidence(invalidProof.Proposal, invalidProof.Header,
+			blockTime, vals, voterSet)
+		if err := ippe.ValidateBasic(); err != nil {
+			evpool.logger.Error("invalid proposer proof from consensus", "height", height, "err", err)
+			continue
+		}
+		if err := VerifyInvalidProposerProof(ippe, state.ChainID, vals, voterSet, proofHash,
+			state.ConsensusParams.Validator.VrfSuite); err != nil {
+			evpool.logger.Error("invalid proposer proof from consensus", "height", height, "err", err)
+			continue
+		}
+		evpool.addEvidenceFromConsensus(ippe)
+	}
+	// reset the buffers
+	evpool.conflictingProposalBuffer = make([]conflictingProposalSet, 0)
+	evpool.invalidProposerProofBuffer = make([]invalidProposerProof, 0)
+}
 
-		evpool.evidenceList.PushBack(dve)
+// blockTime returns the time of the committed block at the height.
+func (evpool *Pool) blockTime(state sm.State, height int64) (time.Time, error) {
+	switch {
+	case height == state.LastBlockHeight:
+		return state.LastBlockTime, nil
+	case height < state.LastBlockHeight:
+		blockMeta := evpool.blockStore.LoadBlockMeta(height)
+		if blockMeta == nil {
+			return time.Time{}, fmt.Errorf("don't have header #%d", height)
+		}
+		return blockMeta.Header.Time, nil
+	default:
+		// evidence pool shouldn't expect to get proposals from consensus of a height that is above the
+		// current state.
+		return time.Time{}, fmt.Errorf("height %d is greater than the current state %d",
+			height, state.LastBlockHeight)
+	}
+}
 
-		evpool.logger.Info("verified new evidence of byzantine behavior", "evidence", dve)
+// loadElection loads the validator set and the voter set at the height, and the proof hash the
+// proposer and the voters of the height are elected with.
+func (evpool *Pool) loadElection(height int64, voterParams *types.VoterParams) (
+	*types.ValidatorSet, *types.VoterSet, []byte, error) {
+	vals, err := evpool.stateDB.LoadValidators(height)
+	if err != nil {
+		return nil, nil, nil, err
 	}
-	// reset consensus buffer
-	evpool.consensusBuffer = make([]duplicateVoteSet, 0)
+	voterSet, err := evpool.stateDB.LoadVoters(height, voterParams)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	proofHash, err := evpool.stateDB.LoadProofHash(height)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return vals, voterSet, proofHash, nil
 }
 
-type duplicateVoteSet struct {
-	VoteA *types.Vote
-	VoteB *types.Vote
+// addEvidenceFromConsensus adds the evidence formed from consensus to the pool unless it is
+// already pending or committed.
+func (evpool *Pool) addEvidenceFromConsensus(ev types.Evidence) {
+	// check if we already have this evidence
+	if evpool.isPending(ev) {
+		evpool.logger.Debug("evidence already pending; ignoring", "evidence", ev)
+		return
+	}
+
+	// check that the evidence is not already committed on chain
+	if evpool.isCommitted(ev) {
+		evpool.logger.Debug("evidence already committed; ignoring", "evidence", ev)
+		return
+	}
+
+	if err := evpool.addPendingEvidence(ev); err != nil {
+		evpool.logger.Error("failed to flush evidence from consensus buffer to pending list", "err", err)
+		return
+	}
+
+	evpool.evidenceList.PushBack(ev)
+
+	evpool.logger.Info("verified new evidence of byzantine behavior", "evidence", ev)
+}
+
+type conflictingProposalSet struct {
+	ProposalA *types.Proposal
+	ProposalB *types.Proposal
+}
+
+type invalidProposerProof struct {
+	Proposal *types.Proposal
+	Header   *types.Header
 }
 
 func bytesToEv(evBytes []byte) (types.Evidence, error) {
@@ -192,6 +192,52 @@ func TestReportConflictingVotes(t *testing.T) {
 	require.Equal(t, []types.Evidence{ev}, evList)
 }
 
+func TestReportProposerMisbehavior(t *testing.T) {
+	var height int64 = 10
+
+	pool, pv := defaultTestPool(height)
+	val := pv.ExtractIntoValidator(10)
+	otherPV := types.NewMockPV(types.PrivKeyComposite)
+
+	// conflicting proposals
+	blockID := makeBlockID([]byte("blockhash"), 1000, []byte("partshash"))
+	blockID2 := makeBlockID([]byte("blockhash2"), 1000, []byte("partshash"))
+	proposalA := makeProposal(t, pv, evidenceChainID, height+1, 0, blockID)
+	proposalB := makeProposal(t, pv, evidenceChainID, height+1, 0, blockID2)
+	pool.ReportConflictingProposals(proposalA, proposalB)
+	// shouldn't be able to submit the same evidence twice
+	pool.ReportConflictingProposals(proposalB, proposalA)
+	// proposals signed by the others are ignored
+	pool.ReportConflictingProposals(proposalA, makeProposal(t, otherPV, evidenceChainID, height+1, 0, blockID2))
+
+	// a proposal of a block with an invalid proof
+	header := makeHeaderRandom(height + 1)
+	header.ProposerAddress = val.Address
+	proposal := makeProposal(t, pv, evidenceChainID, height+1, 0, makeBlockID(header.Hash(), 1000, []byte("partshash")))
+	pool.ReportInvalidProposerProof(proposal, header)
+
+	// evidence from consensus should not be added immediately but reside in the buffers
+	evList, evSize := pool.PendingEvidence(defaultEvidenceMaxBytes)
+	require.Empty(t, evList)
+	require.Zero(t, evSize)
+
+	// move to next height and update state and evidence pool
+	state := pool.State()
+	state.LastBlockHeight++
+	state.LastBlockTime = defaultEvidenceTime
+	pool.Update(state, []types.Evidence{})
+
+	// should be able to retrieve evidence from pool
+	voterSet := types.ToVoterAll([]*types.Validator{val})
+	valSet := types.NewValidatorSet([]*types.Validator{val})
+	evList, _ = pool.PendingEvidence(-1)
+	require.Len(t, evList, 2)
+	assert.Contains(t, evList, types.NewConflictingProposalEvidence(proposalA, proposalB, val.Address,
+		defaultEvidenceTime, valSet, voterSet))
+	assert.Contains(t, evList, types.NewInvalidProposerProofEvidence(proposal, header,
+		defaultEvidenceTime, valSet, voterSet))
+}
+
 func TestEvidencePoolUpdate(t *testing.T) {
 	height := int64(21)
 	pool, val := defaultTestPool(height)
@@ -272,7 +318,8 @@ func TestCheckEvidenceWithLightClientAttack(t *testing.T) {
 	// for simplicity we are simulating a duplicate vote attack where all the validators in the
 	// conflictingVals set voted twice
 	blockID := makeBlockID(conflictingHeader.Hash(), 1000, []byte("partshash"))
-	voteSet := types.NewVoteSet(evidenceChainID, height, 1, tmproto.SignedMsgType(2), conflictingVoters)
+	voteSet := types.NewVoteSet(evidenceChainID, height, 1, tmproto.SignedMsgType(2),
+		conflictingVoters, version.BlockProtocol)
 	commit, err := types.MakeCommit(blockID, height, 1, voteSet, conflictingPrivVals, defaultEvidenceTime)
 	require.NoError(t, err)
 	ev := &types.LightClientAttackEvidence{
@@ -291,7 +338,8 @@ func TestCheckEvidenceWithLightClientAttack(t *testing.T) {
 	}
 
 	trustedBlockID := makeBlockID(trustedHeader.Hash(), 1000, []byte("partshash"))
-	trustedVoteSet := types.NewVoteSet(evidenceChainID, height, 1, tmproto.SignedMsgType(2), conflictingVoters)
+	trustedVoteSet := types.NewVoteSet(evidenceChainID, height, 1, tmproto.SignedMsgType(2),
+		conflictingVoters, version.BlockProtocol)
 	trustedCommit, err := types.MakeCommit(trustedBlockID, height, 1, trustedVoteSet, conflictingPrivVals,
 		defaultEvidenceTime)
 	require.NoError(t, err)
@@ -415,10 +463,9 @@ func initializeStateFromValidatorSet(valSet *types.ValidatorSet, height int64) s
 	return stateStore
 }
 
-func initializeValidatorState(privVal types.PrivValidator, height int64) sm.Store {
+func initializeValidatorState(privVal types.MockPV, height int64) sm.Store {
 
-	pubKey, _ := privVal.GetPubKey()
-	validator := &types.Validator{Address: pubKey.Address(), StakingPower: 10, PubKey: pubKey}
+	validator := privVal.ExtractIntoValidator(10)
 
 	// create validator set and state
 	valSet := &types.ValidatorSet{
@@ -7,6 +7,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/line/ostracon/crypto"
 	"github.com/line/ostracon/light"
 	"github.com/line/ostracon/types"
 )
@@ -55,17 +56,13 @@ func (evpool *Pool) verify(evidence types.Evidence) error {
 		if err != nil {
 			return err
 		}
-		return VerifyDuplicateVote(ev, state.ChainID, voterSet)
+		return VerifyDuplicateVote(ev, state.ChainID, voterSet, blockMeta.Header.Version.Block)
 
 	case *types.LightClientAttackEvidence:
 		commonHeader, err := getSignedHeader(evpool.blockStore, evidence.Height())
 		if err != nil {
 			return err
 		}
-		commonVals, err := evpool.stateDB.LoadValidators(evidence.Height())
-		if err != nil {
-			return err
-		}
 		commonVoters, err := evpool.stateDB.LoadVoters(evidence.Height(), state.VoterParams)
 		if err != nil {
 			return err
@@ -79,8 +76,8 @@ func (evpool *Pool) verify(evidence types.Evidence) error {
 			}
 		}
 
-		err = VerifyLightClientAttack(ev, commonHeader, trustedHeader, commonVals, commonVoters, state.LastBlockTime,
-			state.ConsensusParams.Evidence.MaxAgeDuration, state.VoterParams)
+		err = VerifyLightClientAttack(ev, commonHeader, trustedHeader, commonVoters, state.LastBlockTime,
+			state.ConsensusParams.Evidence.MaxAgeDuration)
 		if err != nil {
 			return err
 		}
@@ -113,6 +110,22 @@ func (evpool *Pool) verify(evidence types.Evidence) error {
 		}
 
 		return nil
+
+	case *types.ConflictingProposalEvidence:
+		vals, voterSet, proofHash, err := evpool.loadElection(evidence.Height(), state.VoterParams)
+		if err != nil {
+			return err
+		}
+		return VerifyConflictingProposals(ev, state.ChainID, vals, voterSet, proofHash)
+
+	case *types.InvalidProposerProofEvidence:
+		vals, voterSet, proofHash, err := evpool.loadElection(evidence.Height(), state.VoterParams)
+		if err != nil {
+			return err
+		}
+		return VerifyInvalidProposerProof(ev, state.ChainID, vals, voterSet, proofHash,
+			state.ConsensusParams.Validator.VrfSuite)
+
 	default:
 		return fmt.Errorf("unrecognized evidence type: %T", evidence)
 	}
@@ -125,12 +138,12 @@ func (evpool *Pool) verify(evidence types.Evidence) error {
 //       the conflicting header's commit
 //     - the nodes trusted header at the same height as the conflicting header has a different hash
 func VerifyLightClientAttack(e *types.LightClientAttackEvidence, commonHeader, trustedHeader *types.SignedHeader,
-	commonVals *types.ValidatorSet, commonVoters *types.VoterSet, now time.Time, trustPeriod time.Duration, voterParams *types.VoterParams) error {
+	commonVoters *types.VoterSet, now time.Time, trustPeriod time.Duration) error {
 	// In the case of lunatic attack we need to perform a single verification jump between the
 	// common header and the conflicting one
 	if commonHeader.Height != trustedHeader.Height {
-		err := light.Verify(commonHeader, commonVals, e.ConflictingBlock.SignedHeader, e.ConflictingBlock.ValidatorSet,
-			trustPeriod, now, 0*time.Second, light.DefaultTrustLevel, voterParams)
+		err := light.Verify(commonHeader, commonVoters, e.ConflictingBlock.SignedHeader, e.ConflictingBlock.VoterSet,
+			trustPeriod, now, 0*time.Second, light.DefaultTrustLevel)
 		if err != nil {
 			return fmt.Errorf("skipping verification from common to conflicting header failed: %w", err)
 		}
@@ -142,7 +155,7 @@ func VerifyLightClientAttack(e *types.LightClientAttackEvidence, commonHeader, t
 		}
 		// ensure that 2/3 of the voter set did vote for this block
 		if err := e.ConflictingBlock.VoterSet.VerifyCommitLight(trustedHeader.ChainID, e.ConflictingBlock.Commit.BlockID,
-			e.ConflictingBlock.Height, e.ConflictingBlock.Commit); err != nil {
+			e.ConflictingBlock.Height, e.ConflictingBlock.Commit, e.ConflictingBlock.Version.Block); err != nil {
 			return fmt.Errorf("invalid commit from conflicting block: %w", err)
 		}
 	}
@@ -165,8 +178,9 @@ func VerifyLightClientAttack(e *types.LightClientAttackEvidence, commonHeader, t
 //      - the validator is in the voter set at the height of the evidence
 //      - the height, round, type and validator address of the votes must be the same
 //      - the block ID's must be different
-//      - The signatures must both be valid
-func VerifyDuplicateVote(e *types.DuplicateVoteEvidence, chainID string, voterSet *types.VoterSet) error {
+//      - The signatures must both be valid under the rules of the block protocol blockVersion of the height
+func VerifyDuplicateVote(e *types.DuplicateVoteEvidence, chainID string, voterSet *types.VoterSet,
+	blockVersion uint64) error {
 	_, val := voterSet.GetByAddress(e.VoteA.ValidatorAddress)
 	if val == nil {
 		return fmt.Errorf("address %X was not a validator at height %d", e.VoteA.ValidatorAddress, e.Height())
@@ -218,16 +232,114 @@ func VerifyDuplicateVote(e *types.DuplicateVoteEvidence, chainID string, voterSe
 	va := e.VoteA.ToProto()
 	vb := e.VoteB.ToProto()
 	// Signatures must be valid
-	if !pubKey.VerifySignature(types.VoteSignBytes(chainID, va), e.VoteA.Signature) {
+	if !types.VerifySignature(pubKey, types.VoteSignBytes(chainID, va), e.VoteA.Signature, blockVersion) {
 		return fmt.Errorf("verifying VoteA: %w", types.ErrVoteInvalidSignature)
 	}
-	if !pubKey.VerifySignature(types.VoteSignBytes(chainID, vb), e.VoteB.Signature) {
+	if !types.VerifySignature(pubKey, types.VoteSignBytes(chainID, vb), e.VoteB.Signature, blockVersion) {
 		return fmt.Errorf("verifying VoteB: %w", types.ErrVoteInvalidSignature)
 	}
 
 	return nil
 }
 
+// VerifyConflictingProposals verifies ConflictingProposalEvidence against the state of full node. This
+// involves the following checks:
+//      - the proposer is the one elected from the validator set with the proof hash at the height and
+//        the round of the proposals
+//      - the height, round and type of the proposals must be the same
+//      - the block ID's must be different
+//      - The signatures must both be valid
+func VerifyConflictingProposals(e *types.ConflictingProposalEvidence, chainID string, vals *types.ValidatorSet,
+	voterSet *types.VoterSet, proofHash []byte) error {
+	// H/R/S must be the same
+	if e.ProposalA.Height != e.ProposalB.Height ||
+		e.ProposalA.Round != e.ProposalB.Round ||
+		e.ProposalA.Type != e.ProposalB.Type {
+		return fmt.Errorf("h/r/s does not match: %d/%d/%v vs %d/%d/%v",
+			e.ProposalA.Height, e.ProposalA.Round, e.ProposalA.Type,
+			e.ProposalB.Height, e.ProposalB.Round, e.ProposalB.Type)
+	}
+
+	// BlockIDs must be different
+	if e.ProposalA.BlockID.Equals(e.ProposalB.BlockID) {
+		return fmt.Errorf(
+			"block IDs are the same (%v) - not conflicting proposals",
+			e.ProposalA.BlockID,
+		)
+	}
+
+	proposer, err := verifyProposer(e.ProposerAddress, e.ProposalA.Height, e.ProposalA.Round,
+		e.ValidatorPower, e.TotalVotingPower, vals, voterSet, proofHash)
+	if err != nil {
+		return err
+	}
+
+	pa := e.ProposalA.ToProto()
+	pb := e.ProposalB.ToProto()
+	// Signatures must be valid
+	if !proposer.PubKey.VerifySignature(types.ProposalSignBytes(chainID, pa), e.ProposalA.Signature) {
+		return errors.New("verifying ProposalA: invalid signature")
+	}
+	if !proposer.PubKey.VerifySignature(types.ProposalSignBytes(chainID, pb), e.ProposalB.Signature) {
+		return errors.New("verifying ProposalB: invalid signature")
+	}
+
+	return nil
+}
+
+// VerifyInvalidProposerProof verifies InvalidProposerProofEvidence against the state of full node. This
+// involves the following checks:
+//      - the proposer of the header is the one elected from the validator set with the proof hash at
+//        the height and the round of the proposal
+//      - the signature of the proposal must be valid
+//      - the VRF proof in the header must be invalid for the proof hash, the height and the round under the
+//        VRF suite of the chain
+func VerifyInvalidProposerProof(e *types.InvalidProposerProofEvidence, chainID string, vals *types.ValidatorSet,
+	voterSet *types.VoterSet, proofHash []byte, vrfSuite string) error {
+	proposer, err := verifyProposer(e.Header.ProposerAddress, e.Proposal.Height, e.Proposal.Round,
+		e.ValidatorPower, e.TotalVotingPower, vals, voterSet, proofHash)
+	if err != nil {
+		return err
+	}
+
+	// Signature must be valid
+	if !proposer.PubKey.VerifySignature(types.ProposalSignBytes(chainID, e.Proposal.ToProto()), e.Proposal.Signature) {
+		return errors.New("verifying Proposal: invalid signature")
+	}
+
+	// The proof must be invalid
+	message := types.MakeRoundHash(proofHash, e.Header.Height-1, e.Header.Round)
+	if _, err := types.VRFVerify(proposer.PubKey, crypto.Proof(e.Header.Proof), message, vrfSuite); err == nil {
+		return fmt.Errorf("the proof of the proposer %X is valid at height %d and round %d",
+			proposer.Address, e.Header.Height, e.Header.Round)
+	}
+
+	return nil
+}
+
+// verifyProposer checks that address is the proposer elected at the height and the round, and that
+// the powers of the evidence match our validator and voter sets.
+func verifyProposer(address types.Address, height int64, round int32, validatorPower, totalVotingPower int64,
+	vals *types.ValidatorSet, voterSet *types.VoterSet, proofHash []byte) (*types.Validator, error) {
+	proposer := vals.SelectProposer(proofHash, height, round)
+	if !bytes.Equal(proposer.Address, address) {
+		return nil, fmt.Errorf("address %X was not the proposer at height %d and round %d, expected %X",
+			address, height, round, proposer.Address)
+	}
+
+	// validator power and total voting power must match
+	if proposer.StakingPower != validatorPower {
+		return nil, fmt.Errorf("validator power from evidence and our validator set does not match (%d != %d)",
+			validatorPower, proposer.StakingPower)
+	}
+	if voterSet.TotalVotingPower() != totalVotingPower {
+		return nil, fmt.Errorf("total voting power from the evidence and our voter set does not match (%d != %d)",
+			totalVotingPower, voterSet.TotalVotingPower())
+	}
+
+	return proposer, nil
+}
+
 func getSignedHeader(blockStore BlockStore, height int64) (*types.SignedHeader, error) {
 	blockMeta := blockStore.LoadBlockMeta(height)
 	if blockMeta == nil {
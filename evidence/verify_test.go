@@ -48,7 +48,8 @@ func TestVerifyLightClientAttack_Lunatic(t *testing.T) {
 
 	// we are simulating a lunatic light client attack
 	blockID := makeBlockID(conflictingHeader.Hash(), 1000, []byte("partshash"))
-	voteSet := types.NewVoteSet(evidenceChainID, 10, 1, tmproto.SignedMsgType(2), conflictingVoterSet)
+	voteSet := types.NewVoteSet(evidenceChainID, 10, 1, tmproto.SignedMsgType(2),
+		conflictingVoterSet, version.BlockProtocol)
 	commit, err := types.MakeCommit(blockID, 10, 1, voteSet, conflictingPrivVals, defaultEvidenceTime)
 	require.NoError(t, err)
 	ev := &types.LightClientAttackEvidence{
@@ -72,7 +73,7 @@ func TestVerifyLightClientAttack_Lunatic(t *testing.T) {
 	}
 	trustedBlockID := makeBlockID(trustedHeader.Hash(), 1000, []byte("partshash"))
 	_, voters, privVals := types.RandVoterSet(3, 8)
-	trustedVoteSet := types.NewVoteSet(evidenceChainID, 10, 1, tmproto.SignedMsgType(2), voters)
+	trustedVoteSet := types.NewVoteSet(evidenceChainID, 10, 1, tmproto.SignedMsgType(2), voters, version.BlockProtocol)
 	trustedCommit, err := types.MakeCommit(trustedBlockID, 10, 1, trustedVoteSet, privVals, defaultEvidenceTime)
 	require.NoError(t, err)
 	trustedSignedHeader := &types.SignedHeader{
@@ -81,19 +82,19 @@ func TestVerifyLightClientAttack_Lunatic(t *testing.T) {
 	}
 
 	// good pass -> no error
-	err = evidence.VerifyLightClientAttack(ev, commonSignedHeader, trustedSignedHeader, commonVals, commonVoters,
-		defaultEvidenceTime.Add(2*time.Hour), 3*time.Hour, types.DefaultVoterParams())
+	err = evidence.VerifyLightClientAttack(ev, commonSignedHeader, trustedSignedHeader, commonVoters,
+		defaultEvidenceTime.Add(2*time.Hour), 3*time.Hour)
 	assert.NoError(t, err)
 
 	// trusted and conflicting hashes are the same -> an error should be returned
-	err = evidence.VerifyLightClientAttack(ev, commonSignedHeader, ev.ConflictingBlock.SignedHeader, commonVals, commonVoters,
-		defaultEvidenceTime.Add(2*time.Hour), 3*time.Hour, types.DefaultVoterParams())
+	err = evidence.VerifyLightClientAttack(ev, commonSignedHeader, ev.ConflictingBlock.SignedHeader, commonVoters,
+		defaultEvidenceTime.Add(2*time.Hour), 3*time.Hour)
 	assert.Error(t, err)
 
 	// evidence with different total validator power should fail
 	ev.TotalVotingPower = 1
-	err = evidence.VerifyLightClientAttack(ev, commonSignedHeader, trustedSignedHeader, commonVals, commonVoters,
-		defaultEvidenceTime.Add(2*time.Hour), 3*time.Hour, types.DefaultVoterParams())
+	err = evidence.VerifyLightClientAttack(ev, commonSignedHeader, trustedSignedHeader, commonVoters,
+		defaultEvidenceTime.Add(2*time.Hour), 3*time.Hour)
 	assert.Error(t, err)
 	ev.TotalVotingPower = 20
 
@@ -154,7 +155,8 @@ func TestVerifyLightClientAttack_Equivocation(t *testing.T) {
 	// we are simulating a duplicate vote attack where all the validators in the conflictingVals set
 	// except the last validator vote twice
 	blockID := makeBlockID(conflictingHeader.Hash(), 1000, []byte("partshash"))
-	voteSet := types.NewVoteSet(evidenceChainID, 10, 1, tmproto.SignedMsgType(2), conflictingVoters)
+	voteSet := types.NewVoteSet(evidenceChainID, 10, 1, tmproto.SignedMsgType(2),
+		conflictingVoters, version.BlockProtocol)
 	commit, err := types.MakeCommit(blockID, 10, 1, voteSet, conflictingPrivVals[:4], defaultEvidenceTime)
 	require.NoError(t, err)
 	ev := &types.LightClientAttackEvidence{
@@ -173,7 +175,8 @@ func TestVerifyLightClientAttack_Equivocation(t *testing.T) {
 	}
 
 	trustedBlockID := makeBlockID(trustedHeader.Hash(), 1000, []byte("partshash"))
-	trustedVoteSet := types.NewVoteSet(evidenceChainID, 10, 1, tmproto.SignedMsgType(2), conflictingVoters)
+	trustedVoteSet := types.NewVoteSet(evidenceChainID, 10, 1, tmproto.SignedMsgType(2),
+		conflictingVoters, version.BlockProtocol)
 	trustedCommit, err := types.MakeCommit(trustedBlockID, 10, 1, trustedVoteSet, conflictingPrivVals, defaultEvidenceTime)
 	require.NoError(t, err)
 	trustedSignedHeader := &types.SignedHeader{
@@ -182,20 +185,20 @@ func TestVerifyLightClientAttack_Equivocation(t *testing.T) {
 	}
 
 	// good pass -> no error
-	err = evidence.VerifyLightClientAttack(ev, trustedSignedHeader, trustedSignedHeader, conflictingVals, conflictingVoters,
-		defaultEvidenceTime.Add(1*time.Minute), 2*time.Hour, types.DefaultVoterParams())
+	err = evidence.VerifyLightClientAttack(ev, trustedSignedHeader, trustedSignedHeader, conflictingVoters,
+		defaultEvidenceTime.Add(1*time.Minute), 2*time.Hour)
 	assert.NoError(t, err)
 
 	// trusted and conflicting hashes are the same -> an error should be returned
-	err = evidence.VerifyLightClientAttack(ev, trustedSignedHeader, ev.ConflictingBlock.SignedHeader, conflictingVals, conflictingVoters,
-		defaultEvidenceTime.Add(1*time.Minute), 2*time.Hour, types.DefaultVoterParams())
+	err = evidence.VerifyLightClientAttack(ev, trustedSignedHeader, ev.ConflictingBlock.SignedHeader, conflictingVoters,
+		defaultEvidenceTime.Add(1*time.Minute), 2*time.Hour)
 	assert.Error(t, err)
 
 	// conflicting header has different next validators hash which should have been correctly derived from
 	// the previous round
 	ev.ConflictingBlock.Header.NextValidatorsHash = crypto.CRandBytes(tmhash.Size)
-	err = evidence.VerifyLightClientAttack(ev, trustedSignedHeader, trustedSignedHeader, nil, nil,
-		defaultEvidenceTime.Add(1*time.Minute), 2*time.Hour, types.DefaultVoterParams())
+	err = evidence.VerifyLightClientAttack(ev, trustedSignedHeader, trustedSignedHeader, nil,
+		defaultEvidenceTime.Add(1*time.Minute), 2*time.Hour)
 	assert.Error(t, err)
 	// revert next validators hash
 	ev.ConflictingBlock.Header.NextValidatorsHash = trustedHeader.NextValidatorsHash
@@ -240,7 +243,8 @@ func TestVerifyLightClientAttack_Amnesia(t *testing.T) {
 	// we are simulating an amnesia attack where all the validators in the conflictingVals set
 	// except the last validator vote twice. However this time the commits are of different rounds.
 	blockID := makeBlockID(conflictingHeader.Hash(), 1000, []byte("partshash"))
-	voteSet := types.NewVoteSet(evidenceChainID, 10, 0, tmproto.SignedMsgType(2), conflictingVoters)
+	voteSet := types.NewVoteSet(evidenceChainID, 10, 0, tmproto.SignedMsgType(2),
+		conflictingVoters, version.BlockProtocol)
 	commit, err := types.MakeCommit(blockID, 10, 0, voteSet, conflictingPrivVals, defaultEvidenceTime)
 	require.NoError(t, err)
 	ev := &types.LightClientAttackEvidence{
@@ -259,7 +263,8 @@ func TestVerifyLightClientAttack_Amnesia(t *testing.T) {
 	}
 
 	trustedBlockID := makeBlockID(trustedHeader.Hash(), 1000, []byte("partshash"))
-	trustedVoteSet := types.NewVoteSet(evidenceChainID, 10, 1, tmproto.SignedMsgType(2), conflictingVoters)
+	trustedVoteSet := types.NewVoteSet(evidenceChainID, 10, 1, tmproto.SignedMsgType(2),
+		conflictingVoters, version.BlockProtocol)
 	trustedCommit, err := types.MakeCommit(trustedBlockID, 10, 1, trustedVoteSet, conflictingPrivVals, defaultEvidenceTime)
 	require.NoError(t, err)
 	trustedSignedHeader := &types.SignedHeader{
@@ -268,13 +273,13 @@ func TestVerifyLightClientAttack_Amnesia(t *testing.T) {
 	}
 
 	// good pass -> no error
-	err = evidence.VerifyLightClientAttack(ev, trustedSignedHeader, trustedSignedHeader, conflictingVals, conflictingVoters,
-		defaultEvidenceTime.Add(1*time.Minute), 2*time.Hour, types.DefaultVoterParams())
+	err = evidence.VerifyLightClientAttack(ev, trustedSignedHeader, trustedSignedHeader, conflictingVoters,
+		defaultEvidenceTime.Add(1*time.Minute), 2*time.Hour)
 	assert.NoError(t, err)
 
 	// trusted and conflicting hashes are the same -> an error should be returned
-	err = evidence.VerifyLightClientAttack(ev, trustedSignedHeader, ev.ConflictingBlock.SignedHeader, conflictingVals, conflictingVoters,
-		defaultEvidenceTime.Add(1*time.Minute), 2*time.Hour, types.DefaultVoterParams())
+	err = evidence.VerifyLightClientAttack(ev, trustedSignedHeader, ev.ConflictingBlock.SignedHeader, conflictingVoters,
+		defaultEvidenceTime.Add(1*time.Minute), 2*time.Hour)
 	assert.Error(t, err)
 
 	state := sm.State{
@@ -358,9 +363,11 @@ func TestVerifyDuplicateVoteEvidence(t *testing.T) {
 			Timestamp:        defaultEvidenceTime,
 		}
 		if c.valid {
-			assert.Nil(t, evidence.VerifyDuplicateVote(ev, chainID, voterSet), "evidence should be valid")
+			assert.Nil(t, evidence.VerifyDuplicateVote(ev, chainID, voterSet, version.BlockProtocol),
+				"evidence should be valid")
 		} else {
-			assert.NotNil(t, evidence.VerifyDuplicateVote(ev, chainID, voterSet), "evidence should be invalid")
+			assert.NotNil(t, evidence.VerifyDuplicateVote(ev, chainID, voterSet, version.BlockProtocol),
+				"evidence should be invalid")
 		}
 	}
 
@@ -402,6 +409,166 @@ func TestVerifyDuplicateVoteEvidence(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestVerifyConflictingProposals(t *testing.T) {
+	val := types.NewMockPV(types.PrivKeyComposite)
+	val2 := types.NewMockPV(types.PrivKeyComposite)
+	valSet := types.NewValidatorSet([]*types.Validator{val.ExtractIntoValidator(1)})
+	voterSet := types.ToVoterAll(valSet.Validators)
+	proposerAddress := valSet.Validators[0].Address
+	proofHash := []byte("proofhash")
+
+	blockID := makeBlockID([]byte("blockhash"), 1000, []byte("partshash"))
+	blockID2 := makeBlockID([]byte("blockhash2"), 1000, []byte("partshash"))
+
+	const chainID = "mychain"
+
+	proposal1 := makeProposal(t, val, chainID, 10, 2, blockID)
+	cases := []struct {
+		proposal1 *types.Proposal
+		proposal2 *types.Proposal
+		valid     bool
+	}{
+		{proposal1, makeProposal(t, val, chainID, 10, 2, blockID2), true},     // different block ids
+		{proposal1, makeProposal(t, val, chainID, 10, 2, blockID), false},     // same block id
+		{proposal1, makeProposal(t, val, "mychain2", 10, 2, blockID2), false}, // wrong chain id
+		{proposal1, makeProposal(t, val, chainID, 11, 2, blockID2), false},    // wrong height
+		{proposal1, makeProposal(t, val, chainID, 10, 3, blockID2), false},    // wrong round
+		{proposal1, makeProposal(t, val2, chainID, 10, 2, blockID2), false},   // wrong proposer
+	}
+	for _, c := range cases {
+		ev := &types.ConflictingProposalEvidence{
+			ProposalA:        c.proposal1,
+			ProposalB:        c.proposal2,
+			ProposerAddress:  proposerAddress,
+			ValidatorPower:   1,
+			TotalVotingPower: 1,
+			Timestamp:        defaultEvidenceTime,
+		}
+		err := evidence.VerifyConflictingProposals(ev, chainID, valSet, voterSet, proofHash)
+		if c.valid {
+			assert.Nil(t, err, "evidence should be valid")
+		} else {
+			assert.NotNil(t, err, "evidence should be invalid")
+		}
+	}
+
+	goodEv := types.NewConflictingProposalEvidence(proposal1, makeProposal(t, val, chainID, 10, 2, blockID2),
+		proposerAddress, defaultEvidenceTime, valSet, voterSet)
+	require.NoError(t, evidence.VerifyConflictingProposals(goodEv, chainID, valSet, voterSet, proofHash))
+
+	// the address must be of the proposer
+	badEv := *goodEv
+	badEv.ProposerAddress = val2.PrivKey.PubKey().Address()
+	assert.Error(t, evidence.VerifyConflictingProposals(&badEv, chainID, valSet, voterSet, proofHash))
+
+	// the powers must match
+	badEv = *goodEv
+	badEv.ValidatorPower = 2
+	assert.Error(t, evidence.VerifyConflictingProposals(&badEv, chainID, valSet, voterSet, proofHash))
+	badEv = *goodEv
+	badEv.TotalVotingPower = 2
+	assert.Error(t, evidence.VerifyConflictingProposals(&badEv, chainID, valSet, voterSet, proofHash))
+
+	// the evidence pool verifies the evidence against its state
+	state := sm.State{
+		ChainID:         chainID,
+		LastBlockTime:   defaultEvidenceTime.Add(1 * time.Minute),
+		LastBlockHeight: 11,
+		ConsensusParams: *types.DefaultConsensusParams(),
+	}
+	stateStore := &smmocks.Store{}
+	stateStore.On("LoadValidators", int64(10)).Return(valSet, nil)
+	stateStore.On("LoadVoters", int64(10), mock.AnythingOfType("*types.VoterParams")).Return(voterSet, nil)
+	stateStore.On("LoadProofHash", int64(10)).Return(proofHash, nil)
+	stateStore.On("Load").Return(state, nil)
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("LoadBlockMeta", int64(10)).Return(&types.BlockMeta{Header: types.Header{Time: defaultEvidenceTime}})
+
+	pool, err := evidence.NewPool(dbm.NewMemDB(), stateStore, blockStore)
+	require.NoError(t, err)
+
+	assert.NoError(t, pool.CheckEvidence(types.EvidenceList{goodEv}))
+	assert.Error(t, pool.CheckEvidence(types.EvidenceList{&badEv}))
+}
+
+func TestVerifyInvalidProposerProof(t *testing.T) {
+	val := types.NewMockPV(types.PrivKeyComposite)
+	val2 := types.NewMockPV(types.PrivKeyComposite)
+	valSet := types.NewValidatorSet([]*types.Validator{val.ExtractIntoValidator(1)})
+	voterSet := types.ToVoterAll(valSet.Validators)
+	proposerAddress := valSet.Validators[0].Address
+	proofHash := []byte("proofhash")
+
+	const chainID = "mychain"
+
+	validProof, err := val.GenerateVRFProof(types.MakeRoundHash(proofHash, 9, 2), "")
+	require.NoError(t, err)
+	otherRoundProof, err := val.GenerateVRFProof(types.MakeRoundHash(proofHash, 9, 3), "")
+	require.NoError(t, err)
+
+	makeEvidence := func(signer types.MockPV, proposer types.Address, proof []byte) *types.InvalidProposerProofEvidence {
+		header := makeHeaderRandom(10)
+		header.Round = 2
+		header.ProposerAddress = proposer
+		header.Proof = proof
+		proposal := makeProposal(t, signer, chainID, 10, 2, makeBlockID(header.Hash(), 1000, []byte("partshash")))
+		return &types.InvalidProposerProofEvidence{
+			Proposal:         proposal,
+			Header:           header,
+			ValidatorPower:   1,
+			TotalVotingPower: 1,
+			Timestamp:        defaultEvidenceTime,
+		}
+	}
+
+	cases := []struct {
+		ev    *types.InvalidProposerProofEvidence
+		valid bool
+	}{
+		{makeEvidence(val, proposerAddress, crypto.CRandBytes(vrf.ProofSize)), true},                   // invalid proof
+		{makeEvidence(val, proposerAddress, otherRoundProof), true},                                    // proof of another round
+		{makeEvidence(val, proposerAddress, validProof), false},                                        // valid proof
+		{makeEvidence(val2, proposerAddress, crypto.CRandBytes(vrf.ProofSize)), false},                 // wrong signer
+		{makeEvidence(val2, val2.PrivKey.PubKey().Address(), crypto.CRandBytes(vrf.ProofSize)), false}, // wrong proposer
+	}
+	for _, c := range cases {
+		require.NoError(t, c.ev.ValidateBasic())
+		err := evidence.VerifyInvalidProposerProof(c.ev, chainID, valSet, voterSet, proofHash, "")
+		if c.valid {
+			assert.Nil(t, err, "evidence should be valid")
+		} else {
+			assert.NotNil(t, err, "evidence should be invalid")
+		}
+	}
+
+	// the powers must match
+	badEv := makeEvidence(val, proposerAddress, crypto.CRandBytes(vrf.ProofSize))
+	badEv.ValidatorPower = 2
+	assert.Error(t, evidence.VerifyInvalidProposerProof(badEv, chainID, valSet, voterSet, proofHash, ""))
+	badEv = makeEvidence(val, proposerAddress, crypto.CRandBytes(vrf.ProofSize))
+	badEv.TotalVotingPower = 2
+	assert.Error(t, evidence.VerifyInvalidProposerProof(badEv, chainID, valSet, voterSet, proofHash, ""))
+}
+
+func makeProposal(
+	t *testing.T, val types.PrivValidator, chainID string, height int64, round int32,
+	blockID types.BlockID) *types.Proposal {
+	p := &types.Proposal{
+		Type:      tmproto.ProposalType,
+		Height:    height,
+		Round:     round,
+		POLRound:  -1,
+		BlockID:   blockID,
+		Timestamp: defaultEvidenceTime,
+	}
+
+	ppb := p.ToProto()
+	err := val.SignProposal(chainID, ppb)
+	require.NoError(t, err)
+	p.Signature = ppb.Signature
+	return p
+}
+
 func makeVote(
 	t *testing.T, val types.PrivValidator, chainID string, valIndex int32, height int64,
 	round int32, step int, blockID types.BlockID, time time.Time) *types.Vote {
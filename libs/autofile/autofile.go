@@ -157,6 +157,25 @@ func (af *AutoFile) Sync() error {
 	return af.file.Sync()
 }
 
+// Rename moves the file of the AutoFile to path, e.g. to rotate it. A new file
+// is created at the path of the AutoFile on the next write.
+func (af *AutoFile) Rename(path string) error {
+	af.mtx.Lock()
+	defer af.mtx.Unlock()
+
+	if err := os.Rename(af.Path, path); err != nil {
+		return err
+	}
+
+	// the file opened before is the moved one
+	file := af.file
+	if file == nil {
+		return nil
+	}
+	af.file = nil
+	return file.Close()
+}
+
 func (af *AutoFile) openFile() error {
 	file, err := os.OpenFile(af.Path, os.O_RDWR|os.O_CREATE|os.O_APPEND, autoFilePerms)
 	if err != nil {
@@ -147,3 +147,33 @@ func TestAutoFileSize(t *testing.T) {
 	// Cleanup
 	_ = os.Remove(f.Name())
 }
+
+func TestAutoFileRename(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rename_test")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	path := filepath.Join(dir, "autofile")
+
+	af, err := OpenAutoFile(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = af.Close() })
+
+	_, err = af.Write([]byte("Maniac\n"))
+	require.NoError(t, err)
+	require.NoError(t, af.Rename(path+".000"))
+	assert.NoFileExists(t, path)
+
+	// written to a new file, and not to the moved one
+	_, err = af.Write([]byte("Fixer\n"))
+	require.NoError(t, err)
+	data, err := ioutil.ReadFile(path + ".000")
+	require.NoError(t, err)
+	require.Equal(t, []byte("Maniac\n"), data)
+	data, err = ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, []byte("Fixer\n"), data)
+
+	// nothing to move
+	require.NoError(t, af.Rename(path+".001"))
+	assert.True(t, os.IsNotExist(af.Rename(path+".002")))
+}
@@ -0,0 +1,226 @@
+// Package erasure implements a systematic Reed-Solomon erasure code over
+// GF(2^8).
+//
+// The data is split into data shards of the same size, and parity shards are
+// computed from them, so that the data shards can be rebuilt from any
+// data-shards count of the shards. The total number of the shards can't be
+// more than MaxShards.
+package erasure
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxShards is the maximum total number of the shards of a code.
+const MaxShards = 256
+
+var (
+	ErrShardCount   = errors.New("wrong number of shards")
+	ErrShardSize    = errors.New("shards of different sizes")
+	ErrTooFewShards = errors.New("too few shards to reconstruct the data")
+)
+
+// Code is a Reed-Solomon code with a fixed number of data and parity shards.
+//
+// The encoding matrix is the identity matrix on top of a Cauchy matrix, so
+// that any square matrix made of its rows can be inverted.
+type Code struct {
+	dataShards   int
+	parityShards int
+	// parity rows of the encoding matrix
+	parity [][]byte
+}
+
+// New returns a Code with the given numbers of data and parity shards.
+func New(dataShards, parityShards int) (*Code, error) {
+	if dataShards <= 0 || parityShards < 0 || dataShards+parityShards > MaxShards {
+		return nil, fmt.Errorf("%w: %d data shards and %d parity shards, max total: %d",
+			ErrShardCount, dataShards, parityShards, MaxShards)
+	}
+
+	parity := make([][]byte, parityShards)
+	for i := range parity {
+		parity[i] = make([]byte, dataShards)
+		for j := range parity[i] {
+			// 1 / (x_i + y_j), with x_i = dataShards + i and y_j = j, which are all distinct
+			parity[i][j] = galInv(byte(dataShards+i) ^ byte(j))
+		}
+	}
+
+	return &Code{
+		dataShards:   dataShards,
+		parityShards: parityShards,
+		parity:       parity,
+	}, nil
+}
+
+// DataShards returns the number of the data shards.
+func (c *Code) DataShards() int {
+	return c.dataShards
+}
+
+// ParityShards returns the number of the parity shards.
+func (c *Code) ParityShards() int {
+	return c.parityShards
+}
+
+// Encode computes the parity shards from the data shards. The shards must hold
+// all the data shards followed by the parity shards, which are overwritten,
+// and all of them must have the same size.
+func (c *Code) Encode(shards [][]byte) error {
+	if len(shards) != c.dataShards+c.parityShards {
+		return ErrShardCount
+	}
+	size, err := shardSize(shards)
+	if err != nil {
+		return err
+	}
+	for i := c.dataShards; i < len(shards); i++ {
+		if shards[i] == nil {
+			shards[i] = make([]byte, size)
+		}
+		if len(shards[i]) != size {
+			return ErrShardSize
+		}
+	}
+
+	for i := 0; i < c.parityShards; i++ {
+		c.encodeParity(i, shards[:c.dataShards], shards[c.dataShards+i])
+	}
+	return nil
+}
+
+// Reconstruct rebuilds the missing shards, which are nil, from the others.
+// At least data-shards count of the shards must be present.
+func (c *Code) Reconstruct(shards [][]byte) error {
+	if len(shards) != c.dataShards+c.parityShards {
+		return ErrShardCount
+	}
+	size, err := shardSize(shards)
+	if err != nil {
+		return err
+	}
+
+	// take the first data-shards count of the present shards, and the rows of the
+	// encoding matrix which made them
+	present := make([]int, 0, c.dataShards)
+	dataMissing := false
+	for i := 0; i < len(shards) && len(present) < c.dataShards; i++ {
+		if shards[i] != nil {
+			present = append(present, i)
+		} else if i < c.dataShards {
+			dataMissing = true
+		}
+	}
+	if len(present) < c.dataShards {
+		return ErrTooFewShards
+	}
+
+	if dataMissing {
+		matrix := make([][]byte, c.dataShards)
+		for r, i := range present {
+			if i < c.dataShards {
+				matrix[r] = make([]byte, c.dataShards)
+				matrix[r][i] = 1
+			} else {
+				matrix[r] = append([]byte{}, c.parity[i-c.dataShards]...)
+			}
+		}
+		decode, err := invertMatrix(matrix)
+		if err != nil {
+			return err
+		}
+
+		// data = decode * present shards
+		for i := 0; i < c.dataShards; i++ {
+			if shards[i] != nil {
+				continue
+			}
+			shard := make([]byte, size)
+			for r, j := range present {
+				galMulSliceXor(decode[i][r], shards[j], shard)
+			}
+			shards[i] = shard
+		}
+	}
+
+	for i := 0; i < c.parityShards; i++ {
+		if shards[c.dataShards+i] != nil {
+			continue
+		}
+		shard := make([]byte, size)
+		c.encodeParity(i, shards[:c.dataShards], shard)
+		shards[c.dataShards+i] = shard
+	}
+	return nil
+}
+
+func (c *Code) encodeParity(i int, data [][]byte, out []byte) {
+	for j := range out {
+		out[j] = 0
+	}
+	for j, shard := range data {
+		galMulSliceXor(c.parity[i][j], shard, out)
+	}
+}
+
+// shardSize returns the size of the present shards, which must be the same.
+func shardSize(shards [][]byte) (int, error) {
+	size := -1
+	for _, shard := range shards {
+		if shard == nil {
+			continue
+		}
+		if size == -1 {
+			size = len(shard)
+		} else if len(shard) != size {
+			return 0, ErrShardSize
+		}
+	}
+	if size == -1 {
+		return 0, ErrTooFewShards
+	}
+	return size, nil
+}
+
+// invertMatrix inverts the square matrix by Gauss-Jordan elimination. The
+// matrix is modified.
+func invertMatrix(matrix [][]byte) ([][]byte, error) {
+	n := len(matrix)
+	inverse := make([][]byte, n)
+	for i := range inverse {
+		inverse[i] = make([]byte, n)
+		inverse[i][i] = 1
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for pivot < n && matrix[pivot][col] == 0 {
+			pivot++
+		}
+		if pivot == n {
+			return nil, errors.New("singular matrix")
+		}
+		matrix[col], matrix[pivot] = matrix[pivot], matrix[col]
+		inverse[col], inverse[pivot] = inverse[pivot], inverse[col]
+
+		if matrix[col][col] != 1 {
+			scale := galInv(matrix[col][col])
+			for j := 0; j < n; j++ {
+				matrix[col][j] = galMul(matrix[col][j], scale)
+				inverse[col][j] = galMul(inverse[col][j], scale)
+			}
+		}
+
+		for row := 0; row < n; row++ {
+			if row == col || matrix[row][col] == 0 {
+				continue
+			}
+			factor := matrix[row][col]
+			galMulSliceXor(factor, matrix[col], matrix[row])
+			galMulSliceXor(factor, inverse[col], inverse[row])
+		}
+	}
+	return inverse, nil
+}
@@ -0,0 +1,80 @@
+package erasure
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tmrand "github.com/line/ostracon/libs/rand"
+)
+
+func TestGalois(t *testing.T) {
+	for a := 1; a < 256; a++ {
+		assert.EqualValues(t, 1, galMul(byte(a), galInv(byte(a))), "a=%d", a)
+		assert.EqualValues(t, 0, galMul(byte(a), 0))
+	}
+	// 0x80 * 2 overflows into the polynomial
+	assert.EqualValues(t, 0x1d, galMul(0x80, 2))
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(0, 1)
+	assert.ErrorIs(t, err, ErrShardCount)
+	_, err = New(200, 57)
+	assert.ErrorIs(t, err, ErrShardCount)
+	_, err = New(200, 56)
+	assert.NoError(t, err)
+}
+
+func TestEncodeReconstruct(t *testing.T) {
+	testCases := []struct {
+		dataShards, parityShards int
+	}{
+		{1, 1},
+		{4, 2},
+		{10, 5},
+		{128, 128},
+		{200, 56},
+	}
+	for _, tc := range testCases {
+		code, err := New(tc.dataShards, tc.parityShards)
+		require.NoError(t, err)
+
+		shards := make([][]byte, tc.dataShards+tc.parityShards)
+		for i := 0; i < tc.dataShards; i++ {
+			shards[i] = tmrand.Bytes(100)
+		}
+		require.NoError(t, code.Encode(shards))
+		expected := make([][]byte, len(shards))
+		for i := range shards {
+			expected[i] = append([]byte{}, shards[i]...)
+		}
+
+		// lose as many random shards as there are parity shards
+		for _, i := range tmrand.Perm(len(shards))[:tc.parityShards] {
+			shards[i] = nil
+		}
+		require.NoError(t, code.Reconstruct(shards))
+		for i := range shards {
+			assert.True(t, bytes.Equal(expected[i], shards[i]), "shard %d of %d+%d",
+				i, tc.dataShards, tc.parityShards)
+		}
+
+		// one shard too many is lost
+		for _, i := range tmrand.Perm(len(shards))[:tc.parityShards+1] {
+			shards[i] = nil
+		}
+		assert.ErrorIs(t, code.Reconstruct(shards), ErrTooFewShards)
+	}
+}
+
+func TestWrongShards(t *testing.T) {
+	code, err := New(2, 1)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, code.Encode([][]byte{{1}, {2}}), ErrShardCount)
+	assert.ErrorIs(t, code.Encode([][]byte{{1}, {2, 3}, nil}), ErrShardSize)
+	assert.ErrorIs(t, code.Reconstruct([][]byte{nil, nil, nil}), ErrTooFewShards)
+}
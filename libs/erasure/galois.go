@@ -0,0 +1,52 @@
+package erasure
+
+// Arithmetic in GF(2^8) with the polynomial x^8 + x^4 + x^3 + x^2 + 1 (0x11d),
+// whose generator is 2. The addition and the subtraction are XOR.
+
+const fieldPolynomial = 0x11d
+
+var (
+	expTable [510]byte
+	logTable [256]byte
+	// mulTable[a][b] is a * b
+	mulTable [256][256]byte
+)
+
+func init() {
+	x := 1
+	for i := 0; i < 255; i++ {
+		expTable[i] = byte(x)
+		expTable[i+255] = byte(x)
+		logTable[x] = byte(i)
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= fieldPolynomial
+		}
+	}
+
+	for a := 1; a < 256; a++ {
+		for b := 1; b < 256; b++ {
+			mulTable[a][b] = expTable[int(logTable[a])+int(logTable[b])]
+		}
+	}
+}
+
+func galMul(a, b byte) byte {
+	return mulTable[a][b]
+}
+
+// galInv returns the multiplicative inverse of a, which must not be 0.
+func galInv(a byte) byte {
+	return expTable[255-int(logTable[a])]
+}
+
+// galMulSliceXor adds c * in to out.
+func galMulSliceXor(c byte, in, out []byte) {
+	if c == 0 {
+		return
+	}
+	mt := &mulTable[c]
+	for i, b := range in {
+		out[i] ^= mt[b]
+	}
+}
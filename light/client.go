@@ -14,6 +14,7 @@ import (
 	"github.com/line/ostracon/light/provider"
 	"github.com/line/ostracon/light/store"
 	"github.com/line/ostracon/types"
+	"github.com/line/ostracon/version"
 )
 
 type mode byte
@@ -108,6 +109,19 @@ func MaxClockDrift(d time.Duration) Option {
 	}
 }
 
+// GenesisParams option sets the voter params and the VRF suite of the genesis
+// of the chain, which elect the voters of the blocks of the block protocols
+// before version.BlockProtocolExtendedParamsHash. Those can't change the voter
+// params and their ConsensusHash doesn't cover them, so they are not fetched.
+// The later blocks are verified with the consensus params of their height.
+// Default: the default voter params and the build-time default VRF suite.
+func GenesisParams(voterParams *types.VoterParams, vrfSuite string) Option {
+	return func(c *Client) {
+		c.genesisVoterParams = voterParams
+		c.genesisVRFSuite = vrfSuite
+	}
+}
+
 // Client represents a light client, connected to a single chain, which gets
 // light blocks from a primary provider, verifies them either sequentially or by
 // skipping some and stores them in a trusted store (usually, a local FS).
@@ -140,7 +154,9 @@ type Client struct {
 
 	quit chan struct{}
 
-	voterParams *types.VoterParams
+	// See GenesisParams option
+	genesisVoterParams *types.VoterParams
+	genesisVRFSuite    string
 
 	logger log.Logger
 }
@@ -162,14 +178,13 @@ func NewClient(
 	primary provider.Provider,
 	witnesses []provider.Provider,
 	trustedStore store.Store,
-	voterParams *types.VoterParams,
 	options ...Option) (*Client, error) {
 
 	if err := trustOptions.ValidateBasic(); err != nil {
 		return nil, fmt.Errorf("invalid TrustOptions: %w", err)
 	}
 
-	c, err := NewClientFromTrustedStore(chainID, trustOptions.Period, primary, witnesses, trustedStore, voterParams, options...)
+	c, err := NewClientFromTrustedStore(chainID, trustOptions.Period, primary, witnesses, trustedStore, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -200,7 +215,6 @@ func NewClientFromTrustedStore(
 	primary provider.Provider,
 	witnesses []provider.Provider,
 	trustedStore store.Store,
-	voterParams *types.VoterParams,
 	options ...Option) (*Client, error) {
 
 	c := &Client{
@@ -216,8 +230,9 @@ func NewClientFromTrustedStore(
 		pruningSize:      defaultPruningSize,
 		confirmationFn:   func(action string) bool { return true },
 		quit:             make(chan struct{}),
-		voterParams:      voterParams,
 		logger:           log.NewNopLogger(),
+
+		genesisVoterParams: types.DefaultVoterParams(),
 	}
 
 	for _, o := range options {
@@ -364,7 +379,7 @@ func (c *Client) initializeWithTrustOptions(ctx context.Context, options TrustOp
 	}
 
 	// 2) Ensure that +2/3 of validators signed correctly.
-	err = l.VoterSet.VerifyCommitLight(c.chainID, l.Commit.BlockID, l.Height, l.Commit)
+	err = l.VoterSet.VerifyCommitLight(c.chainID, l.Commit.BlockID, l.Height, l.Commit, l.Version.Block)
 	if err != nil {
 		return fmt.Errorf("invalid commit: %w", err)
 	}
@@ -626,8 +641,11 @@ func (c *Client) verifySequential(
 			"newHeight", interimBlock.Height,
 			"newHash", interimBlock.Hash())
 
-		err = VerifyAdjacent(verifiedBlock.SignedHeader, interimBlock.SignedHeader, interimBlock.ValidatorSet,
-			c.trustingPeriod, now, c.maxClockDrift, c.voterParams)
+		err = VerifyAdjacent(verifiedBlock.SignedHeader, interimBlock.SignedHeader, interimBlock.VoterSet,
+			c.trustingPeriod, now, c.maxClockDrift)
+		if err == nil {
+			err = c.verifyElection(ctx, c.primary, verifiedBlock, interimBlock)
+		}
 		if err != nil {
 			err := ErrVerificationFailed{From: verifiedBlock.Height, To: interimBlock.Height, Reason: err}
 
@@ -717,8 +735,11 @@ func (c *Client) verifySkipping(
 			"newHeight", blockCache[depth].Height,
 			"newHash", blockCache[depth].Hash())
 
-		err := Verify(verifiedBlock.SignedHeader, verifiedBlock.ValidatorSet, blockCache[depth].SignedHeader,
-			blockCache[depth].ValidatorSet, c.trustingPeriod, now, c.maxClockDrift, c.trustLevel, c.voterParams)
+		err := Verify(verifiedBlock.SignedHeader, verifiedBlock.VoterSet, blockCache[depth].SignedHeader,
+			blockCache[depth].VoterSet, c.trustingPeriod, now, c.maxClockDrift, c.trustLevel)
+		if err == nil {
+			err = c.verifyElection(ctx, source, verifiedBlock, blockCache[depth])
+		}
 		switch err.(type) {
 		case nil:
 			// Have we verified the last header
@@ -754,6 +775,48 @@ func (c *Client) verifySkipping(
 	}
 }
 
+// verifyElection verifies the election of newLightBlock with the light block
+// right before it, which is fetched from source unless it's trustedBlock.
+// The voters are elected with the voter params of the consensus params of the
+// height, which are fetched from source and checked against the ConsensusHash
+// of newLightBlock.
+func (c *Client) verifyElection(
+	ctx context.Context,
+	source provider.Provider,
+	trustedBlock *types.LightBlock,
+	newLightBlock *types.LightBlock) error {
+
+	lastBlock := trustedBlock
+	if newLightBlock.Height != trustedBlock.Height+1 {
+		var err error
+		lastBlock, err = source.LightBlock(ctx, newLightBlock.Height-1)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The ConsensusHash of the block protocols before
+	// version.BlockProtocolExtendedParamsHash doesn't cover the voter params nor
+	// the VRF suite, so those of the genesis are used instead of trusting the
+	// ones of the source.
+	if newLightBlock.Version.Block < version.BlockProtocolExtendedParamsHash {
+		return VerifyElection(lastBlock, newLightBlock, c.genesisVoterParams, c.genesisVRFSuite)
+	}
+
+	params, err := source.ConsensusParams(ctx, newLightBlock.Height)
+	if err != nil {
+		return err
+	}
+	if hash := types.HashConsensusParams(*params, newLightBlock.Version.Block); !bytes.Equal(
+		hash, newLightBlock.ConsensusHash) {
+		return fmt.Errorf("consensus params hash %X does not match the header's %X at height %d",
+			hash, newLightBlock.ConsensusHash, newLightBlock.Height)
+	}
+
+	return VerifyElection(lastBlock, newLightBlock, types.VoterParamsFromProto(&params.Voter),
+		params.Validator.VrfSuite)
+}
+
 // verifySkippingAgainstPrimary does verifySkipping plus it compares new header with
 // witnesses and replaces primary if it sends the light client an invalid header
 func (c *Client) verifySkippingAgainstPrimary(
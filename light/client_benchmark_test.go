@@ -12,7 +12,6 @@ import (
 	"github.com/line/ostracon/light/provider"
 	mockp "github.com/line/ostracon/light/provider/mock"
 	dbs "github.com/line/ostracon/light/store/db"
-	"github.com/line/ostracon/types"
 )
 
 // NOTE: block is produced every minute. Make sure the verification time
@@ -39,7 +38,6 @@ func BenchmarkSequence(b *testing.B) {
 		benchmarkFullNode,
 		[]provider.Provider{benchmarkFullNode},
 		dbs.New(dbm.NewMemDB(), chainID),
-		types.DefaultVoterParams(),
 		light.Logger(log.TestingLogger()),
 		light.SequentialVerification(),
 	)
@@ -68,7 +66,6 @@ func BenchmarkBisection(b *testing.B) {
 		benchmarkFullNode,
 		[]provider.Provider{benchmarkFullNode},
 		dbs.New(dbm.NewMemDB(), chainID),
-		types.DefaultVoterParams(),
 		light.Logger(log.TestingLogger()),
 	)
 	if err != nil {
@@ -97,7 +94,6 @@ func BenchmarkBackwards(b *testing.B) {
 		benchmarkFullNode,
 		[]provider.Provider{benchmarkFullNode},
 		dbs.New(dbm.NewMemDB(), chainID),
-		types.DefaultVoterParams(),
 		light.Logger(log.TestingLogger()),
 	)
 	if err != nil {
@@ -6,21 +6,19 @@ import (
 	"testing"
 	"time"
 
-	tmrand "github.com/line/ostracon/libs/rand"
-
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tm-db"
 
-	"github.com/line/ostracon/crypto/ed25519"
 	"github.com/line/ostracon/crypto/vrf"
-	tmbytes "github.com/line/ostracon/libs/bytes"
 	"github.com/line/ostracon/libs/log"
 	"github.com/line/ostracon/light"
 	"github.com/line/ostracon/light/provider"
 	mockp "github.com/line/ostracon/light/provider/mock"
 	dbs "github.com/line/ostracon/light/store/db"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
 	"github.com/line/ostracon/types"
+	"github.com/line/ostracon/version"
 )
 
 const (
@@ -35,16 +33,18 @@ var (
 		VoterElectionThreshold:          4,
 		MaxTolerableByzantinePercentage: 1,
 	}
+	consParams, consHash = genConsensusParams(voterParam)
+
 	bTime, _ = time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
 	h1       = keys.GenSignedHeader(chainID, 1, bTime, nil, vals, vals,
-		hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys), voterParam)
+		hash("app_hash"), consHash, hash("results_hash"), 0, len(keys), voterParam)
 	// 3/3 signed
 	h2 = keys.GenSignedHeaderLastBlockID(chainID, 2, bTime.Add(30*time.Minute), nil, vals, vals,
-		hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys), types.BlockID{Hash: h1.Hash()},
+		hash("app_hash"), consHash, hash("results_hash"), 0, len(keys), h1,
 		voterParam)
 	// 3/3 signed
 	h3 = keys.GenSignedHeaderLastBlockID(chainID, 3, bTime.Add(1*time.Hour), nil, vals, vals,
-		hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys), types.BlockID{Hash: h2.Hash()},
+		hash("app_hash"), consHash, hash("results_hash"), 0, len(keys), h2,
 		voterParam)
 	trustPeriod  = 4 * time.Hour
 	trustOptions = light.TrustOptions{
@@ -64,8 +64,11 @@ var (
 	}
 	voterSet = map[int64]*types.VoterSet{
 		1: types.SelectVoter(vals, proofHash(h1), voterParam),
-		2: types.SelectVoter(vals, proofHash(h2), voterParam),
-		3: types.SelectVoter(vals, proofHash(h3), voterParam),
+		2: types.SelectVoter(vals, proofHash(h1), voterParam),
+		3: types.SelectVoter(vals, proofHash(h2), voterParam),
+	}
+	paramsSet = map[int64]*tmproto.ConsensusParams{
+		1: consParams,
 	}
 	headerSet = map[int64]*types.SignedHeader{
 		1: h1,
@@ -81,6 +84,7 @@ var (
 		headerSet,
 		valSet,
 		voterSet,
+		paramsSet,
 	)
 	deadNode      = mockp.NewDeadMock(chainID)
 	largeFullNode = mockp.New(genMockNode(chainID, 10, 3, 0, bTime))
@@ -144,6 +148,8 @@ func TestClient_SequentialVerification(t *testing.T) {
 	newVals := newKeys.ToValidators(10, 1)
 	newVoters := types.ToVoterAll(newVals.Validators)
 	differentVals, differentVoters, _ := types.RandVoterSet(10, 100)
+	forgedHeader, forgedVoters := genSignedHeaderWithForgedVoters(keys, chainID, 2, bTime.Add(30*time.Minute), nil,
+		vals, vals, hash("app_hash"), consHash, hash("results_hash"), h1, voterParam)
 
 	testCases := []struct {
 		name         string
@@ -166,7 +172,7 @@ func TestClient_SequentialVerification(t *testing.T) {
 			map[int64]*types.SignedHeader{
 				// different header
 				1: keys.GenSignedHeader(chainID, 1, bTime.Add(1*time.Hour), nil, vals, vals,
-					hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys),
+					hash("app_hash"), consHash, hash("results_hash"), 0, len(keys),
 					voterParam),
 			},
 			map[int64]*types.ValidatorSet{
@@ -210,13 +216,11 @@ func TestClient_SequentialVerification(t *testing.T) {
 				// trusted header
 				1: h1,
 				// interim header (1/3 signed)
-				2: keys.GenSignedHeader(chainID, 2, bTime.Add(1*time.Hour), nil, vals, vals,
-					hash("app_hash"), hash("cons_hash"), hash("results_hash"), len(keys)-1, len(keys),
-					voterParam),
+				2: keys.GenSignedHeaderLastBlockID(chainID, 2, bTime.Add(30*time.Minute), nil, vals, vals,
+					hash("app_hash"), consHash, hash("results_hash"), len(keys)-1, len(keys),
+					h1, voterParam),
 				// last header (3/3 signed)
-				3: keys.GenSignedHeader(chainID, 3, bTime.Add(2*time.Hour), nil, vals, vals,
-					hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys),
-					voterParam),
+				3: h3,
 			},
 			valSet,
 			voterSet,
@@ -229,13 +233,11 @@ func TestClient_SequentialVerification(t *testing.T) {
 				// trusted header
 				1: h1,
 				// interim header (3/3 signed)
-				2: keys.GenSignedHeader(chainID, 2, bTime.Add(1*time.Hour), nil, vals, vals,
-					hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys),
-					voterParam),
+				2: h2,
 				// last header (1/3 signed)
-				3: keys.GenSignedHeader(chainID, 3, bTime.Add(2*time.Hour), nil, vals, vals,
-					hash("app_hash"), hash("cons_hash"), hash("results_hash"), len(keys)-1, len(keys),
-					voterParam),
+				3: keys.GenSignedHeaderLastBlockID(chainID, 3, bTime.Add(1*time.Hour), nil, vals, vals,
+					hash("app_hash"), consHash, hash("results_hash"), len(keys)-1, len(keys),
+					h2, voterParam),
 			},
 			valSet,
 			voterSet,
@@ -259,21 +261,21 @@ func TestClient_SequentialVerification(t *testing.T) {
 			true,
 		},
 		{
-			"bad: voters from invalid proof",
+			"bad: voters not elected at height 2",
 			map[int64]*types.SignedHeader{
 				// trusted header
 				1: h1,
-				// voters from invalid proof hash
-				2: genSignedHeaderWithInvalidProof(keys, chainID, 2, bTime.Add(1*time.Hour), nil,
-					vals, vals, []byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys),
-					voterParam),
-				// last header (1/3 signed)
-				3: keys.GenSignedHeader(chainID, 3, bTime.Add(2*time.Hour), nil,
-					vals, vals, []byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys),
-					voterParam),
+				// interim header (3/3 signed by the forged voters)
+				2: forgedHeader,
+				// last header (3/3 signed)
+				3: h3,
 			},
 			valSet,
-			voterSet,
+			map[int64]*types.VoterSet{
+				1: voterSet[1],
+				2: forgedVoters,
+				3: voterSet[3],
+			},
 			false,
 			true,
 		},
@@ -291,15 +293,16 @@ func TestClient_SequentialVerification(t *testing.T) {
 					tc.otherHeaders,
 					tc.vals,
 					tc.voters,
+					paramsSet,
 				),
 				[]provider.Provider{mockp.New(
 					chainID,
 					tc.otherHeaders,
 					tc.vals,
 					tc.voters,
+					paramsSet,
 				)},
 				dbs.New(dbm.NewMemDB(), chainID),
-				voterParam,
 				light.SequentialVerification(),
 				light.Logger(log.TestingLogger()),
 			)
@@ -321,26 +324,178 @@ func TestClient_SequentialVerification(t *testing.T) {
 	}
 }
 
-func genSignedHeaderWithInvalidProof(pkz privKeys, chainID string, height int64, bTime time.Time, txs types.Txs,
-	valset, nextValset *types.ValidatorSet, appHash, consHash, resHash []byte,
-	first, last int, voterParams *types.VoterParams) *types.SignedHeader {
-
-	secret := [64]byte{}
-	privateKey := ed25519.GenPrivKeyFromSecret(secret[:])
-	message := tmrand.Bytes(10)
-	proof, _ := privateKey.VRFProve(message)
-	proofHash, _ := privateKey.PubKey().VRFVerify(proof, message)
-	invalidProofHash := make([]byte, len(proofHash))
-	copy(invalidProofHash, proofHash)
-	invalidProofHash[0] ^= 0x01 // force invalid proof hash
-	voterSet := types.SelectVoter(valset, invalidProofHash, voterParams)
-
-	header := genHeader(chainID, height, bTime, txs, voterSet, valset, nextValset, appHash, consHash, resHash,
-		tmbytes.HexBytes(proof))
+func TestClient_SequentialVerificationVoterParamsChange(t *testing.T) {
+	// the voter params change from height 3
+	newVoterParam := types.DefaultVoterParams()
+	newConsParams, newConsHash := genConsensusParams(newVoterParam)
+	header3 := keys.GenSignedHeaderLastBlockID(chainID, 3, bTime.Add(1*time.Hour), nil, vals, vals,
+		hash("app_hash"), newConsHash, hash("results_hash"), 0, len(keys), h2, newVoterParam)
+	headers := map[int64]*types.SignedHeader{
+		1: h1,
+		2: h2,
+		3: header3,
+	}
+	voters := map[int64]*types.VoterSet{
+		1: voterSet[1],
+		2: voterSet[2],
+		3: types.SelectVoter(vals, proofHash(h2), newVoterParam),
+	}
+	require.NotEqual(t, types.SelectVoter(vals, proofHash(h2), voterParam).Hash(), voters[3].Hash())
+
+	testCases := []struct {
+		name   string
+		params map[int64]*tmproto.ConsensusParams
+		err    bool
+	}{
+		{
+			"new params from height 3",
+			map[int64]*tmproto.ConsensusParams{1: consParams, 3: newConsParams},
+			false,
+		},
+		{
+			"old params at height 3",
+			paramsSet,
+			true,
+		},
+		{
+			"new params from height 2",
+			map[int64]*tmproto.ConsensusParams{1: consParams, 2: newConsParams},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			node := mockp.New(chainID, headers, valSet, voters, tc.params)
+			c, err := light.NewClient(
+				ctx,
+				chainID,
+				trustOptions,
+				node,
+				[]provider.Provider{node},
+				dbs.New(dbm.NewMemDB(), chainID),
+				light.SequentialVerification(),
+				light.Logger(log.TestingLogger()),
+			)
+			require.NoError(t, err)
+
+			_, err = c.VerifyLightBlockAtHeight(ctx, 3, bTime.Add(3*time.Hour))
+			if tc.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestClient_SequentialVerificationGenesisParams(t *testing.T) {
+	// the ConsensusHash of the blocks before BlockProtocolExtendedParamsHash doesn't commit to the voter params, so
+	// the client elects their voters with the genesis params and never asks the provider for the consensus params
+	blockVersion := version.BlockProtocolExtendedParamsHash - 1
+	params, _ := genConsensusParams(voterParam)
+	legacyConsHash := types.HashConsensusParams(*params, blockVersion)
+	header1 := keys.GenSignedHeader(chainID, 1, bTime, nil, vals, vals,
+		hash("app_hash"), legacyConsHash, hash("results_hash"), 0, len(keys), voterParam)
+	voters := map[int64]*types.VoterSet{
+		1: types.SelectVoter(vals, proofHash(header1), voterParam),
+		2: types.SelectVoter(vals, proofHash(header1), voterParam),
+	}
+	header1 = withBlockVersion(keys, header1, voters[1], blockVersion)
+	header2 := withBlockVersion(keys, keys.GenSignedHeaderLastBlockID(chainID, 2, bTime.Add(30*time.Minute), nil,
+		vals, vals, hash("app_hash"), legacyConsHash, hash("results_hash"), 0, len(keys), header1, voterParam),
+		voters[2], blockVersion)
+	voters[3] = types.SelectVoter(vals, proofHash(header2), voterParam)
+	header3 := withBlockVersion(keys, keys.GenSignedHeaderLastBlockID(chainID, 3, bTime.Add(1*time.Hour), nil,
+		vals, vals, hash("app_hash"), legacyConsHash, hash("results_hash"), 0, len(keys), header2, voterParam),
+		voters[3], blockVersion)
+	headers := map[int64]*types.SignedHeader{
+		1: header1,
+		2: header2,
+		3: header3,
+	}
+
+	testCases := []struct {
+		name    string
+		options []light.Option
+		err     bool
+	}{
+		{
+			"genesis params",
+			[]light.Option{light.GenesisParams(voterParam, "")},
+			false,
+		},
+		{
+			"default params",
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			// no consensus params to serve
+			node := mockp.New(chainID, headers, valSet, voters, nil)
+			options := append([]light.Option{
+				light.SequentialVerification(),
+				light.Logger(log.TestingLogger()),
+			}, tc.options...)
+			c, err := light.NewClient(
+				ctx,
+				chainID,
+				light.TrustOptions{Period: trustPeriod, Height: 1, Hash: header1.Hash()},
+				node,
+				[]provider.Provider{node},
+				dbs.New(dbm.NewMemDB(), chainID),
+				options...,
+			)
+			require.NoError(t, err)
+
+			_, err = c.VerifyLightBlockAtHeight(ctx, 3, bTime.Add(3*time.Hour))
+			if tc.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// withBlockVersion returns sh of blockVersion, which is signed by voterSet.
+func withBlockVersion(pkz privKeys, sh *types.SignedHeader, voterSet *types.VoterSet,
+	blockVersion uint64) *types.SignedHeader {
+
+	header := sh.Header
+	header.Version.Block = blockVersion
 	return &types.SignedHeader{
 		Header: header,
-		Commit: pkz.signHeader(header, voterSet, first, last),
+		Commit: pkz.signHeader(header, voterSet, 0, len(pkz)),
+	}
+}
+
+// genSignedHeaderWithForgedVoters generates a header linking to lastHeader with the elected proposer and its valid
+// proof, but whose voters are the validators that are not elected. The header is signed by the forged voters.
+func genSignedHeaderWithForgedVoters(pkz privKeys, chainID string, height int64, bTime time.Time, txs types.Txs,
+	valset, nextValset *types.ValidatorSet, appHash, consHash, resHash []byte,
+	lastHeader *types.SignedHeader, voterParams *types.VoterParams) (*types.SignedHeader, *types.VoterSet) {
+
+	header := pkz.GenSignedHeaderLastBlockID(chainID, height, bTime, txs, valset, nextValset, appHash, consHash,
+		resHash, 0, len(pkz), lastHeader, voterParams).Header
+	elected := types.SelectVoter(valset, proofHash(lastHeader), voterParams)
+	forged := make([]*types.Validator, 0, valset.Size())
+	for _, val := range valset.Validators {
+		if idx, _ := elected.GetByAddress(val.Address); idx < 0 {
+			forged = append(forged, val)
+		}
 	}
+	voterSet := types.ToVoterAll(forged)
+	header.VotersHash = voterSet.Hash()
+	return &types.SignedHeader{
+		Header: header,
+		Commit: pkz.signHeader(header, voterSet, 0, len(pkz)),
+	}, voterSet
 }
 
 func TestClient_SkippingVerification(t *testing.T) {
@@ -383,7 +538,7 @@ func TestClient_SkippingVerification(t *testing.T) {
 				// trusted header
 				1: h1,
 				3: transitKeys.GenSignedHeader(chainID, 3, bTime.Add(2*time.Hour), nil, transitVals, transitVals,
-					hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(transitKeys),
+					hash("app_hash"), consHash, hash("results_hash"), 0, len(transitKeys),
 					voterParam),
 			},
 			map[int64]*types.ValidatorSet{
@@ -406,11 +561,11 @@ func TestClient_SkippingVerification(t *testing.T) {
 				1: h1,
 				// interim header (3/3 signed)
 				2: keys.GenSignedHeader(chainID, 2, bTime.Add(1*time.Hour), nil, vals, newVals,
-					hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys),
+					hash("app_hash"), consHash, hash("results_hash"), 0, len(keys),
 					voterParam),
 				// last header (0/4 of the original voter set signed)
 				3: newKeys.GenSignedHeader(chainID, 3, bTime.Add(2*time.Hour), nil, newVals, newVals,
-					hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(newKeys),
+					hash("app_hash"), consHash, hash("results_hash"), 0, len(newKeys),
 					voterParam),
 			},
 			map[int64]*types.ValidatorSet{
@@ -433,11 +588,11 @@ func TestClient_SkippingVerification(t *testing.T) {
 				1: h1,
 				// last header (0/4 of the original val set signed)
 				2: keys.GenSignedHeader(chainID, 2, bTime.Add(1*time.Hour), nil, vals, newVals,
-					hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, 0,
+					hash("app_hash"), consHash, hash("results_hash"), 0, 0,
 					voterParam),
 				// last header (0/4 of the original val set signed)
 				3: newKeys.GenSignedHeader(chainID, 3, bTime.Add(2*time.Hour), nil, newVals, newVals,
-					hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(newKeys),
+					hash("app_hash"), consHash, hash("results_hash"), 0, len(newKeys),
 					voterParam),
 			},
 			map[int64]*types.ValidatorSet{
@@ -467,15 +622,16 @@ func TestClient_SkippingVerification(t *testing.T) {
 					tc.otherHeaders,
 					tc.vals,
 					tc.voters,
+					paramsSet,
 				),
 				[]provider.Provider{mockp.New(
 					chainID,
 					tc.otherHeaders,
 					tc.vals,
 					tc.voters,
+					paramsSet,
 				)},
 				dbs.New(dbm.NewMemDB(), chainID),
-				voterParam,
 				light.SkippingVerification(light.DefaultTrustLevel),
 				light.Logger(log.TestingLogger()),
 			)
@@ -514,7 +670,6 @@ func TestClientLargeBisectionVerification(t *testing.T) {
 		veryLargeFullNode,
 		[]provider.Provider{veryLargeFullNode},
 		dbs.New(dbm.NewMemDB(), chainID),
-		voterParam,
 		light.SequentialVerification(),
 	)
 	require.NoError(t, err)
@@ -537,7 +692,6 @@ func TestClientBisectionBetweenTrustedHeaders(t *testing.T) {
 		fullNode,
 		[]provider.Provider{fullNode},
 		dbs.New(dbm.NewMemDB(), chainID),
-		voterParam,
 		light.SequentialVerification(),
 	)
 	require.NoError(t, err)
@@ -562,7 +716,6 @@ func TestClient_Cleanup(t *testing.T) {
 		fullNode,
 		[]provider.Provider{fullNode},
 		dbs.New(dbm.NewMemDB(), chainID),
-		voterParam,
 		light.Logger(log.TestingLogger()),
 	)
 	require.NoError(t, err)
@@ -593,7 +746,6 @@ func TestClientRestoresTrustedHeaderAfterStartup1(t *testing.T) {
 			fullNode,
 			[]provider.Provider{fullNode},
 			trustedStore,
-			voterParam,
 			light.Logger(log.TestingLogger()),
 		)
 		require.NoError(t, err)
@@ -613,7 +765,7 @@ func TestClientRestoresTrustedHeaderAfterStartup1(t *testing.T) {
 
 		// header1 != h1
 		header1 := keys.GenSignedHeader(chainID, 1, bTime.Add(1*time.Hour), nil, vals, vals,
-			hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys),
+			hash("app_hash"), consHash, hash("results_hash"), 0, len(keys),
 			voterParam)
 
 		primary := mockp.New(
@@ -626,6 +778,7 @@ func TestClientRestoresTrustedHeaderAfterStartup1(t *testing.T) {
 			map[int64]*types.VoterSet{
 				1: types.SelectVoter(valSet[1], proofHash(header1), voterParam),
 			},
+			paramsSet,
 		)
 
 		c, err := light.NewClient(
@@ -639,7 +792,6 @@ func TestClientRestoresTrustedHeaderAfterStartup1(t *testing.T) {
 			primary,
 			[]provider.Provider{primary},
 			trustedStore,
-			voterParam,
 			light.Logger(log.TestingLogger()),
 		)
 		require.NoError(t, err)
@@ -672,7 +824,6 @@ func TestClientRestoresTrustedHeaderAfterStartup2(t *testing.T) {
 			fullNode,
 			[]provider.Provider{fullNode},
 			trustedStore,
-			voterParam,
 			light.Logger(log.TestingLogger()),
 		)
 		require.NoError(t, err)
@@ -694,11 +845,11 @@ func TestClientRestoresTrustedHeaderAfterStartup2(t *testing.T) {
 
 		// header1 != header
 		diffHeader1 := keys.GenSignedHeader(chainID, 1, bTime.Add(1*time.Hour), nil, vals, vals,
-			hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys),
+			hash("app_hash"), consHash, hash("results_hash"), 0, len(keys),
 			voterParam)
 
 		diffHeader2 := keys.GenSignedHeader(chainID, 2, bTime.Add(2*time.Hour), nil, vals, vals,
-			hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys),
+			hash("app_hash"), consHash, hash("results_hash"), 0, len(keys),
 			voterParam)
 
 		primary := mockp.New(
@@ -712,6 +863,7 @@ func TestClientRestoresTrustedHeaderAfterStartup2(t *testing.T) {
 				1: types.SelectVoter(valSet[1], proofHash(diffHeader1), voterParam),
 				2: types.SelectVoter(valSet[2], proofHash(diffHeader2), voterParam),
 			},
+			paramsSet,
 		)
 
 		c, err := light.NewClient(
@@ -725,7 +877,6 @@ func TestClientRestoresTrustedHeaderAfterStartup2(t *testing.T) {
 			primary,
 			[]provider.Provider{primary},
 			trustedStore,
-			voterParam,
 			light.Logger(log.TestingLogger()),
 		)
 		require.NoError(t, err)
@@ -756,7 +907,6 @@ func TestClientRestoresTrustedHeaderAfterStartup3(t *testing.T) {
 			fullNode,
 			[]provider.Provider{fullNode},
 			trustedStore,
-			voterParam,
 			light.Logger(log.TestingLogger()),
 		)
 		require.NoError(t, err)
@@ -787,10 +937,10 @@ func TestClientRestoresTrustedHeaderAfterStartup3(t *testing.T) {
 
 		// header1 != header
 		header1 := keys.GenSignedHeader(chainID, 1, bTime.Add(1*time.Hour), nil, vals, vals,
-			hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys), voterParam)
+			hash("app_hash"), consHash, hash("results_hash"), 0, len(keys), voterParam)
 
 		header2 := keys.GenSignedHeader(chainID, 2, bTime.Add(2*time.Hour), nil, vals, vals,
-			hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys), voterParam)
+			hash("app_hash"), consHash, hash("results_hash"), 0, len(keys), voterParam)
 		err = trustedStore.SaveLightBlock(&types.LightBlock{
 			SignedHeader: header2,
 			VoterSet:     voterSet[2],
@@ -806,6 +956,7 @@ func TestClientRestoresTrustedHeaderAfterStartup3(t *testing.T) {
 			map[int64]*types.VoterSet{
 				1: types.SelectVoter(valSet[1], proofHash(header1), voterParam),
 			},
+			paramsSet,
 		)
 
 		c, err := light.NewClient(
@@ -819,7 +970,6 @@ func TestClientRestoresTrustedHeaderAfterStartup3(t *testing.T) {
 			primary,
 			[]provider.Provider{primary},
 			trustedStore,
-			voterParam,
 			light.Logger(log.TestingLogger()),
 		)
 		require.NoError(t, err)
@@ -846,7 +996,6 @@ func TestClient_Update(t *testing.T) {
 		fullNode,
 		[]provider.Provider{fullNode},
 		dbs.New(dbm.NewMemDB(), chainID),
-		voterParam,
 		light.Logger(log.TestingLogger()),
 	)
 	require.NoError(t, err)
@@ -868,7 +1017,6 @@ func TestClient_Concurrency(t *testing.T) {
 		fullNode,
 		[]provider.Provider{fullNode},
 		dbs.New(dbm.NewMemDB(), chainID),
-		voterParam,
 		light.Logger(log.TestingLogger()),
 	)
 	require.NoError(t, err)
@@ -910,7 +1058,6 @@ func TestClientReplacesPrimaryWithWitnessIfPrimaryIsUnavailable(t *testing.T) {
 		deadNode,
 		[]provider.Provider{fullNode, fullNode},
 		dbs.New(dbm.NewMemDB(), chainID),
-		voterParam,
 		light.Logger(log.TestingLogger()),
 		light.MaxRetryAttempts(1),
 	)
@@ -937,7 +1084,6 @@ func TestClient_BackwardsVerification(t *testing.T) {
 			largeFullNode,
 			[]provider.Provider{largeFullNode},
 			dbs.New(dbm.NewMemDB(), chainID),
-			voterParam,
 			light.Logger(log.TestingLogger()),
 		)
 		require.NoError(t, err)
@@ -987,12 +1133,13 @@ func TestClient_BackwardsVerification(t *testing.T) {
 					map[int64]*types.SignedHeader{
 						1: h1,
 						2: keys.GenSignedHeader(chainID, 1, bTime.Add(30*time.Minute), nil, vals, vals,
-							hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys),
+							hash("app_hash"), consHash, hash("results_hash"), 0, len(keys),
 							voterParam),
 						3: h3,
 					},
 					valSet,
 					voterSet,
+					paramsSet,
 				),
 			},
 			{
@@ -1008,6 +1155,7 @@ func TestClient_BackwardsVerification(t *testing.T) {
 					},
 					valSet,
 					voterSet,
+					paramsSet,
 				),
 			},
 		}
@@ -1024,7 +1172,6 @@ func TestClient_BackwardsVerification(t *testing.T) {
 				tc.provider,
 				[]provider.Provider{tc.provider},
 				dbs.New(dbm.NewMemDB(), chainID),
-				voterParam,
 				light.Logger(log.TestingLogger()),
 			)
 			require.NoError(t, err, idx)
@@ -1047,7 +1194,6 @@ func TestClient_NewClientFromTrustedStore(t *testing.T) {
 		deadNode,
 		[]provider.Provider{deadNode},
 		db,
-		voterParam,
 	)
 	require.NoError(t, err)
 
@@ -1061,8 +1207,8 @@ func TestClient_NewClientFromTrustedStore(t *testing.T) {
 func TestClientRemovesWitnessIfItSendsUsIncorrectHeader(t *testing.T) {
 	// different headers hash then primary plus less than 1/3 signed (no fork)
 	h2 := keys.GenSignedHeaderLastBlockID(chainID, 2, bTime.Add(30*time.Minute), nil, vals, vals,
-		hash("app_hash2"), hash("cons_hash"), hash("results_hash"),
-		len(keys), len(keys), types.BlockID{Hash: h1.Hash()}, voterParam)
+		hash("app_hash2"), consHash, hash("results_hash"),
+		len(keys), len(keys), h1, voterParam)
 	badProvider1 := mockp.New(
 		chainID,
 		map[int64]*types.SignedHeader{
@@ -1075,8 +1221,9 @@ func TestClientRemovesWitnessIfItSendsUsIncorrectHeader(t *testing.T) {
 		},
 		map[int64]*types.VoterSet{
 			1: voterSet[1],
-			2: types.SelectVoter(vals, proofHash(h2), voterParam),
+			2: types.SelectVoter(vals, proofHash(h1), voterParam),
 		},
+		paramsSet,
 	)
 	// header is empty
 	badProvider2 := mockp.New(
@@ -1091,8 +1238,9 @@ func TestClientRemovesWitnessIfItSendsUsIncorrectHeader(t *testing.T) {
 		},
 		map[int64]*types.VoterSet{
 			1: voterSet[1],
-			2: types.SelectVoter(vals, proofHash(h2), voterParam),
+			2: types.SelectVoter(vals, proofHash(h1), voterParam),
 		},
+		paramsSet,
 	)
 
 	lb1, err := badProvider1.LightBlock(ctx, 2)
@@ -1106,7 +1254,6 @@ func TestClientRemovesWitnessIfItSendsUsIncorrectHeader(t *testing.T) {
 		fullNode,
 		[]provider.Provider{badProvider1, badProvider2},
 		dbs.New(dbm.NewMemDB(), chainID),
-		voterParam,
 		light.Logger(log.TestingLogger()),
 		light.MaxRetryAttempts(1),
 	)
@@ -1139,8 +1286,8 @@ func TestClient_TrustedValidatorSet(t *testing.T) {
 			// 3/3 signed, but voter set at height 2 below is invalid -> witness
 			// should be removed.
 			2: keys.GenSignedHeaderLastBlockID(chainID, 2, bTime.Add(30*time.Minute), nil, vals, vals,
-				hash("app_hash2"), hash("cons_hash"), hash("results_hash"),
-				0, len(keys), types.BlockID{Hash: h1.Hash()}, voterParam),
+				hash("app_hash2"), consHash, hash("results_hash"),
+				0, len(keys), h1, voterParam),
 			3: h3,
 		},
 		map[int64]*types.ValidatorSet{
@@ -1153,6 +1300,7 @@ func TestClient_TrustedValidatorSet(t *testing.T) {
 			2: differentVoters,
 			3: differentVoters,
 		},
+		paramsSet,
 	)
 
 	c, err := light.NewClient(
@@ -1162,7 +1310,6 @@ func TestClient_TrustedValidatorSet(t *testing.T) {
 		fullNode,
 		[]provider.Provider{badVoterSetNode, fullNode},
 		dbs.New(dbm.NewMemDB(), chainID),
-		voterParam,
 		light.Logger(log.TestingLogger()),
 	)
 	require.NoError(t, err)
@@ -1181,7 +1328,6 @@ func TestClientPrunesHeadersAndValidatorSets(t *testing.T) {
 		fullNode,
 		[]provider.Provider{fullNode},
 		dbs.New(dbm.NewMemDB(), chainID),
-		voterParam,
 		light.Logger(log.TestingLogger()),
 		light.PruningSize(1),
 	)
@@ -1263,6 +1409,7 @@ func TestClientEnsureValidHeadersAndValSets(t *testing.T) {
 			tc.headers,
 			tc.vals,
 			tc.voters,
+			paramsSet,
 		)
 		c, err := light.NewClient(
 			ctx,
@@ -1271,7 +1418,6 @@ func TestClientEnsureValidHeadersAndValSets(t *testing.T) {
 			badNode,
 			[]provider.Provider{badNode, badNode},
 			dbs.New(dbm.NewMemDB(), chainID),
-			voterParam,
 			light.MaxRetryAttempts(1),
 		)
 		require.NoError(t, err)
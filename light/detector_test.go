@@ -29,8 +29,9 @@ func TestLightClientAttackEvidence_Lunatic(t *testing.T) {
 		primaryVoters     = make(map[int64]*types.VoterSet, latestHeight)
 	)
 
-	witnessHeaders, witnessValidators, witnessVoters, chainKeys := genMockNodeWithKeys(chainID, latestHeight, valSize, 2, bTime)
-	witness := mockp.New(chainID, witnessHeaders, witnessValidators, witnessVoters)
+	witnessHeaders, witnessValidators, witnessVoters, params, chainKeys := genMockNodeWithKeys(chainID, latestHeight, valSize, 2, bTime)
+	witness := mockp.New(chainID, witnessHeaders, witnessValidators, witnessVoters, params)
+	_, consHash := genConsensusParams(types.DefaultVoterParams())
 	forgedKeys := chainKeys[divergenceHeight-1].ChangeKeys(3) // we change 3 out of the 5 validators (still 2/5 remain)
 	forgedVals := forgedKeys.ToValidators(2, 0)
 
@@ -42,13 +43,13 @@ func TestLightClientAttackEvidence_Lunatic(t *testing.T) {
 			continue
 		}
 		primaryHeaders[height] = forgedKeys.GenSignedHeader(chainID, height, bTime.Add(time.Duration(height)*time.Minute),
-			nil, forgedVals, forgedVals, hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(forgedKeys),
+			nil, forgedVals, forgedVals, hash("app_hash"), consHash, hash("results_hash"), 0, len(forgedKeys),
 			types.DefaultVoterParams())
 		primaryValidators[height] = forgedVals
 		primaryVoters[height] = types.SelectVoter(primaryValidators[height], proofHash(primaryHeaders[height]), types.DefaultVoterParams())
 	}
 
-	primary := mockp.New(chainID, primaryHeaders, primaryValidators, primaryVoters)
+	primary := mockp.New(chainID, primaryHeaders, primaryValidators, primaryVoters, params)
 
 	c, err := light.NewClient(
 		ctx,
@@ -61,7 +62,6 @@ func TestLightClientAttackEvidence_Lunatic(t *testing.T) {
 		primary,
 		[]provider.Provider{witness},
 		dbs.New(dbm.NewMemDB(), chainID),
-		types.DefaultVoterParams(),
 		light.Logger(log.TestingLogger()),
 		light.MaxRetryAttempts(1),
 	)
@@ -118,8 +118,9 @@ func TestLightClientAttackEvidence_Equivocation(t *testing.T) {
 			primaryVoters     = make(map[int64]*types.VoterSet, latestHeight)
 		)
 		// validators don't change in this network (however we still use a map just for convenience)
-		witnessHeaders, witnessValidators, witnessVoters, chainKeys := genMockNodeWithKeys(chainID, latestHeight+2, valSize, 2, bTime)
-		witness := mockp.New(chainID, witnessHeaders, witnessValidators, witnessVoters)
+		witnessHeaders, witnessValidators, witnessVoters, params, chainKeys := genMockNodeWithKeys(chainID, latestHeight+2, valSize, 2, bTime)
+		witness := mockp.New(chainID, witnessHeaders, witnessValidators, witnessVoters, params)
+		_, consHash := genConsensusParams(types.DefaultVoterParams())
 
 		for height := int64(1); height <= latestHeight; height++ {
 			if height < divergenceHeight {
@@ -133,12 +134,12 @@ func TestLightClientAttackEvidence_Equivocation(t *testing.T) {
 			primaryHeaders[height] = chainKeys[height].GenSignedHeader(chainID, height,
 				bTime.Add(time.Duration(height)*time.Minute), []types.Tx{[]byte("abcd")},
 				witnessValidators[height], witnessValidators[height+1], hash("app_hash"),
-				hash("cons_hash"), hash("results_hash"), 0, len(chainKeys[height])-1,
+				consHash, hash("results_hash"), 0, len(chainKeys[height])-1,
 				types.DefaultVoterParams())
 			primaryValidators[height] = witnessValidators[height]
 			primaryVoters[height] = witnessVoters[height]
 		}
-		primary := mockp.New(chainID, primaryHeaders, primaryValidators, primaryVoters)
+		primary := mockp.New(chainID, primaryHeaders, primaryValidators, primaryVoters, params)
 
 		c, err := light.NewClient(
 			ctx,
@@ -151,7 +152,6 @@ func TestLightClientAttackEvidence_Equivocation(t *testing.T) {
 			primary,
 			[]provider.Provider{witness},
 			dbs.New(dbm.NewMemDB(), chainID),
-			types.DefaultVoterParams(),
 			light.Logger(log.TestingLogger()),
 			light.MaxRetryAttempts(1),
 			verificationOption,
@@ -209,7 +209,6 @@ func TestClientDivergentTraces1(t *testing.T) {
 		primary,
 		[]provider.Provider{witness},
 		dbs.New(dbm.NewMemDB(), chainID),
-		types.DefaultVoterParams(),
 		light.Logger(log.TestingLogger()),
 		light.MaxRetryAttempts(1),
 	)
@@ -234,7 +233,6 @@ func TestClientDivergentTraces2(t *testing.T) {
 		primary,
 		[]provider.Provider{deadNode, deadNode, primary},
 		dbs.New(dbm.NewMemDB(), chainID),
-		types.DefaultVoterParams(),
 		light.Logger(log.TestingLogger()),
 		light.MaxRetryAttempts(1),
 	)
@@ -248,17 +246,17 @@ func TestClientDivergentTraces2(t *testing.T) {
 // 3. witness has the same first header, but different second header
 // => creation should succeed, but the verification should fail
 func TestClientDivergentTraces3(t *testing.T) {
-	_, primaryHeaders, primaryVals, primaryVoters := genMockNode(chainID, 10, 5, 2, bTime)
-	primary := mockp.New(chainID, primaryHeaders, primaryVals, primaryVoters)
+	_, primaryHeaders, primaryVals, primaryVoters, params := genMockNode(chainID, 10, 5, 2, bTime)
+	primary := mockp.New(chainID, primaryHeaders, primaryVals, primaryVoters, params)
 
 	firstBlock, err := primary.LightBlock(ctx, 1)
 	require.NoError(t, err)
 
-	_, mockHeaders, mockVals, mockVoters := genMockNode(chainID, 10, 5, 2, bTime)
+	_, mockHeaders, mockVals, mockVoters, _ := genMockNode(chainID, 10, 5, 2, bTime)
 	mockHeaders[1] = primaryHeaders[1]
 	mockVals[1] = primaryVals[1]
 	mockVoters[1] = primaryVoters[1]
-	witness := mockp.New(chainID, mockHeaders, mockVals, mockVoters)
+	witness := mockp.New(chainID, mockHeaders, mockVals, mockVoters, params)
 
 	c, err := light.NewClient(
 		ctx,
@@ -271,7 +269,6 @@ func TestClientDivergentTraces3(t *testing.T) {
 		primary,
 		[]provider.Provider{witness},
 		dbs.New(dbm.NewMemDB(), chainID),
-		types.DefaultVoterParams(),
 		light.Logger(log.TestingLogger()),
 		light.MaxRetryAttempts(1),
 	)
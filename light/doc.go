@@ -97,6 +97,10 @@ Verify function verifies a new header against some trusted header. See
 https://github.com/tendermint/spec/blob/master/spec/consensus/light-client/verification.md
 for details.
 
+VerifyElection function verifies that the proposer and the voters of a new header are the ones
+elected from its validator set with the VRF proof of the previous header. The light client
+verifies the election of every header it verifies, fetching the previous light block if needed.
+
 There are two methods of verification: sequential and bisection
 
 Sequential uses the headers hashes and the validator sets to verify each adjacent header until
@@ -9,7 +9,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/line/ostracon/types"
 
 	dbm "github.com/tendermint/tm-db"
 
@@ -64,7 +63,6 @@ func ExampleClient_Update() {
 		primary,
 		[]provider.Provider{primary}, // NOTE: primary should not be used here
 		dbs.New(db, chainID),
-		types.DefaultVoterParams(),
 		light.Logger(log.TestingLogger()),
 	)
 	if err != nil {
@@ -133,7 +131,6 @@ func ExampleClient_VerifyLightBlockAtHeight() {
 		primary,
 		[]provider.Provider{primary}, // NOTE: primary should not be used here
 		dbs.New(db, chainID),
-		types.DefaultVoterParams(),
 		light.Logger(log.TestingLogger()),
 	)
 	if err != nil {
@@ -233,27 +233,41 @@ func (pkz privKeys) GenSignedHeaderByRate(chainID string, height int64, bTime ti
 	}
 }
 
-// GenSignedHeaderLastBlockID calls genHeader and signHeader and combines them into a SignedHeader.
+// GenSignedHeaderLastBlockID calls genHeader and signHeader and combines them into a SignedHeader, which links
+// to lastHeader. The proposer and the voters are elected from valset with the VRF output of the proof in lastHeader,
+// and the proposer, which must be one of pkz, proves the header.
 func (pkz privKeys) GenSignedHeaderLastBlockID(chainID string, height int64, bTime time.Time, txs types.Txs,
 	valset, nextValset *types.ValidatorSet, appHash, consHash, resHash []byte, first, last int,
-	lastBlockID types.BlockID, voterParams *types.VoterParams) *types.SignedHeader {
-
-	secret := [64]byte{}
-	privateKey := ed25519.GenPrivKeyFromSecret(secret[:])
-	message := rand.Bytes(10)
-	proof, _ := privateKey.VRFProve(message)
-	proofHash, _ := privateKey.PubKey().VRFVerify(proof, message)
-	voterSet := types.SelectVoter(valset, proofHash, voterParams)
+	lastHeader *types.SignedHeader, voterParams *types.VoterParams) *types.SignedHeader {
+
+	lastProofHash := proofHash(lastHeader)
+	proposer := valset.SelectProposer(lastProofHash, height, 0)
+	var proof crypto.Proof
+	for _, privKey := range pkz {
+		if bytes.Equal(privKey.PubKey().Address(), proposer.Address) {
+			proof, _ = privKey.VRFProve(types.MakeRoundHash(lastProofHash, height-1, 0))
+		}
+	}
+	voterSet := types.SelectVoter(valset, lastProofHash, voterParams)
 
 	header := genHeader(chainID, height, bTime, txs, voterSet, valset, nextValset, appHash, consHash, resHash,
 		tmbytes.HexBytes(proof))
-	header.LastBlockID = lastBlockID
+	header.LastBlockID = types.BlockID{Hash: lastHeader.Hash()}
+	header.ProposerAddress = proposer.Address
 	return &types.SignedHeader{
 		Header: header,
 		Commit: pkz.signHeader(header, voterSet, first, last),
 	}
 }
 
+// genConsensusParams returns the default consensus params with voterParams, and
+// the hash of them for the ConsensusHash of the headers.
+func genConsensusParams(voterParams *types.VoterParams) (*tmproto.ConsensusParams, []byte) {
+	params := types.DefaultConsensusParams()
+	params.Voter = *voterParams.ToProto()
+	return params, types.HashConsensusParams(*params, version.BlockProtocol)
+}
+
 func (pkz privKeys) ChangeKeys(delta int) privKeys {
 	newKeys := pkz[delta:]
 	return newKeys.Extend(delta)
@@ -271,17 +285,19 @@ func genMockNodeWithKeys(
 	map[int64]*types.SignedHeader,
 	map[int64]*types.ValidatorSet,
 	map[int64]*types.VoterSet,
+	map[int64]*tmproto.ConsensusParams,
 	map[int64]privKeys) {
 
 	var (
-		headers         = make(map[int64]*types.SignedHeader, blockSize)
-		valSet          = make(map[int64]*types.ValidatorSet, blockSize+1)
-		voterSet        = make(map[int64]*types.VoterSet, blockSize+1)
-		keymap          = make(map[int64]privKeys, blockSize+1)
-		keys            = genPrivKeys(valSize)
-		totalVariation  = valVariation
-		valVariationInt int
-		newKeys         privKeys
+		headers          = make(map[int64]*types.SignedHeader, blockSize)
+		valSet           = make(map[int64]*types.ValidatorSet, blockSize+1)
+		voterSet         = make(map[int64]*types.VoterSet, blockSize+1)
+		keymap           = make(map[int64]privKeys, blockSize+1)
+		keys             = genPrivKeys(valSize)
+		totalVariation   = valVariation
+		valVariationInt  int
+		newKeys          privKeys
+		params, consHash = genConsensusParams(types.DefaultVoterParams())
 	)
 
 	valVariationInt = int(totalVariation)
@@ -294,7 +310,7 @@ func genMockNodeWithKeys(
 	valSet[1] = keys.ToValidators(2, 2)
 	lastHeader := keys.GenSignedHeader(chainID, 1, bTime.Add(1*time.Minute), nil,
 		valSet[1], newKeys.ToValidators(2, 2),
-		hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys), types.DefaultVoterParams())
+		hash("app_hash"), consHash, hash("results_hash"), 0, len(keys), types.DefaultVoterParams())
 	currentHeader := lastHeader
 	headers[1] = currentHeader
 	voterSet[1] = types.SelectVoter(valSet[1], proofHash(headers[1]), types.DefaultVoterParams())
@@ -309,19 +325,19 @@ func genMockNodeWithKeys(
 		currentHeader = keys.GenSignedHeaderLastBlockID(chainID, height, bTime.Add(time.Duration(height)*time.Minute),
 			nil,
 			valSet[height], newKeys.ToValidators(2, 2),
-			hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys),
-			types.BlockID{Hash: lastHeader.Hash()}, types.DefaultVoterParams())
+			hash("app_hash"), consHash, hash("results_hash"), 0, len(keys),
+			lastHeader, types.DefaultVoterParams())
 		if !bytes.Equal(currentHeader.Hash(), currentHeader.Commit.BlockID.Hash) {
 			panic(fmt.Sprintf("commit hash didn't match: %X != %X", currentHeader.Hash(), currentHeader.Commit.BlockID.Hash))
 		}
 		headers[height] = currentHeader
-		voterSet[height] = types.SelectVoter(valSet[height], proofHash(headers[height]), types.DefaultVoterParams())
+		voterSet[height] = types.SelectVoter(valSet[height], proofHash(lastHeader), types.DefaultVoterParams())
 		lastHeader = currentHeader
 		keys = newKeys
 		keymap[height+1] = keys
 	}
 
-	return headers, valSet, voterSet, keymap
+	return headers, valSet, voterSet, map[int64]*tmproto.ConsensusParams{1: params}, keymap
 }
 
 func genMockNode(
@@ -333,9 +349,10 @@ func genMockNode(
 	string,
 	map[int64]*types.SignedHeader,
 	map[int64]*types.ValidatorSet,
-	map[int64]*types.VoterSet) {
-	headers, valset, voterset, _ := genMockNodeWithKeys(chainID, blockSize, valSize, valVariation, bTime)
-	return chainID, headers, valset, voterset
+	map[int64]*types.VoterSet,
+	map[int64]*tmproto.ConsensusParams) {
+	headers, valset, voterset, params, _ := genMockNodeWithKeys(chainID, blockSize, valSize, valVariation, bTime)
+	return chainID, headers, valset, voterset, params
 }
 
 func hash(s string) []byte {
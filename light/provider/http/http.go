@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/line/ostracon/light/provider"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
 	rpcclient "github.com/line/ostracon/rpc/client"
 	rpchttp "github.com/line/ostracon/rpc/client/http"
 	"github.com/line/ostracon/types"
@@ -94,6 +95,28 @@ func (p *http) LightBlock(ctx context.Context, height int64) (*types.LightBlock,
 	return lb, nil
 }
 
+// ConsensusParams calls `/consensus_params` endpoint.
+func (p *http) ConsensusParams(ctx context.Context, height int64) (*tmproto.ConsensusParams, error) {
+	if height <= 0 {
+		return nil, fmt.Errorf("expected height > 0, got height %d", height)
+	}
+
+	for attempt := 1; attempt <= maxRetryAttempts; attempt++ {
+		res, err := p.client.ConsensusParams(ctx, &height)
+		if err != nil {
+			// TODO: standardize errors on the RPC side
+			if regexpMissingHeight.MatchString(err.Error()) {
+				return nil, provider.ErrLightBlockNotFound
+			}
+			// we wait and try again with exponential backoff
+			time.Sleep(backoffTimeout(uint16(attempt)))
+			continue
+		}
+		return &res.ConsensusParams, nil
+	}
+	return nil, provider.ErrNoResponse
+}
+
 // ReportEvidence calls `/broadcast_evidence` endpoint.
 func (p *http) ReportEvidence(ctx context.Context, ev types.Evidence) error {
 	_, err := p.client.BroadcastEvidence(ctx, ev)
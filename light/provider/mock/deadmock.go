@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/line/ostracon/light/provider"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
 	"github.com/line/ostracon/types"
 )
 
@@ -27,6 +28,10 @@ func (p *deadMock) LightBlock(_ context.Context, height int64) (*types.LightBloc
 	return nil, errNoResp
 }
 
+func (p *deadMock) ConsensusParams(_ context.Context, height int64) (*tmproto.ConsensusParams, error) {
+	return nil, errNoResp
+}
+
 func (p *deadMock) ReportEvidence(_ context.Context, ev types.Evidence) error {
 	return errNoResp
 }
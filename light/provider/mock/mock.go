@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/line/ostracon/light/provider"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
 	"github.com/line/ostracon/types"
 )
 
@@ -15,19 +16,29 @@ type Mock struct {
 	headers          map[int64]*types.SignedHeader
 	vals             map[int64]*types.ValidatorSet
 	voters           map[int64]*types.VoterSet
-	evidenceToReport map[string]types.Evidence // hash => evidence
+	params           map[int64]*tmproto.ConsensusParams // height of change => params
+	evidenceToReport map[string]types.Evidence          // hash => evidence
 }
 
 var _ provider.Provider = (*Mock)(nil)
 
-// New creates a mock provider with the given set of headers and validator
-// sets.
-func New(chainID string, headers map[int64]*types.SignedHeader, vals map[int64]*types.ValidatorSet, voters map[int64]*types.VoterSet) *Mock {
+// New creates a mock provider with the given set of headers, validator sets
+// and consensus params. The consensus params of a height are the ones of the
+// highest height not above it, so that params need only be given for the
+// heights they change at.
+func New(
+	chainID string,
+	headers map[int64]*types.SignedHeader,
+	vals map[int64]*types.ValidatorSet,
+	voters map[int64]*types.VoterSet,
+	params map[int64]*tmproto.ConsensusParams,
+) *Mock {
 	return &Mock{
 		chainID:          chainID,
 		headers:          headers,
 		vals:             vals,
 		voters:           voters,
+		params:           params,
 		evidenceToReport: make(map[string]types.Evidence),
 	}
 }
@@ -86,6 +97,25 @@ func (p *Mock) LightBlock(_ context.Context, height int64) (*types.LightBlock, e
 	return lb, nil
 }
 
+func (p *Mock) ConsensusParams(_ context.Context, height int64) (*tmproto.ConsensusParams, error) {
+	if _, ok := p.headers[height]; !ok {
+		return nil, provider.ErrLightBlockNotFound
+	}
+	var (
+		params       *tmproto.ConsensusParams
+		paramsHeight int64
+	)
+	for h, ps := range p.params {
+		if h <= height && h > paramsHeight {
+			params, paramsHeight = ps, h
+		}
+	}
+	if params == nil {
+		return nil, provider.ErrLightBlockNotFound
+	}
+	return params, nil
+}
+
 func (p *Mock) ReportEvidence(_ context.Context, ev types.Evidence) error {
 	p.evidenceToReport[string(ev.Hash())] = ev
 	return nil
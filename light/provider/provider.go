@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
 	"github.com/line/ostracon/types"
 )
 
@@ -24,6 +25,15 @@ type Provider interface {
 	// error is returned.
 	LightBlock(ctx context.Context, height int64) (*types.LightBlock, error)
 
+	// ConsensusParams returns the consensus params of the given height, which
+	// the ConsensusHash of the header at the height commits to.
+	//
+	// height must be > 0.
+	//
+	// If there's no LightBlock for the given height, ErrLightBlockNotFound
+	// error is returned.
+	ConsensusParams(ctx context.Context, height int64) (*tmproto.ConsensusParams, error)
+
 	// ReportEvidence reports an evidence of misbehavior.
 	ReportEvidence(context.Context, types.Evidence) error
 }
@@ -27,6 +27,7 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"block_by_hash":        rpcserver.NewRPCFunc(makeBlockByHashFunc(c), "hash"),
 		"block_results":        rpcserver.NewRPCFunc(makeBlockResultsFunc(c), "height"),
 		"commit":               rpcserver.NewRPCFunc(makeCommitFunc(c), "height"),
+		"random_beacon":        rpcserver.NewRPCFunc(makeRandomBeaconFunc(c), "height"),
 		"tx":                   rpcserver.NewRPCFunc(makeTxFunc(c), "hash,prove"),
 		"tx_search":            rpcserver.NewRPCFunc(makeTxSearchFunc(c), "query,prove,page,per_page,order_by"),
 		"validators":           rpcserver.NewRPCFunc(makeValidatorsFunc(c), "height,page,per_page"),
@@ -124,6 +125,14 @@ func makeCommitFunc(c *lrpc.Client) rpcCommitFunc {
 	}
 }
 
+type rpcRandomBeaconFunc func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultRandomBeacon, error)
+
+func makeRandomBeaconFunc(c *lrpc.Client) rpcRandomBeaconFunc {
+	return func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultRandomBeacon, error) {
+		return c.RandomBeacon(ctx.Context(), height)
+	}
+}
+
 type rpcTxFunc func(ctx *rpctypes.Context, hash []byte, prove bool) (*ctypes.ResultTx, error)
 
 func makeTxFunc(c *lrpc.Client) rpcTxFunc {
@@ -181,6 +181,10 @@ func (c *Client) UnconfirmedTxs(ctx context.Context, limit *int) (*ctypes.Result
 	return c.next.UnconfirmedTxs(ctx, limit)
 }
 
+func (c *Client) UnconfirmedTx(ctx context.Context, hash []byte) (*ctypes.ResultUnconfirmedTx, error) {
+	return c.next.UnconfirmedTx(ctx, hash)
+}
+
 func (c *Client) NumUnconfirmedTxs(ctx context.Context) (*ctypes.ResultUnconfirmedTxs, error) {
 	return c.next.NumUnconfirmedTxs(ctx)
 }
@@ -189,6 +193,10 @@ func (c *Client) CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultCheckT
 	return c.next.CheckTx(ctx, tx)
 }
 
+func (c *Client) RemoveTx(ctx context.Context, hash []byte) (*ctypes.ResultRemoveTx, error) {
+	return c.next.RemoveTx(ctx, hash)
+}
+
 func (c *Client) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	return c.next.NetInfo(ctx)
 }
@@ -222,7 +230,7 @@ func (c *Client) ConsensusParams(ctx context.Context, height *int64) (*ctypes.Re
 	}
 
 	// Verify hash.
-	if cH, tH := types.HashConsensusParams(res.ConsensusParams), l.ConsensusHash; !bytes.Equal(cH, tH) {
+	if cH, tH := types.HashConsensusParams(res.ConsensusParams, l.Version.Block), l.ConsensusHash; !bytes.Equal(cH, tH) {
 		return nil, fmt.Errorf("params hash %X does not match trusted hash %X",
 			cH, tH)
 	}
@@ -425,6 +433,38 @@ func (c *Client) Commit(ctx context.Context, height *int64) (*ctypes.ResultCommi
 	}, nil
 }
 
+// RandomBeacon calls rpcclient#RandomBeacon and then verifies the result
+// against the light block of its height.
+func (c *Client) RandomBeacon(ctx context.Context, height *int64) (*ctypes.ResultRandomBeacon, error) {
+	res, err := c.next.RandomBeacon(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate res.
+	if res.Height <= 0 {
+		return nil, errNegOrZeroHeight
+	}
+
+	// Update the light client if we're behind.
+	l, err := c.updateLightClientIfNeededTo(ctx, &res.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	// The consensus params are verified against the light block of the height.
+	params, err := c.ConsensusParams(ctx, &res.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rpcclient.VerifyRandomBeacon(res, l.Header, l.ValidatorSet,
+		params.ConsensusParams.Validator.VrfSuite); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // Tx calls rpcclient#Tx method and then verifies the proof if such was
 // requested.
 func (c *Client) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/line/ostracon/crypto/vrf"
 	tmmath "github.com/line/ostracon/libs/math"
 	"github.com/line/ostracon/types"
 )
@@ -22,24 +21,26 @@ var (
 //
 //	a) trustedHeader can still be trusted (if not, ErrOldHeaderExpired is returned)
 //	b) untrustedHeader is valid (if not, ErrInvalidHeader is returned)
-//	c) trustLevel ([1/3, 1]) of trustedHeaderVals (or trustedHeaderNextVals)
-//  signed correctly (if not, ErrNewValSetCantBeTrusted is returned)
+//	c) trustLevel ([1/3, 1]) of trustedVoters signed correctly
+//  (if not, ErrNewValSetCantBeTrusted is returned)
 //	d) more than 2/3 of untrustedVoters have signed h2
 //    (otherwise, ErrInvalidHeader is returned)
 //  e) headers are non-adjacent.
 //
 // maxClockDrift defines how much untrustedHeader.Time can drift into the
 // future.
+//
+// NOTE: untrustedVoters are only checked against the header. Use
+// VerifyElection to ensure they are the voters elected for untrustedHeader.
 func VerifyNonAdjacent(
 	trustedHeader *types.SignedHeader, // height=X
-	trustedVals *types.ValidatorSet, // height=X or height=X+1
+	trustedVoters *types.VoterSet, // height=X
 	untrustedHeader *types.SignedHeader, // height=Y
-	untrustedVals *types.ValidatorSet, // height=Y
+	untrustedVoters *types.VoterSet, // height=Y
 	trustingPeriod time.Duration,
 	now time.Time,
 	maxClockDrift time.Duration,
-	trustLevel tmmath.Fraction,
-	voterParams *types.VoterParams) error {
+	trustLevel tmmath.Fraction) error {
 
 	if untrustedHeader.Height == trustedHeader.Height+1 {
 		return errors.New("headers must be non adjacent in height")
@@ -49,18 +50,6 @@ func VerifyNonAdjacent(
 		return ErrOldHeaderExpired{trustedHeader.Time.Add(trustingPeriod), now}
 	}
 
-	proofHash, err := vrf.ProofToHash(trustedHeader.Proof.Bytes())
-	if err != nil {
-		return errors.New(fmt.Sprintf("invalid proof: %s", err.Error()))
-	}
-	trustedVoters := types.SelectVoter(trustedVals, proofHash, voterParams)
-
-	proofHash, err = vrf.ProofToHash(untrustedHeader.Proof.Bytes())
-	if err != nil {
-		return errors.New(fmt.Sprintf("invalid proof: %s", err.Error()))
-	}
-	untrustedVoters := types.SelectVoter(untrustedVals, proofHash, voterParams)
-
 	if err := verifyNewHeaderAndVoters(
 		untrustedHeader, untrustedVoters,
 		trustedHeader,
@@ -69,7 +58,8 @@ func VerifyNonAdjacent(
 	}
 
 	// Ensure that +`trustLevel` (default 1/3) or more of last trusted validators signed correctly.
-	err = trustedVoters.VerifyCommitLightTrusting(trustedHeader.ChainID, untrustedHeader.Commit, trustLevel)
+	err := trustedVoters.VerifyCommitLightTrusting(trustedHeader.ChainID, untrustedHeader.Commit, trustLevel,
+		untrustedHeader.Version.Block)
 	if err != nil {
 		switch e := err.(type) {
 		case types.ErrNotEnoughVotingPowerSigned:
@@ -85,7 +75,7 @@ func VerifyNonAdjacent(
 	// intentionally made very large to DOS the light client. not the case for
 	// VerifyAdjacent, where voter set is known in advance.
 	if err := untrustedVoters.VerifyCommitLight(trustedHeader.ChainID, untrustedHeader.Commit.BlockID,
-		untrustedHeader.Height, untrustedHeader.Commit); err != nil {
+		untrustedHeader.Height, untrustedHeader.Commit, untrustedHeader.Version.Block); err != nil {
 		return ErrInvalidHeader{err}
 	}
 
@@ -104,14 +94,16 @@ func VerifyNonAdjacent(
 //
 // maxClockDrift defines how much untrustedHeader.Time can drift into the
 // future.
+//
+// NOTE: untrustedVoters are only checked against the header. Use
+// VerifyElection to ensure they are the voters elected for untrustedHeader.
 func VerifyAdjacent(
 	trustedHeader *types.SignedHeader, // height=X
 	untrustedHeader *types.SignedHeader, // height=X+1
-	untrustedVals *types.ValidatorSet, // height=X+1
+	untrustedVoters *types.VoterSet, // height=X+1
 	trustingPeriod time.Duration,
 	now time.Time,
-	maxClockDrift time.Duration,
-	voterParams *types.VoterParams) error {
+	maxClockDrift time.Duration) error {
 
 	if untrustedHeader.Height != trustedHeader.Height+1 {
 		return errors.New("headers must be adjacent in height")
@@ -121,11 +113,6 @@ func VerifyAdjacent(
 		return ErrOldHeaderExpired{trustedHeader.Time.Add(trustingPeriod), now}
 	}
 
-	proofHash, err := vrf.ProofToHash(untrustedHeader.Proof.Bytes())
-	if err != nil {
-		return errors.New(fmt.Sprintf("invalid proof: %s", err.Error()))
-	}
-	untrustedVoters := types.SelectVoter(untrustedVals, proofHash, voterParams)
 	if err := verifyNewHeaderAndVoters(
 		untrustedHeader, untrustedVoters,
 		trustedHeader,
@@ -137,23 +124,14 @@ func VerifyAdjacent(
 	if !bytes.Equal(untrustedHeader.ValidatorsHash, trustedHeader.NextValidatorsHash) {
 		err := fmt.Errorf("expected old header next validators (%X) to match those from new header (%X)",
 			trustedHeader.NextValidatorsHash,
-			untrustedHeader.VotersHash,
-		)
-		return err
-	}
-
-	// Check the voter hashes are right
-	if !bytes.Equal(untrustedHeader.VotersHash, untrustedVoters.Hash()) {
-		err := fmt.Errorf("expected new header's voters hash (%X) to calculated voters' hash (%X)",
-			untrustedHeader.VotersHash,
-			untrustedVoters.Hash(),
+			untrustedHeader.ValidatorsHash,
 		)
 		return err
 	}
 
 	// Ensure that +2/3 of new validators signed correctly.
 	if err := untrustedVoters.VerifyCommitLight(trustedHeader.ChainID, untrustedHeader.Commit.BlockID,
-		untrustedHeader.Height, untrustedHeader.Commit); err != nil {
+		untrustedHeader.Height, untrustedHeader.Commit, untrustedHeader.Version.Block); err != nil {
 		return ErrInvalidHeader{err}
 	}
 
@@ -163,21 +141,101 @@ func VerifyAdjacent(
 // Verify combines both VerifyAdjacent and VerifyNonAdjacent functions.
 func Verify(
 	trustedHeader *types.SignedHeader, // height=X
-	trustedVals *types.ValidatorSet, // height=X or height=X+1
+	trustedVoters *types.VoterSet, // height=X
 	untrustedHeader *types.SignedHeader, // height=Y
-	untrustedVals *types.ValidatorSet, // height=Y
+	untrustedVoters *types.VoterSet, // height=Y
 	trustingPeriod time.Duration,
 	now time.Time,
 	maxClockDrift time.Duration,
-	trustLevel tmmath.Fraction,
-	voterParams *types.VoterParams) error {
+	trustLevel tmmath.Fraction) error {
 
 	if untrustedHeader.Height != trustedHeader.Height+1 {
-		return VerifyNonAdjacent(trustedHeader, trustedVals, untrustedHeader, untrustedVals,
-			trustingPeriod, now, maxClockDrift, trustLevel, voterParams)
+		return VerifyNonAdjacent(trustedHeader, trustedVoters, untrustedHeader, untrustedVoters,
+			trustingPeriod, now, maxClockDrift, trustLevel)
+	}
+
+	return VerifyAdjacent(trustedHeader, untrustedHeader, untrustedVoters, trustingPeriod, now, maxClockDrift)
+}
+
+// VerifyElection verifies that the proposer and the voters of untrustedBlock
+// are the ones elected from its validator set with the VRF output of the proof
+// in lastBlock, the block right before it. It ensures that:
+//
+//	a) the blocks are adjacent and untrustedBlock links to lastBlock
+//	b) the validator sets match the ones in the headers
+//	c) untrustedBlock is proposed by the elected proposer with a valid VRF proof
+//	d) the voters of untrustedBlock are the ones elected
+//
+// If not, ErrInvalidHeader is returned. lastBlock is expected to be trusted or
+// verified; the VRF proof in it is not verified. The proofs are of vrfSuite,
+// the Validator.VrfSuite of the consensus params of the chain.
+func VerifyElection(lastBlock, untrustedBlock *types.LightBlock, voterParams *types.VoterParams,
+	vrfSuite string) error {
+	if untrustedBlock.Height != lastBlock.Height+1 {
+		return errors.New("blocks must be adjacent in height")
+	}
+
+	if err := verifyElection(lastBlock, untrustedBlock, voterParams, vrfSuite); err != nil {
+		return ErrInvalidHeader{err}
+	}
+
+	return nil
+}
+
+func verifyElection(lastBlock, untrustedBlock *types.LightBlock, voterParams *types.VoterParams,
+	vrfSuite string) error {
+	if !bytes.Equal(untrustedBlock.LastBlockID.Hash, lastBlock.Hash()) {
+		return fmt.Errorf("expected new header's last block (%X) to match the previous header (%X)",
+			untrustedBlock.LastBlockID.Hash,
+			lastBlock.Hash(),
+		)
+	}
+
+	if err := verifyValidatorSet(lastBlock); err != nil {
+		return fmt.Errorf("previous block: %w", err)
+	}
+	if err := verifyValidatorSet(untrustedBlock); err != nil {
+		return err
+	}
+
+	lastProofHash, err := types.ProposerProofHash(lastBlock.Header, lastBlock.ValidatorSet, vrfSuite)
+	if err != nil {
+		return fmt.Errorf("invalid proof of previous header: %w", err)
 	}
 
-	return VerifyAdjacent(trustedHeader, untrustedHeader, untrustedVals, trustingPeriod, now, maxClockDrift, voterParams)
+	if err := types.VerifyProposer(untrustedBlock.Header, untrustedBlock.ValidatorSet, lastProofHash,
+		vrfSuite); err != nil {
+		return err
+	}
+
+	voters := types.SelectVoter(types.VoterCandidates(untrustedBlock.ValidatorSet, untrustedBlock.Version.Block),
+		lastProofHash, voterParams)
+	if !bytes.Equal(untrustedBlock.VotersHash, voters.Hash()) {
+		return fmt.Errorf("expected new header voters (%X) to match the elected ones (%X) at height %d",
+			untrustedBlock.VotersHash,
+			voters.Hash(),
+			untrustedBlock.Height,
+		)
+	}
+
+	return nil
+}
+
+func verifyValidatorSet(lb *types.LightBlock) error {
+	if lb.ValidatorSet == nil {
+		return errors.New("missing validator set")
+	}
+	if err := lb.ValidatorSet.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid validator set: %w", err)
+	}
+	if !bytes.Equal(lb.ValidatorsHash, lb.ValidatorSet.Hash()) {
+		return fmt.Errorf("expected header validators (%X) to match those that were supplied (%X) at height %d",
+			lb.ValidatorsHash,
+			lb.ValidatorSet.Hash(),
+			lb.Height,
+		)
+	}
+	return nil
 }
 
 func verifyNewHeaderAndVoters(
@@ -1,11 +1,13 @@
 package light_test
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/line/ostracon/crypto/tmhash"
+	"github.com/line/ostracon/crypto/vrf"
 
 	"github.com/stretchr/testify/assert"
 
@@ -180,7 +182,8 @@ func TestVerifyAdjacentHeaders(t *testing.T) {
 	for i, tc := range testCases {
 		tc := tc
 		t.Run(fmt.Sprintf("#%d", i), func(t *testing.T) {
-			err := light.VerifyAdjacent(header, tc.newHeader, tc.newVals, tc.trustingPeriod, tc.now, maxClockDrift, types.DefaultVoterParams())
+			newVoters := types.SelectVoter(tc.newVals, proofHash(tc.newHeader), types.DefaultVoterParams())
+			err := light.VerifyAdjacent(header, tc.newHeader, newVoters, tc.trustingPeriod, tc.now, maxClockDrift)
 			switch {
 			case tc.expErr != nil && assert.Error(t, err):
 				assert.Equal(t, tc.expErr, err)
@@ -354,8 +357,9 @@ func TestVerifyAdjacentHeadersWithVoterSampling(t *testing.T) {
 	for i, tc := range testCases {
 		tc := tc
 		t.Run(fmt.Sprintf("#%d", i), func(t *testing.T) {
-			err := light.VerifyAdjacent(header, tc.newHeader, tc.newVals, tc.trustingPeriod, tc.now,
-				maxClockDrift, voterParamsHalf)
+			newVoters := types.SelectVoter(tc.newVals, proofHash(tc.newHeader), voterParamsHalf)
+			err := light.VerifyAdjacent(header, tc.newHeader, newVoters, tc.trustingPeriod, tc.now,
+				maxClockDrift)
 			switch {
 			case tc.expErr != nil && assert.Error(t, err):
 				assert.Equal(t, tc.expErr, err)
@@ -484,9 +488,11 @@ func TestVerifyNonAdjacentHeaders(t *testing.T) {
 	for i, tc := range testCases {
 		tc := tc
 		t.Run(fmt.Sprintf("#%d", i), func(t *testing.T) {
-			err := light.VerifyNonAdjacent(header, vals, tc.newHeader, tc.newVals, tc.trustingPeriod,
+			voters := types.SelectVoter(vals, proofHash(header), types.DefaultVoterParams())
+			newVoters := types.SelectVoter(tc.newVals, proofHash(tc.newHeader), types.DefaultVoterParams())
+			err := light.VerifyNonAdjacent(header, voters, tc.newHeader, newVoters, tc.trustingPeriod,
 				tc.now, maxClockDrift,
-				light.DefaultTrustLevel, types.DefaultVoterParams())
+				light.DefaultTrustLevel)
 
 			switch {
 			case tc.expErr != nil && assert.Error(t, err):
@@ -500,6 +506,105 @@ func TestVerifyNonAdjacentHeaders(t *testing.T) {
 	}
 }
 
+func TestVerifyElection(t *testing.T) {
+	lightBlock := func(header *types.SignedHeader, vals *types.ValidatorSet,
+		modify func(h *types.Header)) *types.LightBlock {
+		h := *header.Header
+		if modify != nil {
+			modify(&h)
+		}
+		return &types.LightBlock{
+			SignedHeader: &types.SignedHeader{Header: &h, Commit: header.Commit},
+			ValidatorSet: vals,
+		}
+	}
+	var otherProposer types.Address
+	for _, val := range vals.Validators {
+		if !bytes.Equal(val.Address, h2.ProposerAddress) {
+			otherProposer = val.Address
+			break
+		}
+	}
+
+	testCases := []struct {
+		lastBlock  *types.LightBlock
+		newBlock   *types.LightBlock
+		expErrText string
+	}{
+		// elected proposer and voters -> no error
+		0: {
+			lightBlock(h1, vals, nil),
+			lightBlock(h2, vals, nil),
+			"",
+		},
+		1: {
+			lightBlock(h2, vals, nil),
+			lightBlock(h3, vals, nil),
+			"",
+		},
+		// non-adjacent blocks -> error
+		2: {
+			lightBlock(h1, vals, nil),
+			lightBlock(h3, vals, nil),
+			"blocks must be adjacent in height",
+		},
+		// new block doesn't link to the last block -> error
+		3: {
+			lightBlock(h1, vals, nil),
+			lightBlock(h2, vals, func(h *types.Header) { h.LastBlockID = types.BlockID{Hash: hash("last_block")} }),
+			"to match the previous header",
+		},
+		// validators are inconsistent with the new block -> error
+		4: {
+			lightBlock(h1, vals, nil),
+			lightBlock(h2, keys.ToValidators(10, 1), nil),
+			"to match those that were supplied",
+		},
+		// validators are inconsistent with the last block -> error
+		5: {
+			lightBlock(h1, keys.ToValidators(10, 1), nil),
+			lightBlock(h2, vals, nil),
+			"previous block",
+		},
+		// not the elected proposer -> error
+		6: {
+			lightBlock(h1, vals, nil),
+			lightBlock(h2, vals, func(h *types.Header) { h.ProposerAddress = otherProposer }),
+			"is not the proposer",
+		},
+		// invalid proof -> error
+		7: {
+			lightBlock(h1, vals, nil),
+			lightBlock(h2, vals, func(h *types.Header) { h.Proof = h1.Proof }),
+			"Proof verification failed",
+		},
+		// voters are not the elected ones -> error
+		8: {
+			lightBlock(h1, vals, nil),
+			lightBlock(h2, vals, func(h *types.Header) { h.VotersHash = hash("voters_hash") }),
+			"to match the elected ones",
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("#%d", i), func(t *testing.T) {
+			err := light.VerifyElection(tc.lastBlock, tc.newBlock, voterParam, "")
+			if tc.expErrText != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tc.expErrText)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	// the proofs are not of the VRF suite of the chain -> error
+	assert.Error(t, light.VerifyElection(lightBlock(h1, vals, nil), lightBlock(h2, vals, nil), voterParam,
+		vrf.SuiteEdwards25519SHA512ELL2))
+}
+
 func TestVerifyReturnsErrorIfTrustLevelIsInvalid(t *testing.T) {
 	const (
 		chainID    = "TestVerifyReturnsErrorIfTrustLevelIsInvalid"
@@ -517,8 +622,9 @@ func TestVerifyReturnsErrorIfTrustLevelIsInvalid(t *testing.T) {
 			types.DefaultVoterParams())
 	)
 
-	err := light.Verify(header, vals, header, vals, 2*time.Hour, time.Now(), maxClockDrift,
-		tmmath.Fraction{Numerator: 2, Denominator: 1}, types.DefaultVoterParams())
+	voters := types.SelectVoter(vals, proofHash(header), types.DefaultVoterParams())
+	err := light.Verify(header, voters, header, voters, 2*time.Hour, time.Now(), maxClockDrift,
+		tmmath.Fraction{Numerator: 2, Denominator: 1})
 	assert.Error(t, err)
 }
 
@@ -27,12 +27,14 @@ func TestCacheRemove(t *testing.T) {
 		// make sure its added to both the linked list and the map
 		require.Equal(t, i+1, len(cache.cacheMap))
 		require.Equal(t, i+1, cache.list.Len())
+		require.True(t, cache.Has(TxKey(txBytes)))
 	}
 	for i := 0; i < numTxs; i++ {
 		cache.Remove(txs[i])
 		// make sure its removed from both the map and the linked list
 		require.Equal(t, numTxs-(i+1), len(cache.cacheMap))
 		require.Equal(t, numTxs-(i+1), cache.list.Len())
+		require.False(t, cache.Has(TxKey(txs[i])))
 	}
 }
 
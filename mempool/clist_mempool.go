@@ -5,27 +5,26 @@ import (
 	"container/list"
 	"crypto/sha256"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	abci "github.com/line/ostracon/abci/types"
 	cfg "github.com/line/ostracon/config"
-	auto "github.com/line/ostracon/libs/autofile"
 	"github.com/line/ostracon/libs/clist"
 	"github.com/line/ostracon/libs/log"
 	tmmath "github.com/line/ostracon/libs/math"
-	tmos "github.com/line/ostracon/libs/os"
 	tmsync "github.com/line/ostracon/libs/sync"
 	"github.com/line/ostracon/p2p"
 	"github.com/line/ostracon/proxy"
 	"github.com/line/ostracon/types"
+	tmtime "github.com/line/ostracon/types/time"
 )
 
 // TxKeySize is the size of the transaction key index
 const TxKeySize = sha256.Size
 
-var newline = []byte("\n")
-
 //--------------------------------------------------------------------------------
 
 // CListMempool is an ordered in-memory pool for transactions before they are
@@ -50,8 +49,9 @@ type CListMempool struct {
 	preCheck  PreCheckFunc
 	postCheck PostCheckFunc
 
-	wal          *auto.AutoFile // a log of mempool txs
-	txs          *clist.CList   // concurrent linked-list of good txs
+	wal          *mempoolWAL  // a log of mempool txs
+	txs          *clist.CList // concurrent linked-list of good txs, in the order they arrived
+	order        txOrder      // order the txs are reaped in
 	proxyAppConn proxy.AppConnMempool
 
 	// Track whether we're rechecking txs.
@@ -68,12 +68,15 @@ type CListMempool struct {
 	// This reduces the pressure on the proxyApp.
 	cache txCache
 
+	// Notified of the txs purged after their TTL.
+	eventBus types.MempoolEventPublisher
+
 	logger log.Logger
 
 	metrics *Metrics
 }
 
-var _ Mempool = &CListMempool{}
+var _ GossipMempool = &CListMempool{}
 
 // CListMempoolOption sets an optional parameter on the mempool.
 type CListMempoolOption func(*CListMempool)
@@ -84,14 +87,26 @@ func NewCListMempool(
 	proxyAppConn proxy.AppConnMempool,
 	height int64,
 	options ...CListMempoolOption,
+) *CListMempool {
+	return newCListMempool(config, proxyAppConn, height, arrivalOrder{}, options...)
+}
+
+func newCListMempool(
+	config *cfg.MempoolConfig,
+	proxyAppConn proxy.AppConnMempool,
+	height int64,
+	order txOrder,
+	options ...CListMempoolOption,
 ) *CListMempool {
 	mempool := &CListMempool{
 		config:        config,
 		proxyAppConn:  proxyAppConn,
 		txs:           clist.New(),
+		order:         order,
 		height:        height,
 		recheckCursor: nil,
 		recheckEnd:    nil,
+		eventBus:      types.NopEventBus{},
 		logger:        log.NewNopLogger(),
 		metrics:       NopMetrics(),
 	}
@@ -136,26 +151,29 @@ func WithMetrics(metrics *Metrics) CListMempoolOption {
 	return func(mem *CListMempool) { mem.metrics = metrics }
 }
 
-func (mem *CListMempool) InitWAL() error {
-	var (
-		walDir  = mem.config.WalDir()
-		walFile = walDir + "/wal"
-	)
-
-	const perm = 0700
-	if err := tmos.EnsureDir(walDir, perm); err != nil {
-		return err
-	}
+// WithEventBus sets the event bus, to which the expired txs are published.
+func WithEventBus(eventBus types.MempoolEventPublisher) CListMempoolOption {
+	return func(mem *CListMempool) { mem.eventBus = eventBus }
+}
 
-	af, err := auto.OpenAutoFile(walFile)
+func (mem *CListMempool) InitWAL() error {
+	af, err := openWAL(mem.config, mem.logger)
 	if err != nil {
-		return fmt.Errorf("can't open autofile %s: %w", walFile, err)
+		return err
 	}
-
 	mem.wal = af
 	return nil
 }
 
+// ReplayWAL restores the txs pending in the WAL into the mempool, except the
+// given committed txs. See replayWAL.
+func (mem *CListMempool) ReplayWAL(committedTxs types.Txs) error {
+	if mem.wal == nil {
+		return nil
+	}
+	return replayWAL(mem, mem.wal, &mem.updateMtx, committedTxs, mem.logger)
+}
+
 func (mem *CListMempool) CloseWAL() {
 	if err := mem.wal.Close(); err != nil {
 		mem.logger.Error("Error closing WAL", "err", err)
@@ -197,8 +215,7 @@ func (mem *CListMempool) Flush() {
 	mem.cache.Reset()
 
 	for e := mem.txs.Front(); e != nil; e = e.Next() {
-		mem.txs.Remove(e)
-		e.DetachPrev()
+		mem.order.remove(mem.txs, e)
 	}
 
 	mem.txsMap.Range(func(key, _ interface{}) bool {
@@ -230,6 +247,9 @@ func (mem *CListMempool) TxsWaitChan() <-chan struct{} {
 //     It gets called from another goroutine.
 // CONTRACT: Either cb will get called, or err returned.
 //
+// If the order of the mempool may evict txs, a tx is checked even if the
+// mempool is full, since it may make room for itself.
+//
 // Safe for concurrent use by multiple goroutines.
 func (mem *CListMempool) CheckTx(tx types.Tx, cb func(*abci.Response), txInfo TxInfo) error {
 	mem.updateMtx.RLock()
@@ -238,8 +258,10 @@ func (mem *CListMempool) CheckTx(tx types.Tx, cb func(*abci.Response), txInfo Tx
 
 	txSize := len(tx)
 
-	if err := mem.isFull(txSize); err != nil {
-		return err
+	if !mem.order.canEvict() {
+		if err := mem.isFull(txSize); err != nil {
+			return err
+		}
 	}
 
 	if txSize > mem.config.MaxTxBytes {
@@ -258,8 +280,7 @@ func (mem *CListMempool) CheckTx(tx types.Tx, cb func(*abci.Response), txInfo Tx
 	// all even once.
 	if mem.wal != nil {
 		// TODO: Notify administrators when WAL fails
-		_, err := mem.wal.Write(append([]byte(tx), newline...))
-		if err != nil {
+		if err := mem.wal.write(tx); err != nil {
 			return fmt.Errorf("wal.Write: %w", err)
 		}
 	}
@@ -276,7 +297,7 @@ func (mem *CListMempool) CheckTx(tx types.Tx, cb func(*abci.Response), txInfo Tx
 		// so we only record the sender for txs still in the mempool.
 		if e, ok := mem.txsMap.Load(TxKey(tx)); ok {
 			memTx := e.(*clist.CElement).Value.(*mempoolTx)
-			memTx.senders.LoadOrStore(txInfo.SenderID, true)
+			memTx.senders.LoadOrStore(txInfo.SenderID, txInfo.SenderP2PID)
 			// TODO: consider punishing peer for dups,
 			// its non-trivial since invalid txs can become valid,
 			// but they can spam the same tx with little cost to them atm.
@@ -348,7 +369,7 @@ func (mem *CListMempool) reqResCb(
 // Called from:
 //  - resCbFirstTime (lock not held) if tx is valid
 func (mem *CListMempool) addTx(memTx *mempoolTx) {
-	e := mem.txs.PushBack(memTx)
+	e := mem.order.push(mem.txs, memTx)
 	mem.txsMap.Store(TxKey(memTx.tx), e)
 	atomic.AddInt64(&mem.txsBytes, int64(len(memTx.tx)))
 	mem.metrics.TxSizeBytes.Observe(float64(len(memTx.tx)))
@@ -357,9 +378,9 @@ func (mem *CListMempool) addTx(memTx *mempoolTx) {
 // Called from:
 //  - Update (lock held) if tx was committed
 // 	- resCbRecheck (lock not held) if tx was invalidated
+//  - makeRoom (lock not held) if tx was evicted
 func (mem *CListMempool) removeTx(tx types.Tx, elem *clist.CElement, removeFromCache bool) {
-	mem.txs.Remove(elem)
-	elem.DetachPrev()
+	mem.order.remove(mem.txs, elem)
 	mem.txsMap.Delete(TxKey(tx))
 	atomic.AddInt64(&mem.txsBytes, int64(-len(tx)))
 
@@ -368,12 +389,49 @@ func (mem *CListMempool) removeTx(tx types.Tx, elem *clist.CElement, removeFromC
 	}
 }
 
+// TxByKey returns the transaction of the given TxKey, if it's in the mempool.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *CListMempool) TxByKey(txKey [TxKeySize]byte) (types.Tx, bool) {
+	if e, ok := mem.txsMap.Load(txKey); ok {
+		return e.(*clist.CElement).Value.(*mempoolTx).tx, true
+	}
+	return nil, false
+}
+
+// InCache returns true if the transaction of the given TxKey is in the cache,
+// i.e. it's been seen recently.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *CListMempool) InCache(txKey [TxKeySize]byte) bool {
+	return mem.cache.Has(txKey)
+}
+
+// UnconfirmedTxByKey returns the transaction of the given TxKey with its
+// details, if it's in the mempool.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *CListMempool) UnconfirmedTxByKey(txKey [TxKeySize]byte) (*UnconfirmedTx, bool) {
+	if e, ok := mem.txsMap.Load(txKey); ok {
+		return e.(*clist.CElement).Value.(*mempoolTx).unconfirmedTx(), true
+	}
+	return nil, false
+}
+
 // RemoveTxByKey removes a transaction from the mempool by its TxKey index.
+// The WAL is compacted, so that the tx isn't restored after a restart.
+//
+// Lock() must be held by the caller during execution.
 func (mem *CListMempool) RemoveTxByKey(txKey [TxKeySize]byte, removeFromCache bool) {
 	if e, ok := mem.txsMap.Load(txKey); ok {
 		memTx := e.(*clist.CElement).Value.(*mempoolTx)
 		if memTx != nil {
 			mem.removeTx(memTx.tx, e.(*clist.CElement), removeFromCache)
+			if mem.wal != nil {
+				if err := mem.wal.compact(mem.txs); err != nil {
+					mem.logger.Error("Error compacting mempool WAL", "err", err)
+				}
+			}
 		}
 	}
 }
@@ -394,6 +452,36 @@ func (mem *CListMempool) isFull(txSize int) error {
 	return nil
 }
 
+// makeRoom checks the mempool isn't full for the new tx. If it is, the txs
+// chosen by the order of the mempool are evicted to make room for the new tx.
+// It returns ErrMempoolIsFull, and evicts nothing, if there isn't enough room.
+func (mem *CListMempool) makeRoom(newTx *mempoolTx) error {
+	err := mem.isFull(len(newTx.tx))
+	if err == nil || !mem.order.canEvict() {
+		return err
+	}
+
+	evicted, ok := mem.order.evict(mem.txs, newTx,
+		mem.Size()+1-mem.config.Size,
+		mem.TxsBytes()+int64(len(newTx.tx))-mem.config.MaxTxsBytes)
+	if !ok {
+		return err
+	}
+	for _, e := range evicted {
+		memTx := e.Value.(*mempoolTx)
+		mem.logger.Info("Evicted transaction",
+			"tx", txID(memTx.tx),
+			"priority", memTx.priority,
+			"newTx", txID(newTx.tx),
+			"newPriority", newTx.priority,
+		)
+		// remove from cache (mempool might have a space later)
+		mem.removeTx(memTx.tx, e, true)
+		mem.metrics.EvictedTxs.Add(1)
+	}
+	return nil
+}
+
 // callback, which is called after the app checked the tx for the first time.
 //
 // The case where the app checks the tx for the second and subsequent times is
@@ -411,21 +499,26 @@ func (mem *CListMempool) resCbFirstTime(
 			postCheckErr = mem.postCheck(tx, r.CheckTx)
 		}
 		if (r.CheckTx.Code == abci.CodeTypeOK) && postCheckErr == nil {
+			memTx := &mempoolTx{
+				height:    mem.height,
+				timestamp: tmtime.Now(),
+				gasWanted: r.CheckTx.GasWanted,
+				tx:        tx,
+				checkTx:   r.CheckTx,
+				priority:  r.CheckTx.Priority,
+				sender:    r.CheckTx.Sender,
+			}
+
 			// Check mempool isn't full again to reduce the chance of exceeding the
-			// limits.
-			if err := mem.isFull(len(tx)); err != nil {
+			// limits, and make room for the tx if the order of the mempool allows.
+			if err := mem.makeRoom(memTx); err != nil {
 				// remove from cache (mempool might have a space later)
 				mem.cache.Remove(tx)
 				mem.logger.Error(err.Error())
 				return
 			}
 
-			memTx := &mempoolTx{
-				height:    mem.height,
-				gasWanted: r.CheckTx.GasWanted,
-				tx:        tx,
-			}
-			memTx.senders.Store(peerID, true)
+			memTx.senders.Store(peerID, peerP2PID)
 			mem.addTx(memTx)
 			mem.logger.Info("Added good transaction",
 				"tx", txID(tx),
@@ -469,7 +562,8 @@ func (mem *CListMempool) resCbRecheck(req *abci.Request, res *abci.Response) {
 			postCheckErr = mem.postCheck(tx, r.CheckTx)
 		}
 		if (r.CheckTx.Code == abci.CodeTypeOK) && postCheckErr == nil {
-			// Good, nothing to do.
+			// Good, only the order may change due to newly committed block.
+			mem.order.rechecked(memTx, r.CheckTx)
 		} else {
 			// Tx became invalidated due to newly committed block.
 			mem.logger.Info("Tx is no longer valid", "tx", txID(tx), "res", r, "err", postCheckErr)
@@ -525,14 +619,12 @@ func (mem *CListMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 	// size per tx, and set the initial capacity based off of that.
 	// txs := make([]types.Tx, 0, tmmath.MinInt(mem.txs.Len(), max/mem.avgTxSize))
 	txs := make([]types.Tx, 0, mem.txs.Len())
-	for e := mem.txs.Front(); e != nil; e = e.Next() {
-		memTx := e.Value.(*mempoolTx)
-
+	mem.order.reap(mem.txs, func(memTx *mempoolTx) bool {
 		dataSize := types.ComputeProtoSizeForTxs(append(txs, memTx.tx))
 
 		// Check total size requirement
 		if maxBytes > -1 && dataSize > maxBytes {
-			return txs
+			return false
 		}
 		// Check total gas requirement.
 		// If maxGas is negative, skip this check.
@@ -540,11 +632,12 @@ func (mem *CListMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 		// must be non-negative, it follows that this won't overflow.
 		newTotalGas := totalGas + memTx.gasWanted
 		if maxGas > -1 && newTotalGas > maxGas {
-			return txs
+			return false
 		}
 		totalGas = newTotalGas
 		txs = append(txs, memTx.tx)
-	}
+		return true
+	})
 	return txs
 }
 
@@ -558,10 +651,13 @@ func (mem *CListMempool) ReapMaxTxs(max int) types.Txs {
 	}
 
 	txs := make([]types.Tx, 0, tmmath.MinInt(mem.txs.Len(), max))
-	for e := mem.txs.Front(); e != nil && len(txs) <= max; e = e.Next() {
-		memTx := e.Value.(*mempoolTx)
+	mem.order.reap(mem.txs, func(memTx *mempoolTx) bool {
+		if len(txs) >= max {
+			return false
+		}
 		txs = append(txs, memTx.tx)
-	}
+		return true
+	})
 	return txs
 }
 
@@ -608,6 +704,17 @@ func (mem *CListMempool) Update(
 		}
 	}
 
+	// Purge the txs, which have been in the mempool longer than their TTL,
+	// before they're rechecked.
+	mem.purgeExpiredTxs(height)
+
+	// Keep only the pending txs in the WAL.
+	if mem.wal != nil {
+		if err := mem.wal.compact(mem.txs); err != nil {
+			mem.logger.Error("Error compacting mempool WAL", "err", err)
+		}
+	}
+
 	// Either recheck non-committed txs to see if they became invalid
 	// or just notify there're some txs left.
 	if mem.Size() > 0 {
@@ -628,6 +735,37 @@ func (mem *CListMempool) Update(
 	return nil
 }
 
+// purgeExpiredTxs removes the txs, which have been in the mempool for more than
+// TTLNumBlocks blocks or for longer than TTLDuration, as of the given height.
+func (mem *CListMempool) purgeExpiredTxs(height int64) {
+	if mem.config.TTLNumBlocks == 0 && mem.config.TTLDuration == 0 {
+		return
+	}
+
+	now := tmtime.Now()
+	for e := mem.txs.Front(); e != nil; {
+		next := e.Next()
+		memTx := e.Value.(*mempoolTx)
+		if memTx.isExpired(mem.config, height, now) {
+			mem.removeTx(memTx.tx, e, !mem.config.KeepInvalidTxsInCache)
+			mem.expiredTx(memTx, height)
+		}
+		e = next
+	}
+}
+
+func (mem *CListMempool) expiredTx(memTx *mempoolTx, height int64) {
+	mem.logger.Info("Purged expired transaction",
+		"tx", txID(memTx.tx), "txHeight", memTx.height, "height", height)
+	mem.metrics.ExpiredTxs.Add(1)
+	if err := mem.eventBus.PublishEventExpiredTx(types.EventDataExpiredTx{
+		Tx:     memTx.tx,
+		Height: height,
+	}); err != nil {
+		mem.logger.Error("Error publishing expired tx event", "err", err)
+	}
+}
+
 func (mem *CListMempool) recheckTxs() {
 	if mem.Size() == 0 {
 		panic("recheckTxs is called, but the mempool is empty")
@@ -653,26 +791,117 @@ func (mem *CListMempool) recheckTxs() {
 
 // mempoolTx is a transaction that successfully ran
 type mempoolTx struct {
-	height    int64    // height that this tx had been validated in
-	gasWanted int64    // amount of gas this tx states it will require
-	tx        types.Tx //
+	height    int64     // height that this tx had been validated in
+	timestamp time.Time // time that this tx had been added to the mempool
+	gasWanted int64     // amount of gas this tx states it will require
+	tx        types.Tx  //
+
+	// response of the app to the CheckTx, when this tx had been added
+	checkTx *abci.ResponseCheckTx
+
+	priority int64  // priority of this tx returned by the app, updated by the rechecks
+	sender   string // sender of this tx returned by the app
+	seq      uint64 // order of the arrival of this tx, set by the priorityOrder
 
 	// ids of peers who've sent us this tx (as a map for quick lookups).
-	// senders: PeerID -> bool
+	// senders: PeerID -> p2p.ID
 	senders sync.Map
 }
 
+// unconfirmedTx returns the details of this transaction.
+func (memTx *mempoolTx) unconfirmedTx() *UnconfirmedTx {
+	utx := &UnconfirmedTx{
+		Tx:      memTx.tx,
+		Height:  memTx.Height(),
+		CheckTx: memTx.checkTx,
+	}
+	memTx.senders.Range(func(_, value interface{}) bool {
+		// skip the txs received by RPC
+		if peerID := value.(p2p.ID); peerID != "" {
+			utx.Senders = append(utx.Senders, peerID)
+		}
+		return true
+	})
+	sort.Slice(utx.Senders, func(i, j int) bool { return utx.Senders[i] < utx.Senders[j] })
+	return utx
+}
+
 // Height returns the height for this transaction
 func (memTx *mempoolTx) Height() int64 {
 	return atomic.LoadInt64(&memTx.height)
 }
 
+// isExpired returns true if this transaction has been in the mempool for more
+// than config.TTLNumBlocks blocks or for longer than config.TTLDuration.
+// Zero TTLs are disabled.
+func (memTx *mempoolTx) isExpired(config *cfg.MempoolConfig, height int64, now time.Time) bool {
+	if config.TTLNumBlocks > 0 && height-memTx.Height() > config.TTLNumBlocks {
+		return true
+	}
+	return config.TTLDuration > 0 && now.Sub(memTx.timestamp) > config.TTLDuration
+}
+
+//--------------------------------------------------------------------------------
+
+// txOrder is the order, in which the txs of a CListMempool are reaped. The txs
+// are kept in the order they arrived, for gossiping and rechecking, and the
+// order may keep its own indexes of them, which are updated as they're pushed
+// and removed. An order may also evict txs to make room for a new one.
+type txOrder interface {
+	// push adds the tx to the back of the txs and returns its element.
+	push(txs *clist.CList, memTx *mempoolTx) *clist.CElement
+	// remove removes the element of a tx from the txs.
+	remove(txs *clist.CList, e *clist.CElement)
+	// rechecked is called with the response of the app when a tx is still
+	// valid after a recheck.
+	rechecked(memTx *mempoolTx, res *abci.ResponseCheckTx)
+	// reap calls fn with the txs in the order they're reaped, until fn
+	// returns false.
+	reap(txs *clist.CList, fn func(memTx *mempoolTx) bool)
+	// canEvict returns true if the order may evict txs for a new one.
+	canEvict() bool
+	// evict returns the txs to evict to make room for the new tx, which take
+	// at least numTxs txs and numBytes bytes, or false if it can't.
+	evict(txs *clist.CList, newTx *mempoolTx, numTxs int, numBytes int64) ([]*clist.CElement, bool)
+}
+
+// arrivalOrder reaps the txs in the order they arrived, and evicts none.
+type arrivalOrder struct{}
+
+var _ txOrder = arrivalOrder{}
+
+func (arrivalOrder) push(txs *clist.CList, memTx *mempoolTx) *clist.CElement {
+	return txs.PushBack(memTx)
+}
+
+func (arrivalOrder) remove(txs *clist.CList, e *clist.CElement) {
+	txs.Remove(e)
+	e.DetachPrev()
+}
+
+func (arrivalOrder) rechecked(*mempoolTx, *abci.ResponseCheckTx) {}
+
+func (arrivalOrder) reap(txs *clist.CList, fn func(memTx *mempoolTx) bool) {
+	for e := txs.Front(); e != nil; e = e.Next() {
+		if !fn(e.Value.(*mempoolTx)) {
+			return
+		}
+	}
+}
+
+func (arrivalOrder) canEvict() bool { return false }
+
+func (arrivalOrder) evict(*clist.CList, *mempoolTx, int, int64) ([]*clist.CElement, bool) {
+	return nil, false
+}
+
 //--------------------------------------------------------------------------------
 
 type txCache interface {
 	Reset()
 	Push(tx types.Tx) bool
 	Remove(tx types.Tx)
+	Has(txKey [TxKeySize]byte) bool
 }
 
 // mapTxCache maintains a LRU cache of transactions. This only stores the hash
@@ -742,13 +971,23 @@ func (cache *mapTxCache) Remove(tx types.Tx) {
 	cache.mtx.Unlock()
 }
 
+// Has returns true if the tx of the given TxKey is in the cache.
+func (cache *mapTxCache) Has(txKey [TxKeySize]byte) bool {
+	cache.mtx.Lock()
+	defer cache.mtx.Unlock()
+
+	_, exists := cache.cacheMap[txKey]
+	return exists
+}
+
 type nopTxCache struct{}
 
 var _ txCache = (*nopTxCache)(nil)
 
-func (nopTxCache) Reset()             {}
-func (nopTxCache) Push(types.Tx) bool { return true }
-func (nopTxCache) Remove(types.Tx)    {}
+func (nopTxCache) Reset()                   {}
+func (nopTxCache) Push(types.Tx) bool       { return true }
+func (nopTxCache) Remove(types.Tx)          {}
+func (nopTxCache) Has([TxKeySize]byte) bool { return false }
 
 //--------------------------------------------------------------------------------
 
@@ -1,6 +1,7 @@
 package mempool
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/binary"
@@ -25,6 +26,7 @@ import (
 	"github.com/line/ostracon/libs/log"
 	tmrand "github.com/line/ostracon/libs/rand"
 	"github.com/line/ostracon/libs/service"
+	"github.com/line/ostracon/p2p"
 	"github.com/line/ostracon/proxy"
 	"github.com/line/ostracon/types"
 )
@@ -139,6 +141,19 @@ func TestReapMaxBytesMaxGas(t *testing.T) {
 	}
 }
 
+func TestReapMaxTxs(t *testing.T) {
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mempool, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+
+	txs := checkTxs(t, mempool, 5, UnknownPeerID)
+	assert.Equal(t, txs, mempool.ReapMaxTxs(-1))
+	assert.Equal(t, txs[:3], mempool.ReapMaxTxs(3))
+	assert.Empty(t, mempool.ReapMaxTxs(0))
+	assert.Equal(t, txs, mempool.ReapMaxTxs(10))
+}
+
 func TestMempoolFilters(t *testing.T) {
 	app := kvstore.NewApplication()
 	cc := proxy.NewLocalClientCreator(app)
@@ -216,6 +231,92 @@ func TestMempoolUpdate(t *testing.T) {
 	}
 }
 
+func TestMempoolTTL(t *testing.T) {
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	config := cfg.ResetTestRoot("mempool_test")
+	config.Mempool.TTLNumBlocks = 2
+	config.Mempool.TTLDuration = 500 * time.Millisecond
+	mempool, cleanup := newMempoolWithAppAndConfig(cc, config)
+	defer cleanup()
+
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	WithEventBus(eventBus)(mempool)
+	sub, err := eventBus.Subscribe(context.Background(), "test", types.EventQueryExpiredTx, 10)
+	require.NoError(t, err)
+
+	// 1. Purges the txs after TTLNumBlocks blocks
+	{
+		require.NoError(t, mempool.CheckTx([]byte{0x01}, nil, TxInfo{}))
+		require.NoError(t, mempool.Update(1, []types.Tx{}, abciResponses(0, abci.CodeTypeOK), nil, nil))
+		require.NoError(t, mempool.CheckTx([]byte{0x02}, nil, TxInfo{}))
+
+		require.NoError(t, mempool.Update(2, []types.Tx{}, abciResponses(0, abci.CodeTypeOK), nil, nil))
+		assert.Equal(t, 2, mempool.Size())
+
+		require.NoError(t, mempool.Update(3, []types.Tx{}, abciResponses(0, abci.CodeTypeOK), nil, nil))
+		assert.Equal(t, []types.Tx{{0x02}}, []types.Tx(mempool.ReapMaxTxs(-1)))
+
+		select {
+		case msg := <-sub.Out():
+			data := msg.Data().(types.EventDataExpiredTx)
+			assert.Equal(t, types.Tx{0x01}, data.Tx)
+			assert.EqualValues(t, 3, data.Height)
+		case <-time.After(time.Second):
+			t.Fatal("did not receive an expired tx after 1 sec.")
+		}
+
+		// the purged tx can be resubmitted
+		require.NoError(t, mempool.CheckTx([]byte{0x01}, nil, TxInfo{}))
+	}
+
+	// 2. Purges the txs after TTLDuration
+	{
+		time.Sleep(config.Mempool.TTLDuration)
+		require.NoError(t, mempool.CheckTx([]byte{0x03}, nil, TxInfo{}))
+		require.NoError(t, mempool.Update(3, []types.Tx{}, abciResponses(0, abci.CodeTypeOK), nil, nil))
+		assert.Equal(t, []types.Tx{{0x03}}, []types.Tx(mempool.ReapMaxTxs(-1)))
+	}
+}
+
+func TestMempoolUnconfirmedTxByKey(t *testing.T) {
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mempool, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+
+	tx := types.Tx("foo=bar")
+	_, ok := mempool.UnconfirmedTxByKey(TxKey(tx))
+	assert.False(t, ok)
+
+	require.NoError(t, mempool.Update(1, []types.Tx{}, abciResponses(0, abci.CodeTypeOK), nil, nil))
+	require.NoError(t, mempool.CheckTx(tx, nil, TxInfo{SenderID: 2, SenderP2PID: "peer2"}))
+	err := mempool.CheckTx(tx, nil, TxInfo{SenderID: 1, SenderP2PID: "peer1"})
+	assert.Equal(t, ErrTxInCache, err)
+	err = mempool.CheckTx(tx, nil, TxInfo{})
+	assert.Equal(t, ErrTxInCache, err)
+
+	utx, ok := mempool.UnconfirmedTxByKey(TxKey(tx))
+	require.True(t, ok)
+	assert.Equal(t, tx, utx.Tx)
+	assert.EqualValues(t, 1, utx.Height)
+	assert.Equal(t, []p2p.ID{"peer1", "peer2"}, utx.Senders)
+	require.NotNil(t, utx.CheckTx)
+	assert.Equal(t, abci.CodeTypeOK, utx.CheckTx.Code)
+
+	mempool.Lock()
+	mempool.RemoveTxByKey(TxKey(tx), false)
+	mempool.Unlock()
+	_, ok = mempool.UnconfirmedTxByKey(TxKey(tx))
+	assert.False(t, ok)
+}
+
 func TestMempool_KeepInvalidTxsInCache(t *testing.T) {
 	app := counter.NewApplication(true)
 	cc := proxy.NewLocalClientCreator(app)
@@ -457,11 +558,11 @@ func TestMempoolCloseWAL(t *testing.T) {
 	// 5. Write some contents to the WAL
 	err = mempool.CheckTx(types.Tx([]byte("foo")), nil, TxInfo{})
 	require.NoError(t, err)
-	walFilepath := mempool.wal.Path
+	walFilepath := mempool.wal.Path()
 	sum1 := checksumFile(walFilepath, t)
 
 	// 6. Sanity check to ensure that the written TX matches the expectation.
-	require.Equal(t, sum1, checksumIt([]byte("foo\n")), "foo with a newline should be written")
+	require.Equal(t, sum1, checksumIt([]byte("\x05\x0a\x03foo")), "length-delimited foo should be written")
 
 	// 7. Invoke CloseWAL() and ensure it discards the
 	// WAL thus any other write won't go through.
@@ -477,6 +578,107 @@ func TestMempoolCloseWAL(t *testing.T) {
 	require.Equal(t, 1, len(m3), "expecting the wal match in")
 }
 
+func TestMempoolReplayWAL(t *testing.T) {
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	config := cfg.ResetTestRoot("mempool_test")
+	mempool, cleanup := newMempoolWithAppAndConfig(cc, config)
+	defer cleanup()
+	require.NoError(t, mempool.InitWAL())
+
+	txs := types.Txs{[]byte("a\nb"), []byte("c"), []byte("d"), []byte("e")}
+	for _, tx := range txs[:3] {
+		require.NoError(t, mempool.CheckTx(tx, nil, TxInfo{}))
+	}
+
+	// the committed txs are removed from the WAL, which is compacted into a
+	// segment of the pending txs when it's closed at the latest
+	require.NoError(t, mempool.Update(1, txs[1:2], abciResponses(1, abci.CodeTypeOK), nil, nil))
+	require.NoError(t, mempool.CheckTx(txs[3], nil, TxInfo{}))
+	walFile := mempool.wal.Path()
+	mempool.CloseWAL()
+	segments, err := filepath.Glob(walFile + ".*")
+	require.NoError(t, err)
+	assert.Equal(t, []string{walFile + ".000"}, segments)
+
+	// a partially written tx is left by a crash
+	f, err := os.OpenFile(walFile, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0x05, 0x0a})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	mempool2, _ := newMempoolWithAppAndConfig(cc, config)
+	require.NoError(t, mempool2.InitWAL())
+	defer mempool2.CloseWAL()
+	walTxs, err := mempool2.wal.read()
+	require.NoError(t, err)
+	assert.Equal(t, types.Txs{txs[0], txs[2], txs[3]}, walTxs)
+
+	// the txs are restored, except the committed one
+	require.NoError(t, mempool2.ReplayWAL(txs[2:3]))
+	assert.Equal(t, types.Txs{txs[0], txs[3]}, mempool2.ReapMaxTxs(-1))
+
+	walTxs, err = mempool2.wal.read()
+	require.NoError(t, err)
+	assert.Equal(t, types.Txs{txs[0], txs[3]}, walTxs)
+	segments, err = filepath.Glob(walFile + ".*")
+	require.NoError(t, err)
+	assert.Empty(t, segments)
+}
+
+func TestMempoolRemoveTxByKeyWAL(t *testing.T) {
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	config := cfg.ResetTestRoot("mempool_test")
+	mempool, cleanup := newMempoolWithAppAndConfig(cc, config)
+	defer cleanup()
+	require.NoError(t, mempool.InitWAL())
+
+	txs := types.Txs{[]byte("a"), []byte("b")}
+	for _, tx := range txs {
+		require.NoError(t, mempool.CheckTx(tx, nil, TxInfo{}))
+	}
+	mempool.Lock()
+	mempool.RemoveTxByKey(TxKey(txs[0]), false)
+	mempool.Unlock()
+	mempool.CloseWAL()
+
+	// the removed tx isn't restored, and can't be resubmitted while it's in the cache
+	mempool2, _ := newMempoolWithAppAndConfig(cc, config)
+	require.NoError(t, mempool2.InitWAL())
+	defer mempool2.CloseWAL()
+	walTxs, err := mempool2.wal.read()
+	require.NoError(t, err)
+	assert.Equal(t, txs[1:], walTxs)
+	assert.Equal(t, ErrTxInCache, mempool.CheckTx(txs[0], nil, TxInfo{}))
+}
+
+func TestMempoolLegacyWAL(t *testing.T) {
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	config := cfg.ResetTestRoot("mempool_test")
+	mempool, cleanup := newMempoolWithAppAndConfig(cc, config)
+	defer cleanup()
+
+	// the txs delimited by newlines
+	walDir := config.Mempool.WalDir()
+	require.NoError(t, os.MkdirAll(walDir, 0700))
+	legacyWALFile := filepath.Join(walDir, legacyWALFileName)
+	require.NoError(t, ioutil.WriteFile(legacyWALFile, []byte("foo\nbar\n"), 0600))
+
+	require.NoError(t, mempool.InitWAL())
+	defer mempool.CloseWAL()
+	require.NoError(t, mempool.ReplayWAL(nil))
+	assert.Zero(t, mempool.Size())
+
+	// moved aside
+	assert.NoFileExists(t, legacyWALFile)
+	data, err := ioutil.ReadFile(legacyWALFile + ".bak")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("foo\nbar\n"), data)
+}
+
 func TestMempool_CheckTxChecksTxSize(t *testing.T) {
 	app := kvstore.NewApplication()
 	cc := proxy.NewLocalClientCreator(app)
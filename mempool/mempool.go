@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	abci "github.com/line/ostracon/abci/types"
+	"github.com/line/ostracon/libs/clist"
+	"github.com/line/ostracon/libs/log"
 	"github.com/line/ostracon/p2p"
 	"github.com/line/ostracon/types"
 )
@@ -54,6 +56,16 @@ type Mempool interface {
 	// Flush removes all transactions from the mempool and cache
 	Flush()
 
+	// UnconfirmedTxByKey returns the transaction of the given TxKey with its
+	// details, if it's in the mempool.
+	UnconfirmedTxByKey(txKey [TxKeySize]byte) (*UnconfirmedTx, bool)
+
+	// RemoveTxByKey removes the transaction of the given TxKey from the mempool
+	// and the WAL, and from the cache if removeFromCache is true.
+	// NOTE: Lock/Unlock must be managed by caller, and FlushAppConn must be
+	// called before, so that the txs being rechecked aren't removed.
+	RemoveTxByKey(txKey [TxKeySize]byte, removeFromCache bool)
+
 	// TxsAvailable returns a channel which fires once for every height,
 	// and only when transactions are available in the mempool.
 	// NOTE: the returned channel may be nil if EnableTxsAvailable was not called.
@@ -73,11 +85,40 @@ type Mempool interface {
 	// there is an error, it will be of type *PathError.
 	InitWAL() error
 
+	// ReplayWAL restores the txs pending in the WAL into the mempool by checking
+	// them again, except the given committed txs. It must be called after
+	// InitWAL.
+	ReplayWAL(committedTxs types.Txs) error
+
 	// CloseWAL closes and discards the underlying WAL file.
 	// Any further writes will not be relayed to disk.
 	CloseWAL()
 }
 
+// GossipMempool is a Mempool whose transactions are gossiped by the Reactor in
+// the order they arrived.
+type GossipMempool interface {
+	Mempool
+
+	// SetLogger sets the Logger.
+	SetLogger(l log.Logger)
+
+	// TxsFront returns the first transaction in the order of the arrival.
+	TxsFront() *clist.CElement
+
+	// TxsWaitChan returns a channel to wait on transactions.
+	TxsWaitChan() <-chan struct{}
+
+	// TxByKey returns the transaction of the given TxKey, if it's in the
+	// mempool.
+	TxByKey(txKey [TxKeySize]byte) (types.Tx, bool)
+
+	// InCache returns true if the transaction of the given TxKey is in the
+	// cache of the recently seen transactions, which includes the committed
+	// ones.
+	InCache(txKey [TxKeySize]byte) bool
+}
+
 //--------------------------------------------------------------------------------
 
 // PreCheckFunc is an optional filter executed before CheckTx and rejects
@@ -100,6 +141,19 @@ type TxInfo struct {
 	SenderP2PID p2p.ID
 }
 
+// UnconfirmedTx describes a transaction in the mempool.
+type UnconfirmedTx struct {
+	Tx types.Tx
+	// Height is the height of the last block committed, when the tx had been
+	// added to the mempool.
+	Height int64
+	// Senders are the peers which have sent the tx.
+	Senders []p2p.ID
+	// CheckTx is the response of the app to the CheckTx, when the tx had been
+	// added to the mempool.
+	CheckTx *abci.ResponseCheckTx
+}
+
 //--------------------------------------------------------------------------------
 
 // PreCheckMaxBytes checks that the size of the transaction is smaller or equal to the expected maxBytes.
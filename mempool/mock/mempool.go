@@ -34,9 +34,14 @@ func (Mempool) FlushAppConn() error           { return nil }
 func (Mempool) TxsAvailable() <-chan struct{} { return make(chan struct{}) }
 func (Mempool) EnableTxsAvailable()           {}
 func (Mempool) TxsBytes() int64               { return 0 }
+func (Mempool) UnconfirmedTxByKey(_ [mempl.TxKeySize]byte) (*mempl.UnconfirmedTx, bool) {
+	return nil, false
+}
+func (Mempool) RemoveTxByKey(_ [mempl.TxKeySize]byte, _ bool) {}
 
 func (Mempool) TxsFront() *clist.CElement    { return nil }
 func (Mempool) TxsWaitChan() <-chan struct{} { return nil }
 
-func (Mempool) InitWAL() error { return nil }
-func (Mempool) CloseWAL()      {}
+func (Mempool) InitWAL() error                { return nil }
+func (Mempool) ReplayWAL(txs types.Txs) error { return nil }
+func (Mempool) CloseWAL()                     {}
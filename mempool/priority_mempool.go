@@ -0,0 +1,237 @@
+package mempool
+
+import (
+	"container/heap"
+
+	abci "github.com/line/ostracon/abci/types"
+	cfg "github.com/line/ostracon/config"
+	"github.com/line/ostracon/libs/clist"
+	tmsync "github.com/line/ostracon/libs/sync"
+	"github.com/line/ostracon/proxy"
+)
+
+//--------------------------------------------------------------------------------
+
+// PriorityMempool is an in-memory pool for transactions, which are proposed in
+// the order of the priority returned by the app in ResponseCheckTx, while the
+// transactions of the same sender, also returned by the app, are proposed in the
+// order they arrived. When the mempool is full, the transactions of the lowest
+// priority are evicted in favor of a new transaction of a higher priority.
+//
+// Otherwise it's a CListMempool, and the transactions are also kept in the
+// order they arrived, for gossiping and rechecking.
+type PriorityMempool struct {
+	*CListMempool
+}
+
+var _ GossipMempool = &PriorityMempool{}
+
+// NewPriorityMempool returns a new prioritized mempool with the given
+// configuration and connection to an application.
+func NewPriorityMempool(
+	config *cfg.MempoolConfig,
+	proxyAppConn proxy.AppConnMempool,
+	height int64,
+	options ...CListMempoolOption,
+) *PriorityMempool {
+	return &PriorityMempool{
+		CListMempool: newCListMempool(config, proxyAppConn, height, newPriorityOrder(), options...),
+	}
+}
+
+//--------------------------------------------------------------------------------
+
+// priorityOrder reaps the txs in the order of their priority, while the txs of
+// a sender are reaped in the order they arrived. The txs of the same priority
+// are reaped in the order they arrived. It evicts the txs of the lowest
+// priority, which is lower than the priority of the new tx.
+type priorityOrder struct {
+	// Protects the indexes below, which are modified by the abci responses
+	// while the txs are reaped.
+	mtx tmsync.RWMutex
+	// senderTxs: sender -> CElements of the txs of the sender, in the order they arrived
+	senderTxs map[string][]*clist.CElement
+	// order of the arrival of the last tx
+	lastSeq uint64
+}
+
+var _ txOrder = (*priorityOrder)(nil)
+
+func newPriorityOrder() *priorityOrder {
+	return &priorityOrder{
+		senderTxs: make(map[string][]*clist.CElement),
+	}
+}
+
+func (o *priorityOrder) push(txs *clist.CList, memTx *mempoolTx) *clist.CElement {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.lastSeq++
+	memTx.seq = o.lastSeq
+	e := txs.PushBack(memTx)
+	if memTx.sender != "" {
+		o.senderTxs[memTx.sender] = append(o.senderTxs[memTx.sender], e)
+	}
+	return e
+}
+
+func (o *priorityOrder) remove(txs *clist.CList, elem *clist.CElement) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	txs.Remove(elem)
+	elem.DetachPrev()
+	if sender := elem.Value.(*mempoolTx).sender; sender != "" {
+		elems := o.senderTxs[sender]
+		for i, e := range elems {
+			if e == elem {
+				elems = append(elems[:i:i], elems[i+1:]...)
+				break
+			}
+		}
+		if len(elems) == 0 {
+			delete(o.senderTxs, sender)
+		} else {
+			o.senderTxs[sender] = elems
+		}
+	}
+}
+
+// rechecked updates the priority of the tx, which may have changed due to
+// newly committed block.
+func (o *priorityOrder) rechecked(memTx *mempoolTx, res *abci.ResponseCheckTx) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	memTx.priority = res.Priority
+}
+
+func (o *priorityOrder) reap(txs *clist.CList, fn func(memTx *mempoolTx) bool) {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	// the first txs of the senders, the highest priority and the earliest first
+	heads := &txHeap{less: func(a, b *mempoolTx) bool {
+		if a.priority != b.priority {
+			return a.priority > b.priority
+		}
+		return a.seq < b.seq
+	}}
+	for e := txs.Front(); e != nil; e = e.Next() {
+		memTx := e.Value.(*mempoolTx)
+		if memTx.sender == "" {
+			heads.elems = append(heads.elems, e)
+		}
+	}
+	for _, elems := range o.senderTxs {
+		heads.elems = append(heads.elems, elems[0])
+	}
+	heap.Init(heads)
+
+	// the number of the visited txs of the senders
+	senderVisited := make(map[string]int)
+	for heads.Len() > 0 {
+		e := heap.Pop(heads).(*clist.CElement)
+		memTx := e.Value.(*mempoolTx)
+		if !fn(memTx) {
+			return
+		}
+		if memTx.sender != "" {
+			senderVisited[memTx.sender]++
+			elems := o.senderTxs[memTx.sender]
+			if n := senderVisited[memTx.sender]; n < len(elems) {
+				heap.Push(heads, elems[n])
+			}
+		}
+	}
+}
+
+func (o *priorityOrder) canEvict() bool { return true }
+
+// evict returns the txs of the lowest priority, which is lower than the
+// priority of the new tx, to make room for it. The txs of a sender are evicted
+// in the reverse order they arrived, and the txs of the sender of the new tx are
+// not evicted.
+func (o *priorityOrder) evict(
+	txs *clist.CList,
+	newTx *mempoolTx,
+	numTxs int,
+	numBytes int64,
+) ([]*clist.CElement, bool) {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	// the last txs of the senders, the lowest priority and the latest first
+	tails := &txHeap{less: func(a, b *mempoolTx) bool {
+		if a.priority != b.priority {
+			return a.priority < b.priority
+		}
+		return a.seq > b.seq
+	}}
+	for e := txs.Front(); e != nil; e = e.Next() {
+		memTx := e.Value.(*mempoolTx)
+		if memTx.sender == "" {
+			tails.elems = append(tails.elems, e)
+		}
+	}
+	for sender, elems := range o.senderTxs {
+		if sender != newTx.sender {
+			tails.elems = append(tails.elems, elems[len(elems)-1])
+		}
+	}
+	heap.Init(tails)
+
+	var evicted []*clist.CElement
+	// the number of the evicted txs of the senders
+	senderEvicted := make(map[string]int)
+	for numTxs > 0 || numBytes > 0 {
+		if tails.Len() == 0 {
+			return nil, false
+		}
+		e := heap.Pop(tails).(*clist.CElement)
+		memTx := e.Value.(*mempoolTx)
+		if memTx.priority >= newTx.priority {
+			return nil, false
+		}
+		evicted = append(evicted, e)
+		numTxs--
+		numBytes -= int64(len(memTx.tx))
+
+		if memTx.sender != "" {
+			senderEvicted[memTx.sender]++
+			elems := o.senderTxs[memTx.sender]
+			if n := len(elems) - senderEvicted[memTx.sender]; n > 0 {
+				heap.Push(tails, elems[n-1])
+			}
+		}
+	}
+	return evicted, true
+}
+
+//--------------------------------------------------------------------------------
+
+// txHeap is a heap of the CElements of mempoolTxs.
+type txHeap struct {
+	elems []*clist.CElement
+	less  func(a, b *mempoolTx) bool
+}
+
+var _ heap.Interface = (*txHeap)(nil)
+
+func (h *txHeap) Len() int { return len(h.elems) }
+
+func (h *txHeap) Less(i, j int) bool {
+	return h.less(h.elems[i].Value.(*mempoolTx), h.elems[j].Value.(*mempoolTx))
+}
+
+func (h *txHeap) Swap(i, j int) { h.elems[i], h.elems[j] = h.elems[j], h.elems[i] }
+
+func (h *txHeap) Push(x interface{}) { h.elems = append(h.elems, x.(*clist.CElement)) }
+
+func (h *txHeap) Pop() interface{} {
+	n := len(h.elems)
+	x := h.elems[n-1]
+	h.elems = h.elems[:n-1]
+	return x
+}
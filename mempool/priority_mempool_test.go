@@ -0,0 +1,213 @@
+package mempool
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/line/ostracon/abci/types"
+	cfg "github.com/line/ostracon/config"
+	"github.com/line/ostracon/libs/log"
+	"github.com/line/ostracon/p2p"
+	"github.com/line/ostracon/proxy"
+	"github.com/line/ostracon/types"
+)
+
+// priorityApp accepts the txs of the form "sender/priority/nonce", or
+// "priority/nonce" without a sender.
+type priorityApp struct {
+	abci.BaseApplication
+}
+
+func (app *priorityApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	parts := strings.Split(string(req.Tx), "/")
+	var sender string
+	if len(parts) == 3 {
+		sender, parts = parts[0], parts[1:]
+	}
+	priority, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return abci.ResponseCheckTx{Code: 1}
+	}
+	return abci.ResponseCheckTx{Code: abci.CodeTypeOK, Priority: priority, Sender: sender}
+}
+
+func newPriorityMempool(t *testing.T, size int) *PriorityMempool {
+	config := cfg.ResetTestRoot("mempool_test")
+	t.Cleanup(func() { os.RemoveAll(config.RootDir) })
+	config.Mempool.Version = "v1"
+	config.Mempool.Size = size
+
+	appConnMem, err := proxy.NewLocalClientCreator(&priorityApp{}).NewABCIClient()
+	require.NoError(t, err)
+	require.NoError(t, appConnMem.Start())
+	t.Cleanup(func() { appConnMem.Stop() }) // nolint:errcheck // ignore for tests
+
+	mempool := NewPriorityMempool(config.Mempool, appConnMem, 0)
+	mempool.SetLogger(log.TestingLogger())
+	return mempool
+}
+
+func checkPriorityTxs(t *testing.T, mempool Mempool, txs ...string) {
+	for _, tx := range txs {
+		require.NoError(t, mempool.CheckTx(types.Tx(tx), nil, TxInfo{}))
+	}
+}
+
+func txsStrings(txs types.Txs) []string {
+	strs := make([]string, len(txs))
+	for i, tx := range txs {
+		strs[i] = string(tx)
+	}
+	return strs
+}
+
+func TestPriorityMempoolReap(t *testing.T) {
+	mempool := newPriorityMempool(t, 100)
+
+	checkPriorityTxs(t, mempool,
+		"1/0",
+		"a/5/0",
+		"b/3/0",
+		"a/10/1", // after a/5/0
+		"7/0",
+		"b/1/1",
+		"5/1", // after a/5/0, which arrived earlier
+	)
+	require.Equal(t, 7, mempool.Size())
+
+	expected := []string{"7/0", "a/5/0", "a/10/1", "5/1", "b/3/0", "1/0", "b/1/1"}
+	assert.Equal(t, expected, txsStrings(mempool.ReapMaxTxs(-1)))
+	assert.Equal(t, expected[:3], txsStrings(mempool.ReapMaxTxs(3)))
+	assert.Equal(t, expected, txsStrings(mempool.ReapMaxBytesMaxGas(-1, -1)))
+	// each tx takes 2 bytes more in the proto encoding
+	assert.Equal(t, expected[:2], txsStrings(mempool.ReapMaxBytesMaxGas(19, -1)))
+
+	// committed txs are removed
+	mempool.Lock()
+	err := mempool.Update(1, types.Txs{types.Tx("7/0"), types.Tx("a/5/0")},
+		[]*abci.ResponseDeliverTx{{Code: abci.CodeTypeOK}, {Code: abci.CodeTypeOK}}, nil, nil)
+	mempool.Unlock()
+	require.NoError(t, err)
+	assert.Equal(t, expected[2:], txsStrings(mempool.ReapMaxTxs(-1)))
+
+	mempool.Flush()
+	assert.Zero(t, mempool.Size())
+	assert.Zero(t, mempool.TxsBytes())
+	assert.Empty(t, mempool.ReapMaxTxs(-1))
+}
+
+func TestPriorityMempoolEviction(t *testing.T) {
+	mempool := newPriorityMempool(t, 3)
+
+	checkPriorityTxs(t, mempool, "a/5/0", "a/7/1", "2/0")
+	require.Equal(t, 3, mempool.Size())
+
+	// not a higher priority than the lowest one
+	checkPriorityTxs(t, mempool, "1/1", "2/1")
+	assert.Equal(t, []string{"a/5/0", "a/7/1", "2/0"}, txsStrings(mempool.ReapMaxTxs(-1)))
+
+	// the lowest priority is evicted
+	checkPriorityTxs(t, mempool, "3/1")
+	assert.Equal(t, []string{"a/5/0", "a/7/1", "3/1"}, txsStrings(mempool.ReapMaxTxs(-1)))
+
+	checkPriorityTxs(t, mempool, "6/2")
+	assert.Equal(t, []string{"6/2", "a/5/0", "a/7/1"}, txsStrings(mempool.ReapMaxTxs(-1)))
+
+	// the last tx of a sender is evicted before the earlier ones
+	checkPriorityTxs(t, mempool, "8/3")
+	assert.Equal(t, []string{"8/3", "a/5/0", "a/7/1"}, txsStrings(mempool.ReapMaxTxs(-1)))
+
+	// the txs of the sender of the new tx are not evicted
+	checkPriorityTxs(t, mempool, "a/9/2")
+	assert.Equal(t, []string{"a/5/0", "a/7/1", "a/9/2"}, txsStrings(mempool.ReapMaxTxs(-1)))
+	checkPriorityTxs(t, mempool, "a/9/3")
+	assert.Equal(t, []string{"a/5/0", "a/7/1", "a/9/2"}, txsStrings(mempool.ReapMaxTxs(-1)))
+
+	// the evicted txs can be added again
+	mempool.Lock()
+	err := mempool.Update(1, types.Txs{types.Tx("a/5/0")},
+		[]*abci.ResponseDeliverTx{{Code: abci.CodeTypeOK}}, nil, nil)
+	mempool.Unlock()
+	require.NoError(t, err)
+	checkPriorityTxs(t, mempool, "2/0")
+	assert.Equal(t, []string{"a/7/1", "a/9/2", "2/0"}, txsStrings(mempool.ReapMaxTxs(-1)))
+	assert.EqualValues(t, len("a/7/1a/9/22/0"), mempool.TxsBytes())
+}
+
+func TestPriorityMempoolUnconfirmedTxByKey(t *testing.T) {
+	mempool := newPriorityMempool(t, 10)
+
+	tx := types.Tx("alice/5/0")
+	require.NoError(t, mempool.CheckTx(tx, nil, TxInfo{SenderID: 1, SenderP2PID: "peer1"}))
+
+	utx, ok := mempool.UnconfirmedTxByKey(TxKey(tx))
+	require.True(t, ok)
+	assert.Equal(t, tx, utx.Tx)
+	assert.Equal(t, []p2p.ID{"peer1"}, utx.Senders)
+	require.NotNil(t, utx.CheckTx)
+	assert.EqualValues(t, 5, utx.CheckTx.Priority)
+	assert.Equal(t, "alice", utx.CheckTx.Sender)
+
+	mempool.Lock()
+	mempool.RemoveTxByKey(TxKey(tx), false)
+	mempool.Unlock()
+	_, ok = mempool.UnconfirmedTxByKey(TxKey(tx))
+	assert.False(t, ok)
+	assert.Equal(t, 0, mempool.Size())
+}
+
+func TestPriorityMempoolTTL(t *testing.T) {
+	mempool := newPriorityMempool(t, 100)
+	mempool.config.TTLNumBlocks = 1
+	checkPriorityTxs(t, mempool, "a/1/0", "2/0")
+
+	mempool.Lock()
+	err := mempool.Update(1, types.Txs{}, []*abci.ResponseDeliverTx{}, nil, nil)
+	mempool.Unlock()
+	require.NoError(t, err)
+	checkPriorityTxs(t, mempool, "a/3/1")
+	assert.Equal(t, []string{"2/0", "a/1/0", "a/3/1"}, txsStrings(mempool.ReapMaxTxs(-1)))
+
+	// the txs and their sender indexes are purged
+	mempool.Lock()
+	err = mempool.Update(2, types.Txs{}, []*abci.ResponseDeliverTx{}, nil, nil)
+	mempool.Unlock()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a/3/1"}, txsStrings(mempool.ReapMaxTxs(-1)))
+	assert.Len(t, mempool.order.(*priorityOrder).senderTxs["a"], 1)
+	assert.EqualValues(t, len("a/3/1"), mempool.TxsBytes())
+}
+
+func TestPriorityMempoolRecheck(t *testing.T) {
+	mempool := newPriorityMempool(t, 100)
+	checkPriorityTxs(t, mempool, "1/0", "2/0", "x/0")
+
+	// the invalid tx isn't added
+	assert.Equal(t, []string{"2/0", "1/0"}, txsStrings(mempool.ReapMaxTxs(-1)))
+
+	// the rechecked txs remain
+	mempool.Lock()
+	err := mempool.Update(1, types.Txs{}, []*abci.ResponseDeliverTx{}, nil, nil)
+	mempool.Unlock()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2/0", "1/0"}, txsStrings(mempool.ReapMaxTxs(-1)))
+
+	// a failing post check removes the txs
+	mempool.Lock()
+	err = mempool.Update(2, types.Txs{}, []*abci.ResponseDeliverTx{}, nil,
+		func(tx types.Tx, res *abci.ResponseCheckTx) error {
+			if res.Priority < 2 {
+				return fmt.Errorf("priority %d too low", res.Priority)
+			}
+			return nil
+		})
+	mempool.Unlock()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2/0"}, txsStrings(mempool.ReapMaxTxs(-1)))
+}
@@ -1,9 +1,11 @@
 package mempool
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"time"
 
 	cfg "github.com/line/ostracon/config"
@@ -16,9 +18,25 @@ import (
 )
 
 const (
+	// MempoolChannel carries the txs, which are pushed to the peers or requested
+	// by them.
 	MempoolChannel = byte(0x30)
+	// MempoolAnnounceChannel carries the announcements of the tx hashes, and the
+	// requests for the announced txs. The peers not supporting it are pushed the
+	// txs instead.
+	MempoolAnnounceChannel = byte(0x31)
 
 	peerCatchupSleepIntervalMS = 100 // If peer is behind, sleep this amount
+	txRequestIntervalMS        = 100 // Check the tx requests every this amount
+
+	// maxAnnounceMsgBytes is the maximum size of an announcement or a request,
+	// which also caps MaxBatchBytes.
+	maxAnnounceMsgBytes = 1024 * 1024 // 1MB
+	// announceMsgOverhead is the space needed by encoding a message, besides
+	// the tx hashes: the field tag of HaveTxs or WantTxs, and its length.
+	announceMsgOverhead = 2 + 3
+	// txKeyBytes is the space needed by a tx hash, with its field tag and length.
+	txKeyBytes = 1 + 1 + TxKeySize
 
 	// UnknownPeerID is the peer ID to use when running CheckTx when there is
 	// no peer (e.g. RPC)
@@ -32,9 +50,12 @@ const (
 // peers you received it from.
 type Reactor struct {
 	p2p.BaseReactor
-	config  *cfg.MempoolConfig
-	mempool *CListMempool
-	ids     *mempoolIDs
+	config   *cfg.MempoolConfig
+	mempool  GossipMempool
+	ids      *mempoolIDs
+	requests *txRequests
+
+	maxBatchTxKeys int // maximum number of tx hashes in an announcement or a request
 }
 
 type mempoolIDs struct {
@@ -100,12 +121,220 @@ func newMempoolIDs() *mempoolIDs {
 	}
 }
 
+// txRequests tracks the txs announced by the peers until they're received.
+// Each tx is requested from one of the peers, which announced it, at a time.
+// When the request times out, the tx is requested from another one, or
+// forgotten if no other peer announced it.
+type txRequests struct {
+	mtx          tmsync.Mutex
+	maxTxs       int           // maximum number of the announced txs tracked
+	maxPerPeer   int           // maximum number of txs requested from a peer at once (0: unlimited)
+	maxAnnounced int           // maximum number of txs tracked for a peer (0: unlimited)
+	timeout      time.Duration // time after which a request times out
+	lastSeq      uint64        // order of the last announced tx
+
+	txs       map[[TxKeySize]byte]*txRequest
+	inFlight  map[p2p.ID]int // number of txs requested from each peer
+	announced map[p2p.ID]int // number of txs announced by each peer, including the requested ones
+}
+
+type txRequest struct {
+	seq        uint64    // order of the announcement, which the requests follow
+	announcers []p2p.ID  // peers which announced the tx, but haven't been requested it
+	peer       p2p.ID    // peer which the tx is requested from, if any
+	deadline   time.Time // time after which the request to the peer times out
+}
+
+func newTxRequests(maxTxs, maxPerPeer, maxAnnounced int, timeout time.Duration) *txRequests {
+	return &txRequests{
+		maxTxs:       maxTxs,
+		maxPerPeer:   maxPerPeer,
+		maxAnnounced: maxAnnounced,
+		timeout:      timeout,
+		txs:          make(map[[TxKeySize]byte]*txRequest),
+		inFlight:     make(map[p2p.ID]int),
+		announced:    make(map[p2p.ID]int),
+	}
+}
+
+// Announced records the tx announced by the peer, and returns true if the tx
+// should be requested from the peer now. The announcement is ignored if the
+// peer has too many txs announced but not received yet.
+func (r *txRequests) Announced(peerID p2p.ID, txKey [TxKeySize]byte, now time.Time) bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.maxAnnounced > 0 && r.announced[peerID] >= r.maxAnnounced {
+		return false
+	}
+	req, ok := r.txs[txKey]
+	if !ok {
+		if len(r.txs) >= r.maxTxs {
+			return false
+		}
+		r.lastSeq++
+		req = &txRequest{seq: r.lastSeq}
+		r.txs[txKey] = req
+	}
+	if req.peer == peerID || containsPeerID(req.announcers, peerID) {
+		return false
+	}
+	r.announced[peerID]++
+	if req.peer != "" || !r.canRequest(peerID) {
+		req.announcers = append(req.announcers, peerID)
+		return false
+	}
+	r.request(req, peerID, now)
+	return true
+}
+
+// Received stops tracking the tx.
+func (r *txRequests) Received(txKey [TxKeySize]byte) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if req, ok := r.txs[txKey]; ok {
+		if req.peer != "" {
+			r.done(req.peer)
+			r.forget(req.peer)
+		}
+		for _, peerID := range req.announcers {
+			r.forget(peerID)
+		}
+		delete(r.txs, txKey)
+	}
+}
+
+// RemovePeer forgets the peer. The txs requested from the peer are requested
+// from the other peers which announced them.
+func (r *txRequests) RemovePeer(peerID p2p.ID) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	delete(r.inFlight, peerID)
+	delete(r.announced, peerID)
+	for txKey, req := range r.txs {
+		if req.peer == peerID {
+			req.peer = ""
+		}
+		req.announcers = removePeerID(req.announcers, peerID)
+		if req.peer == "" && len(req.announcers) == 0 {
+			delete(r.txs, txKey)
+		}
+	}
+}
+
+// Next times out the expired requests, and returns the txs to be requested from
+// each peer now, in the order they were announced. A tx is requested from the
+// first peer which announced it and can take more requests. A timed out tx,
+// which no other peer announced, is forgotten.
+func (r *txRequests) Next(now time.Time) map[p2p.ID][][TxKeySize]byte {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	type nextRequest struct {
+		txKey [TxKeySize]byte
+		seq   uint64
+	}
+	next := make(map[p2p.ID][]nextRequest)
+	for txKey, req := range r.txs {
+		if req.peer != "" {
+			if now.Before(req.deadline) {
+				continue
+			}
+			r.done(req.peer)
+			r.forget(req.peer)
+			req.peer = ""
+		}
+		if len(req.announcers) == 0 {
+			delete(r.txs, txKey)
+			continue
+		}
+		for i, peerID := range req.announcers {
+			if r.canRequest(peerID) {
+				req.announcers = append(req.announcers[:i:i], req.announcers[i+1:]...)
+				r.request(req, peerID, now)
+				next[peerID] = append(next[peerID], nextRequest{txKey, req.seq})
+				break
+			}
+		}
+	}
+
+	txKeys := make(map[p2p.ID][][TxKeySize]byte, len(next))
+	for peerID, reqs := range next {
+		sort.Slice(reqs, func(i, j int) bool { return reqs[i].seq < reqs[j].seq })
+		for _, req := range reqs {
+			txKeys[peerID] = append(txKeys[peerID], req.txKey)
+		}
+	}
+	return txKeys
+}
+
+func (r *txRequests) canRequest(peerID p2p.ID) bool {
+	return r.maxPerPeer == 0 || r.inFlight[peerID] < r.maxPerPeer
+}
+
+func (r *txRequests) request(req *txRequest, peerID p2p.ID, now time.Time) {
+	req.peer = peerID
+	req.deadline = now.Add(r.timeout)
+	r.inFlight[peerID]++
+}
+
+func (r *txRequests) done(peerID p2p.ID) {
+	if r.inFlight[peerID] <= 1 {
+		delete(r.inFlight, peerID)
+	} else {
+		r.inFlight[peerID]--
+	}
+}
+
+// forget releases a tx announced by the peer.
+func (r *txRequests) forget(peerID p2p.ID) {
+	if r.announced[peerID] <= 1 {
+		delete(r.announced, peerID)
+	} else {
+		r.announced[peerID]--
+	}
+}
+
+func containsPeerID(peerIDs []p2p.ID, peerID p2p.ID) bool {
+	for _, id := range peerIDs {
+		if id == peerID {
+			return true
+		}
+	}
+	return false
+}
+
+func removePeerID(peerIDs []p2p.ID, peerID p2p.ID) []p2p.ID {
+	for i, id := range peerIDs {
+		if id == peerID {
+			return append(peerIDs[:i:i], peerIDs[i+1:]...)
+		}
+	}
+	return peerIDs
+}
+
 // NewReactor returns a new Reactor with the given config and mempool.
-func NewReactor(config *cfg.MempoolConfig, async bool, recvBufSize int, mempool *CListMempool) *Reactor {
+func NewReactor(config *cfg.MempoolConfig, async bool, recvBufSize int, mempool GossipMempool) *Reactor {
+	batchBytes := config.MaxBatchBytes
+	if batchBytes <= 0 || batchBytes > maxAnnounceMsgBytes {
+		batchBytes = maxAnnounceMsgBytes
+	}
+	maxBatchTxKeys := (batchBytes - announceMsgOverhead) / txKeyBytes
+	if maxBatchTxKeys < 1 {
+		maxBatchTxKeys = 1
+	}
+
+	requests := newTxRequests(
+		config.Size, config.MaxTxRequestsPerPeer, config.MaxTxAnnouncementsPerPeer, config.TxRequestTimeout)
+
 	memR := &Reactor{
-		config:  config,
-		mempool: mempool,
-		ids:     newMempoolIDs(),
+		config:         config,
+		mempool:        mempool,
+		ids:            newMempoolIDs(),
+		requests:       requests,
+		maxBatchTxKeys: maxBatchTxKeys,
 	}
 	memR.BaseReactor = *p2p.NewBaseReactor("Mempool", memR, async, recvBufSize)
 	return memR
@@ -131,6 +360,7 @@ func (memR *Reactor) OnStart() error {
 	if !memR.config.Broadcast {
 		memR.Logger.Info("Tx broadcasting is disabled")
 	}
+	go memR.requestTxsRoutine()
 	return nil
 }
 
@@ -150,27 +380,40 @@ func (memR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			Priority:            5,
 			RecvMessageCapacity: batchMsg.Size(),
 		},
+		{
+			ID:                  MempoolAnnounceChannel,
+			Priority:            5,
+			RecvMessageCapacity: maxAnnounceMsgBytes,
+		},
 	}
 }
 
 // AddPeer implements Reactor.
-// It starts a broadcast routine ensuring all txs are forwarded to the given peer.
+// It starts a routine announcing all txs to the given peer, or if the peer
+// doesn't support the announcements, a broadcast routine ensuring all txs are
+// forwarded to the peer.
 func (memR *Reactor) AddPeer(peer p2p.Peer) {
 	if memR.config.Broadcast {
-		go memR.broadcastTxRoutine(peer)
+		if memR.config.Announce && peerHasChannel(peer, MempoolAnnounceChannel) {
+			go memR.announceTxRoutine(peer)
+		} else {
+			go memR.broadcastTxRoutine(peer)
+		}
 	}
 }
 
 // RemovePeer implements Reactor.
 func (memR *Reactor) RemovePeer(peer p2p.Peer, reason interface{}) {
 	memR.ids.Reclaim(peer)
+	memR.requests.RemovePeer(peer.ID())
 	// broadcast routine checks if peer is gone and returns
 }
 
 // Receive implements Reactor.
-// It adds any received transactions to the mempool.
+// It adds any received transactions to the mempool, requests the announced
+// transactions it doesn't have, and sends the requested ones.
 func (memR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
-	msg, err := memR.decodeMsg(msgBytes)
+	msg, err := memR.decodeMsg(chID, msgBytes)
 	if err != nil {
 		memR.Logger.Error("Error decoding message", "src", src, "chId", chID, "err", err)
 		memR.Switch.StopPeerForError(src, err)
@@ -178,17 +421,56 @@ func (memR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 	}
 	memR.Logger.Debug("Receive", "src", src, "chId", chID, "msg", msg)
 
-	txInfo := TxInfo{SenderID: memR.ids.GetForPeer(src)}
-	if src != nil {
-		txInfo.SenderP2PID = src.ID()
-	}
-	for _, tx := range msg.Txs {
-		err = memR.mempool.CheckTx(tx, nil, txInfo)
-		if err != nil {
-			memR.Logger.Info("Could not check tx", "tx", txID(tx), "err", err)
+	switch msg := msg.(type) {
+	case *TxsMessage:
+		txInfo := TxInfo{SenderID: memR.ids.GetForPeer(src)}
+		if src != nil {
+			txInfo.SenderP2PID = src.ID()
+		}
+		for _, tx := range msg.Txs {
+			memR.requests.Received(TxKey(tx))
+			err = memR.mempool.CheckTx(tx, nil, txInfo)
+			if err != nil {
+				memR.Logger.Info("Could not check tx", "tx", txID(tx), "err", err)
+			}
+		}
+		// broadcasting happens from go routines per peer
+
+	case *HaveTxsMessage:
+		now := time.Now()
+		var wanted [][TxKeySize]byte
+		for _, txKey := range msg.TxKeys {
+			// the tx may have been received from another peer, or committed
+			if _, ok := memR.mempool.TxByKey(txKey); ok || memR.mempool.InCache(txKey) {
+				continue
+			}
+			if memR.requests.Announced(src.ID(), txKey, now) {
+				wanted = append(wanted, txKey)
+			}
+		}
+		memR.requestTxs(src, wanted)
+
+	case *WantTxsMessage:
+		for _, txKey := range msg.TxKeys {
+			// the tx may have been committed or evicted since it was announced
+			tx, ok := memR.mempool.TxByKey(txKey)
+			if !ok {
+				continue
+			}
+			if !src.Send(MempoolChannel, mustEncodeMsg(protomem.Message{
+				Sum: &protomem.Message_Txs{Txs: &protomem.Txs{Txs: [][]byte{tx}}},
+			})) {
+				// the peer requests the txs again from the other peers
+				return
+			}
 		}
 	}
-	// broadcasting happens from go routines per peer
+}
+
+// peerHasChannel returns true if the peer has the channel of the given ID.
+func peerHasChannel(peer p2p.Peer, chID byte) bool {
+	nodeInfo, ok := peer.NodeInfo().(p2p.DefaultNodeInfo)
+	return ok && bytes.IndexByte(nodeInfo.Channels, chID) >= 0
 }
 
 // PeerState describes the state of a peer.
@@ -245,16 +527,9 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 		// https://github.com/tendermint/tendermint/issues/5796
 
 		if _, ok := memTx.senders.Load(peerID); !ok {
-			msg := protomem.Message{
-				Sum: &protomem.Message_Txs{
-					Txs: &protomem.Txs{Txs: [][]byte{memTx.tx}},
-				},
-			}
-			bz, err := msg.Marshal()
-			if err != nil {
-				panic(err)
-			}
-			success := peer.Send(MempoolChannel, bz)
+			success := peer.Send(MempoolChannel, mustEncodeMsg(protomem.Message{
+				Sum: &protomem.Message_Txs{Txs: &protomem.Txs{Txs: [][]byte{memTx.tx}}},
+			}))
 			if !success {
 				time.Sleep(peerCatchupSleepIntervalMS * time.Millisecond)
 				continue
@@ -273,23 +548,155 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 	}
 }
 
+// Announce the hashes of new mempool txs to the peer, which requests the txs
+// it doesn't have. The hashes of the txs available at once are batched.
+func (memR *Reactor) announceTxRoutine(peer p2p.Peer) {
+	peerID := memR.ids.GetForPeer(peer)
+	var next *clist.CElement
+	var txKeys [][TxKeySize]byte // not announced yet
+
+	for {
+		// In case of both next.NextWaitChan() and peer.Quit() are variable at the same time
+		if !memR.IsRunning() || !peer.IsRunning() {
+			return
+		}
+		// This happens because the CElement we were looking at got garbage
+		// collected (removed). That is, .NextWait() returned nil. Go ahead and
+		// start from the beginning.
+		if next == nil {
+			select {
+			case <-memR.mempool.TxsWaitChan(): // Wait until a tx is available
+				if next = memR.mempool.TxsFront(); next == nil {
+					continue
+				}
+			case <-peer.Quit():
+				return
+			case <-memR.Quit():
+				return
+			}
+		}
+
+		// Make sure the peer is up to date. See broadcastTxRoutine.
+		peerState, ok := peer.Get(types.PeerStateKey).(PeerState)
+		if !ok {
+			time.Sleep(peerCatchupSleepIntervalMS * time.Millisecond)
+			continue
+		}
+
+		// Allow for a lag of 1 block.
+		memTx := next.Value.(*mempoolTx)
+		if peerState.GetHeight() < memTx.Height()-1 {
+			time.Sleep(peerCatchupSleepIntervalMS * time.Millisecond)
+			continue
+		}
+
+		if _, ok := memTx.senders.Load(peerID); !ok {
+			txKeys = append(txKeys, TxKey(memTx.tx))
+		}
+
+		// Announce the batch when it's full, or no more txs are available for now.
+		if len(txKeys) >= memR.maxBatchTxKeys || (len(txKeys) > 0 && next.Next() == nil) {
+			if !memR.announceTxs(peer, txKeys) {
+				return
+			}
+			txKeys = txKeys[:0]
+		}
+
+		select {
+		case <-next.NextWaitChan():
+			// see the start of the for loop for nil check
+			next = next.Next()
+		case <-peer.Quit():
+			return
+		case <-memR.Quit():
+			return
+		}
+	}
+}
+
+// announceTxs sends the tx hashes to the peer, retrying until it succeeds. It
+// returns false if the peer or the reactor stops in the meantime.
+func (memR *Reactor) announceTxs(peer p2p.Peer, txKeys [][TxKeySize]byte) bool {
+	bz := mustEncodeMsg(protomem.Message{
+		Sum: &protomem.Message_HaveTxs{HaveTxs: &protomem.HaveTxs{Hashes: txKeysToHashes(txKeys)}},
+	})
+	for !peer.Send(MempoolAnnounceChannel, bz) {
+		select {
+		case <-time.After(peerCatchupSleepIntervalMS * time.Millisecond):
+		case <-peer.Quit():
+			return false
+		case <-memR.Quit():
+			return false
+		}
+	}
+	return true
+}
+
+// requestTxs requests the announced txs from the peer, in batches.
+func (memR *Reactor) requestTxs(peer p2p.Peer, txKeys [][TxKeySize]byte) {
+	for len(txKeys) > 0 {
+		n := memR.maxBatchTxKeys
+		if n > len(txKeys) {
+			n = len(txKeys)
+		}
+		if !peer.Send(MempoolAnnounceChannel, mustEncodeMsg(protomem.Message{
+			Sum: &protomem.Message_WantTxs{WantTxs: &protomem.WantTxs{Hashes: txKeysToHashes(txKeys[:n])}},
+		})) {
+			// the requests time out, and the txs are requested again
+			memR.Logger.Debug("Could not request txs", "peer", peer, "txs", len(txKeys))
+			return
+		}
+		txKeys = txKeys[n:]
+	}
+}
+
+// Request the announced txs again when the requests time out, and the ones
+// announced by the peers, which had too many txs requested.
+func (memR *Reactor) requestTxsRoutine() {
+	ticker := time.NewTicker(txRequestIntervalMS * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			for peerID, txKeys := range memR.requests.Next(time.Now()) {
+				if peer := memR.Switch.Peers().Get(peerID); peer != nil {
+					memR.requestTxs(peer, txKeys)
+				}
+			}
+		case <-memR.Quit():
+			return
+		}
+	}
+}
+
 //-----------------------------------------------------------------------------
 // Messages
 
-func (memR *Reactor) decodeMsg(bz []byte) (TxsMessage, error) {
+func mustEncodeMsg(msg protomem.Message) []byte {
+	bz, err := msg.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func (memR *Reactor) decodeMsg(chID byte, bz []byte) (interface{}, error) {
 	msg := protomem.Message{}
 	err := msg.Unmarshal(bz)
 	if err != nil {
-		return TxsMessage{}, err
+		return nil, err
 	}
 
-	var message TxsMessage
-
-	if i, ok := msg.Sum.(*protomem.Message_Txs); ok {
+	switch i := msg.Sum.(type) {
+	case *protomem.Message_Txs:
+		if chID != MempoolChannel {
+			break
+		}
 		txs := i.Txs.GetTxs()
 
 		if len(txs) == 0 {
-			return message, errors.New("empty TxsMessage")
+			return nil, errors.New("empty TxsMessage")
 		}
 
 		decoded := make([]types.Tx, len(txs))
@@ -297,12 +704,53 @@ func (memR *Reactor) decodeMsg(bz []byte) (TxsMessage, error) {
 			decoded[j] = types.Tx(tx)
 		}
 
-		message = TxsMessage{
+		return &TxsMessage{
 			Txs: decoded,
+		}, nil
+
+	case *protomem.Message_HaveTxs:
+		if chID != MempoolAnnounceChannel {
+			break
+		}
+		txKeys, err := hashesToTxKeys(i.HaveTxs.GetHashes())
+		if err != nil {
+			return nil, err
+		}
+		return &HaveTxsMessage{TxKeys: txKeys}, nil
+
+	case *protomem.Message_WantTxs:
+		if chID != MempoolAnnounceChannel {
+			break
+		}
+		txKeys, err := hashesToTxKeys(i.WantTxs.GetHashes())
+		if err != nil {
+			return nil, err
+		}
+		return &WantTxsMessage{TxKeys: txKeys}, nil
+	}
+	return nil, fmt.Errorf("msg type: %T is not supported on channel %#x", msg.Sum, chID)
+}
+
+func txKeysToHashes(txKeys [][TxKeySize]byte) [][]byte {
+	hashes := make([][]byte, len(txKeys))
+	for i := range txKeys {
+		hashes[i] = txKeys[i][:]
+	}
+	return hashes
+}
+
+func hashesToTxKeys(hashes [][]byte) ([][TxKeySize]byte, error) {
+	if len(hashes) == 0 {
+		return nil, errors.New("empty tx hashes")
+	}
+	txKeys := make([][TxKeySize]byte, len(hashes))
+	for i, hash := range hashes {
+		if len(hash) != TxKeySize {
+			return nil, fmt.Errorf("wrong tx hash size %d, expected %d", len(hash), TxKeySize)
 		}
-		return message, nil
+		copy(txKeys[i][:], hash)
 	}
-	return message, fmt.Errorf("msg type: %T is not supported", msg)
+	return txKeys, nil
 }
 
 //-------------------------------------
@@ -316,3 +764,24 @@ type TxsMessage struct {
 func (m *TxsMessage) String() string {
 	return fmt.Sprintf("[TxsMessage %v]", m.Txs)
 }
+
+// HaveTxsMessage is a Message announcing the hashes of transactions.
+type HaveTxsMessage struct {
+	TxKeys [][TxKeySize]byte
+}
+
+// String returns a string representation of the HaveTxsMessage.
+func (m *HaveTxsMessage) String() string {
+	return fmt.Sprintf("[HaveTxsMessage %d]", len(m.TxKeys))
+}
+
+// WantTxsMessage is a Message requesting the announced transactions by their
+// hashes.
+type WantTxsMessage struct {
+	TxKeys [][TxKeySize]byte
+}
+
+// String returns a string representation of the WantTxsMessage.
+func (m *WantTxsMessage) String() string {
+	return fmt.Sprintf("[WantTxsMessage %d]", len(m.TxKeys))
+}
@@ -70,6 +70,228 @@ func TestReactorBroadcastTxsMessage(t *testing.T) {
 	waitForTxsOnReactors(t, txs, reactors)
 }
 
+// Same as TestReactorBroadcastTxsMessage, but the txs are pushed to the peers
+// instead of announced.
+func TestReactorBroadcastTxsMessageWithoutAnnounce(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.Announce = false
+	const N = 2
+	config.P2P.MempoolRecvBufSize = (N - 1) * 1000
+
+	reactors := makeAndConnectReactors(config, N)
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				assert.NoError(t, err)
+			}
+		}
+	}()
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().List() {
+			peer.Set(types.PeerStateKey, peerState{1})
+		}
+	}
+
+	txs := checkTxs(t, reactors[0].mempool, numTxs, UnknownPeerID)
+	waitForTxsOnReactors(t, txs, reactors)
+}
+
+// Send a bunch of txs to the first reactor's mempool, which are announced to
+// and requested by the others.
+func TestReactorAnnounceTxs(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.MaxBatchBytes = 10 * txKeyBytes
+	config.Mempool.MaxTxRequestsPerPeer = 100
+	const N = 3
+
+	reactors := makeAndConnectReactors(config, N)
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				assert.NoError(t, err)
+			}
+		}
+	}()
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().List() {
+			require.True(t, peerHasChannel(peer, MempoolAnnounceChannel))
+			peer.Set(types.PeerStateKey, peerState{1})
+		}
+	}
+
+	txs := checkTxs(t, reactors[0].mempool, numTxs, UnknownPeerID)
+	for _, r := range reactors[1:] {
+		r := r
+		require.Eventually(t, func() bool { return r.mempool.Size() == len(txs) }, timeout, 10*time.Millisecond)
+		for _, tx := range txs {
+			_, ok := r.mempool.TxByKey(TxKey(tx))
+			assert.True(t, ok)
+		}
+	}
+}
+
+func TestTxRequests(t *testing.T) {
+	const timeout = time.Second
+	requests := newTxRequests(3, 2, 0, timeout)
+	now := time.Now()
+	tx1, tx2, tx3, tx4 := TxKey([]byte{1}), TxKey([]byte{2}), TxKey([]byte{3}), TxKey([]byte{4})
+
+	// requested from the first peer announcing them, up to the limit
+	assert.True(t, requests.Announced("a", tx1, now))
+	assert.True(t, requests.Announced("a", tx2, now))
+	assert.False(t, requests.Announced("a", tx2, now))
+	assert.False(t, requests.Announced("a", tx3, now))
+	assert.False(t, requests.Announced("b", tx1, now))
+	// no more txs are tracked
+	assert.False(t, requests.Announced("b", tx4, now))
+	assert.Empty(t, requests.Next(now))
+
+	// the txs waiting for the peer are requested once it has room
+	requests.Received(tx1)
+	assert.Equal(t, map[p2p.ID][][TxKeySize]byte{"a": {tx3}}, requests.Next(now))
+
+	// the timed out txs are requested from the other peers announcing them
+	assert.False(t, requests.Announced("b", tx2, now))
+	assert.Equal(t, map[p2p.ID][][TxKeySize]byte{"b": {tx2}}, requests.Next(now.Add(timeout)))
+	assert.Empty(t, requests.Next(now.Add(2*timeout)))
+	assert.Len(t, requests.txs, 0)
+	assert.Empty(t, requests.inFlight)
+	assert.Empty(t, requests.announced)
+
+	// the txs requested from a removed peer are requested from the others
+	assert.True(t, requests.Announced("a", tx1, now))
+	assert.False(t, requests.Announced("b", tx1, now))
+	requests.RemovePeer("a")
+	assert.Equal(t, map[p2p.ID][][TxKeySize]byte{"b": {tx1}}, requests.Next(now))
+	requests.RemovePeer("b")
+	assert.Len(t, requests.txs, 0)
+	assert.Empty(t, requests.inFlight)
+	assert.Empty(t, requests.announced)
+}
+
+func TestTxRequestsMaxAnnounced(t *testing.T) {
+	const timeout = time.Second
+	requests := newTxRequests(10, 0, 2, timeout)
+	now := time.Now()
+	tx1, tx2, tx3 := TxKey([]byte{1}), TxKey([]byte{2}), TxKey([]byte{3})
+
+	// the announcements of a peer are ignored beyond the limit
+	assert.True(t, requests.Announced("a", tx1, now))
+	assert.True(t, requests.Announced("a", tx2, now))
+	assert.False(t, requests.Announced("a", tx3, now))
+	assert.NotContains(t, requests.txs, tx3)
+	assert.True(t, requests.Announced("b", tx3, now))
+
+	// the received txs release the announcements of the peer
+	requests.Received(tx1)
+	assert.False(t, requests.Announced("a", tx3, now))
+	assert.Equal(t, map[p2p.ID]int{"a": 2, "b": 1}, requests.announced)
+
+	// a timed out tx, which no other peer announced, is forgotten
+	assert.Equal(t, map[p2p.ID][][TxKeySize]byte{"a": {tx3}}, requests.Next(now.Add(timeout)))
+	assert.NotContains(t, requests.txs, tx2)
+	assert.Equal(t, map[p2p.ID]int{"a": 1}, requests.announced)
+	assert.Empty(t, requests.Next(now.Add(2*timeout)))
+	assert.Empty(t, requests.txs)
+	assert.Empty(t, requests.inFlight)
+	assert.Empty(t, requests.announced)
+}
+
+func TestReactorHaveTxsSkipsCachedTxs(t *testing.T) {
+	config := cfg.TestConfig()
+	reactors := makeAndConnectReactors(config, 1)
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				assert.NoError(t, err)
+			}
+		}
+	}()
+	reactor := reactors[0]
+
+	// the committed tx is only in the cache
+	committedTx, newTx := types.Tx("committed"), types.Tx("new")
+	require.NoError(t, reactor.mempool.CheckTx(committedTx, nil, TxInfo{}))
+	reactor.mempool.Lock()
+	err := reactor.mempool.Update(1, types.Txs{committedTx}, abciResponses(1, abci.CodeTypeOK), nil, nil)
+	reactor.mempool.Unlock()
+	require.NoError(t, err)
+	require.Zero(t, reactor.mempool.Size())
+
+	peer := mock.NewPeer(nil)
+	reactor.Receive(MempoolAnnounceChannel, peer, mustEncodeMsg(memproto.Message{
+		Sum: &memproto.Message_HaveTxs{HaveTxs: &memproto.HaveTxs{
+			Hashes: txKeysToHashes([][TxKeySize]byte{TxKey(committedTx), TxKey(newTx)}),
+		}},
+	}))
+	assert.Equal(t, 1, len(reactor.requests.txs))
+	assert.Contains(t, reactor.requests.txs, TxKey(newTx))
+}
+
+func TestReactorDecodeMsg(t *testing.T) {
+	memR := NewReactor(cfg.TestMempoolConfig(), false, 0, nil)
+	encode := func(msg memproto.Message) []byte {
+		bz, err := msg.Marshal()
+		require.NoError(t, err)
+		return bz
+	}
+	txKey := TxKey([]byte{1})
+	txs := encode(memproto.Message{Sum: &memproto.Message_Txs{Txs: &memproto.Txs{Txs: [][]byte{{1}}}}})
+	haveTxs := encode(memproto.Message{Sum: &memproto.Message_HaveTxs{
+		HaveTxs: &memproto.HaveTxs{Hashes: [][]byte{txKey[:]}}}})
+	wantTxs := encode(memproto.Message{Sum: &memproto.Message_WantTxs{
+		WantTxs: &memproto.WantTxs{Hashes: [][]byte{txKey[:]}}}})
+
+	msg, err := memR.decodeMsg(MempoolChannel, txs)
+	require.NoError(t, err)
+	assert.Equal(t, &TxsMessage{Txs: []types.Tx{{1}}}, msg)
+	msg, err = memR.decodeMsg(MempoolAnnounceChannel, haveTxs)
+	require.NoError(t, err)
+	assert.Equal(t, &HaveTxsMessage{TxKeys: [][TxKeySize]byte{txKey}}, msg)
+	msg, err = memR.decodeMsg(MempoolAnnounceChannel, wantTxs)
+	require.NoError(t, err)
+	assert.Equal(t, &WantTxsMessage{TxKeys: [][TxKeySize]byte{txKey}}, msg)
+
+	// wrong channels
+	_, err = memR.decodeMsg(MempoolAnnounceChannel, txs)
+	assert.Error(t, err)
+	_, err = memR.decodeMsg(MempoolChannel, haveTxs)
+	assert.Error(t, err)
+	_, err = memR.decodeMsg(MempoolChannel, wantTxs)
+	assert.Error(t, err)
+
+	// wrong hashes
+	_, err = memR.decodeMsg(MempoolAnnounceChannel, encode(memproto.Message{Sum: &memproto.Message_HaveTxs{
+		HaveTxs: &memproto.HaveTxs{Hashes: [][]byte{{1}}}}}))
+	assert.Error(t, err)
+	_, err = memR.decodeMsg(MempoolAnnounceChannel, encode(memproto.Message{Sum: &memproto.Message_WantTxs{
+		WantTxs: &memproto.WantTxs{}}}))
+	assert.Error(t, err)
+}
+
+func TestReactorMaxBatchBytes(t *testing.T) {
+	testCases := []struct {
+		maxBatchBytes int
+		expBytes      int
+	}{
+		{0, maxAnnounceMsgBytes},
+		{100, 100},
+		{1, txKeyBytes + announceMsgOverhead},
+		{maxAnnounceMsgBytes + 1, maxAnnounceMsgBytes},
+	}
+	for _, tc := range testCases {
+		config := cfg.TestMempoolConfig()
+		config.MaxBatchBytes = tc.maxBatchBytes
+		memR := NewReactor(config, false, 0, nil)
+
+		msg := memproto.Message{Sum: &memproto.Message_HaveTxs{HaveTxs: &memproto.HaveTxs{
+			Hashes: txKeysToHashes(make([][TxKeySize]byte, memR.maxBatchTxKeys)),
+		}}}
+		assert.LessOrEqual(t, msg.Size(), tc.expBytes, tc.maxBatchBytes)
+		assert.Greater(t, msg.Size()+txKeyBytes, tc.expBytes, tc.maxBatchBytes)
+	}
+}
+
 // regression test for https://github.com/tendermint/tendermint/issues/5408
 func TestReactorConcurrency(t *testing.T) {
 	config := cfg.TestConfig()
@@ -0,0 +1,352 @@
+package mempool
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	cfg "github.com/line/ostracon/config"
+	auto "github.com/line/ostracon/libs/autofile"
+	"github.com/line/ostracon/libs/clist"
+	"github.com/line/ostracon/libs/log"
+	tmos "github.com/line/ostracon/libs/os"
+	"github.com/line/ostracon/libs/protoio"
+	protomem "github.com/line/ostracon/proto/ostracon/mempool"
+	"github.com/line/ostracon/types"
+)
+
+// The mempool WAL holds the txs pending in the mempool, so that they can be
+// restored after a restart. Each tx is written as a length-delimited
+// protobuf Txs message.
+//
+// The txs are appended to the head file. After every committed block, the
+// head is rotated to a segment file numbered after it, and the txs still
+// pending are written over that segment in the background, which then
+// replaces all the earlier segments. So the WAL only holds the txs which are
+// pending, and the txs checked since the last compaction, without any work
+// on the commit path but a walk of the mempool.
+const (
+	walFileName = "txs.wal"
+	// The WAL used to be written to this file, with each tx followed by a
+	// newline. It's moved aside to legacyWALFileName+".bak" on start.
+	legacyWALFileName = "wal"
+)
+
+// mempoolWAL is the mempool WAL. Its writes and rotations must be excluded by
+// the caller, which the mempool does with its lock.
+type mempoolWAL struct {
+	head *auto.AutoFile
+
+	// index of the segment the head is rotated to next
+	nextIndex int
+
+	// the pending txs to compact the WAL into, with the index of the segment
+	// they're written over
+	compactionc chan walCompaction
+	done        chan struct{}
+
+	logger log.Logger
+}
+
+type walCompaction struct {
+	index int
+	txs   types.Txs
+}
+
+// openWAL creates a directory for the WAL file and opens a file itself. The
+// WAL file of the legacy format, if any, is moved aside.
+func openWAL(config *cfg.MempoolConfig, logger log.Logger) (*mempoolWAL, error) {
+	var (
+		walDir  = config.WalDir()
+		walFile = filepath.Join(walDir, walFileName)
+	)
+
+	const perm = 0700
+	if err := tmos.EnsureDir(walDir, perm); err != nil {
+		return nil, err
+	}
+
+	legacyWALFile := filepath.Join(walDir, legacyWALFileName)
+	if tmos.FileExists(legacyWALFile) {
+		if err := os.Rename(legacyWALFile, legacyWALFile+".bak"); err != nil {
+			return nil, fmt.Errorf("can't move aside legacy mempool WAL %s: %w", legacyWALFile, err)
+		}
+		logger.Info("Moved aside legacy mempool WAL, whose txs aren't replayed",
+			"path", legacyWALFile+".bak")
+	}
+
+	af, err := auto.OpenAutoFile(walFile)
+	if err != nil {
+		return nil, fmt.Errorf("can't open autofile %s: %w", walFile, err)
+	}
+	wal := &mempoolWAL{
+		head:        af,
+		compactionc: make(chan walCompaction, 1),
+		done:        make(chan struct{}),
+		logger:      logger,
+	}
+	// the temporary files left by a crash while compacting
+	tmpFiles, err := filepath.Glob(af.Path + ".*.tmp-*")
+	if err != nil {
+		af.Close()
+		return nil, err
+	}
+	for _, path := range tmpFiles {
+		if err := os.Remove(path); err != nil {
+			af.Close()
+			return nil, err
+		}
+	}
+	indices, err := wal.segments()
+	if err != nil {
+		af.Close()
+		return nil, err
+	}
+	if len(indices) > 0 {
+		wal.nextIndex = indices[len(indices)-1] + 1
+	}
+	go wal.compactRoutine()
+	return wal, nil
+}
+
+// Path returns the path of the head file.
+func (wal *mempoolWAL) Path() string {
+	return wal.head.Path
+}
+
+// Close waits for the compaction in progress, if any, and closes the head.
+func (wal *mempoolWAL) Close() error {
+	close(wal.compactionc)
+	<-wal.done
+	return wal.head.Close()
+}
+
+// write appends the tx to the WAL.
+func (wal *mempoolWAL) write(tx types.Tx) error {
+	bz, err := protoio.MarshalDelimited(&protomem.Txs{Txs: [][]byte{tx}})
+	if err != nil {
+		return err
+	}
+	_, err = wal.head.Write(bz)
+	return err
+}
+
+// compact rotates the head, and has the WAL compacted into the txs of the
+// list in the background.
+func (wal *mempoolWAL) compact(txs *clist.CList) error {
+	index := wal.nextIndex
+	if err := wal.head.Rename(wal.segmentPath(index)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("can't rotate the mempool WAL: %w", err)
+	}
+	wal.nextIndex++
+
+	pending := make(types.Txs, 0, txs.Len())
+	for e := txs.Front(); e != nil; e = e.Next() {
+		pending = append(pending, e.Value.(*mempoolTx).tx)
+	}
+
+	// the compaction not started yet, if any, is superseded by this one, which
+	// covers all of its txs
+	select {
+	case <-wal.compactionc:
+	default:
+	}
+	wal.compactionc <- walCompaction{index: index, txs: pending}
+	return nil
+}
+
+func (wal *mempoolWAL) compactRoutine() {
+	defer close(wal.done)
+	for c := range wal.compactionc {
+		if err := wal.writeSegment(c.index, c.txs); err != nil {
+			wal.logger.Error("Error compacting mempool WAL", "err", err)
+			continue
+		}
+		// the earlier segments are replaced by the one written
+		if err := wal.removeSegments(c.index); err != nil {
+			wal.logger.Error("Error removing mempool WAL segments", "err", err)
+		}
+	}
+}
+
+// writeSegment atomically writes the txs over the segment of the index: they
+// are written to a temporary file, which is synced and renamed to the path of
+// the segment, so that the segment isn't lost by a crash while it's written.
+func (wal *mempoolWAL) writeSegment(index int, txs types.Txs) (err error) {
+	path := wal.segmentPath(index)
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+
+	buf := bufio.NewWriter(f)
+	w := protoio.NewDelimitedWriter(buf)
+	for _, tx := range txs {
+		if _, err = w.WriteMsg(&protomem.Txs{Txs: [][]byte{tx}}); err != nil {
+			return err
+		}
+	}
+	if err = buf.Flush(); err != nil {
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// removeSegments removes the segments before the index.
+func (wal *mempoolWAL) removeSegments(index int) error {
+	indices, err := wal.segments()
+	if err != nil {
+		return err
+	}
+	for _, i := range indices {
+		if i >= index {
+			break
+		}
+		if err := os.Remove(wal.segmentPath(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (wal *mempoolWAL) segmentPath(index int) string {
+	return fmt.Sprintf("%s.%03d", wal.head.Path, index)
+}
+
+// segments returns the indices of the segments in ascending order.
+func (wal *mempoolWAL) segments() ([]int, error) {
+	paths, err := filepath.Glob(wal.head.Path + ".*")
+	if err != nil {
+		return nil, err
+	}
+	var indices []int
+	for _, path := range paths {
+		// the temporary files are skipped
+		index, err := strconv.Atoi(strings.TrimPrefix(path, wal.head.Path+"."))
+		if err != nil {
+			continue
+		}
+		indices = append(indices, index)
+	}
+	sort.Ints(indices)
+	return indices, nil
+}
+
+// read returns the txs in the segments and the head. A partially written tx
+// at the end of a file, which is left by a crash, is ignored. In case of any
+// other error, the txs read so far are returned along with the error.
+func (wal *mempoolWAL) read() (types.Txs, error) {
+	indices, err := wal.segments()
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, 0, len(indices)+1)
+	for _, index := range indices {
+		paths = append(paths, wal.segmentPath(index))
+	}
+	paths = append(paths, wal.head.Path)
+
+	var txs types.Txs
+	for _, path := range paths {
+		txs, err = readWALFile(path, txs)
+		if err != nil {
+			return txs, err
+		}
+	}
+	return txs, nil
+}
+
+// readWALFile appends the txs in the file of the path to txs.
+func readWALFile(path string, txs types.Txs) (types.Txs, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return txs, nil
+	} else if err != nil {
+		return txs, err
+	}
+	defer f.Close()
+
+	r := protoio.NewDelimitedReader(f, types.MaxBlockSizeBytes)
+	for {
+		var msg protomem.Txs
+		_, err := r.ReadMsg(&msg)
+		switch {
+		case err == io.EOF || errors.Is(err, io.ErrUnexpectedEOF):
+			return txs, nil
+		case err != nil:
+			return txs, fmt.Errorf("can't read the mempool WAL %s: %w", path, err)
+		}
+		for _, tx := range msg.Txs {
+			txs = append(txs, types.Tx(tx))
+		}
+	}
+}
+
+// truncate removes all the txs in the WAL.
+func (wal *mempoolWAL) truncate() error {
+	if err := wal.head.Rename(wal.segmentPath(wal.nextIndex)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	wal.nextIndex++
+	return wal.removeSegments(wal.nextIndex)
+}
+
+// replayWAL reads the txs back from the WAL and checks them again, except the
+// committed ones, so that the pending txs are restored into the mempool. The
+// WAL is truncated first, and the txs are written to it again as they're
+// checked. lock must exclude the concurrent writes to the WAL.
+func replayWAL(
+	mem Mempool,
+	wal *mempoolWAL,
+	lock sync.Locker,
+	committedTxs types.Txs,
+	logger log.Logger,
+) error {
+	lock.Lock()
+	txs, err := wal.read()
+	if err != nil {
+		// the txs written after the corrupted part are lost
+		logger.Error("Error reading mempool WAL", "err", err)
+	}
+	err = wal.truncate()
+	lock.Unlock()
+	if err != nil {
+		return fmt.Errorf("truncate mempool WAL: %w", err)
+	}
+
+	committed := make(map[[TxKeySize]byte]struct{}, len(committedTxs))
+	for _, tx := range committedTxs {
+		committed[TxKey(tx)] = struct{}{}
+	}
+
+	logger.Info("Replaying mempool WAL", "txs", len(txs))
+	for _, tx := range txs {
+		if _, ok := committed[TxKey(tx)]; ok {
+			continue
+		}
+		if err := mem.CheckTx(tx, nil, TxInfo{}); err != nil {
+			logger.Debug("Dropped tx in mempool WAL", "tx", txID(tx), "err", err)
+		}
+	}
+	return mem.FlushAppConn()
+}
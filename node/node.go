@@ -24,6 +24,7 @@ import (
 	cfg "github.com/line/ostracon/config"
 	cs "github.com/line/ostracon/consensus"
 	"github.com/line/ostracon/crypto"
+	"github.com/line/ostracon/crypto/vrf"
 	"github.com/line/ostracon/evidence"
 	tmjson "github.com/line/ostracon/libs/json"
 	"github.com/line/ostracon/libs/log"
@@ -321,17 +322,32 @@ func onlyValidatorIsUs(state sm.State, pubKey crypto.PubKey) bool {
 	return bytes.Equal(pubKey.Address(), addr)
 }
 
-func createMempoolAndMempoolReactor(config *cfg.Config, proxyApp proxy.AppConns,
-	state sm.State, memplMetrics *mempl.Metrics, logger log.Logger) (*mempl.Reactor, *mempl.CListMempool) {
+func createMempoolAndMempoolReactor(config *cfg.Config, proxyApp proxy.AppConns, state sm.State,
+	eventBus *types.EventBus, memplMetrics *mempl.Metrics, logger log.Logger) (*mempl.Reactor, mempl.GossipMempool) {
 
-	mempool := mempl.NewCListMempool(
-		config.Mempool,
-		proxyApp.Mempool(),
-		state.LastBlockHeight,
-		mempl.WithMetrics(memplMetrics),
-		mempl.WithPreCheck(sm.TxPreCheck(state)),
-		mempl.WithPostCheck(sm.TxPostCheck(state)),
-	)
+	var mempool mempl.GossipMempool
+	switch config.Mempool.Version {
+	case "v1":
+		mempool = mempl.NewPriorityMempool(
+			config.Mempool,
+			proxyApp.Mempool(),
+			state.LastBlockHeight,
+			mempl.WithMetrics(memplMetrics),
+			mempl.WithEventBus(eventBus),
+			mempl.WithPreCheck(sm.TxPreCheck(state)),
+			mempl.WithPostCheck(sm.TxPostCheck(state)),
+		)
+	default:
+		mempool = mempl.NewCListMempool(
+			config.Mempool,
+			proxyApp.Mempool(),
+			state.LastBlockHeight,
+			mempl.WithMetrics(memplMetrics),
+			mempl.WithEventBus(eventBus),
+			mempl.WithPreCheck(sm.TxPreCheck(state)),
+			mempl.WithPostCheck(sm.TxPostCheck(state)),
+		)
+	}
 	mempoolLogger := logger.With("module", "mempool")
 	mempoolReactor := mempl.NewReactor(config.Mempool, config.P2P.RecvAsync, config.P2P.MempoolRecvBufSize, mempool)
 	mempoolReactor.SetLogger(mempoolLogger)
@@ -387,7 +403,7 @@ func createConsensusReactor(config *cfg.Config,
 	state sm.State,
 	blockExec *sm.BlockExecutor,
 	blockStore sm.BlockStore,
-	mempool *mempl.CListMempool,
+	mempool mempl.Mempool,
 	evidencePool *evidence.Pool,
 	privValidator types.PrivValidator,
 	csMetrics *cs.Metrics,
@@ -648,6 +664,12 @@ func NewNode(config *cfg.Config,
 		return nil, err
 	}
 
+	vrfSuite := state.ConsensusParams.Validator.VrfSuite
+	if vrfSuite == "" {
+		vrfSuite = vrf.DefaultSuite()
+	}
+	logger.Info("VRF suite", "suite", vrfSuite)
+
 	// Create the proxyApp and establish connections to the ABCI app (consensus, mempool, query).
 	proxyApp, err := createAndStartProxyAppConns(clientCreator, logger)
 	if err != nil {
@@ -714,10 +736,18 @@ func NewNode(config *cfg.Config,
 
 	logNodeStartupInfo(state, pubKey, logger, consensusLogger)
 
+	// The software must support the block protocol, which may have been switched by an upgrade.
+	if !version.IsBlockProtocolSupported(state.Version.Consensus.Block) {
+		return nil, sm.ErrUnsupportedBlockProtocol{
+			Height:        state.LastBlockHeight + 1,
+			BlockProtocol: state.Version.Consensus.Block,
+		}
+	}
+
 	csMetrics, p2pMetrics, memplMetrics, smMetrics := metricsProvider(genDoc.ChainID)
 
 	// Make MempoolReactor
-	mempoolReactor, mempool := createMempoolAndMempoolReactor(config, proxyApp, state, memplMetrics, logger)
+	mempoolReactor, mempool := createMempoolAndMempoolReactor(config, proxyApp, state, eventBus, memplMetrics, logger)
 
 	// Make Evidence Reactor
 	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateDB, blockStore, logger)
@@ -895,6 +925,17 @@ func (n *Node) OnStart() error {
 		if err != nil {
 			return fmt.Errorf("init mempool WAL: %w", err)
 		}
+
+		// The WAL is rewritten after every committed block, so only the txs of
+		// the latest block may have been committed since.
+		var committedTxs types.Txs
+		if block := n.blockStore.LoadBlock(n.blockStore.Height()); block != nil {
+			committedTxs = block.Txs
+		}
+		err = n.mempool.ReplayWAL(committedTxs)
+		if err != nil {
+			return fmt.Errorf("replay mempool WAL: %w", err)
+		}
 	}
 
 	// Start the switch (the P2P server).
@@ -1266,7 +1307,7 @@ func makeNodeInfo(
 		Channels: []byte{
 			bcChannel,
 			cs.StateChannel, cs.DataChannel, cs.VoteChannel, cs.VoteSetBitsChannel,
-			mempl.MempoolChannel,
+			mempl.MempoolChannel, mempl.MempoolAnnounceChannel,
 			evidence.EvidenceChannel,
 			statesync.SnapshotChannel, statesync.ChunkChannel,
 		},
@@ -292,14 +292,15 @@ func TestCreateProposalBlock(t *testing.T) {
 
 	commit := types.NewCommit(height-1, 0, types.BlockID{}, nil)
 	message := state.MakeHashMessage(0)
-	proof, _ := privVals[0].GenerateVRFProof(message)
-	block, _ := blockExec.CreateProposalBlock(
+	proof, _ := privVals[0].GenerateVRFProof(message, "")
+	block, _, err := blockExec.CreateProposalBlock(
 		height,
-		state, commit,
+		state, types.NewExtendedCommit(commit, nil),
 		proposerAddr,
 		0,
 		proof,
 	)
+	require.NoError(t, err)
 
 	// check that the part set does not exceed the maximum block size
 	partSet := block.MakePartSet(partSize)
@@ -364,14 +365,15 @@ func TestMaxProposalBlockSize(t *testing.T) {
 
 	commit := types.NewCommit(height-1, 0, types.BlockID{}, nil)
 	message := state.MakeHashMessage(0)
-	proof, _ := privVals[0].GenerateVRFProof(message)
-	block, _ := blockExec.CreateProposalBlock(
+	proof, _ := privVals[0].GenerateVRFProof(message, "")
+	block, _, err := blockExec.CreateProposalBlock(
 		height,
-		state, commit,
+		state, types.NewExtendedCommit(commit, nil),
 		proposerAddr,
 		0,
 		proof,
 	)
+	require.NoError(t, err)
 
 	pb, err := block.ToProto()
 	require.NoError(t, err)
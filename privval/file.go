@@ -15,6 +15,7 @@ import (
 
 	"github.com/line/ostracon/crypto"
 	"github.com/line/ostracon/crypto/ed25519"
+	"github.com/line/ostracon/crypto/secp256k1"
 	tmbytes "github.com/line/ostracon/libs/bytes"
 	tmjson "github.com/line/ostracon/libs/json"
 	tmos "github.com/line/ostracon/libs/os"
@@ -36,6 +37,7 @@ const (
 const (
 	PrivKeyTypeEd25519   string = "ed25519"
 	PrivKeyTypeComposite string = "composite"
+	PrivKeyTypeSecp256k1 string = "secp256k1"
 )
 
 // A vote is either stepPrevote or stepPrecommit.
@@ -186,6 +188,8 @@ func GenFilePV(keyFilePath, stateFilePath, privKeyType string) (filePV *FilePV,
 		privKey = ed25519.GenPrivKey()
 	case PrivKeyTypeComposite:
 		privKey = composite.NewPrivKeyComposite(bls.GenPrivKey(), ed25519.GenPrivKey())
+	case PrivKeyTypeSecp256k1:
+		privKey = secp256k1.GenPrivKey()
 	default:
 		return nil, fmt.Errorf("undefined private key type: %s", privKeyType)
 	}
@@ -289,8 +293,8 @@ func (pv *FilePV) SignProposal(chainID string, proposal *tmproto.Proposal) error
 }
 
 // GenerateVRFProof generates a proof for specified message.
-func (pv *FilePV) GenerateVRFProof(message []byte) (crypto.Proof, error) {
-	return pv.Key.PrivKey.VRFProve(message)
+func (pv *FilePV) GenerateVRFProof(message []byte, vrfSuite string) (crypto.Proof, error) {
+	return types.VRFProve(pv.Key.PrivKey, message, vrfSuite)
 }
 
 // Save persists the FilePV to disk.
@@ -351,9 +355,9 @@ func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
 			vote.Timestamp = timestamp
 			vote.Signature = lss.Signature
 		} else {
-			err = fmt.Errorf("conflicting data")
+			return fmt.Errorf("conflicting data")
 		}
-		return err
+		return pv.signVoteExtension(chainID, vote)
 	}
 
 	// It passed the checks. Sign the vote
@@ -363,6 +367,22 @@ func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
 	}
 	pv.saveSigned(height, round, step, signBytes, sig)
 	vote.Signature = sig
+	return pv.signVoteExtension(chainID, vote)
+}
+
+// signVoteExtension sets the signature of the extension of a precommit for a
+// block, even of an empty one. The extension is not a part of the sign bytes of
+// the vote, so signing it needs no double sign protection.
+func (pv *FilePV) signVoteExtension(chainID string, vote *tmproto.Vote) error {
+	vote.ExtensionSignature = nil
+	if !types.IsVoteExtendable(vote) {
+		return nil
+	}
+	sig, err := pv.Key.PrivKey.Sign(types.VoteExtensionSignBytes(chainID, vote))
+	if err != nil {
+		return err
+	}
+	vote.ExtensionSignature = sig
 	return nil
 }
 
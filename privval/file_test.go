@@ -16,12 +16,14 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/line/ostracon/crypto/ed25519"
+	"github.com/line/ostracon/crypto/secp256k1"
 	"github.com/line/ostracon/crypto/tmhash"
 	tmjson "github.com/line/ostracon/libs/json"
 	tmrand "github.com/line/ostracon/libs/rand"
 	tmproto "github.com/line/ostracon/proto/ostracon/types"
 	"github.com/line/ostracon/types"
 	tmtime "github.com/line/ostracon/types/time"
+	"github.com/line/ostracon/version"
 )
 
 func TestGenFilePV(t *testing.T) {
@@ -36,6 +38,10 @@ func TestGenFilePV(t *testing.T) {
 	privValComposite, err := GenFilePV(tempKeyFile.Name(), tempStateFile.Name(), PrivKeyTypeComposite)
 	require.EqualValues(t, reflect.TypeOf(composite.PubKey{}), reflect.TypeOf(privValComposite.Key.PubKey))
 
+	privValSecp256k1, err := GenFilePV(tempKeyFile.Name(), tempStateFile.Name(), PrivKeyTypeSecp256k1)
+	require.Nil(t, err)
+	require.EqualValues(t, reflect.TypeOf(secp256k1.PubKey{}), reflect.TypeOf(privValSecp256k1.Key.PubKey))
+
 	privValUndefinedPrivKeyType, err := GenFilePV(tempKeyFile.Name(), tempStateFile.Name(), "")
 	require.NotNil(t, err)
 	require.Nil(t, privValUndefinedPrivKeyType)
@@ -238,6 +244,54 @@ func TestSignVote(t *testing.T) {
 	assert.Equal(sig, vote.Signature)
 }
 
+func TestSignVoteExtension(t *testing.T) {
+	tempKeyFile, err := ioutil.TempFile("", "priv_validator_key_")
+	require.Nil(t, err)
+	tempStateFile, err := ioutil.TempFile("", "priv_validator_state_")
+	require.Nil(t, err)
+
+	privVal, err := GenFilePV(tempKeyFile.Name(), tempStateFile.Name(), PrivKeyTypeEd25519)
+	require.Nil(t, err)
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+
+	randbytes := tmrand.Bytes(tmhash.Size)
+	block := types.BlockID{Hash: randbytes,
+		PartSetHeader: types.PartSetHeader{Total: 5, Hash: randbytes}}
+	height, round := int64(10), int32(1)
+
+	// a prevote is never extended
+	vote := newVote(privVal.Key.Address, 0, height, round, tmproto.PrevoteType, block)
+	vote.Extension = []byte("extension")
+	v := vote.ToProto()
+	require.NoError(t, privVal.SignVote("mychainid", v))
+	assert.Empty(t, v.ExtensionSignature)
+
+	// a precommit for a block is extended
+	vote = newVote(privVal.Key.Address, 0, height, round, tmproto.PrecommitType, block)
+	vote.Extension = []byte("extension")
+	v = vote.ToProto()
+	require.NoError(t, privVal.SignVote("mychainid", v))
+	vote.Signature = v.Signature
+	vote.ExtensionSignature = v.ExtensionSignature
+	require.NoError(t, vote.VerifyExtension("mychainid", pubKey, version.BlockProtocol))
+
+	// the extension is signed again for the same vote, even if it changes
+	v.Extension = []byte("another extension")
+	require.NoError(t, privVal.SignVote("mychainid", v))
+	assert.Equal(t, vote.Signature, v.Signature)
+	vote.Extension = v.Extension
+	vote.ExtensionSignature = v.ExtensionSignature
+	require.NoError(t, vote.VerifyExtension("mychainid", pubKey, version.BlockProtocol))
+
+	// a precommit for nil is not extended
+	vote = newVote(privVal.Key.Address, 0, height, round+1, tmproto.PrecommitType, types.BlockID{})
+	vote.Extension = []byte("extension")
+	v = vote.ToProto()
+	require.NoError(t, privVal.SignVote("mychainid", v))
+	assert.Empty(t, v.ExtensionSignature)
+}
+
 func TestSignProposal(t *testing.T) {
 	assert := assert.New(t)
 
@@ -300,7 +354,7 @@ func TestGenerateVRFProof(t *testing.T) {
 
 	success := [][]byte{{}, {0x00}, make([]byte, 100)}
 	for _, msg := range success {
-		proof, err := privVal.GenerateVRFProof(msg)
+		proof, err := privVal.GenerateVRFProof(msg, "")
 		require.Nil(t, err)
 		t.Log("  Message    : ", hex.EncodeToString(msg), " -> ", hex.EncodeToString(proof[:]))
 		pubKey, err := privVal.GetPubKey()
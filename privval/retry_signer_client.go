@@ -95,11 +95,11 @@ func (sc *RetrySignerClient) SignProposal(chainID string, proposal *tmproto.Prop
 	return fmt.Errorf("exhausted all attempts to sign proposal: %w", err)
 }
 
-func (sc *RetrySignerClient) GenerateVRFProof(message []byte) (crypto.Proof, error) {
+func (sc *RetrySignerClient) GenerateVRFProof(message []byte, vrfSuite string) (crypto.Proof, error) {
 	var err error
 	var proof crypto.Proof
 	for i := 0; i < sc.retries || sc.retries == 0; i++ {
-		proof, err = sc.next.GenerateVRFProof(message)
+		proof, err = sc.next.GenerateVRFProof(message, vrfSuite)
 		if err == nil {
 			return proof, nil
 		}
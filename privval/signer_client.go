@@ -133,8 +133,8 @@ func (sc *SignerClient) SignProposal(chainID string, proposal *tmproto.Proposal)
 }
 
 // GenerateVRFProof requests a remote signer to generate a VRF proof
-func (sc *SignerClient) GenerateVRFProof(message []byte) (crypto.Proof, error) {
-	msg := &privvalproto.VRFProofRequest{Message: message}
+func (sc *SignerClient) GenerateVRFProof(message []byte, vrfSuite string) (crypto.Proof, error) {
+	msg := &privvalproto.VRFProofRequest{Message: message, VrfSuite: vrfSuite}
 	response, err := sc.endpoint.SendRequest(mustWrapMsg(msg))
 	if err != nil {
 		sc.endpoint.Logger.Error("SignerClient::GenerateVRFProof", "err", err)
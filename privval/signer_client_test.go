@@ -176,7 +176,7 @@ func TestSignerGenerateVRFProof(t *testing.T) {
 			}
 		})
 
-		proof, err := tc.signerClient.GenerateVRFProof(message)
+		proof, err := tc.signerClient.GenerateVRFProof(message, "")
 		require.Nil(t, err)
 		require.True(t, len(proof) > 0)
 		output, err := vrf.ProofToHash(vrf.Proof(proof))
@@ -189,6 +189,14 @@ func TestSignerGenerateVRFProof(t *testing.T) {
 		expected, err := vrf.Verify(ed25519PubKey, vrf.Proof(proof), message)
 		require.Nil(t, err)
 		assert.True(t, expected)
+
+		// the remote signer proves with the requested suite
+		proof, err = tc.signerClient.GenerateVRFProof(message, vrf.SuiteEdwards25519SHA512ELL2)
+		require.Nil(t, err)
+		_, err = ed25519PubKey.VRFVerifyWithSuite(vrf.SuiteEdwards25519SHA512ELL2, proof, message)
+		assert.Nil(t, err)
+		_, err = ed25519PubKey.VRFVerify(proof, message)
+		assert.NotNil(t, err)
 	}
 }
 
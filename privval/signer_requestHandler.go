@@ -87,7 +87,7 @@ func DefaultValidationRequestHandler(
 		err, res = nil, mustWrapMsg(&privvalproto.PingResponse{})
 
 	case *privvalproto.Message_VrfProofRequest:
-		proof, err := privVal.GenerateVRFProof(r.VrfProofRequest.Message)
+		proof, err := privVal.GenerateVRFProof(r.VrfProofRequest.Message, r.VrfProofRequest.VrfSuite)
 		if err != nil {
 			err := privvalproto.RemoteSignerError{Code: 0, Description: err.Error()}
 			res = mustWrapMsg(&privvalproto.VRFProofResponse{Proof: nil, Error: &err})
@@ -104,7 +104,7 @@ func (m *NewRoundStep) GetLastCommitRound() int32 {
 }
 
 // NewValidBlock is sent when a validator observes a valid block B in some round r,
-//i.e., there is a Proposal for block B and 2/3+ prevotes for the block B in the round r.
+// i.e., there is a Proposal for block B and 2/3+ prevotes for the block B in the round r.
 // In case the block is also committed, then IsCommit flag is set to true.
 type NewValidBlock struct {
 	Height             int64               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
@@ -394,6 +394,51 @@ func (m *Vote) GetVote() *types.Vote {
 	return nil
 }
 
+// AggregatedVote is sent when gossiping the votes whose BLS signatures are aggregated.
+type AggregatedVote struct {
+	AggregatedVote *types.AggregatedVote `protobuf:"bytes,1,opt,name=aggregated_vote,json=aggregatedVote,proto3" json:"aggregated_vote,omitempty"`
+}
+
+func (m *AggregatedVote) Reset()         { *m = AggregatedVote{} }
+func (m *AggregatedVote) String() string { return proto.CompactTextString(m) }
+func (*AggregatedVote) ProtoMessage()    {}
+func (*AggregatedVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ef76b376cac7abc, []int{6}
+}
+func (m *AggregatedVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregatedVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregatedVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregatedVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedVote.Merge(m, src)
+}
+func (m *AggregatedVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregatedVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatedVote proto.InternalMessageInfo
+
+func (m *AggregatedVote) GetAggregatedVote() *types.AggregatedVote {
+	if m != nil {
+		return m.AggregatedVote
+	}
+	return nil
+}
+
 // HasVote is sent to indicate that a particular vote has been received.
 type HasVote struct {
 	Height int64               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
@@ -406,7 +451,7 @@ func (m *HasVote) Reset()         { *m = HasVote{} }
 func (m *HasVote) String() string { return proto.CompactTextString(m) }
 func (*HasVote) ProtoMessage()    {}
 func (*HasVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ef76b376cac7abc, []int{6}
+	return fileDescriptor_0ef76b376cac7abc, []int{7}
 }
 func (m *HasVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -475,7 +520,7 @@ func (m *VoteSetMaj23) Reset()         { *m = VoteSetMaj23{} }
 func (m *VoteSetMaj23) String() string { return proto.CompactTextString(m) }
 func (*VoteSetMaj23) ProtoMessage()    {}
 func (*VoteSetMaj23) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ef76b376cac7abc, []int{7}
+	return fileDescriptor_0ef76b376cac7abc, []int{8}
 }
 func (m *VoteSetMaj23) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -545,7 +590,7 @@ func (m *VoteSetBits) Reset()         { *m = VoteSetBits{} }
 func (m *VoteSetBits) String() string { return proto.CompactTextString(m) }
 func (*VoteSetBits) ProtoMessage()    {}
 func (*VoteSetBits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ef76b376cac7abc, []int{8}
+	return fileDescriptor_0ef76b376cac7abc, []int{9}
 }
 func (m *VoteSetBits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -620,6 +665,7 @@ type Message struct {
 	//	*Message_HasVote
 	//	*Message_VoteSetMaj23
 	//	*Message_VoteSetBits
+	//	*Message_AggregatedVote
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
 
@@ -627,7 +673,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ef76b376cac7abc, []int{9}
+	return fileDescriptor_0ef76b376cac7abc, []int{10}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,16 +735,20 @@ type Message_VoteSetMaj23 struct {
 type Message_VoteSetBits struct {
 	VoteSetBits *VoteSetBits `protobuf:"bytes,9,opt,name=vote_set_bits,json=voteSetBits,proto3,oneof" json:"vote_set_bits,omitempty"`
 }
+type Message_AggregatedVote struct {
+	AggregatedVote *AggregatedVote `protobuf:"bytes,1000,opt,name=aggregated_vote,json=aggregatedVote,proto3,oneof" json:"aggregated_vote,omitempty"`
+}
 
-func (*Message_NewRoundStep) isMessage_Sum()  {}
-func (*Message_NewValidBlock) isMessage_Sum() {}
-func (*Message_Proposal) isMessage_Sum()      {}
-func (*Message_ProposalPol) isMessage_Sum()   {}
-func (*Message_BlockPart) isMessage_Sum()     {}
-func (*Message_Vote) isMessage_Sum()          {}
-func (*Message_HasVote) isMessage_Sum()       {}
-func (*Message_VoteSetMaj23) isMessage_Sum()  {}
-func (*Message_VoteSetBits) isMessage_Sum()   {}
+func (*Message_NewRoundStep) isMessage_Sum()   {}
+func (*Message_NewValidBlock) isMessage_Sum()  {}
+func (*Message_Proposal) isMessage_Sum()       {}
+func (*Message_ProposalPol) isMessage_Sum()    {}
+func (*Message_BlockPart) isMessage_Sum()      {}
+func (*Message_Vote) isMessage_Sum()           {}
+func (*Message_HasVote) isMessage_Sum()        {}
+func (*Message_VoteSetMaj23) isMessage_Sum()   {}
+func (*Message_VoteSetBits) isMessage_Sum()    {}
+func (*Message_AggregatedVote) isMessage_Sum() {}
 
 func (m *Message) GetSum() isMessage_Sum {
 	if m != nil {
@@ -770,6 +820,13 @@ func (m *Message) GetVoteSetBits() *VoteSetBits {
 	return nil
 }
 
+func (m *Message) GetAggregatedVote() *AggregatedVote {
+	if x, ok := m.GetSum().(*Message_AggregatedVote); ok {
+		return x.AggregatedVote
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Message) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -782,6 +839,7 @@ func (*Message) XXX_OneofWrappers() []interface{} {
 		(*Message_HasVote)(nil),
 		(*Message_VoteSetMaj23)(nil),
 		(*Message_VoteSetBits)(nil),
+		(*Message_AggregatedVote)(nil),
 	}
 }
 
@@ -792,6 +850,7 @@ func init() {
 	proto.RegisterType((*ProposalPOL)(nil), "ostracon.consensus.ProposalPOL")
 	proto.RegisterType((*BlockPart)(nil), "ostracon.consensus.BlockPart")
 	proto.RegisterType((*Vote)(nil), "ostracon.consensus.Vote")
+	proto.RegisterType((*AggregatedVote)(nil), "ostracon.consensus.AggregatedVote")
 	proto.RegisterType((*HasVote)(nil), "ostracon.consensus.HasVote")
 	proto.RegisterType((*VoteSetMaj23)(nil), "ostracon.consensus.VoteSetMaj23")
 	proto.RegisterType((*VoteSetBits)(nil), "ostracon.consensus.VoteSetBits")
@@ -801,60 +860,63 @@ func init() {
 func init() { proto.RegisterFile("ostracon/consensus/types.proto", fileDescriptor_0ef76b376cac7abc) }
 
 var fileDescriptor_0ef76b376cac7abc = []byte{
-	// 848 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0xb6, 0x59, 0x67, 0x93, 0x3c, 0x27, 0xbb, 0x30, 0xda, 0x82, 0x95, 0xd2, 0x6c, 0xf0, 0x29,
-	0x42, 0xc8, 0xa1, 0xe9, 0x81, 0x0a, 0x09, 0x24, 0xdc, 0x16, 0xb9, 0x6a, 0xb7, 0x8d, 0x9c, 0x6a,
-	0x0f, 0x5c, 0x2c, 0x27, 0x1e, 0x25, 0x03, 0x8e, 0xc7, 0xf2, 0x4c, 0xb2, 0xec, 0x8d, 0x9f, 0xc0,
-	0x99, 0xdf, 0x01, 0xff, 0xa1, 0xc7, 0x1e, 0x39, 0x55, 0x28, 0xcb, 0x95, 0x03, 0xff, 0x00, 0xcd,
-	0x78, 0x62, 0x3b, 0x9b, 0x4d, 0xc5, 0x1e, 0x38, 0x70, 0xb3, 0xf3, 0xde, 0xfb, 0xe6, 0xcd, 0xf7,
-	0xde, 0xf7, 0xc5, 0xd0, 0xa5, 0x8c, 0x67, 0xe1, 0x94, 0x26, 0x83, 0x29, 0x4d, 0x18, 0x4e, 0xd8,
-	0x92, 0x0d, 0xf8, 0x65, 0x8a, 0x99, 0x93, 0x66, 0x94, 0x53, 0x84, 0x36, 0x71, 0xa7, 0x88, 0x77,
-	0x4e, 0x66, 0x74, 0x46, 0x65, 0x78, 0x20, 0x9e, 0xf2, 0xcc, 0x4e, 0xa7, 0x40, 0x92, 0xf5, 0x55,
-	0x94, 0x4e, 0x79, 0x4a, 0x4c, 0x26, 0x6c, 0x30, 0x21, 0x7c, 0x2b, 0x6e, 0xff, 0xa6, 0x43, 0xeb,
-	0x05, 0xbe, 0xf0, 0xe9, 0x32, 0x89, 0xc6, 0x1c, 0xa7, 0xe8, 0x43, 0x38, 0x9c, 0x63, 0x32, 0x9b,
-	0x73, 0x4b, 0xef, 0xe9, 0xfd, 0x03, 0x5f, 0xbd, 0xa1, 0x13, 0xa8, 0x65, 0x22, 0xc9, 0x7a, 0xaf,
-	0xa7, 0xf7, 0x6b, 0x7e, 0xfe, 0x82, 0x10, 0x18, 0x8c, 0xe3, 0xd4, 0x3a, 0xe8, 0xe9, 0xfd, 0xb6,
-	0x2f, 0x9f, 0xd1, 0x17, 0x60, 0x31, 0x3c, 0xa5, 0x49, 0xc4, 0x02, 0x46, 0x92, 0x29, 0x0e, 0x18,
-	0x0f, 0x33, 0x1e, 0x70, 0xb2, 0xc0, 0x96, 0x21, 0x31, 0xef, 0xa8, 0xf8, 0x58, 0x84, 0xc7, 0x22,
-	0xfa, 0x8a, 0x2c, 0x30, 0xfa, 0x14, 0x3e, 0x88, 0x43, 0xc6, 0x83, 0x29, 0x5d, 0x2c, 0x08, 0x0f,
-	0xf2, 0xe3, 0x6a, 0xf2, 0xb8, 0x63, 0x11, 0x78, 0x24, 0x7f, 0x97, 0xad, 0xda, 0x7f, 0xeb, 0xd0,
-	0x7e, 0x81, 0x2f, 0xce, 0xc3, 0x98, 0x44, 0x6e, 0x4c, 0xa7, 0x3f, 0xdc, 0xb2, 0xf1, 0x73, 0xb8,
-	0x33, 0x11, 0x65, 0x41, 0x2a, 0x7a, 0x63, 0x98, 0x07, 0x73, 0x1c, 0x46, 0x38, 0x93, 0x37, 0x31,
-	0x87, 0xf7, 0x9c, 0x82, 0xfd, 0x9c, 0xad, 0x51, 0x98, 0xf1, 0x31, 0xe6, 0x9e, 0x4c, 0x72, 0x8d,
-	0xd7, 0x6f, 0x4f, 0x35, 0x1f, 0x49, 0x84, 0xad, 0x08, 0xfa, 0x0a, 0xcc, 0x12, 0x97, 0xc9, 0xfb,
-	0x9a, 0xc3, 0x8f, 0x4b, 0x34, 0x31, 0x05, 0x47, 0x4c, 0xc1, 0x71, 0x09, 0xff, 0x26, 0xcb, 0xc2,
-	0x4b, 0x1f, 0x0a, 0x18, 0x86, 0xee, 0x42, 0x93, 0x30, 0x45, 0x80, 0xbc, 0x7a, 0xc3, 0x6f, 0x10,
-	0x96, 0x5f, 0xdc, 0xfe, 0x16, 0x1a, 0xa3, 0x8c, 0xa6, 0x94, 0x85, 0x31, 0xfa, 0x12, 0x1a, 0xa9,
-	0x7a, 0x96, 0xf7, 0x35, 0x87, 0xd6, 0x4e, 0xcb, 0x2a, 0xae, 0xba, 0x2d, 0xf2, 0xed, 0x5f, 0x74,
-	0x30, 0x37, 0xc1, 0xd1, 0xcb, 0xe7, 0x7b, 0x99, 0xfb, 0x0c, 0xd0, 0xa6, 0x26, 0x48, 0x69, 0x1c,
-	0x54, 0x69, 0x7c, 0x7f, 0x13, 0x19, 0xd1, 0x58, 0x4e, 0x04, 0x3d, 0x81, 0x56, 0x35, 0x5b, 0x11,
-	0xf9, 0xce, 0xab, 0xab, 0xce, 0xcc, 0x0a, 0x96, 0x4d, 0xa0, 0xe9, 0x6e, 0xf8, 0xb8, 0xe5, 0x4c,
-	0x1d, 0x30, 0x04, 0xeb, 0xea, 0xe4, 0x93, 0x9b, 0x46, 0xa8, 0x4e, 0x94, 0x79, 0xf6, 0xe7, 0x60,
-	0x9c, 0x53, 0x8e, 0x51, 0x1f, 0x8c, 0x15, 0xe5, 0x58, 0xf1, 0xb8, 0x53, 0x27, 0x72, 0x7c, 0x99,
-	0x61, 0xff, 0xa4, 0x43, 0xdd, 0x0b, 0x99, 0xac, 0xba, 0x5d, 0x6f, 0xf7, 0xc1, 0x10, 0x68, 0xb2,
-	0xb7, 0xa3, 0xdd, 0xf5, 0x1a, 0x93, 0x59, 0x82, 0xa3, 0x33, 0x36, 0x7b, 0x75, 0x99, 0x62, 0x5f,
-	0xa6, 0x0a, 0x20, 0x92, 0x44, 0xf8, 0x47, 0xb9, 0x44, 0x35, 0x3f, 0x7f, 0xb1, 0x7f, 0xd5, 0xa1,
-	0x25, 0xce, 0x1f, 0x63, 0x7e, 0x16, 0x7e, 0x3f, 0x7c, 0xf0, 0xdf, 0xf7, 0xf1, 0x08, 0x1a, 0xf9,
-	0x4a, 0x93, 0x48, 0xed, 0xf3, 0x47, 0xd7, 0xcb, 0xe4, 0xc4, 0x9e, 0x3e, 0x76, 0x8f, 0x05, 0xbb,
-	0xeb, 0xb7, 0xa7, 0x75, 0xf5, 0x83, 0x5f, 0x97, 0x95, 0x4f, 0x23, 0xfb, 0x2f, 0x1d, 0x4c, 0xd5,
-	0xb6, 0x4b, 0x38, 0xfb, 0x7f, 0x74, 0x8d, 0x1e, 0x42, 0x4d, 0xcc, 0x9d, 0x49, 0x29, 0xfe, 0xbb,
-	0x65, 0xce, 0x0b, 0xec, 0x3f, 0x0d, 0xa8, 0x9f, 0x61, 0xc6, 0xc2, 0x19, 0x46, 0x1e, 0x1c, 0x25,
-	0xf8, 0x22, 0x97, 0x4f, 0x20, 0xed, 0x32, 0xdf, 0xb4, 0x9e, 0xb3, 0x6b, 0xf1, 0x4e, 0xd5, 0x8c,
-	0x3d, 0xcd, 0x6f, 0x25, 0x55, 0x73, 0x7e, 0x06, 0xc7, 0x02, 0x69, 0x25, 0x5c, 0x2f, 0x90, 0x4d,
-	0x4a, 0x9e, 0xcc, 0xe1, 0x27, 0x7b, 0xa0, 0x4a, 0x7f, 0xf4, 0x34, 0xbf, 0x9d, 0x6c, 0x19, 0x66,
-	0xd5, 0x42, 0x76, 0xc4, 0x5a, 0xa2, 0x6c, 0x9c, 0xc2, 0xab, 0x58, 0x08, 0x7a, 0x7c, 0x4d, 0xec,
-	0x39, 0xc3, 0xa7, 0xef, 0xaa, 0x1f, 0xbd, 0x7c, 0xee, 0x6d, 0x6b, 0x1d, 0x7d, 0x0d, 0x50, 0x9a,
-	0xa5, 0xe2, 0xf8, 0xde, 0x4d, 0x18, 0x85, 0x23, 0x78, 0x9a, 0xdf, 0x2c, 0xec, 0x52, 0x08, 0x5e,
-	0x0a, 0xf7, 0xf0, 0xba, 0x01, 0x96, 0x95, 0x62, 0xe7, 0x3c, 0x2d, 0x97, 0x2f, 0x7a, 0x08, 0x8d,
-	0x79, 0xc8, 0x02, 0x59, 0x53, 0x97, 0x35, 0x77, 0x6f, 0xaa, 0x51, 0x0a, 0xf7, 0x34, 0xbf, 0x3e,
-	0x57, 0x62, 0xf7, 0xe0, 0x48, 0x54, 0xc9, 0x3f, 0x8a, 0x85, 0x90, 0x9d, 0xd5, 0xd8, 0x3f, 0xc2,
-	0xaa, 0x3c, 0xc5, 0x08, 0x57, 0x55, 0xb9, 0x3e, 0x81, 0x76, 0x81, 0x24, 0xf6, 0xc7, 0x6a, 0xee,
-	0xa7, 0xae, 0x22, 0x18, 0x41, 0xdd, 0xaa, 0x7c, 0x75, 0x6b, 0x70, 0xc0, 0x96, 0x0b, 0xf7, 0xd9,
-	0xeb, 0x75, 0x57, 0x7f, 0xb3, 0xee, 0xea, 0x7f, 0xac, 0xbb, 0xfa, 0xcf, 0x57, 0x5d, 0xed, 0xcd,
-	0x55, 0x57, 0xfb, 0xfd, 0xaa, 0xab, 0x7d, 0x77, 0x7f, 0x46, 0xf8, 0x7c, 0x39, 0x71, 0xa6, 0x74,
-	0x31, 0x88, 0x49, 0x82, 0x07, 0xc5, 0x87, 0x40, 0xfe, 0xfd, 0xb0, 0xfb, 0xf5, 0x31, 0x39, 0x94,
-	0x91, 0x07, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x61, 0x4b, 0xdf, 0x94, 0x9a, 0x08, 0x00, 0x00,
+	// 892 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0xb6, 0xc9, 0x6e, 0x76, 0xf3, 0x3a, 0x1f, 0x30, 0x4a, 0xc1, 0x4a, 0xa9, 0x13, 0x7c, 0x8a,
+	0x10, 0xf2, 0xd2, 0xed, 0x81, 0x0a, 0x09, 0xa4, 0xba, 0x2d, 0xb8, 0x6a, 0x93, 0xae, 0x66, 0xab,
+	0x48, 0x70, 0xb1, 0xbc, 0xeb, 0x91, 0x77, 0xc0, 0xeb, 0xb1, 0x3c, 0x93, 0x84, 0xdc, 0xf8, 0x09,
+	0x9c, 0xf9, 0x1d, 0xe5, 0x3f, 0xf4, 0xd8, 0x23, 0xa7, 0x08, 0x25, 0x17, 0x2e, 0x1c, 0xf8, 0x07,
+	0x68, 0x66, 0xbc, 0xb6, 0xf7, 0x23, 0x40, 0x0e, 0x1c, 0xb8, 0xcd, 0xcc, 0xfb, 0x3e, 0xcf, 0xbc,
+	0x1f, 0xf3, 0x3e, 0x36, 0x38, 0x8c, 0x8b, 0x22, 0x1a, 0xb3, 0xac, 0x37, 0x66, 0x19, 0x27, 0x19,
+	0x3f, 0xe5, 0x3d, 0x71, 0x91, 0x13, 0xee, 0xe5, 0x05, 0x13, 0x0c, 0xa1, 0x99, 0xdd, 0xab, 0xec,
+	0x7b, 0xbb, 0x09, 0x4b, 0x98, 0x32, 0xf7, 0xe4, 0x4a, 0x7b, 0xee, 0xed, 0x55, 0x4c, 0x0a, 0xdf,
+	0x64, 0xd9, 0xab, 0x6f, 0x49, 0xe9, 0x88, 0xf7, 0x46, 0x54, 0xcc, 0xd9, 0xdd, 0x5f, 0x4c, 0xd8,
+	0x3c, 0x26, 0xe7, 0x98, 0x9d, 0x66, 0xf1, 0x50, 0x90, 0x1c, 0xbd, 0x0f, 0xeb, 0x13, 0x42, 0x93,
+	0x89, 0xb0, 0xcd, 0x03, 0xf3, 0x70, 0x0d, 0x97, 0x3b, 0xb4, 0x0b, 0xed, 0x42, 0x3a, 0xd9, 0xef,
+	0x1c, 0x98, 0x87, 0x6d, 0xac, 0x37, 0x08, 0x41, 0x8b, 0x0b, 0x92, 0xdb, 0x6b, 0x07, 0xe6, 0xe1,
+	0x16, 0x56, 0x6b, 0xf4, 0x19, 0xd8, 0x9c, 0x8c, 0x59, 0x16, 0xf3, 0x90, 0xd3, 0x6c, 0x4c, 0x42,
+	0x2e, 0xa2, 0x42, 0x84, 0x82, 0x4e, 0x89, 0xdd, 0x52, 0x9c, 0x77, 0x4a, 0xfb, 0x50, 0x9a, 0x87,
+	0xd2, 0xfa, 0x8a, 0x4e, 0x09, 0xfa, 0x18, 0xde, 0x4b, 0x23, 0x2e, 0xc2, 0x31, 0x9b, 0x4e, 0xa9,
+	0x08, 0xf5, 0x75, 0x6d, 0x75, 0xdd, 0x8e, 0x34, 0x3c, 0x56, 0xe7, 0x2a, 0x54, 0xf7, 0x4f, 0x13,
+	0xb6, 0x8e, 0xc9, 0xf9, 0x49, 0x94, 0xd2, 0xd8, 0x4f, 0xd9, 0xf8, 0xfb, 0x5b, 0x06, 0x7e, 0x02,
+	0x77, 0x46, 0x12, 0x16, 0xe6, 0x32, 0x36, 0x4e, 0x44, 0x38, 0x21, 0x51, 0x4c, 0x0a, 0x95, 0x89,
+	0xd5, 0xbf, 0xe7, 0x55, 0xd5, 0xd7, 0xd5, 0x1a, 0x44, 0x85, 0x18, 0x12, 0x11, 0x28, 0x27, 0xbf,
+	0xf5, 0xe6, 0x72, 0xdf, 0xc0, 0x48, 0x31, 0xcc, 0x59, 0xd0, 0x17, 0x60, 0xd5, 0xbc, 0x5c, 0xe5,
+	0x6b, 0xf5, 0x3f, 0xac, 0xd9, 0x64, 0x17, 0x3c, 0xd9, 0x05, 0xcf, 0xa7, 0xe2, 0x51, 0x51, 0x44,
+	0x17, 0x18, 0x2a, 0x1a, 0x8e, 0xee, 0xc2, 0x06, 0xe5, 0x65, 0x01, 0x54, 0xea, 0x5d, 0xdc, 0xa5,
+	0x5c, 0x27, 0xee, 0x7e, 0x05, 0xdd, 0x41, 0xc1, 0x72, 0xc6, 0xa3, 0x14, 0x7d, 0x0e, 0xdd, 0xbc,
+	0x5c, 0xab, 0x7c, 0xad, 0xbe, 0xbd, 0x14, 0x72, 0x69, 0x2f, 0xa3, 0xad, 0xfc, 0xdd, 0x9f, 0x4d,
+	0xb0, 0x66, 0xc6, 0xc1, 0xcb, 0x17, 0x37, 0x56, 0xee, 0x13, 0x40, 0x33, 0x4c, 0x98, 0xb3, 0x34,
+	0x6c, 0x96, 0xf1, 0xdd, 0x99, 0x65, 0xc0, 0x52, 0xd5, 0x11, 0xf4, 0x14, 0x36, 0x9b, 0xde, 0xf6,
+	0xda, 0x3f, 0xa7, 0x5e, 0x46, 0x66, 0x35, 0xb8, 0x5c, 0x0a, 0x1b, 0xfe, 0xac, 0x1e, 0xb7, 0xec,
+	0xa9, 0x07, 0x2d, 0x59, 0xf5, 0xf2, 0xe6, 0xdd, 0x55, 0x2d, 0x2c, 0x6f, 0x54, 0x7e, 0xee, 0xa7,
+	0xd0, 0x3a, 0x61, 0x82, 0xa0, 0x43, 0x68, 0x9d, 0x31, 0x41, 0x6c, 0x73, 0x35, 0x4e, 0xfa, 0x60,
+	0xe5, 0xe1, 0x7e, 0x03, 0xdb, 0x8f, 0x92, 0xa4, 0x20, 0x49, 0x24, 0x48, 0xac, 0xb0, 0x5f, 0xc3,
+	0x4e, 0x54, 0x9d, 0x84, 0x0d, 0x1a, 0x67, 0x91, 0x66, 0x1e, 0x88, 0xb7, 0xa3, 0xb9, 0xbd, 0xfb,
+	0xa3, 0x09, 0x9d, 0x20, 0xe2, 0x8a, 0xf4, 0x76, 0x69, 0xdf, 0x87, 0x96, 0xbc, 0x41, 0xa5, 0xbd,
+	0xbd, 0xfc, 0x72, 0x87, 0x34, 0xc9, 0x48, 0x7c, 0xc4, 0x93, 0x57, 0x17, 0x39, 0xc1, 0xca, 0x55,
+	0x12, 0xd1, 0x2c, 0x26, 0x3f, 0xa8, 0xf7, 0xd9, 0xc6, 0x7a, 0xe3, 0xbe, 0x36, 0x61, 0x53, 0xde,
+	0x3f, 0x24, 0xe2, 0x28, 0xfa, 0xae, 0xff, 0xe0, 0xbf, 0x8f, 0xe3, 0x31, 0x74, 0xf5, 0xb4, 0xd0,
+	0xb8, 0x1c, 0x95, 0x0f, 0x16, 0x61, 0xea, 0x31, 0x3c, 0x7b, 0xe2, 0xef, 0xc8, 0xc6, 0x5d, 0x5d,
+	0xee, 0x77, 0xca, 0x03, 0xdc, 0x51, 0xc8, 0x67, 0xb1, 0xfb, 0x87, 0x09, 0x56, 0x19, 0xb6, 0x4f,
+	0x05, 0xff, 0x7f, 0x44, 0x8d, 0x1e, 0x42, 0x5b, 0xbe, 0x16, 0x6e, 0xb7, 0xff, 0xf5, 0x9c, 0x68,
+	0x80, 0xfb, 0xba, 0x0d, 0x9d, 0x23, 0xc2, 0x79, 0x94, 0x10, 0x14, 0xc0, 0x76, 0x46, 0xce, 0xf5,
+	0x64, 0x86, 0x4a, 0x89, 0xf5, 0xeb, 0x3b, 0xf0, 0x96, 0xbf, 0x1e, 0x5e, 0x53, 0xe7, 0x03, 0x03,
+	0x6f, 0x66, 0x8d, 0x3d, 0x7a, 0x0e, 0x3b, 0x92, 0xe9, 0x4c, 0x0a, 0x6a, 0xa8, 0x82, 0x54, 0x75,
+	0xb2, 0xfa, 0x1f, 0xdd, 0x40, 0x55, 0x4b, 0x6f, 0x60, 0xe0, 0xad, 0xac, 0x79, 0x30, 0xa7, 0x4e,
+	0x4b, 0x3a, 0x50, 0xb3, 0xcc, 0x44, 0x28, 0x68, 0xa8, 0x13, 0x7a, 0xb2, 0xa0, 0x23, 0xba, 0xc2,
+	0xfb, 0x7f, 0x87, 0x1f, 0xbc, 0x7c, 0x11, 0xcc, 0xcb, 0x08, 0xfa, 0x12, 0xa0, 0xd6, 0xe1, 0xb2,
+	0xc6, 0xf7, 0x56, 0x71, 0x54, 0x62, 0x13, 0x18, 0x78, 0xa3, 0x52, 0x62, 0xa9, 0x25, 0x6a, 0x98,
+	0xd7, 0x17, 0xb5, 0xb5, 0x46, 0xca, 0x37, 0x17, 0x18, 0x5a, 0x19, 0xd0, 0x43, 0xe8, 0x4e, 0x22,
+	0xae, 0x05, 0xa0, 0xa3, 0x30, 0x77, 0x57, 0x61, 0xca, 0x09, 0x0f, 0x0c, 0xdc, 0x99, 0xe8, 0xa5,
+	0x6c, 0xa1, 0x44, 0xa9, 0x6f, 0xd0, 0x54, 0x8e, 0x9d, 0xdd, 0xbd, 0xb9, 0x85, 0xcd, 0xf1, 0x94,
+	0x2d, 0x3c, 0x6b, 0x8e, 0xeb, 0x53, 0xd8, 0xaa, 0x98, 0xe4, 0xfb, 0xb1, 0x37, 0x6e, 0x2e, 0x5d,
+	0x63, 0x60, 0x64, 0xe9, 0xce, 0xea, 0x2d, 0x3a, 0x5e, 0x96, 0xb4, 0xdf, 0x75, 0x4a, 0xee, 0x2a,
+	0xa6, 0x79, 0x5d, 0x0b, 0x8c, 0x45, 0x65, 0xf3, 0xdb, 0xb0, 0xc6, 0x4f, 0xa7, 0xfe, 0xf3, 0x37,
+	0x57, 0x8e, 0xf9, 0xf6, 0xca, 0x31, 0x7f, 0xbb, 0x72, 0xcc, 0x9f, 0xae, 0x1d, 0xe3, 0xed, 0xb5,
+	0x63, 0xfc, 0x7a, 0xed, 0x18, 0xdf, 0xde, 0x4f, 0xa8, 0x98, 0x9c, 0x8e, 0xbc, 0x31, 0x9b, 0xf6,
+	0x52, 0x9a, 0x91, 0x5e, 0xf5, 0xcf, 0xa2, 0x7f, 0x75, 0x96, 0x7f, 0x94, 0x46, 0xeb, 0xca, 0xf2,
+	0xe0, 0xaf, 0x01, 0x00, 0x22, 0x84, 0xe5, 0x47, 0x45, 0x09, 0x00, 0x00,
 }
 
 func (m *NewRoundStep) Marshal() (dAtA []byte, err error) {
@@ -1124,6 +1186,41 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AggregatedVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregatedVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregatedVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AggregatedVote != nil {
+		{
+			size, err := m.AggregatedVote.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HasVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1494,6 +1591,29 @@ func (m *Message_VoteSetBits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Message_AggregatedVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_AggregatedVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AggregatedVote != nil {
+		{
+			size, err := m.AggregatedVote.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1611,6 +1731,19 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *AggregatedVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AggregatedVote != nil {
+		l = m.AggregatedVote.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *HasVote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1794,6 +1927,18 @@ func (m *Message_VoteSetBits) Size() (n int) {
 	}
 	return n
 }
+func (m *Message_AggregatedVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AggregatedVote != nil {
+		l = m.AggregatedVote.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -2534,6 +2679,92 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AggregatedVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregatedVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregatedVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AggregatedVote == nil {
+				m.AggregatedVote = &types.AggregatedVote{}
+			}
+			if err := m.AggregatedVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *HasVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3317,6 +3548,41 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Message_VoteSetBits{v}
 			iNdEx = postIndex
+		case 1000:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AggregatedVote{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_AggregatedVote{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
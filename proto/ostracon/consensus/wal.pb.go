@@ -152,7 +152,7 @@ func (m *TimeoutInfo) GetStep() uint32 {
 }
 
 // EndHeight marks the end of the given height inside WAL.
-// @internal used by scripts/wal2json util.
+// @internal used by the wal dump command.
 type EndHeight struct {
 	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
@@ -427,6 +427,9 @@ func (m *SignedProposalResponse) GetError() *RemoteSignerError {
 // VRFProofRequest is a PrivValidatorSocket message containing a message to generate proof.
 type VRFProofRequest struct {
 	Message []byte `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// VRF suite of the ed25519 keys, see the vrf_suite of the ValidatorParams.
+	// Empty means the build-time default suite.
+	VrfSuite string `protobuf:"bytes,2,opt,name=vrf_suite,json=vrfSuite,proto3" json:"vrf_suite,omitempty"`
 }
 
 func (m *VRFProofRequest) Reset()         { *m = VRFProofRequest{} }
@@ -469,6 +472,13 @@ func (m *VRFProofRequest) GetMessage() []byte {
 	return nil
 }
 
+func (m *VRFProofRequest) GetVrfSuite() string {
+	if m != nil {
+		return m.VrfSuite
+	}
+	return ""
+}
+
 // VRFProofResponse is a PrivValidatorSocket message containing a Proof.
 type VRFProofResponse struct {
 	Proof []byte             `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
@@ -804,60 +814,60 @@ func init() {
 func init() { proto.RegisterFile("ostracon/privval/types.proto", fileDescriptor_abbbbe5131a55005) }
 
 var fileDescriptor_abbbbe5131a55005 = []byte{
-	// 834 bytes of a gzipped FileDescriptorProto
+	// 847 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcb, 0x6e, 0xdb, 0x46,
-	0x14, 0x86, 0x49, 0x5b, 0x17, 0xe7, 0xc8, 0x17, 0x79, 0xac, 0xa6, 0x8a, 0xd0, 0x28, 0xae, 0xda,
-	0x02, 0x46, 0x0a, 0x50, 0x40, 0xda, 0x4d, 0xba, 0xab, 0x1d, 0x16, 0x12, 0xdc, 0x90, 0xc4, 0x48,
-	0x71, 0x82, 0x76, 0x41, 0x48, 0xd4, 0x98, 0x21, 0x62, 0x73, 0xa6, 0x1c, 0x4a, 0x80, 0x56, 0xdd,
-	0x75, 0xdb, 0x3e, 0x46, 0x1f, 0x25, 0xdd, 0x65, 0xd9, 0x55, 0x51, 0xd8, 0x9b, 0xf6, 0x2d, 0x0a,
-	0xce, 0x0c, 0x2f, 0xa2, 0xe5, 0x34, 0x80, 0x77, 0x9c, 0x73, 0x8e, 0xbe, 0xf3, 0xff, 0x24, 0x7f,
-	0x88, 0xf0, 0x09, 0xe5, 0x71, 0x34, 0xf1, 0x68, 0xd8, 0x67, 0x51, 0xb0, 0x58, 0x4c, 0x2e, 0xfa,
-	0xf1, 0x92, 0x11, 0x6e, 0xb0, 0x88, 0xc6, 0x14, 0x35, 0xd3, 0xae, 0xa1, 0xba, 0x9d, 0x4e, 0x36,
-	0xef, 0x45, 0x4b, 0x16, 0xd3, 0xfe, 0x1b, 0xb2, 0x54, 0xd3, 0x85, 0x9e, 0x60, 0x14, 0x49, 0x9d,
-	0x96, 0x4f, 0x7d, 0x2a, 0x2e, 0xfb, 0xc9, 0x95, 0xac, 0xf6, 0x86, 0xb0, 0x8f, 0xc9, 0x25, 0x8d,
-	0xc9, 0x28, 0xf0, 0x43, 0x12, 0x99, 0x51, 0x44, 0x23, 0x84, 0xa0, 0xe2, 0xd1, 0x19, 0x69, 0xeb,
-	0x87, 0xfa, 0x51, 0x15, 0x8b, 0x6b, 0x74, 0x08, 0x8d, 0x19, 0xe1, 0x5e, 0x14, 0xb0, 0x38, 0xa0,
-	0x61, 0x7b, 0xe3, 0x50, 0x3f, 0xba, 0x87, 0x8b, 0xa5, 0xde, 0x63, 0xd8, 0x71, 0xe6, 0xd3, 0x53,
-	0xb2, 0xc4, 0xe4, 0xa7, 0x39, 0xe1, 0x31, 0x7a, 0x00, 0x5b, 0xde, 0xeb, 0x49, 0x10, 0xba, 0xc1,
-	0x4c, 0xa0, 0xee, 0xe1, 0xba, 0x38, 0x0f, 0x67, 0xbd, 0x5f, 0x74, 0xd8, 0x4d, 0x87, 0x39, 0xa3,
-	0x21, 0x27, 0xe8, 0x29, 0xd4, 0xd9, 0x7c, 0xea, 0xbe, 0x21, 0x4b, 0x31, 0xdc, 0x78, 0xd2, 0x31,
-	0x32, 0xef, 0xd2, 0xa9, 0xe1, 0xcc, 0xa7, 0x17, 0x81, 0x77, 0x4a, 0x96, 0xc7, 0x95, 0xb7, 0x7f,
-	0x3d, 0xd2, 0x70, 0x8d, 0x09, 0x04, 0x7a, 0x0a, 0x55, 0x92, 0x08, 0x17, 0xaa, 0x1a, 0x4f, 0x3e,
-	0x33, 0xca, 0x37, 0xcd, 0xb8, 0xe1, 0x11, 0xcb, 0x5f, 0xf4, 0xce, 0x60, 0x2f, 0xa9, 0x9e, 0xd1,
-	0x98, 0xa4, 0xb2, 0x8f, 0xa0, 0xb2, 0xa0, 0x31, 0x51, 0x2a, 0x5a, 0x39, 0x4c, 0xde, 0x4d, 0x31,
-	0x2a, 0x26, 0x56, 0x0c, 0x6e, 0xac, 0x1a, 0xfc, 0x19, 0x90, 0xd8, 0x36, 0x93, 0x64, 0xe5, 0xd1,
-	0xf8, 0x7f, 0xb4, 0xb2, 0x26, 0x17, 0xdc, 0xc1, 0xd8, 0x39, 0x1c, 0x24, 0x55, 0x27, 0xa2, 0x8c,
-	0xf2, 0xc9, 0x45, 0x6a, 0xee, 0x6b, 0xd8, 0x62, 0xaa, 0xa4, 0x54, 0xb4, 0xcb, 0x2a, 0xb2, 0x9f,
-	0x64, 0x93, 0xef, 0x33, 0xfa, 0xab, 0x0e, 0xf7, 0xa5, 0xd3, 0x7c, 0x95, 0x72, 0xfb, 0xcd, 0x87,
-	0xef, 0x52, 0xae, 0xf3, 0x8d, 0x77, 0x70, 0xfe, 0x25, 0xec, 0x9d, 0xe1, 0xef, 0x9c, 0x88, 0xd2,
-	0xf3, 0xd4, 0x75, 0x1b, 0xea, 0x97, 0x84, 0xf3, 0x89, 0x2f, 0x6f, 0xfd, 0x36, 0x4e, 0x8f, 0x3d,
-	0x0f, 0x9a, 0xf9, 0xb0, 0xd2, 0xdd, 0x82, 0x2a, 0x4b, 0x0a, 0x6a, 0x56, 0x1e, 0xee, 0xa2, 0x68,
-	0x07, 0x1a, 0x4e, 0x10, 0xfa, 0x4a, 0x4d, 0x6f, 0x17, 0xb6, 0xe5, 0x51, 0xee, 0xeb, 0xfd, 0x51,
-	0x83, 0xfa, 0x73, 0xa9, 0x07, 0x0d, 0x61, 0x4f, 0xa5, 0xc0, 0x8d, 0xe4, 0xb8, 0xba, 0x75, 0x8f,
-	0x6e, 0xee, 0x5b, 0x49, 0xdb, 0x40, 0xc3, 0x3b, 0x6c, 0x25, 0x7e, 0xdf, 0x43, 0x33, 0x47, 0xc9,
-	0x55, 0x4a, 0xfb, 0xe1, 0xed, 0x2c, 0x39, 0x37, 0xd0, 0xf0, 0x2e, 0x5b, 0x8d, 0xa7, 0x0d, 0xfb,
-	0x3c, 0xf0, 0x43, 0x37, 0x79, 0x2f, 0x33, 0x69, 0x9b, 0x02, 0xf7, 0xe9, 0x4d, 0x5c, 0x29, 0x53,
-	0x03, 0x0d, 0xef, 0xf1, 0x52, 0xcc, 0x5e, 0x41, 0x8b, 0x8b, 0xf7, 0x26, 0x45, 0x2a, 0x89, 0x15,
-	0xc1, 0xfc, 0x7c, 0x3d, 0x73, 0x35, 0x4f, 0x03, 0x0d, 0x23, 0x7e, 0x33, 0x65, 0x3f, 0xc2, 0x47,
-	0x42, 0x6a, 0xfa, 0x32, 0x65, 0x72, 0xab, 0x02, 0xfd, 0xc5, 0x7a, 0x74, 0x29, 0x29, 0x03, 0x0d,
-	0x1f, 0xf0, 0x35, 0x01, 0x9a, 0x41, 0x5b, 0xc9, 0x2e, 0xe0, 0x95, 0xf4, 0x9a, 0xe0, 0x1f, 0xdd,
-	0x26, 0xbd, 0x1c, 0x90, 0x81, 0x86, 0xef, 0xf3, 0xf5, 0xd1, 0x39, 0x86, 0x6d, 0x16, 0x84, 0x7e,
-	0xa6, 0xbc, 0x2e, 0xc8, 0x0f, 0xd7, 0x3c, 0xb7, 0xfc, 0xbd, 0x1a, 0x68, 0xb8, 0xc1, 0xf2, 0x23,
-	0x32, 0x61, 0x47, 0x31, 0x94, 0xbc, 0x2d, 0x01, 0xe9, 0xde, 0x06, 0xc9, 0x44, 0x6d, 0xb3, 0xc2,
-	0x19, 0x39, 0xb0, 0xbf, 0x88, 0xce, 0x5d, 0x11, 0x82, 0x4c, 0xcf, 0x3f, 0xf5, 0xdb, 0x9e, 0x7c,
-	0x29, 0x7a, 0xc9, 0x93, 0x5f, 0x44, 0xe7, 0x2b, 0x69, 0x1c, 0x01, 0x2a, 0x12, 0x95, 0xba, 0x7f,
-	0x25, 0xb2, 0xf7, 0x3e, 0x64, 0x26, 0xb1, 0x99, 0x33, 0x65, 0xed, 0xb8, 0x0a, 0x9b, 0x7c, 0x7e,
-	0xf9, 0xf8, 0x77, 0x1d, 0x6a, 0x22, 0x7b, 0x1c, 0x21, 0xd8, 0x35, 0x31, 0xb6, 0xf1, 0xc8, 0x7d,
-	0x61, 0x9d, 0x5a, 0xf6, 0x4b, 0xab, 0xa9, 0xa1, 0x2e, 0x74, 0xb2, 0x9a, 0xf9, 0xca, 0x31, 0x4f,
-	0xc6, 0xe6, 0x33, 0x17, 0x9b, 0x23, 0xc7, 0xb6, 0x46, 0x66, 0x53, 0x47, 0x6d, 0x68, 0xa9, 0xbe,
-	0x65, 0xbb, 0x27, 0xb6, 0x65, 0x99, 0x27, 0xe3, 0xa1, 0x6d, 0x35, 0x37, 0xd0, 0x43, 0x78, 0xa0,
-	0x3a, 0x79, 0xd9, 0x1d, 0x0f, 0x9f, 0x9b, 0xf6, 0x8b, 0x71, 0x73, 0x13, 0x7d, 0x0c, 0x07, 0xaa,
-	0x8d, 0xcd, 0x6f, 0x9f, 0x65, 0x8d, 0x4a, 0x81, 0xf8, 0x12, 0x0f, 0xc7, 0x66, 0xd6, 0xa9, 0x1e,
-	0x0f, 0xdf, 0x5e, 0x75, 0xf5, 0x77, 0x57, 0x5d, 0xfd, 0xef, 0xab, 0xae, 0xfe, 0xdb, 0x75, 0x57,
-	0x7b, 0x77, 0xdd, 0xd5, 0xfe, 0xbc, 0xee, 0x6a, 0x3f, 0xf4, 0xfd, 0x20, 0x7e, 0x3d, 0x9f, 0x1a,
-	0x1e, 0xbd, 0xec, 0x5f, 0x04, 0x21, 0xe9, 0x17, 0x3e, 0x11, 0x92, 0xff, 0xef, 0xf2, 0x17, 0xc3,
-	0xb4, 0x26, 0xea, 0x5f, 0xfd, 0x17, 0x00, 0x00, 0xff, 0xff, 0x45, 0xc7, 0x81, 0xcc, 0x4c, 0x08,
-	0x00, 0x00,
+	0x14, 0x86, 0x49, 0x5b, 0x17, 0xfb, 0xc8, 0x17, 0x79, 0xac, 0xa6, 0x8a, 0xda, 0x28, 0xae, 0xda,
+	0x02, 0x46, 0x16, 0x12, 0x90, 0x76, 0x93, 0xee, 0x6a, 0x87, 0x85, 0x04, 0x37, 0x24, 0x31, 0x52,
+	0x9c, 0xa0, 0x5d, 0x10, 0x12, 0x35, 0x62, 0x88, 0xd8, 0x9c, 0x29, 0x87, 0x12, 0xa0, 0x55, 0x77,
+	0xdd, 0xb6, 0x8f, 0xd1, 0x47, 0x49, 0x77, 0x59, 0x76, 0x55, 0x14, 0xf6, 0xa6, 0x7d, 0x8b, 0x82,
+	0x33, 0xc3, 0x8b, 0x28, 0xdb, 0x0d, 0xe0, 0x1d, 0xe7, 0x9c, 0xa3, 0xef, 0xfc, 0x3f, 0xc9, 0x1f,
+	0x22, 0x7c, 0x4a, 0x79, 0x14, 0x8e, 0x5d, 0x1a, 0xf4, 0x58, 0xe8, 0x2f, 0x16, 0xe3, 0x8b, 0x5e,
+	0xb4, 0x64, 0x84, 0x77, 0x59, 0x48, 0x23, 0x8a, 0xea, 0x49, 0xb7, 0xab, 0xba, 0xad, 0x56, 0x3a,
+	0xef, 0x86, 0x4b, 0x16, 0xd1, 0xde, 0x5b, 0xb2, 0x54, 0xd3, 0xb9, 0x9e, 0x60, 0xe4, 0x49, 0xad,
+	0x86, 0x47, 0x3d, 0x2a, 0x2e, 0x7b, 0xf1, 0x95, 0xac, 0x76, 0x06, 0x70, 0x80, 0xc9, 0x25, 0x8d,
+	0xc8, 0xd0, 0xf7, 0x02, 0x12, 0x1a, 0x61, 0x48, 0x43, 0x84, 0xa0, 0xe4, 0xd2, 0x29, 0x69, 0xea,
+	0x47, 0xfa, 0x71, 0x19, 0x8b, 0x6b, 0x74, 0x04, 0xb5, 0x29, 0xe1, 0x6e, 0xe8, 0xb3, 0xc8, 0xa7,
+	0x41, 0x73, 0xe3, 0x48, 0x3f, 0xde, 0xc6, 0xf9, 0x52, 0xe7, 0x09, 0xec, 0xda, 0xf3, 0xc9, 0x19,
+	0x59, 0x62, 0xf2, 0xd3, 0x9c, 0xf0, 0x08, 0x3d, 0x84, 0x2d, 0xf7, 0xcd, 0xd8, 0x0f, 0x1c, 0x7f,
+	0x2a, 0x50, 0xdb, 0xb8, 0x2a, 0xce, 0x83, 0x69, 0xe7, 0x17, 0x1d, 0xf6, 0x92, 0x61, 0xce, 0x68,
+	0xc0, 0x09, 0x7a, 0x06, 0x55, 0x36, 0x9f, 0x38, 0x6f, 0xc9, 0x52, 0x0c, 0xd7, 0x9e, 0xb6, 0xba,
+	0xa9, 0x77, 0xe9, 0xb4, 0x6b, 0xcf, 0x27, 0x17, 0xbe, 0x7b, 0x46, 0x96, 0x27, 0xa5, 0x77, 0x7f,
+	0x3d, 0xd6, 0x70, 0x85, 0x09, 0x04, 0x7a, 0x06, 0x65, 0x12, 0x0b, 0x17, 0xaa, 0x6a, 0x4f, 0x3f,
+	0xef, 0x16, 0x6f, 0x5a, 0x77, 0xcd, 0x23, 0x96, 0xbf, 0xe8, 0x9c, 0xc3, 0x7e, 0x5c, 0x3d, 0xa7,
+	0x11, 0x49, 0x64, 0x1f, 0x43, 0x69, 0x41, 0x23, 0xa2, 0x54, 0x34, 0x32, 0x98, 0xbc, 0x9b, 0x62,
+	0x54, 0x4c, 0xac, 0x18, 0xdc, 0x58, 0x35, 0xf8, 0x33, 0x20, 0xb1, 0x6d, 0x2a, 0xc9, 0xca, 0x63,
+	0xf7, 0xff, 0xd1, 0xca, 0x9a, 0x5c, 0x70, 0x0f, 0x63, 0x33, 0x38, 0x8c, 0xab, 0x76, 0x48, 0x19,
+	0xe5, 0xe3, 0x8b, 0xc4, 0xdc, 0xd7, 0xb0, 0xc5, 0x54, 0x49, 0xa9, 0x68, 0x16, 0x55, 0xa4, 0x3f,
+	0x49, 0x27, 0xef, 0x32, 0xfa, 0xab, 0x0e, 0x0f, 0xa4, 0xd3, 0x6c, 0x95, 0x72, 0xfb, 0xcd, 0x87,
+	0xef, 0x52, 0xae, 0xb3, 0x8d, 0xf7, 0x70, 0xde, 0x87, 0xfd, 0x73, 0xfc, 0x9d, 0x1d, 0x52, 0x3a,
+	0x4b, 0x5c, 0x37, 0xa1, 0x7a, 0x49, 0x38, 0x1f, 0x7b, 0xf2, 0xd6, 0xef, 0xe0, 0xe4, 0x88, 0x3e,
+	0x81, 0xed, 0x45, 0x38, 0x73, 0xf8, 0xdc, 0x8f, 0x88, 0xb2, 0xb6, 0xb5, 0x08, 0x67, 0xc3, 0xf8,
+	0xdc, 0x71, 0xa1, 0x9e, 0x91, 0x94, 0xa9, 0x06, 0x94, 0x59, 0x5c, 0x50, 0x20, 0x79, 0xb8, 0x8f,
+	0xdc, 0x5d, 0xa8, 0xd9, 0x7e, 0xe0, 0x29, 0xa9, 0x9d, 0x3d, 0xd8, 0x91, 0x47, 0xb9, 0xaf, 0xf3,
+	0x47, 0x05, 0xaa, 0x2f, 0x94, 0xd8, 0x01, 0xec, 0xab, 0x88, 0x38, 0xa1, 0x1c, 0x57, 0xf7, 0xf5,
+	0xf1, 0xfa, 0xbe, 0x95, 0x28, 0xf6, 0x35, 0xbc, 0xcb, 0x56, 0xb2, 0xf9, 0x3d, 0xd4, 0x33, 0x94,
+	0x5c, 0xa5, 0xb4, 0x1f, 0xdd, 0xce, 0x92, 0x73, 0x7d, 0x0d, 0xef, 0xb1, 0xd5, 0xec, 0x5a, 0x70,
+	0xc0, 0x7d, 0x2f, 0x70, 0xe2, 0x97, 0x36, 0x95, 0xb6, 0x29, 0x70, 0x9f, 0xad, 0xe3, 0x0a, 0x81,
+	0xeb, 0x6b, 0x78, 0x9f, 0x17, 0x32, 0xf8, 0x1a, 0x1a, 0x5c, 0xbc, 0x54, 0x09, 0x52, 0x49, 0x2c,
+	0x09, 0xe6, 0x17, 0x37, 0x33, 0x57, 0xc3, 0xd6, 0xd7, 0x30, 0xe2, 0xeb, 0x11, 0xfc, 0x11, 0x3e,
+	0x12, 0x52, 0x93, 0x37, 0x2d, 0x95, 0x5b, 0x16, 0xe8, 0x2f, 0x6f, 0x46, 0x17, 0x62, 0xd4, 0xd7,
+	0xf0, 0x21, 0x5f, 0x2f, 0xa3, 0x29, 0x34, 0x95, 0xec, 0x1c, 0x5e, 0x49, 0xaf, 0x08, 0xfe, 0xf1,
+	0x6d, 0xd2, 0x8b, 0xe9, 0xe9, 0x6b, 0xf8, 0x01, 0xbf, 0x39, 0x57, 0x27, 0xb0, 0xc3, 0xfc, 0xc0,
+	0x4b, 0x95, 0x57, 0x05, 0xf9, 0xd1, 0x0d, 0xcf, 0x2d, 0x7b, 0xaf, 0xfa, 0x1a, 0xae, 0xb1, 0xec,
+	0x88, 0x0c, 0xd8, 0x55, 0x0c, 0x25, 0x6f, 0x4b, 0x40, 0xda, 0xb7, 0x41, 0x52, 0x51, 0x3b, 0x2c,
+	0x77, 0x46, 0x36, 0x1c, 0xc4, 0xf1, 0x11, 0x21, 0x48, 0xf5, 0xfc, 0x53, 0xbd, 0xed, 0xc9, 0x17,
+	0x72, 0x19, 0x3f, 0xf9, 0x45, 0x38, 0x5b, 0x89, 0xea, 0x10, 0x50, 0x9e, 0xa8, 0xd4, 0xfd, 0x2b,
+	0x91, 0x9d, 0xbb, 0x90, 0xa9, 0xc4, 0x7a, 0xc6, 0x94, 0xb5, 0x93, 0x32, 0x6c, 0xf2, 0xf9, 0xe5,
+	0x93, 0xdf, 0x75, 0xa8, 0x88, 0xec, 0x71, 0x84, 0x60, 0xcf, 0xc0, 0xd8, 0xc2, 0x43, 0xe7, 0xa5,
+	0x79, 0x66, 0x5a, 0xaf, 0xcc, 0xba, 0x86, 0xda, 0xd0, 0x4a, 0x6b, 0xc6, 0x6b, 0xdb, 0x38, 0x1d,
+	0x19, 0xcf, 0x1d, 0x6c, 0x0c, 0x6d, 0xcb, 0x1c, 0x1a, 0x75, 0x1d, 0x35, 0xa1, 0xa1, 0xfa, 0xa6,
+	0xe5, 0x9c, 0x5a, 0xa6, 0x69, 0x9c, 0x8e, 0x06, 0x96, 0x59, 0xdf, 0x40, 0x8f, 0xe0, 0xa1, 0xea,
+	0x64, 0x65, 0x67, 0x34, 0x78, 0x61, 0x58, 0x2f, 0x47, 0xf5, 0x4d, 0xf4, 0x31, 0x1c, 0xaa, 0x36,
+	0x36, 0xbe, 0x7d, 0x9e, 0x36, 0x4a, 0x39, 0xe2, 0x2b, 0x3c, 0x18, 0x19, 0x69, 0xa7, 0x7c, 0x32,
+	0x78, 0x77, 0xd5, 0xd6, 0xdf, 0x5f, 0xb5, 0xf5, 0xbf, 0xaf, 0xda, 0xfa, 0x6f, 0xd7, 0x6d, 0xed,
+	0xfd, 0x75, 0x5b, 0xfb, 0xf3, 0xba, 0xad, 0xfd, 0xd0, 0xf3, 0xfc, 0xe8, 0xcd, 0x7c, 0xd2, 0x75,
+	0xe9, 0x65, 0xef, 0xc2, 0x0f, 0x48, 0x2f, 0xf7, 0xfd, 0x10, 0xff, 0xb9, 0x17, 0x3f, 0x27, 0x26,
+	0x15, 0x51, 0xff, 0xea, 0xbf, 0x01, 0x00, 0x84, 0xae, 0xeb, 0xe1, 0x69, 0x08, 0x00, 0x00,
 }
 
 func (m *RemoteSignerError) Marshal() (dAtA []byte, err error) {
@@ -1164,6 +1174,13 @@ func (m *VRFProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VrfSuite) > 0 {
+		i -= len(m.VrfSuite)
+		copy(dAtA[i:], m.VrfSuite)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VrfSuite)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Message) > 0 {
 		i -= len(m.Message)
 		copy(dAtA[i:], m.Message)
@@ -1637,6 +1654,10 @@ func (m *VRFProofRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.VrfSuite)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2653,6 +2674,38 @@ func (m *VRFProofRequest) Unmarshal(dAtA []byte) error {
 				m.Message = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VrfSuite", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VrfSuite = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
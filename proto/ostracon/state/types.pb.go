@@ -146,6 +146,60 @@ func (m *ValidatorsInfo) GetLastHeightChanged() int64 {
 	return 0
 }
 
+// VotersInfo represents the voters elected from the validator set at a height
+type VotersInfo struct {
+	// indices of the voters in the validator set, in the order of the voter set
+	ValidatorIndices []int32 `protobuf:"varint,1,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	VotingPowers     []int64 `protobuf:"varint,2,rep,packed,name=voting_powers,json=votingPowers,proto3" json:"voting_powers,omitempty"`
+}
+
+func (m *VotersInfo) Reset()         { *m = VotersInfo{} }
+func (m *VotersInfo) String() string { return proto.CompactTextString(m) }
+func (*VotersInfo) ProtoMessage()    {}
+func (*VotersInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_898987a4421067cd, []int{2}
+}
+func (m *VotersInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotersInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotersInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotersInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotersInfo.Merge(m, src)
+}
+func (m *VotersInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotersInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotersInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotersInfo proto.InternalMessageInfo
+
+func (m *VotersInfo) GetValidatorIndices() []int32 {
+	if m != nil {
+		return m.ValidatorIndices
+	}
+	return nil
+}
+
+func (m *VotersInfo) GetVotingPowers() []int64 {
+	if m != nil {
+		return m.VotingPowers
+	}
+	return nil
+}
+
 // ConsensusParamsInfo represents the latest consensus params, or the last height it changed
 type ConsensusParamsInfo struct {
 	ConsensusParams   types1.ConsensusParams `protobuf:"bytes,1,opt,name=consensus_params,json=consensusParams,proto3" json:"consensus_params"`
@@ -156,7 +210,7 @@ func (m *ConsensusParamsInfo) Reset()         { *m = ConsensusParamsInfo{} }
 func (m *ConsensusParamsInfo) String() string { return proto.CompactTextString(m) }
 func (*ConsensusParamsInfo) ProtoMessage()    {}
 func (*ConsensusParamsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_898987a4421067cd, []int{2}
+	return fileDescriptor_898987a4421067cd, []int{3}
 }
 func (m *ConsensusParamsInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -208,7 +262,7 @@ func (m *Version) Reset()         { *m = Version{} }
 func (m *Version) String() string { return proto.CompactTextString(m) }
 func (*Version) ProtoMessage()    {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_898987a4421067cd, []int{3}
+	return fileDescriptor_898987a4421067cd, []int{4}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,13 +338,15 @@ type State struct {
 	Voters      *types1.VoterSet    `protobuf:"bytes,1001,opt,name=voters,proto3" json:"voters,omitempty"`
 	LastVoters  *types1.VoterSet    `protobuf:"bytes,1002,opt,name=last_voters,json=lastVoters,proto3" json:"last_voters,omitempty"`
 	VoterParams *types1.VoterParams `protobuf:"bytes,1003,opt,name=voter_params,json=voterParams,proto3" json:"voter_params,omitempty"`
+	// the block protocol of the last block, which its commit is verified under
+	LastBlockVersion uint64 `protobuf:"varint,1004,opt,name=last_block_version,json=lastBlockVersion,proto3" json:"last_block_version,omitempty"`
 }
 
 func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_898987a4421067cd, []int{4}
+	return fileDescriptor_898987a4421067cd, []int{5}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -438,9 +494,17 @@ func (m *State) GetVoterParams() *types1.VoterParams {
 	return nil
 }
 
+func (m *State) GetLastBlockVersion() uint64 {
+	if m != nil {
+		return m.LastBlockVersion
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ABCIResponses)(nil), "ostracon.state.ABCIResponses")
 	proto.RegisterType((*ValidatorsInfo)(nil), "ostracon.state.ValidatorsInfo")
+	proto.RegisterType((*VotersInfo)(nil), "ostracon.state.VotersInfo")
 	proto.RegisterType((*ConsensusParamsInfo)(nil), "ostracon.state.ConsensusParamsInfo")
 	proto.RegisterType((*Version)(nil), "ostracon.state.Version")
 	proto.RegisterType((*State)(nil), "ostracon.state.State")
@@ -449,60 +513,64 @@ func init() {
 func init() { proto.RegisterFile("ostracon/state/types.proto", fileDescriptor_898987a4421067cd) }
 
 var fileDescriptor_898987a4421067cd = []byte{
-	// 834 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x6f, 0xdb, 0x46,
-	0x10, 0x35, 0xeb, 0xc4, 0x94, 0x86, 0x96, 0xdc, 0xae, 0x0b, 0x94, 0x91, 0x03, 0x49, 0x35, 0xfa,
-	0x61, 0x14, 0x28, 0x85, 0xa6, 0x87, 0x02, 0x45, 0x80, 0xc0, 0x94, 0x83, 0x46, 0x40, 0x50, 0x18,
-	0x4c, 0xe0, 0x43, 0x2f, 0xc4, 0x8a, 0x5c, 0x91, 0x44, 0x69, 0x2e, 0xc1, 0x5d, 0xa9, 0xee, 0xb9,
-	0x7f, 0x20, 0xb7, 0xfe, 0xa5, 0x1c, 0x73, 0xe8, 0xa1, 0x27, 0xb7, 0x90, 0x0f, 0xfd, 0xfa, 0x13,
-	0xc5, 0x7e, 0x91, 0x94, 0x5a, 0xa3, 0x29, 0x72, 0xe3, 0xce, 0x7b, 0xf3, 0xf6, 0xed, 0xec, 0x0c,
-	0x17, 0x06, 0x94, 0xf1, 0x0a, 0x47, 0xb4, 0x98, 0x30, 0x8e, 0x39, 0x99, 0xf0, 0xef, 0x4b, 0xc2,
-	0xbc, 0xb2, 0xa2, 0x9c, 0xa2, 0xbe, 0xc1, 0x3c, 0x89, 0x0d, 0xde, 0x4d, 0x68, 0x42, 0x25, 0x34,
-	0x11, 0x5f, 0x8a, 0x35, 0xb8, 0x57, 0x2b, 0xe0, 0x79, 0x94, 0xb5, 0x05, 0x06, 0x8d, 0xb8, 0x8c,
-	0x6e, 0x60, 0xc3, 0x2d, 0x6c, 0x85, 0xf3, 0x2c, 0xc6, 0x9c, 0x56, 0xb7, 0xe4, 0xae, 0x28, 0x27,
-	0x06, 0x3b, 0xda, 0xc2, 0x4a, 0x5c, 0xe1, 0x4b, 0x23, 0x7c, 0xbf, 0x06, 0x57, 0xa4, 0x62, 0x99,
-	0x21, 0x69, 0x74, 0x94, 0x50, 0x9a, 0xe4, 0x64, 0x22, 0x57, 0xf3, 0xe5, 0x62, 0xc2, 0xb3, 0x4b,
-	0xc2, 0x38, 0xbe, 0x2c, 0x15, 0xe1, 0xf8, 0x27, 0x0b, 0x7a, 0xa7, 0xfe, 0x74, 0x16, 0x10, 0x56,
-	0xd2, 0x82, 0x11, 0x86, 0x4e, 0xc1, 0x89, 0x49, 0x9e, 0xad, 0x48, 0x15, 0xf2, 0x2b, 0xe6, 0x5a,
-	0xe3, 0xdd, 0x13, 0xe7, 0xc1, 0xd8, 0xab, 0x8b, 0x23, 0x8e, 0xed, 0x19, 0xfa, 0x99, 0x62, 0x3e,
-	0xbf, 0x0a, 0x20, 0x36, 0x9f, 0x0c, 0x3d, 0x84, 0x2e, 0x29, 0xe2, 0x70, 0x9e, 0xd3, 0xe8, 0x5b,
-	0xf7, 0xad, 0xb1, 0x75, 0xe2, 0x3c, 0x18, 0xdd, 0x22, 0xf0, 0xb8, 0x88, 0x7d, 0x41, 0x0b, 0x3a,
-	0x44, 0x7f, 0x21, 0x1f, 0x9c, 0x39, 0x49, 0xb2, 0x42, 0xe7, 0xef, 0xca, 0xfc, 0xf7, 0x6f, 0xc9,
-	0xf7, 0x05, 0x53, 0x29, 0xc0, 0xbc, 0xfe, 0x3e, 0xfe, 0xc1, 0x82, 0xfe, 0x85, 0x29, 0x31, 0x9b,
-	0x15, 0x0b, 0x8a, 0x4e, 0xa1, 0x57, 0x17, 0x3d, 0x64, 0x84, 0xbb, 0x96, 0x14, 0xbe, 0xdf, 0x08,
-	0xab, 0xc2, 0xd5, 0x69, 0xcf, 0x08, 0x0f, 0xf6, 0x57, 0xad, 0x15, 0xf2, 0xe0, 0x30, 0xc7, 0x8c,
-	0x87, 0x29, 0xc9, 0x92, 0x94, 0x87, 0x51, 0x8a, 0x8b, 0x84, 0xc4, 0xf2, 0x84, 0xbb, 0xc1, 0x3b,
-	0x02, 0x7a, 0x22, 0x91, 0xa9, 0x02, 0x8e, 0x7f, 0xb4, 0xe0, 0x70, 0x2a, 0x5c, 0x16, 0x6c, 0xc9,
-	0xce, 0xe5, 0xad, 0x49, 0x2b, 0xe7, 0xf0, 0x76, 0x64, 0xc2, 0xa1, 0xba, 0x4d, 0xed, 0x66, 0xb4,
-	0xed, 0x66, 0x2b, 0xdd, 0xbf, 0xf3, 0xf2, 0x7a, 0xb4, 0x13, 0x1c, 0x44, 0x9b, 0xe1, 0xff, 0xed,
-	0x6c, 0x01, 0xf6, 0x85, 0x6a, 0x17, 0xf4, 0x08, 0xba, 0xb5, 0x9a, 0x76, 0x71, 0xd4, 0xb8, 0xd0,
-	0x4d, 0xd5, 0xf8, 0xd0, 0x0e, 0x9a, 0x1c, 0x34, 0x80, 0x0e, 0xa3, 0x0b, 0xfe, 0x1d, 0xae, 0x88,
-	0xdc, 0xb0, 0x1b, 0xd4, 0xeb, 0xe3, 0xdf, 0x6c, 0xb8, 0xfb, 0x4c, 0x4c, 0x13, 0xfa, 0x02, 0x6c,
-	0xad, 0xa5, 0x37, 0x79, 0xcf, 0xdb, 0x9c, 0x37, 0x4f, 0x1b, 0xd2, 0x1b, 0x18, 0x36, 0xfa, 0x08,
-	0x3a, 0x51, 0x8a, 0xb3, 0x22, 0xcc, 0xd4, 0x79, 0xba, 0xbe, 0xb3, 0xbe, 0x1e, 0xd9, 0x53, 0x11,
-	0x9b, 0x9d, 0x05, 0xb6, 0x04, 0x67, 0x31, 0xfa, 0x10, 0xfa, 0x59, 0x91, 0xf1, 0x0c, 0xe7, 0xba,
-	0x0a, 0x6e, 0x5f, 0x9e, 0xbe, 0xa7, 0xa3, 0xaa, 0x00, 0xe8, 0x13, 0x90, 0xe5, 0x50, 0xcd, 0x65,
-	0x98, 0xbb, 0x92, 0x79, 0x20, 0x00, 0xd9, 0x3f, 0x9a, 0x7b, 0x0e, 0xbd, 0x16, 0x37, 0x8b, 0xdd,
-	0x3b, 0xdb, 0xce, 0xd5, 0x25, 0xc9, 0x9c, 0xd9, 0x99, 0x7f, 0x28, 0x9c, 0xaf, 0xaf, 0x47, 0xce,
-	0x53, 0x23, 0x34, 0x3b, 0x0b, 0x9c, 0x5a, 0x75, 0x16, 0xa3, 0xa7, 0x70, 0xd0, 0x52, 0x14, 0xc3,
-	0xe8, 0xde, 0x95, 0x9a, 0x03, 0x4f, 0x4d, 0xaa, 0x67, 0x26, 0xd5, 0x7b, 0x6e, 0x26, 0xd5, 0xef,
-	0x08, 0xd9, 0x17, 0xbf, 0x8c, 0xac, 0xa0, 0x57, 0x6b, 0x09, 0x14, 0x3d, 0x86, 0x83, 0x82, 0x5c,
-	0xf1, 0xb0, 0x6e, 0x52, 0xe6, 0xee, 0xbd, 0x46, 0x53, 0xf7, 0x45, 0x52, 0x33, 0x1d, 0xe8, 0x21,
-	0x40, 0x4b, 0xc1, 0x7e, 0x0d, 0x85, 0x16, 0x1f, 0x4d, 0x61, 0xd8, 0x6e, 0xbd, 0x06, 0xa9, 0xbb,
-	0xb0, 0x2b, 0xab, 0x7b, 0xd4, 0x74, 0x61, 0xb3, 0xb7, 0xee, 0xc7, 0x7f, 0x9d, 0x08, 0x78, 0xa3,
-	0x89, 0xf8, 0x1a, 0x3e, 0xd8, 0x98, 0x88, 0x2d, 0xf5, 0xda, 0x9c, 0x23, 0xcd, 0x8d, 0x5b, 0x23,
-	0xb2, 0x29, 0x64, 0x1c, 0x9a, 0xbe, 0xa9, 0x08, 0x5b, 0xe6, 0x9c, 0x85, 0x29, 0x66, 0xa9, 0xbb,
-	0x3f, 0xb6, 0x4e, 0xf6, 0x55, 0xdf, 0x04, 0x2a, 0xfe, 0x04, 0xb3, 0x14, 0xdd, 0x83, 0x0e, 0x2e,
-	0x4b, 0x45, 0xe9, 0x49, 0x8a, 0x8d, 0xcb, 0x52, 0x42, 0x1f, 0xeb, 0x06, 0x28, 0x2b, 0x4a, 0x17,
-	0x8a, 0xf1, 0xbb, 0x2d, 0x29, 0xf2, 0x6e, 0xcf, 0x45, 0x58, 0x12, 0x3f, 0x83, 0x3d, 0xf9, 0x06,
-	0x30, 0xf7, 0x0f, 0x75, 0x23, 0xee, 0x3f, 0x6e, 0x44, 0xc0, 0xe2, 0x36, 0x34, 0x11, 0x7d, 0x09,
-	0xb2, 0xd7, 0x42, 0x9d, 0xf7, 0xe7, 0x7f, 0xe5, 0x81, 0x60, 0x5f, 0xa8, 0xdc, 0x47, 0xb0, 0x2f,
-	0xd3, 0x4c, 0xf1, 0xff, 0xb2, 0xb7, 0xff, 0x04, 0xad, 0x64, 0x55, 0x99, 0xc0, 0x59, 0x35, 0x0b,
-	0xff, 0xab, 0x97, 0xeb, 0xa1, 0xf5, 0x6a, 0x3d, 0xb4, 0x7e, 0x5d, 0x0f, 0xad, 0x17, 0x37, 0xc3,
-	0x9d, 0x57, 0x37, 0xc3, 0x9d, 0x9f, 0x6f, 0x86, 0x3b, 0xdf, 0x7c, 0x9a, 0x64, 0x3c, 0x5d, 0xce,
-	0xbd, 0x88, 0x5e, 0x4e, 0xf2, 0xac, 0x20, 0x93, 0xfa, 0xc5, 0x52, 0x8f, 0xeb, 0xe6, 0x93, 0x3c,
-	0xdf, 0x93, 0xd1, 0xcf, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x04, 0x36, 0x02, 0x0c, 0xab, 0x07,
-	0x00, 0x00,
+	// 902 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0xdb, 0x36,
+	0x14, 0x8e, 0xea, 0x24, 0xb6, 0x9f, 0x6c, 0x27, 0x65, 0x06, 0x4c, 0x75, 0x0a, 0xdb, 0xf3, 0x7e,
+	0x05, 0x1b, 0x2a, 0x63, 0xdd, 0x61, 0xc0, 0x30, 0xa0, 0x88, 0x9d, 0x62, 0x35, 0x50, 0x0c, 0x86,
+	0x5a, 0xe4, 0xb0, 0xc3, 0x04, 0x59, 0xa2, 0x65, 0x62, 0x8e, 0x28, 0x88, 0xb4, 0x9b, 0x9d, 0xf7,
+	0x0f, 0xf4, 0xb4, 0xfd, 0x4b, 0x3d, 0xf6, 0xb0, 0xc3, 0x4e, 0xd9, 0xe0, 0x5c, 0xf6, 0xeb, 0x8f,
+	0x18, 0x48, 0x8a, 0x94, 0xec, 0x2d, 0x58, 0x86, 0xde, 0xc4, 0xf7, 0x7d, 0xef, 0xe3, 0xe3, 0xe3,
+	0xf7, 0x68, 0x43, 0x9b, 0x32, 0x9e, 0x05, 0x21, 0x4d, 0x06, 0x8c, 0x07, 0x1c, 0x0f, 0xf8, 0x77,
+	0x29, 0x66, 0x6e, 0x9a, 0x51, 0x4e, 0x51, 0x4b, 0x63, 0xae, 0xc4, 0xda, 0x6f, 0xc5, 0x34, 0xa6,
+	0x12, 0x1a, 0x88, 0x2f, 0xc5, 0x6a, 0xdf, 0x33, 0x0a, 0xc1, 0x34, 0x24, 0x65, 0x81, 0x76, 0x21,
+	0x2e, 0xa3, 0x1b, 0x58, 0x67, 0x0b, 0x5b, 0x05, 0x0b, 0x12, 0x05, 0x9c, 0x66, 0x37, 0xe4, 0xae,
+	0x28, 0xc7, 0x1a, 0x3b, 0xde, 0xc2, 0xd2, 0x20, 0x0b, 0x2e, 0xb4, 0xf0, 0x7d, 0x03, 0xae, 0x70,
+	0xc6, 0x88, 0x26, 0xe5, 0x68, 0x37, 0xa6, 0x34, 0x5e, 0xe0, 0x81, 0x5c, 0x4d, 0x97, 0xb3, 0x01,
+	0x27, 0x17, 0x98, 0xf1, 0xe0, 0x22, 0x55, 0x84, 0xfe, 0x4f, 0x16, 0x34, 0x4f, 0x87, 0xa3, 0xb1,
+	0x87, 0x59, 0x4a, 0x13, 0x86, 0x19, 0x3a, 0x05, 0x3b, 0xc2, 0x0b, 0xb2, 0xc2, 0x99, 0xcf, 0x2f,
+	0x99, 0x63, 0xf5, 0x2a, 0x27, 0xf6, 0xc3, 0x9e, 0x6b, 0x9a, 0x23, 0x8e, 0xed, 0x6a, 0xfa, 0x99,
+	0x62, 0x3e, 0xbf, 0xf4, 0x20, 0xd2, 0x9f, 0x0c, 0x7d, 0x01, 0x75, 0x9c, 0x44, 0xfe, 0x74, 0x41,
+	0xc3, 0x6f, 0x9d, 0x3b, 0x3d, 0xeb, 0xc4, 0x7e, 0xd8, 0xbd, 0x41, 0xe0, 0x71, 0x12, 0x0d, 0x05,
+	0xcd, 0xab, 0xe1, 0xfc, 0x0b, 0x0d, 0xc1, 0x9e, 0xe2, 0x98, 0x24, 0x79, 0x7e, 0x45, 0xe6, 0xbf,
+	0x73, 0x43, 0xfe, 0x50, 0x30, 0x95, 0x02, 0x4c, 0xcd, 0x77, 0xff, 0x7b, 0x0b, 0x5a, 0xe7, 0xba,
+	0xc5, 0x6c, 0x9c, 0xcc, 0x28, 0x3a, 0x85, 0xa6, 0x69, 0xba, 0xcf, 0x30, 0x77, 0x2c, 0x29, 0x7c,
+	0xbf, 0x10, 0x56, 0x8d, 0x33, 0x69, 0xcf, 0x30, 0xf7, 0x1a, 0xab, 0xd2, 0x0a, 0xb9, 0x70, 0xb4,
+	0x08, 0x18, 0xf7, 0xe7, 0x98, 0xc4, 0x73, 0xee, 0x87, 0xf3, 0x20, 0x89, 0x71, 0x24, 0x4f, 0x58,
+	0xf1, 0xee, 0x0a, 0xe8, 0x89, 0x44, 0x46, 0x0a, 0xe8, 0x7f, 0x03, 0x70, 0x2e, 0xee, 0x51, 0x15,
+	0xf0, 0x31, 0xdc, 0x2d, 0x0a, 0x20, 0x49, 0x44, 0x42, 0xac, 0xda, 0xbb, 0xe7, 0x1d, 0x1a, 0x60,
+	0xac, 0xe2, 0xe8, 0x5d, 0x68, 0xae, 0x28, 0x27, 0x49, 0xec, 0xa7, 0xf4, 0x05, 0xce, 0x98, 0x73,
+	0xa7, 0x57, 0x39, 0xa9, 0x78, 0x0d, 0x15, 0x9c, 0xc8, 0x58, 0xff, 0x47, 0x0b, 0x8e, 0x46, 0xa2,
+	0x0b, 0x09, 0x5b, 0xb2, 0x89, 0x74, 0x85, 0xdc, 0x69, 0x02, 0x87, 0xa1, 0x0e, 0xfb, 0xca, 0x2d,
+	0x8e, 0xb5, 0x7d, 0x0d, 0xea, 0xb4, 0x5b, 0xe9, 0xc3, 0xdd, 0x57, 0x57, 0xdd, 0x1d, 0xef, 0x20,
+	0xdc, 0x0c, 0xff, 0xef, 0x93, 0xcf, 0xa0, 0x7a, 0xae, 0xec, 0x88, 0x1e, 0x41, 0xdd, 0xa8, 0xe5,
+	0x55, 0x1c, 0x17, 0x55, 0xe4, 0xa6, 0x2d, 0xea, 0xc8, 0x2b, 0x28, 0x72, 0x50, 0x1b, 0x6a, 0x8c,
+	0xce, 0xf8, 0x8b, 0x20, 0xc3, 0x72, 0xc3, 0xba, 0x67, 0xd6, 0xfd, 0x1f, 0x6a, 0xb0, 0xf7, 0x4c,
+	0x4c, 0x2b, 0xfa, 0x0c, 0xaa, 0xb9, 0x56, 0xbe, 0xc9, 0xdb, 0xee, 0xe6, 0x3c, 0xbb, 0x79, 0x41,
+	0xf9, 0x06, 0x9a, 0x8d, 0x3e, 0x80, 0x5a, 0x38, 0x0f, 0x48, 0xe2, 0x13, 0x75, 0x9e, 0xfa, 0xd0,
+	0x5e, 0x5f, 0x75, 0xab, 0x23, 0x11, 0x1b, 0x9f, 0x79, 0x55, 0x09, 0x8e, 0x23, 0xf4, 0x3e, 0xb4,
+	0x48, 0x42, 0x38, 0x09, 0x16, 0x79, 0x17, 0x9c, 0x96, 0x3c, 0x7d, 0x33, 0x8f, 0xaa, 0x06, 0xa0,
+	0x8f, 0x40, 0xb6, 0x43, 0x99, 0x57, 0x33, 0x2b, 0x92, 0x79, 0x20, 0x00, 0xe9, 0xcf, 0x9c, 0x3b,
+	0x81, 0x66, 0x89, 0x4b, 0x22, 0x67, 0x77, 0xbb, 0x72, 0x75, 0x49, 0x32, 0x67, 0x7c, 0x36, 0x3c,
+	0x12, 0x95, 0xaf, 0xaf, 0xba, 0xf6, 0x53, 0x2d, 0x34, 0x3e, 0xf3, 0x6c, 0xa3, 0x3a, 0x8e, 0xd0,
+	0x53, 0x38, 0x28, 0x29, 0x8a, 0x61, 0x77, 0xf6, 0xa4, 0x66, 0xdb, 0x55, 0x2f, 0x81, 0xab, 0x5f,
+	0x02, 0xf7, 0xb9, 0x7e, 0x09, 0x86, 0x35, 0x21, 0xfb, 0xf2, 0x97, 0xae, 0xe5, 0x35, 0x8d, 0x96,
+	0x40, 0xd1, 0x63, 0x38, 0x48, 0xf0, 0x25, 0xf7, 0x8d, 0x3b, 0x99, 0xb3, 0x7f, 0x8b, 0xa1, 0x69,
+	0x89, 0x24, 0x13, 0x11, 0xcf, 0x01, 0x94, 0x14, 0xaa, 0xb7, 0x50, 0x28, 0xf1, 0xd1, 0x08, 0x3a,
+	0x65, 0xeb, 0x15, 0x88, 0x71, 0x61, 0x5d, 0x76, 0xf7, 0xb8, 0x70, 0x61, 0xb1, 0x77, 0xee, 0xc7,
+	0x7f, 0x9d, 0x08, 0x78, 0xa3, 0x89, 0xf8, 0x0a, 0xde, 0xdb, 0x98, 0x88, 0x2d, 0x75, 0x53, 0x9c,
+	0x2d, 0x8b, 0xeb, 0x95, 0x46, 0x64, 0x53, 0x48, 0x57, 0xa8, 0x7d, 0x93, 0x61, 0xb6, 0x5c, 0x70,
+	0xe6, 0xcf, 0x03, 0x36, 0x77, 0x1a, 0x3d, 0xeb, 0xa4, 0xa1, 0x7c, 0xe3, 0xa9, 0xf8, 0x93, 0x80,
+	0xcd, 0xd1, 0x3d, 0xa8, 0x05, 0x69, 0xaa, 0x28, 0x4d, 0x49, 0xa9, 0x06, 0x69, 0x2a, 0xa1, 0x0f,
+	0x73, 0x03, 0xa4, 0x19, 0xa5, 0x33, 0xc5, 0xf8, 0xad, 0x2a, 0x29, 0xf2, 0x6e, 0x27, 0x22, 0x2c,
+	0x89, 0x9f, 0xc0, 0xbe, 0xfc, 0x8d, 0x61, 0xce, 0xef, 0xea, 0x46, 0x9c, 0x7f, 0xdc, 0x88, 0x80,
+	0xc5, 0x6d, 0xe4, 0x44, 0xf4, 0x39, 0x48, 0xaf, 0xf9, 0x79, 0xde, 0x1f, 0xff, 0x95, 0x07, 0x82,
+	0x7d, 0xae, 0x72, 0x1f, 0x41, 0x43, 0xa6, 0xe9, 0xe6, 0xff, 0x59, 0xdd, 0x7e, 0x09, 0x4a, 0xc9,
+	0xaa, 0x33, 0x9e, 0xbd, 0x2a, 0x16, 0xe8, 0x01, 0xa0, 0x92, 0xb3, 0xf5, 0xa8, 0xff, 0x25, 0x64,
+	0x76, 0xbd, 0x43, 0xe3, 0x5b, 0x3d, 0xe4, 0x5f, 0xbe, 0x5a, 0x77, 0xac, 0xd7, 0xeb, 0x8e, 0xf5,
+	0xeb, 0xba, 0x63, 0xbd, 0xbc, 0xee, 0xec, 0xbc, 0xbe, 0xee, 0xec, 0xfc, 0x7c, 0xdd, 0xd9, 0xf9,
+	0xfa, 0x41, 0x4c, 0xf8, 0x7c, 0x39, 0x75, 0x43, 0x7a, 0x31, 0x58, 0x90, 0x04, 0x0f, 0xcc, 0x0f,
+	0xa8, 0xfa, 0xad, 0xdf, 0xfc, 0x87, 0x30, 0xdd, 0x97, 0xd1, 0x4f, 0xff, 0x1e, 0x00, 0xea, 0x16,
+	0x07, 0xf4, 0x3a, 0x08, 0x00, 0x00,
 }
 
 func (m *ABCIResponses) Marshal() (dAtA []byte, err error) {
@@ -606,6 +674,67 @@ func (m *ValidatorsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VotersInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotersInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotersInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VotingPowers) > 0 {
+		dAtA5 := make([]byte, len(m.VotingPowers)*10)
+		var j4 int
+		for _, num1 := range m.VotingPowers {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTypes(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorIndices) > 0 {
+		dAtA7 := make([]byte, len(m.ValidatorIndices)*10)
+		var j6 int
+		for _, num1 := range m.ValidatorIndices {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTypes(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ConsensusParamsInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -704,6 +833,13 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastBlockVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastBlockVersion))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xe0
+	}
 	if m.VoterParams != nil {
 		{
 			size, err := m.VoterParams.MarshalToSizedBuffer(dAtA[:i])
@@ -818,12 +954,12 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x32
 	}
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastBlockTime):])
-	if err12 != nil {
-		return 0, err12
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastBlockTime):])
+	if err16 != nil {
+		return 0, err16
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n16
+	i = encodeVarintTypes(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0x2a
 	{
@@ -911,6 +1047,29 @@ func (m *ValidatorsInfo) Size() (n int) {
 	return n
 }
 
+func (m *VotersInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorIndices) > 0 {
+		l = 0
+		for _, e := range m.ValidatorIndices {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.VotingPowers) > 0 {
+		l = 0
+		for _, e := range m.VotingPowers {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *ConsensusParamsInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1002,6 +1161,9 @@ func (m *State) Size() (n int) {
 		l = m.VoterParams.Size()
 		n += 2 + l + sovTypes(uint64(l))
 	}
+	if m.LastBlockVersion != 0 {
+		n += 2 + sovTypes(uint64(m.LastBlockVersion))
+	}
 	return n
 }
 
@@ -1272,6 +1434,208 @@ func (m *ValidatorsInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VotersInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotersInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotersInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ValidatorIndices = append(m.ValidatorIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ValidatorIndices) == 0 {
+					m.ValidatorIndices = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ValidatorIndices = append(m.ValidatorIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndices", wireType)
+			}
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.VotingPowers = append(m.VotingPowers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.VotingPowers) == 0 {
+					m.VotingPowers = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VotingPowers = append(m.VotingPowers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowers", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ConsensusParamsInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2040,6 +2404,25 @@ func (m *State) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 1004:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockVersion", wireType)
+			}
+			m.LastBlockVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBlockVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
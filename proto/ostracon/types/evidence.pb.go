@@ -31,6 +31,8 @@ type Evidence struct {
 	// Types that are valid to be assigned to Sum:
 	//	*Evidence_DuplicateVoteEvidence
 	//	*Evidence_LightClientAttackEvidence
+	//	*Evidence_ConflictingProposalEvidence
+	//	*Evidence_InvalidProposerProofEvidence
 	Sum isEvidence_Sum `protobuf_oneof:"sum"`
 }
 
@@ -79,9 +81,17 @@ type Evidence_DuplicateVoteEvidence struct {
 type Evidence_LightClientAttackEvidence struct {
 	LightClientAttackEvidence *LightClientAttackEvidence `protobuf:"bytes,2,opt,name=light_client_attack_evidence,json=lightClientAttackEvidence,proto3,oneof" json:"light_client_attack_evidence,omitempty"`
 }
+type Evidence_ConflictingProposalEvidence struct {
+	ConflictingProposalEvidence *ConflictingProposalEvidence `protobuf:"bytes,1000,opt,name=conflicting_proposal_evidence,json=conflictingProposalEvidence,proto3,oneof" json:"conflicting_proposal_evidence,omitempty"`
+}
+type Evidence_InvalidProposerProofEvidence struct {
+	InvalidProposerProofEvidence *InvalidProposerProofEvidence `protobuf:"bytes,1001,opt,name=invalid_proposer_proof_evidence,json=invalidProposerProofEvidence,proto3,oneof" json:"invalid_proposer_proof_evidence,omitempty"`
+}
 
-func (*Evidence_DuplicateVoteEvidence) isEvidence_Sum()     {}
-func (*Evidence_LightClientAttackEvidence) isEvidence_Sum() {}
+func (*Evidence_DuplicateVoteEvidence) isEvidence_Sum()        {}
+func (*Evidence_LightClientAttackEvidence) isEvidence_Sum()    {}
+func (*Evidence_ConflictingProposalEvidence) isEvidence_Sum()  {}
+func (*Evidence_InvalidProposerProofEvidence) isEvidence_Sum() {}
 
 func (m *Evidence) GetSum() isEvidence_Sum {
 	if m != nil {
@@ -104,11 +114,27 @@ func (m *Evidence) GetLightClientAttackEvidence() *LightClientAttackEvidence {
 	return nil
 }
 
+func (m *Evidence) GetConflictingProposalEvidence() *ConflictingProposalEvidence {
+	if x, ok := m.GetSum().(*Evidence_ConflictingProposalEvidence); ok {
+		return x.ConflictingProposalEvidence
+	}
+	return nil
+}
+
+func (m *Evidence) GetInvalidProposerProofEvidence() *InvalidProposerProofEvidence {
+	if x, ok := m.GetSum().(*Evidence_InvalidProposerProofEvidence); ok {
+		return x.InvalidProposerProofEvidence
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Evidence) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Evidence_DuplicateVoteEvidence)(nil),
 		(*Evidence_LightClientAttackEvidence)(nil),
+		(*Evidence_ConflictingProposalEvidence)(nil),
+		(*Evidence_InvalidProposerProofEvidence)(nil),
 	}
 }
 
@@ -266,6 +292,168 @@ func (m *LightClientAttackEvidence) GetTimestamp() time.Time {
 	return time.Time{}
 }
 
+// ConflictingProposalEvidence contains evidence of a proposer signed two conflicting proposals.
+type ConflictingProposalEvidence struct {
+	ProposalA        *Proposal `protobuf:"bytes,1,opt,name=proposal_a,json=proposalA,proto3" json:"proposal_a,omitempty"`
+	ProposalB        *Proposal `protobuf:"bytes,2,opt,name=proposal_b,json=proposalB,proto3" json:"proposal_b,omitempty"`
+	ProposerAddress  []byte    `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
+	TotalVotingPower int64     `protobuf:"varint,4,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	ValidatorPower   int64     `protobuf:"varint,5,opt,name=validator_power,json=validatorPower,proto3" json:"validator_power,omitempty"`
+	Timestamp        time.Time `protobuf:"bytes,6,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *ConflictingProposalEvidence) Reset()         { *m = ConflictingProposalEvidence{} }
+func (m *ConflictingProposalEvidence) String() string { return proto.CompactTextString(m) }
+func (*ConflictingProposalEvidence) ProtoMessage()    {}
+func (*ConflictingProposalEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97062afbc223b6b9, []int{3}
+}
+func (m *ConflictingProposalEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConflictingProposalEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConflictingProposalEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConflictingProposalEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictingProposalEvidence.Merge(m, src)
+}
+func (m *ConflictingProposalEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConflictingProposalEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictingProposalEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConflictingProposalEvidence proto.InternalMessageInfo
+
+func (m *ConflictingProposalEvidence) GetProposalA() *Proposal {
+	if m != nil {
+		return m.ProposalA
+	}
+	return nil
+}
+
+func (m *ConflictingProposalEvidence) GetProposalB() *Proposal {
+	if m != nil {
+		return m.ProposalB
+	}
+	return nil
+}
+
+func (m *ConflictingProposalEvidence) GetProposerAddress() []byte {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *ConflictingProposalEvidence) GetTotalVotingPower() int64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+func (m *ConflictingProposalEvidence) GetValidatorPower() int64 {
+	if m != nil {
+		return m.ValidatorPower
+	}
+	return 0
+}
+
+func (m *ConflictingProposalEvidence) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+// InvalidProposerProofEvidence contains evidence of a proposer signed a proposal of a block with an invalid VRF proof.
+type InvalidProposerProofEvidence struct {
+	Proposal         *Proposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	Header           *Header   `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	TotalVotingPower int64     `protobuf:"varint,3,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	ValidatorPower   int64     `protobuf:"varint,4,opt,name=validator_power,json=validatorPower,proto3" json:"validator_power,omitempty"`
+	Timestamp        time.Time `protobuf:"bytes,5,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *InvalidProposerProofEvidence) Reset()         { *m = InvalidProposerProofEvidence{} }
+func (m *InvalidProposerProofEvidence) String() string { return proto.CompactTextString(m) }
+func (*InvalidProposerProofEvidence) ProtoMessage()    {}
+func (*InvalidProposerProofEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97062afbc223b6b9, []int{4}
+}
+func (m *InvalidProposerProofEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvalidProposerProofEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvalidProposerProofEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvalidProposerProofEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidProposerProofEvidence.Merge(m, src)
+}
+func (m *InvalidProposerProofEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvalidProposerProofEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidProposerProofEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvalidProposerProofEvidence proto.InternalMessageInfo
+
+func (m *InvalidProposerProofEvidence) GetProposal() *Proposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return nil
+}
+
+func (m *InvalidProposerProofEvidence) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *InvalidProposerProofEvidence) GetTotalVotingPower() int64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+func (m *InvalidProposerProofEvidence) GetValidatorPower() int64 {
+	if m != nil {
+		return m.ValidatorPower
+	}
+	return 0
+}
+
+func (m *InvalidProposerProofEvidence) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
 type EvidenceList struct {
 	Evidence []Evidence `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence"`
 }
@@ -274,7 +462,7 @@ func (m *EvidenceList) Reset()         { *m = EvidenceList{} }
 func (m *EvidenceList) String() string { return proto.CompactTextString(m) }
 func (*EvidenceList) ProtoMessage()    {}
 func (*EvidenceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97062afbc223b6b9, []int{3}
+	return fileDescriptor_97062afbc223b6b9, []int{5}
 }
 func (m *EvidenceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -314,47 +502,59 @@ func init() {
 	proto.RegisterType((*Evidence)(nil), "ostracon.types.Evidence")
 	proto.RegisterType((*DuplicateVoteEvidence)(nil), "ostracon.types.DuplicateVoteEvidence")
 	proto.RegisterType((*LightClientAttackEvidence)(nil), "ostracon.types.LightClientAttackEvidence")
+	proto.RegisterType((*ConflictingProposalEvidence)(nil), "ostracon.types.ConflictingProposalEvidence")
+	proto.RegisterType((*InvalidProposerProofEvidence)(nil), "ostracon.types.InvalidProposerProofEvidence")
 	proto.RegisterType((*EvidenceList)(nil), "ostracon.types.EvidenceList")
 }
 
 func init() { proto.RegisterFile("ostracon/types/evidence.proto", fileDescriptor_97062afbc223b6b9) }
 
 var fileDescriptor_97062afbc223b6b9 = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x9b, 0xa6, 0x9d, 0x8a, 0x37, 0xc6, 0x30, 0x9d, 0x68, 0x23, 0x48, 0xab, 0x22, 0x44,
-	0x11, 0x90, 0x48, 0xe3, 0xc6, 0x6d, 0x01, 0xb4, 0x09, 0xf5, 0x80, 0x22, 0xb4, 0x03, 0x97, 0xc8,
-	0x71, 0xbd, 0xd4, 0x9a, 0x13, 0x47, 0x8d, 0x5b, 0x34, 0x3e, 0x03, 0x87, 0x7d, 0xac, 0x71, 0xdb,
-	0x91, 0x13, 0x4c, 0xed, 0x17, 0x41, 0x76, 0x12, 0xaf, 0x0b, 0x2d, 0xa7, 0x5d, 0xa2, 0xe4, 0xfd,
-	0x7f, 0xcf, 0xef, 0xbd, 0xbf, 0xed, 0x80, 0xa7, 0x3c, 0x13, 0x53, 0x84, 0x79, 0xe2, 0x8a, 0xf3,
-	0x94, 0x64, 0x2e, 0x99, 0xd3, 0x31, 0x49, 0x30, 0x71, 0xd2, 0x29, 0x17, 0x1c, 0xee, 0x96, 0xb2,
-	0xa3, 0x64, 0xab, 0x1d, 0xf1, 0x88, 0x2b, 0xc9, 0x95, 0x6f, 0x39, 0x65, 0xf5, 0x22, 0xce, 0x23,
-	0x46, 0x5c, 0xf5, 0x15, 0xce, 0x4e, 0x5d, 0x41, 0x63, 0x92, 0x09, 0x14, 0xa7, 0x05, 0x60, 0x55,
-	0xaa, 0xa8, 0x67, 0xa1, 0xd9, 0x15, 0x6d, 0x8e, 0x18, 0x1d, 0x23, 0xc1, 0xa7, 0xb9, 0x3e, 0xb8,
-	0x36, 0x40, 0xeb, 0x63, 0xd1, 0x15, 0x0c, 0xc0, 0xe3, 0xf1, 0x2c, 0x65, 0x14, 0x23, 0x41, 0x82,
-	0x39, 0x17, 0x24, 0x28, 0x1b, 0xee, 0x18, 0x7d, 0x63, 0xb8, 0x7d, 0xf0, 0xdc, 0xb9, 0xdd, 0xb1,
-	0xf3, 0xa1, 0xc4, 0x4f, 0xb8, 0x20, 0xe5, 0x3a, 0xc7, 0x35, 0x7f, 0x7f, 0xbc, 0x4e, 0x80, 0x0c,
-	0x3c, 0x61, 0x34, 0x9a, 0x88, 0x00, 0x33, 0x4a, 0x12, 0x11, 0x20, 0x21, 0x10, 0x3e, 0xbb, 0xa9,
-	0x52, 0x57, 0x55, 0x5e, 0x56, 0xab, 0x8c, 0x64, 0xce, 0x7b, 0x95, 0x72, 0xa8, 0x32, 0x56, 0x2a,
-	0x75, 0xd9, 0x26, 0xd1, 0x6b, 0x02, 0x33, 0x9b, 0xc5, 0x83, 0x1f, 0x75, 0xb0, 0xbf, 0xb6, 0x4f,
-	0xf8, 0x0a, 0x6c, 0xa9, 0x29, 0x51, 0x31, 0x5e, 0xbb, 0x5a, 0x58, 0xd2, 0x7e, 0x53, 0x32, 0x87,
-	0x1a, 0x0e, 0x8b, 0x2e, 0xff, 0x03, 0x7b, 0xf0, 0x35, 0x80, 0x82, 0x0b, 0xc4, 0xa4, 0x8b, 0x34,
-	0x89, 0x82, 0x94, 0x7f, 0x23, 0xd3, 0x8e, 0xd9, 0x37, 0x86, 0xa6, 0xbf, 0xa7, 0x94, 0x13, 0x25,
-	0x7c, 0x96, 0x71, 0xf8, 0x02, 0x3c, 0xd0, 0xfb, 0x52, 0xa0, 0x0d, 0x85, 0xee, 0xea, 0x70, 0x0e,
-	0x7a, 0xe0, 0x9e, 0xde, 0xfc, 0x4e, 0x53, 0xb5, 0x61, 0x39, 0xf9, 0xf1, 0x70, 0xca, 0xe3, 0xe1,
-	0x7c, 0x29, 0x09, 0xaf, 0x75, 0xf9, 0xbb, 0x57, 0xbb, 0xf8, 0xd3, 0x33, 0xfc, 0x9b, 0xb4, 0xc1,
-	0xcf, 0x3a, 0xe8, 0x6e, 0x34, 0x14, 0x1e, 0x81, 0x87, 0x98, 0x27, 0xa7, 0x8c, 0x62, 0xd5, 0x77,
-	0xc8, 0x38, 0x3e, 0x2b, 0xdc, 0xb1, 0xd6, 0x6e, 0x8b, 0x27, 0x09, 0x7f, 0x6f, 0x25, 0x49, 0x45,
-	0xe0, 0x33, 0x70, 0x1f, 0xf3, 0x38, 0xe6, 0x49, 0x30, 0x21, 0x92, 0x53, 0xae, 0x99, 0xfe, 0x4e,
-	0x1e, 0x3c, 0x56, 0x31, 0x38, 0x02, 0xed, 0xf0, 0xfc, 0x3b, 0x4a, 0x04, 0x4d, 0x48, 0xa0, 0x67,
-	0xcd, 0x3a, 0x66, 0xdf, 0x1c, 0x6e, 0x1f, 0x74, 0xff, 0x71, 0xb8, 0x24, 0xfc, 0x47, 0x3a, 0x4d,
-	0xc7, 0xb2, 0x0d, 0xa6, 0x37, 0x36, 0x98, 0x7e, 0x17, 0x5e, 0x7e, 0x02, 0x3b, 0xa5, 0x73, 0x23,
-	0x9a, 0x09, 0xf8, 0x0e, 0xb4, 0x56, 0x6e, 0x8c, 0x9c, 0xa1, 0x53, 0x9d, 0x41, 0x9f, 0xce, 0x86,
-	0x5c, 0xd0, 0xd7, 0xbc, 0x77, 0x74, 0xb9, 0xb0, 0x8d, 0xab, 0x85, 0x6d, 0x5c, 0x2f, 0x6c, 0xe3,
-	0x62, 0x69, 0xd7, 0xae, 0x96, 0x76, 0xed, 0xd7, 0xd2, 0xae, 0x7d, 0x7d, 0x13, 0x51, 0x31, 0x99,
-	0x85, 0x0e, 0xe6, 0xb1, 0xcb, 0x68, 0x42, 0x5c, 0x7d, 0xa7, 0xf3, 0x7f, 0xc5, 0xed, 0x2b, 0x1e,
-	0x6e, 0xa9, 0xe8, 0xdb, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x98, 0xd4, 0x86, 0x84, 0x7d, 0x04,
-	0x00, 0x00,
+	// 702 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x41, 0x4f, 0xd4, 0x40,
+	0x18, 0xdd, 0x6e, 0xd9, 0x75, 0xf9, 0x40, 0xc0, 0x11, 0x74, 0x59, 0xa0, 0x4b, 0x30, 0x46, 0x08,
+	0xd8, 0x26, 0x68, 0x62, 0xe2, 0x6d, 0x8b, 0x06, 0x34, 0x1c, 0x36, 0x8d, 0xe1, 0xe0, 0xa5, 0xe9,
+	0xb6, 0x43, 0x77, 0x42, 0xb7, 0xb3, 0x69, 0x67, 0xd7, 0xe0, 0x6f, 0xf0, 0xc0, 0x3f, 0xf0, 0xef,
+	0x60, 0xe2, 0x81, 0x8b, 0x89, 0x27, 0x35, 0x70, 0xd1, 0x7f, 0x61, 0x3a, 0xed, 0xcc, 0x2e, 0x75,
+	0x17, 0xd4, 0x78, 0xf0, 0xb2, 0xe9, 0x7e, 0xef, 0xbd, 0xbe, 0x99, 0x37, 0xdf, 0xd7, 0x81, 0x15,
+	0x1a, 0xb3, 0xc8, 0x71, 0x69, 0x68, 0xb0, 0xe3, 0x2e, 0x8e, 0x0d, 0xdc, 0x27, 0x1e, 0x0e, 0x5d,
+	0xac, 0x77, 0x23, 0xca, 0x28, 0x9a, 0x11, 0xb0, 0xce, 0xe1, 0xda, 0xbc, 0x4f, 0x7d, 0xca, 0x21,
+	0x23, 0x79, 0x4a, 0x59, 0xb5, 0xba, 0x4f, 0xa9, 0x1f, 0x60, 0x83, 0xff, 0x6b, 0xf5, 0x0e, 0x0d,
+	0x46, 0x3a, 0x38, 0x66, 0x4e, 0xa7, 0x9b, 0x11, 0x6a, 0x39, 0x17, 0xfe, 0x9b, 0x61, 0x5a, 0x0e,
+	0xeb, 0x3b, 0x01, 0xf1, 0x1c, 0x46, 0xa3, 0x14, 0x5f, 0xfb, 0xa8, 0x42, 0xe5, 0x79, 0xb6, 0x2a,
+	0x64, 0xc3, 0x5d, 0xaf, 0xd7, 0x0d, 0x88, 0xeb, 0x30, 0x6c, 0xf7, 0x29, 0xc3, 0xb6, 0x58, 0x70,
+	0x55, 0x59, 0x55, 0xd6, 0xa7, 0xb6, 0xef, 0xeb, 0x97, 0x57, 0xac, 0x3f, 0x13, 0xf4, 0x03, 0xca,
+	0xb0, 0x78, 0xcf, 0x5e, 0xc1, 0x5a, 0xf0, 0x46, 0x01, 0x28, 0x80, 0xe5, 0x80, 0xf8, 0x6d, 0x66,
+	0xbb, 0x01, 0xc1, 0x21, 0xb3, 0x1d, 0xc6, 0x1c, 0xf7, 0x68, 0xe0, 0x52, 0xe4, 0x2e, 0x1b, 0x79,
+	0x97, 0xfd, 0x44, 0xb3, 0xc3, 0x25, 0x0d, 0xae, 0x18, 0x72, 0x5a, 0x0c, 0xc6, 0x81, 0x28, 0x82,
+	0x15, 0x97, 0x86, 0x87, 0x01, 0x71, 0x19, 0x09, 0x7d, 0xbb, 0x1b, 0xd1, 0x2e, 0x8d, 0x9d, 0x60,
+	0x60, 0xf7, 0xfd, 0x06, 0xf7, 0xdb, 0xcc, 0xfb, 0xed, 0x0c, 0x54, 0xcd, 0x4c, 0x34, 0xe4, 0xb8,
+	0xe4, 0x8e, 0x87, 0x51, 0x1f, 0xea, 0x24, 0xe4, 0x21, 0x67, 0x7e, 0x38, 0x4a, 0x1e, 0xe8, 0xe1,
+	0xc0, 0xf5, 0x47, 0xea, 0xba, 0x95, 0x77, 0x7d, 0x91, 0xea, 0x9a, 0x99, 0xac, 0x99, 0xa8, 0x86,
+	0x6c, 0x97, 0xc9, 0x15, 0xb8, 0x59, 0x02, 0x35, 0xee, 0x75, 0xd6, 0xde, 0x15, 0x61, 0x61, 0xe4,
+	0x99, 0xa0, 0x4d, 0x28, 0xf3, 0x13, 0x75, 0xb2, 0xa3, 0x9c, 0xcf, 0xdb, 0x27, 0x6c, 0xab, 0x94,
+	0x70, 0x1a, 0x92, 0xdc, 0xaa, 0x16, 0xaf, 0x23, 0x9b, 0x68, 0x0b, 0x10, 0xa3, 0xcc, 0x09, 0x92,
+	0x8e, 0xe1, 0x39, 0xd3, 0x37, 0x38, 0xaa, 0xaa, 0xab, 0xca, 0xba, 0x6a, 0xcd, 0x71, 0xe4, 0x80,
+	0x03, 0xcd, 0xa4, 0x8e, 0x1e, 0xc0, 0xac, 0xec, 0xc1, 0x8c, 0x3a, 0xc1, 0xa9, 0x33, 0xb2, 0x9c,
+	0x12, 0x4d, 0x98, 0x94, 0x8d, 0x5e, 0x2d, 0xf1, 0x65, 0xd4, 0xf4, 0x74, 0x14, 0x74, 0x31, 0x0a,
+	0xfa, 0x2b, 0xc1, 0x30, 0x2b, 0xa7, 0x5f, 0xea, 0x85, 0x93, 0xaf, 0x75, 0xc5, 0x1a, 0xc8, 0xd6,
+	0x3e, 0x14, 0x61, 0x71, 0x6c, 0xf3, 0xa0, 0x5d, 0xb8, 0x35, 0xdc, 0x1f, 0xad, 0x80, 0xba, 0x47,
+	0x59, 0x3a, 0xb5, 0x91, 0x2d, 0x68, 0x26, 0x0c, 0x6b, 0x6e, 0x48, 0xc4, 0x2b, 0xe8, 0x1e, 0xdc,
+	0x74, 0x69, 0xa7, 0x43, 0x43, 0xbb, 0x8d, 0x13, 0x1e, 0x4f, 0x4d, 0xb5, 0xa6, 0xd3, 0xe2, 0x1e,
+	0xaf, 0xa1, 0x7d, 0x98, 0x6f, 0x1d, 0xbf, 0x75, 0x42, 0x46, 0x42, 0x6c, 0xcb, 0xbd, 0xc6, 0x55,
+	0x75, 0x55, 0x5d, 0x9f, 0xda, 0x5e, 0xfc, 0x25, 0x61, 0xc1, 0xb0, 0x6e, 0x4b, 0x99, 0xac, 0xc5,
+	0x63, 0x42, 0x9f, 0x18, 0x13, 0xfa, 0xbf, 0xc8, 0xf2, 0x53, 0x11, 0x96, 0xae, 0x18, 0x0c, 0xf4,
+	0x04, 0x40, 0x4e, 0x98, 0x68, 0xb2, 0x6a, 0x7e, 0x57, 0x42, 0x65, 0x4d, 0x0a, 0x6e, 0xe3, 0x92,
+	0x50, 0x34, 0xdc, 0x6f, 0x08, 0x4d, 0xb4, 0x01, 0x73, 0x72, 0xc6, 0x1c, 0xcf, 0x8b, 0x70, 0x1c,
+	0xf3, 0xb6, 0x9b, 0xb6, 0x66, 0x45, 0xbd, 0x91, 0x96, 0xff, 0x30, 0xae, 0x11, 0x3d, 0x5a, 0xba,
+	0xbe, 0x47, 0xcb, 0x7f, 0x97, 0xeb, 0xfb, 0x22, 0x2c, 0x5f, 0x35, 0xfa, 0xe8, 0x31, 0x54, 0xc4,
+	0x9e, 0xaf, 0x8d, 0x55, 0x32, 0x91, 0x0e, 0xe5, 0x36, 0x76, 0x3c, 0x1c, 0x65, 0x89, 0xde, 0xc9,
+	0x6b, 0xf6, 0x38, 0x6a, 0x65, 0xac, 0xff, 0x79, 0x8a, 0x5f, 0xc2, 0xb4, 0x08, 0x63, 0x9f, 0xc4,
+	0x0c, 0x3d, 0x85, 0xca, 0xd0, 0xbd, 0xa4, 0x8e, 0x0a, 0x44, 0x7e, 0x17, 0x27, 0x92, 0x17, 0x5a,
+	0x92, 0x6f, 0xee, 0x9e, 0x9e, 0x6b, 0xca, 0xd9, 0xb9, 0xa6, 0x7c, 0x3b, 0xd7, 0x94, 0x93, 0x0b,
+	0xad, 0x70, 0x76, 0xa1, 0x15, 0x3e, 0x5f, 0x68, 0x85, 0xd7, 0x0f, 0x7d, 0xc2, 0xda, 0xbd, 0x96,
+	0xee, 0xd2, 0x8e, 0x11, 0x90, 0x10, 0x1b, 0xf2, 0xe6, 0x4c, 0x6f, 0xe4, 0xcb, 0x17, 0x69, 0xab,
+	0xcc, 0xab, 0x8f, 0x7e, 0x0e, 0x00, 0x83, 0x23, 0xd0, 0x5e, 0xe3, 0x07, 0x00, 0x00,
 }
 
 func (m *Evidence) Marshal() (dAtA []byte, err error) {
@@ -431,6 +631,52 @@ func (m *Evidence_LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Evidence_ConflictingProposalEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Evidence_ConflictingProposalEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ConflictingProposalEvidence != nil {
+		{
+			size, err := m.ConflictingProposalEvidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Evidence_InvalidProposerProofEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Evidence_InvalidProposerProofEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.InvalidProposerProofEvidence != nil {
+		{
+			size, err := m.InvalidProposerProofEvidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xca
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DuplicateVoteEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -451,12 +697,12 @@ func (m *DuplicateVoteEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintEvidence(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintEvidence(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x2a
 	if m.ValidatorPower != 0 {
@@ -516,12 +762,12 @@ func (m *LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err6 != nil {
-		return 0, err6
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n6
-	i = encodeVarintEvidence(dAtA, i, uint64(n6))
+	i -= n8
+	i = encodeVarintEvidence(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x2a
 	if m.TotalVotingPower != 0 {
@@ -563,7 +809,7 @@ func (m *LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *EvidenceList) Marshal() (dAtA []byte, err error) {
+func (m *ConflictingProposalEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -573,81 +819,242 @@ func (m *EvidenceList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EvidenceList) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConflictingProposalEvidence) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EvidenceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConflictingProposalEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Evidence) > 0 {
-		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvidence(dAtA, i, uint64(size))
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintEvidence(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x32
+	if m.ValidatorPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.ValidatorPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalB != nil {
+		{
+			size, err := m.ProposalB.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalA != nil {
+		{
+			size, err := m.ProposalA.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvidence(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *InvalidProposerProofEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Evidence) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sum != nil {
-		n += m.Sum.Size()
-	}
-	return n
+
+func (m *InvalidProposerProofEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Evidence_DuplicateVoteEvidence) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *InvalidProposerProofEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DuplicateVoteEvidence != nil {
-		l = m.DuplicateVoteEvidence.Size()
-		n += 1 + l + sovEvidence(uint64(l))
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err13 != nil {
+		return 0, err13
 	}
-	return n
-}
-func (m *Evidence_LightClientAttackEvidence) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= n13
+	i = encodeVarintEvidence(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x2a
+	if m.ValidatorPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.ValidatorPower))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	if m.LightClientAttackEvidence != nil {
-		l = m.LightClientAttackEvidence.Size()
-		n += 1 + l + sovEvidence(uint64(l))
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-func (m *DuplicateVoteEvidence) Size() (n int) {
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Proposal != nil {
+		{
+			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvidenceList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvidenceList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvidenceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvidence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvidence(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Evidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *Evidence_DuplicateVoteEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DuplicateVoteEvidence != nil {
+		l = m.DuplicateVoteEvidence.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+func (m *Evidence_LightClientAttackEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LightClientAttackEvidence != nil {
+		l = m.LightClientAttackEvidence.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+func (m *Evidence_ConflictingProposalEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConflictingProposalEvidence != nil {
+		l = m.ConflictingProposalEvidence.Size()
+		n += 2 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+func (m *Evidence_InvalidProposerProofEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InvalidProposerProofEvidence != nil {
+		l = m.InvalidProposerProofEvidence.Size()
+		n += 2 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+func (m *DuplicateVoteEvidence) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -699,6 +1106,60 @@ func (m *LightClientAttackEvidence) Size() (n int) {
 	return n
 }
 
+func (m *ConflictingProposalEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalA != nil {
+		l = m.ProposalA.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.ProposalB != nil {
+		l = m.ProposalB.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovEvidence(uint64(m.TotalVotingPower))
+	}
+	if m.ValidatorPower != 0 {
+		n += 1 + sovEvidence(uint64(m.ValidatorPower))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovEvidence(uint64(l))
+	return n
+}
+
+func (m *InvalidProposerProofEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovEvidence(uint64(m.TotalVotingPower))
+	}
+	if m.ValidatorPower != 0 {
+		n += 1 + sovEvidence(uint64(m.ValidatorPower))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovEvidence(uint64(l))
+	return n
+}
+
 func (m *EvidenceList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -819,6 +1280,76 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Evidence_LightClientAttackEvidence{v}
 			iNdEx = postIndex
+		case 1000:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingProposalEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ConflictingProposalEvidence{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Evidence_ConflictingProposalEvidence{v}
+			iNdEx = postIndex
+		case 1001:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidProposerProofEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &InvalidProposerProofEvidence{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Evidence_InvalidProposerProofEvidence{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvidence(dAtA[iNdEx:])
@@ -1224,6 +1755,426 @@ func (m *LightClientAttackEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConflictingProposalEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConflictingProposalEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConflictingProposalEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposalA == nil {
+				m.ProposalA = &Proposal{}
+			}
+			if err := m.ProposalA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposalB == nil {
+				m.ProposalB = &Proposal{}
+			}
+			if err := m.ProposalB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPower", wireType)
+			}
+			m.ValidatorPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvalidProposerProofEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvalidProposerProofEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvalidProposerProofEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proposal == nil {
+				m.Proposal = &Proposal{}
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPower", wireType)
+			}
+			m.ValidatorPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EvidenceList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
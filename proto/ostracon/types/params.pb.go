@@ -34,6 +34,11 @@ type ConsensusParams struct {
 	Evidence  EvidenceParams  `protobuf:"bytes,2,opt,name=evidence,proto3" json:"evidence"`
 	Validator ValidatorParams `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator"`
 	Version   VersionParams   `protobuf:"bytes,4,opt,name=version,proto3" json:"version"`
+	// *** Ostracon Extended Fields ***
+	Voter     VoterParams     `protobuf:"bytes,1000,opt,name=voter,proto3" json:"voter"`
+	Timestamp TimestampParams `protobuf:"bytes,1001,opt,name=timestamp,proto3" json:"timestamp"`
+	PartSet   PartSetParams   `protobuf:"bytes,1002,opt,name=part_set,json=partSet,proto3" json:"part_set"`
+	Abci      ABCIParams      `protobuf:"bytes,1003,opt,name=abci,proto3" json:"abci"`
 }
 
 func (m *ConsensusParams) Reset()         { *m = ConsensusParams{} }
@@ -97,6 +102,34 @@ func (m *ConsensusParams) GetVersion() VersionParams {
 	return VersionParams{}
 }
 
+func (m *ConsensusParams) GetVoter() VoterParams {
+	if m != nil {
+		return m.Voter
+	}
+	return VoterParams{}
+}
+
+func (m *ConsensusParams) GetTimestamp() TimestampParams {
+	if m != nil {
+		return m.Timestamp
+	}
+	return TimestampParams{}
+}
+
+func (m *ConsensusParams) GetPartSet() PartSetParams {
+	if m != nil {
+		return m.PartSet
+	}
+	return PartSetParams{}
+}
+
+func (m *ConsensusParams) GetAbci() ABCIParams {
+	if m != nil {
+		return m.Abci
+	}
+	return ABCIParams{}
+}
+
 // BlockParams contains limits on the block size.
 type BlockParams struct {
 	// Max block size, in bytes.
@@ -243,6 +276,11 @@ func (m *EvidenceParams) GetMaxBytes() int64 {
 // NOTE: uses ABCI pubkey naming, not Amino names.
 type ValidatorParams struct {
 	PubKeyTypes []string `protobuf:"bytes,1,rep,name=pub_key_types,json=pubKeyTypes,proto3" json:"pub_key_types,omitempty"`
+	// *** Ostracon Extended Fields ***
+	// VRF suite of the ed25519 proposer proofs, one of the names of crypto/vrf.
+	// Empty means the build-time default suite. It is pinned at genesis and
+	// isn't changed by the updates from the app.
+	VrfSuite string `protobuf:"bytes,1000,opt,name=vrf_suite,json=vrfSuite,proto3" json:"vrf_suite,omitempty"`
 }
 
 func (m *ValidatorParams) Reset()         { *m = ValidatorParams{} }
@@ -285,9 +323,20 @@ func (m *ValidatorParams) GetPubKeyTypes() []string {
 	return nil
 }
 
+func (m *ValidatorParams) GetVrfSuite() string {
+	if m != nil {
+		return m.VrfSuite
+	}
+	return ""
+}
+
 // VersionParams contains the ABCI application version.
 type VersionParams struct {
 	AppVersion uint64 `protobuf:"varint,1,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// *** Ostracon Extended Fields ***
+	// The scheduled upgrade, if any. A param update leaves it unchanged if it is
+	// not set, and cancels it if it is set with a height of 0.
+	Upgrade *UpgradePlan `protobuf:"bytes,1000,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
 }
 
 func (m *VersionParams) Reset()         { *m = VersionParams{} }
@@ -330,19 +379,311 @@ func (m *VersionParams) GetAppVersion() uint64 {
 	return 0
 }
 
+func (m *VersionParams) GetUpgrade() *UpgradePlan {
+	if m != nil {
+		return m.Upgrade
+	}
+	return nil
+}
+
+// UpgradePlan schedules switching to a block protocol at a height.
+type UpgradePlan struct {
+	// The block protocol the blocks are made with from the height.
+	// Note: must be greater than 0 if the height is set
+	BlockVersion uint64 `protobuf:"varint,1,opt,name=block_version,json=blockVersion,proto3" json:"block_version,omitempty"`
+	// The height from which the block protocol is used. No upgrade is scheduled
+	// if it is 0.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *UpgradePlan) Reset()         { *m = UpgradePlan{} }
+func (m *UpgradePlan) String() string { return proto.CompactTextString(m) }
+func (*UpgradePlan) ProtoMessage()    {}
+func (*UpgradePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93f70d04c868d295, []int{5}
+}
+func (m *UpgradePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradePlan.Merge(m, src)
+}
+func (m *UpgradePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradePlan proto.InternalMessageInfo
+
+func (m *UpgradePlan) GetBlockVersion() uint64 {
+	if m != nil {
+		return m.BlockVersion
+	}
+	return 0
+}
+
+func (m *UpgradePlan) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// VoterParams determine how the voters are elected from the validators.
+type VoterParams struct {
+	// The validators are all voters if there are no more validators than this.
+	VoterElectionThreshold int32 `protobuf:"varint,1,opt,name=voter_election_threshold,json=voterElectionThreshold,proto3" json:"voter_election_threshold,omitempty"`
+	// Max percentage of the voting power of the voters that can be byzantine.
+	// Note: must be in between 1 and 33
+	MaxTolerableByzantinePercentage int32 `protobuf:"varint,2,opt,name=max_tolerable_byzantine_percentage,json=maxTolerableByzantinePercentage,proto3" json:"max_tolerable_byzantine_percentage,omitempty"`
+}
+
+func (m *VoterParams) Reset()         { *m = VoterParams{} }
+func (m *VoterParams) String() string { return proto.CompactTextString(m) }
+func (*VoterParams) ProtoMessage()    {}
+func (*VoterParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93f70d04c868d295, []int{6}
+}
+func (m *VoterParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoterParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoterParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoterParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoterParams.Merge(m, src)
+}
+func (m *VoterParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoterParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoterParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoterParams proto.InternalMessageInfo
+
+func (m *VoterParams) GetVoterElectionThreshold() int32 {
+	if m != nil {
+		return m.VoterElectionThreshold
+	}
+	return 0
+}
+
+func (m *VoterParams) GetMaxTolerableByzantinePercentage() int32 {
+	if m != nil {
+		return m.MaxTolerableByzantinePercentage
+	}
+	return 0
+}
+
+// TimestampParams determine how the block time is decided.
+type TimestampParams struct {
+	// Use the time proposed by the proposer as the block time instead of the
+	// weighted median of the precommit times of the last commit.
+	ProposerBased bool `protobuf:"varint,1,opt,name=proposer_based,json=proposerBased,proto3" json:"proposer_based,omitempty"`
+	// Bound on the clock drift between any two correct validators.
+	// Note: must be greater than 0 if proposer_based is set
+	Precision time.Duration `protobuf:"bytes,2,opt,name=precision,proto3,stdduration" json:"precision"`
+	// Bound on the delay for a proposal to reach all correct validators.
+	// Note: must be greater than 0 if proposer_based is set
+	MessageDelay time.Duration `protobuf:"bytes,3,opt,name=message_delay,json=messageDelay,proto3,stdduration" json:"message_delay"`
+}
+
+func (m *TimestampParams) Reset()         { *m = TimestampParams{} }
+func (m *TimestampParams) String() string { return proto.CompactTextString(m) }
+func (*TimestampParams) ProtoMessage()    {}
+func (*TimestampParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93f70d04c868d295, []int{7}
+}
+func (m *TimestampParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimestampParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimestampParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimestampParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimestampParams.Merge(m, src)
+}
+func (m *TimestampParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimestampParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimestampParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimestampParams proto.InternalMessageInfo
+
+func (m *TimestampParams) GetProposerBased() bool {
+	if m != nil {
+		return m.ProposerBased
+	}
+	return false
+}
+
+func (m *TimestampParams) GetPrecision() time.Duration {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+func (m *TimestampParams) GetMessageDelay() time.Duration {
+	if m != nil {
+		return m.MessageDelay
+	}
+	return 0
+}
+
+// PartSetParams determine how the blocks are split into the parts which are gossiped.
+type PartSetParams struct {
+	// Erasure-code the parts with this many parity parts per 100 data parts, so
+	// that any data-parts count of the parts rebuild a block. 0 disables the
+	// erasure coding.
+	// Note: must be less than or equal to 100
+	ParityPercentage uint32 `protobuf:"varint,1,opt,name=parity_percentage,json=parityPercentage,proto3" json:"parity_percentage,omitempty"`
+}
+
+func (m *PartSetParams) Reset()         { *m = PartSetParams{} }
+func (m *PartSetParams) String() string { return proto.CompactTextString(m) }
+func (*PartSetParams) ProtoMessage()    {}
+func (*PartSetParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93f70d04c868d295, []int{8}
+}
+func (m *PartSetParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartSetParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartSetParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PartSetParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartSetParams.Merge(m, src)
+}
+func (m *PartSetParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartSetParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartSetParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartSetParams proto.InternalMessageInfo
+
+func (m *PartSetParams) GetParityPercentage() uint32 {
+	if m != nil {
+		return m.ParityPercentage
+	}
+	return 0
+}
+
+// ABCIParams determine which ABCI methods the app is called with.
+type ABCIParams struct {
+	// The height from which the precommits for a block are extended by the app
+	// and the app prepares and processes the proposals. 0 disables them. Once
+	// the height is reached, it can't be changed anymore.
+	// Note: must not be negative
+	VoteExtensionsEnableHeight int64 `protobuf:"varint,1,opt,name=vote_extensions_enable_height,json=voteExtensionsEnableHeight,proto3" json:"vote_extensions_enable_height,omitempty"`
+}
+
+func (m *ABCIParams) Reset()         { *m = ABCIParams{} }
+func (m *ABCIParams) String() string { return proto.CompactTextString(m) }
+func (*ABCIParams) ProtoMessage()    {}
+func (*ABCIParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93f70d04c868d295, []int{9}
+}
+func (m *ABCIParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ABCIParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ABCIParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ABCIParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ABCIParams.Merge(m, src)
+}
+func (m *ABCIParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ABCIParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ABCIParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ABCIParams proto.InternalMessageInfo
+
+func (m *ABCIParams) GetVoteExtensionsEnableHeight() int64 {
+	if m != nil {
+		return m.VoteExtensionsEnableHeight
+	}
+	return 0
+}
+
 // HashedParams is a subset of ConsensusParams.
 //
 // It is hashed into the Header.ConsensusHash.
 type HashedParams struct {
 	BlockMaxBytes int64 `protobuf:"varint,1,opt,name=block_max_bytes,json=blockMaxBytes,proto3" json:"block_max_bytes,omitempty"`
 	BlockMaxGas   int64 `protobuf:"varint,2,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+	// *** Ostracon Extended Fields ***
+	VoterElectionThreshold               int32  `protobuf:"varint,1000,opt,name=voter_election_threshold,json=voterElectionThreshold,proto3" json:"voter_election_threshold,omitempty"`
+	VoterMaxTolerableByzantinePercentage int32  `protobuf:"varint,1001,opt,name=voter_max_tolerable_byzantine_percentage,json=voterMaxTolerableByzantinePercentage,proto3" json:"voter_max_tolerable_byzantine_percentage,omitempty"`
+	TimestampProposerBased               bool   `protobuf:"varint,1002,opt,name=timestamp_proposer_based,json=timestampProposerBased,proto3" json:"timestamp_proposer_based,omitempty"`
+	PartSetParityPercentage              uint32 `protobuf:"varint,1003,opt,name=part_set_parity_percentage,json=partSetParityPercentage,proto3" json:"part_set_parity_percentage,omitempty"`
+	AbciVoteExtensionsEnableHeight       int64  `protobuf:"varint,1004,opt,name=abci_vote_extensions_enable_height,json=abciVoteExtensionsEnableHeight,proto3" json:"abci_vote_extensions_enable_height,omitempty"`
+	ValidatorVrfSuite                    string `protobuf:"bytes,1005,opt,name=validator_vrf_suite,json=validatorVrfSuite,proto3" json:"validator_vrf_suite,omitempty"`
 }
 
 func (m *HashedParams) Reset()         { *m = HashedParams{} }
 func (m *HashedParams) String() string { return proto.CompactTextString(m) }
 func (*HashedParams) ProtoMessage()    {}
 func (*HashedParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93f70d04c868d295, []int{5}
+	return fileDescriptor_93f70d04c868d295, []int{10}
 }
 func (m *HashedParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,53 +726,129 @@ func (m *HashedParams) GetBlockMaxGas() int64 {
 	return 0
 }
 
+func (m *HashedParams) GetVoterElectionThreshold() int32 {
+	if m != nil {
+		return m.VoterElectionThreshold
+	}
+	return 0
+}
+
+func (m *HashedParams) GetVoterMaxTolerableByzantinePercentage() int32 {
+	if m != nil {
+		return m.VoterMaxTolerableByzantinePercentage
+	}
+	return 0
+}
+
+func (m *HashedParams) GetTimestampProposerBased() bool {
+	if m != nil {
+		return m.TimestampProposerBased
+	}
+	return false
+}
+
+func (m *HashedParams) GetPartSetParityPercentage() uint32 {
+	if m != nil {
+		return m.PartSetParityPercentage
+	}
+	return 0
+}
+
+func (m *HashedParams) GetAbciVoteExtensionsEnableHeight() int64 {
+	if m != nil {
+		return m.AbciVoteExtensionsEnableHeight
+	}
+	return 0
+}
+
+func (m *HashedParams) GetValidatorVrfSuite() string {
+	if m != nil {
+		return m.ValidatorVrfSuite
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ConsensusParams)(nil), "ostracon.types.ConsensusParams")
 	proto.RegisterType((*BlockParams)(nil), "ostracon.types.BlockParams")
 	proto.RegisterType((*EvidenceParams)(nil), "ostracon.types.EvidenceParams")
 	proto.RegisterType((*ValidatorParams)(nil), "ostracon.types.ValidatorParams")
 	proto.RegisterType((*VersionParams)(nil), "ostracon.types.VersionParams")
+	proto.RegisterType((*UpgradePlan)(nil), "ostracon.types.UpgradePlan")
+	proto.RegisterType((*VoterParams)(nil), "ostracon.types.VoterParams")
+	proto.RegisterType((*TimestampParams)(nil), "ostracon.types.TimestampParams")
+	proto.RegisterType((*PartSetParams)(nil), "ostracon.types.PartSetParams")
+	proto.RegisterType((*ABCIParams)(nil), "ostracon.types.ABCIParams")
 	proto.RegisterType((*HashedParams)(nil), "ostracon.types.HashedParams")
 }
 
 func init() { proto.RegisterFile("ostracon/types/params.proto", fileDescriptor_93f70d04c868d295) }
 
 var fileDescriptor_93f70d04c868d295 = []byte{
-	// 535 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
-	0x18, 0xaf, 0x97, 0xb1, 0xb5, 0x5f, 0xd7, 0x16, 0x59, 0x48, 0x94, 0x55, 0x24, 0x55, 0x0e, 0x68,
-	0x12, 0x22, 0x91, 0xe0, 0x80, 0x34, 0x09, 0x01, 0x19, 0x68, 0xa0, 0xa9, 0x08, 0x45, 0x88, 0xc3,
-	0x2e, 0x91, 0xd3, 0x9a, 0x2c, 0x5a, 0x1d, 0x47, 0xb1, 0x53, 0xb5, 0x6f, 0xb1, 0x23, 0xc7, 0x1d,
-	0xe1, 0x0d, 0x78, 0x84, 0x1d, 0x77, 0xe4, 0x04, 0xa8, 0xbd, 0xf0, 0x18, 0x28, 0x4e, 0xbc, 0x2e,
-	0xe5, 0x16, 0x7f, 0xbf, 0x3f, 0xce, 0xf7, 0xfb, 0xc9, 0x30, 0xe0, 0x42, 0x66, 0x64, 0xcc, 0x13,
-	0x57, 0x2e, 0x52, 0x2a, 0xdc, 0x94, 0x64, 0x84, 0x09, 0x27, 0xcd, 0xb8, 0xe4, 0xb8, 0xab, 0x41,
-	0x47, 0x81, 0xfb, 0xf7, 0x22, 0x1e, 0x71, 0x05, 0xb9, 0xc5, 0x57, 0xc9, 0xda, 0x37, 0x23, 0xce,
-	0xa3, 0x29, 0x75, 0xd5, 0x29, 0xcc, 0xbf, 0xb8, 0x93, 0x3c, 0x23, 0x32, 0xe6, 0x49, 0x89, 0xdb,
-	0x17, 0x5b, 0xd0, 0x3b, 0xe2, 0x89, 0xa0, 0x89, 0xc8, 0xc5, 0x47, 0xe5, 0x8f, 0x9f, 0xc3, 0x9d,
-	0x70, 0xca, 0xc7, 0xe7, 0x7d, 0x34, 0x44, 0x07, 0xed, 0xa7, 0x03, 0xa7, 0x7e, 0x93, 0xe3, 0x15,
-	0x60, 0xc9, 0xf5, 0xb6, 0xaf, 0x7e, 0x59, 0x0d, 0xbf, 0xe4, 0xe3, 0x57, 0xd0, 0xa4, 0xb3, 0x78,
-	0x42, 0x93, 0x31, 0xed, 0x6f, 0x29, 0xad, 0xb9, 0xa9, 0x7d, 0x5b, 0xe1, 0x35, 0xf9, 0x8d, 0x0a,
-	0x1f, 0x41, 0x6b, 0x46, 0xa6, 0xf1, 0x84, 0x48, 0x9e, 0xf5, 0x0d, 0x65, 0x61, 0x6d, 0x5a, 0x7c,
-	0xd6, 0x84, 0x9a, 0xc7, 0x5a, 0x87, 0x5f, 0xc0, 0xee, 0x8c, 0x66, 0x22, 0xe6, 0x49, 0x7f, 0x5b,
-	0x59, 0x3c, 0xfc, 0xcf, 0xa2, 0x84, 0x6b, 0x06, 0x5a, 0x63, 0x53, 0x68, 0xdf, 0xda, 0x10, 0x0f,
-	0xa0, 0xc5, 0xc8, 0x3c, 0x08, 0x17, 0x92, 0x0a, 0x95, 0x88, 0xe1, 0x37, 0x19, 0x99, 0x7b, 0xc5,
-	0x19, 0xdf, 0x87, 0xdd, 0x02, 0x8c, 0x88, 0x50, 0x0b, 0x1b, 0xfe, 0x0e, 0x23, 0xf3, 0x63, 0x22,
-	0xf0, 0x10, 0xf6, 0x64, 0xcc, 0x68, 0x10, 0x73, 0x49, 0x02, 0x26, 0xd4, 0x2e, 0x86, 0x0f, 0xc5,
-	0xec, 0x3d, 0x97, 0x64, 0x24, 0xec, 0xef, 0x08, 0xba, 0xf5, 0x34, 0xf0, 0x63, 0xc0, 0x85, 0x1b,
-	0x89, 0x68, 0x90, 0xe4, 0x2c, 0x50, 0xa1, 0xea, 0x3b, 0x7b, 0x8c, 0xcc, 0x5f, 0x47, 0xf4, 0x43,
-	0xce, 0xd4, 0xcf, 0x09, 0x3c, 0x82, 0xbb, 0x9a, 0xac, 0x3b, 0xad, 0x42, 0x7f, 0xe0, 0x94, 0xa5,
-	0x3b, 0xba, 0x74, 0xe7, 0x4d, 0x45, 0xf0, 0x9a, 0xc5, 0xaa, 0x5f, 0x7f, 0x5b, 0xc8, 0xef, 0x96,
-	0x7e, 0x1a, 0xa9, 0xaf, 0x69, 0xd4, 0xd7, 0xb4, 0x5f, 0x42, 0x6f, 0x23, 0x75, 0x6c, 0x43, 0x27,
-	0xcd, 0xc3, 0xe0, 0x9c, 0x2e, 0x02, 0x95, 0x69, 0x1f, 0x0d, 0x8d, 0x83, 0x96, 0xdf, 0x4e, 0xf3,
-	0xf0, 0x84, 0x2e, 0x3e, 0x15, 0xa3, 0xc3, 0xe6, 0x8f, 0x4b, 0x0b, 0xfd, 0xbd, 0xb4, 0x90, 0x7d,
-	0x08, 0x9d, 0x5a, 0xe6, 0xd8, 0x82, 0x36, 0x49, 0xd3, 0x40, 0xf7, 0x54, 0xec, 0xb8, 0xed, 0x03,
-	0x49, 0xd3, 0x8a, 0x76, 0x4b, 0x7b, 0x0a, 0x7b, 0xef, 0x88, 0x38, 0xa3, 0x93, 0x4a, 0xfa, 0x08,
-	0x7a, 0x2a, 0x99, 0x60, 0xb3, 0x96, 0x8e, 0x1a, 0x8f, 0x74, 0x37, 0x36, 0x74, 0xd6, 0xbc, 0x75,
-	0x43, 0x6d, 0xcd, 0x3a, 0x26, 0xc2, 0x3b, 0xf9, 0xb6, 0x34, 0xd1, 0xd5, 0xd2, 0x44, 0xd7, 0x4b,
-	0x13, 0xfd, 0x59, 0x9a, 0xe8, 0x62, 0x65, 0x36, 0xae, 0x57, 0x66, 0xe3, 0xe7, 0xca, 0x6c, 0x9c,
-	0x3e, 0x89, 0x62, 0x79, 0x96, 0x87, 0xce, 0x98, 0x33, 0x77, 0x1a, 0x27, 0xd4, 0xbd, 0x79, 0x8f,
-	0xe5, 0x3b, 0xab, 0x3f, 0xcf, 0x70, 0x47, 0x4d, 0x9f, 0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x3c,
-	0x58, 0x77, 0x3c, 0xb7, 0x03, 0x00, 0x00,
+	// 1007 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdf, 0x6e, 0x1b, 0xc5,
+	0x17, 0xce, 0xfe, 0x9c, 0xc4, 0xf6, 0x71, 0x9c, 0xb4, 0xf3, 0x43, 0xe9, 0x92, 0x50, 0x3b, 0x32,
+	0x7f, 0x14, 0xa9, 0xc2, 0x96, 0x40, 0x40, 0x41, 0x20, 0x88, 0x93, 0x40, 0xaa, 0x28, 0x28, 0xda,
+	0x86, 0x20, 0x90, 0xd0, 0x68, 0xd6, 0x9e, 0xac, 0x57, 0xdd, 0xdd, 0x59, 0xcd, 0xcc, 0x5a, 0x36,
+	0x4f, 0xc0, 0x25, 0x97, 0x5c, 0xa1, 0x5e, 0xc2, 0x1b, 0xf0, 0x02, 0x48, 0xbd, 0xec, 0x25, 0x57,
+	0x80, 0x12, 0x21, 0xa5, 0xfc, 0x79, 0x07, 0x34, 0x33, 0x9e, 0xb5, 0xd7, 0x4d, 0xa3, 0x72, 0x17,
+	0x9f, 0xef, 0xfb, 0xce, 0xcc, 0x39, 0xe7, 0x9b, 0x93, 0x85, 0x4d, 0x26, 0x24, 0x27, 0x3d, 0x96,
+	0x74, 0xe4, 0x38, 0xa5, 0xa2, 0x93, 0x12, 0x4e, 0x62, 0xd1, 0x4e, 0x39, 0x93, 0x0c, 0xad, 0x5a,
+	0xb0, 0xad, 0xc1, 0x8d, 0x17, 0x02, 0x16, 0x30, 0x0d, 0x75, 0xd4, 0x5f, 0x86, 0xb5, 0xd1, 0x08,
+	0x18, 0x0b, 0x22, 0xda, 0xd1, 0xbf, 0xfc, 0xec, 0xac, 0xd3, 0xcf, 0x38, 0x91, 0x21, 0x4b, 0x0c,
+	0xde, 0xfa, 0x66, 0x11, 0xd6, 0x76, 0x59, 0x22, 0x68, 0x22, 0x32, 0x71, 0xac, 0xf3, 0xa3, 0x77,
+	0x60, 0xc9, 0x8f, 0x58, 0xef, 0x81, 0xeb, 0x6c, 0x39, 0xdb, 0xb5, 0x37, 0x36, 0xdb, 0xc5, 0x93,
+	0xda, 0x5d, 0x05, 0x1a, 0x6e, 0x77, 0xf1, 0xd1, 0xaf, 0xcd, 0x05, 0xcf, 0xf0, 0xd1, 0x47, 0x50,
+	0xa1, 0xc3, 0xb0, 0x4f, 0x93, 0x1e, 0x75, 0xff, 0xa7, 0xb5, 0x8d, 0x79, 0xed, 0xfe, 0x04, 0x2f,
+	0xc8, 0x73, 0x15, 0xda, 0x85, 0xea, 0x90, 0x44, 0x61, 0x9f, 0x48, 0xc6, 0xdd, 0x92, 0x4e, 0xd1,
+	0x9c, 0x4f, 0x71, 0x6a, 0x09, 0x85, 0x1c, 0x53, 0x1d, 0xfa, 0x00, 0xca, 0x43, 0xca, 0x45, 0xc8,
+	0x12, 0x77, 0x51, 0xa7, 0xb8, 0xfd, 0x54, 0x0a, 0x03, 0x17, 0x12, 0x58, 0x0d, 0xba, 0x0b, 0x4b,
+	0x43, 0x26, 0x29, 0x77, 0x2f, 0xcb, 0x57, 0xd7, 0x7f, 0xaa, 0xd0, 0x62, 0xfd, 0x5a, 0x80, 0xf6,
+	0xa0, 0x2a, 0xc3, 0x98, 0x0a, 0x49, 0xe2, 0xd4, 0x7d, 0x52, 0xbe, 0xfa, 0xfa, 0x27, 0x96, 0x51,
+	0xbc, 0x7e, 0x2e, 0x44, 0x1f, 0x42, 0x25, 0x25, 0x5c, 0x62, 0x41, 0xa5, 0xfb, 0x67, 0xf9, 0xea,
+	0x02, 0x8e, 0x09, 0x97, 0xf7, 0xa9, 0x2c, 0x16, 0x90, 0x9a, 0x20, 0x7a, 0x0b, 0x16, 0x89, 0xdf,
+	0x0b, 0xdd, 0xbf, 0x8c, 0x78, 0x63, 0x5e, 0xbc, 0xd3, 0xdd, 0xbd, 0x57, 0x50, 0x6a, 0x7a, 0x8b,
+	0x42, 0x6d, 0x66, 0xb2, 0x68, 0x13, 0xaa, 0x31, 0x19, 0x61, 0x7f, 0x2c, 0xa9, 0xd0, 0x4e, 0x28,
+	0x79, 0x95, 0x98, 0x8c, 0xba, 0xea, 0x37, 0xba, 0x05, 0x65, 0x05, 0x06, 0x44, 0xe8, 0x41, 0x97,
+	0xbc, 0xe5, 0x98, 0x8c, 0x3e, 0x21, 0x02, 0x6d, 0xc1, 0x8a, 0xaa, 0x04, 0x87, 0x4c, 0x12, 0x1c,
+	0x0b, 0x3d, 0xc3, 0x92, 0x07, 0x2a, 0x76, 0x8f, 0x49, 0x72, 0x24, 0x5a, 0x3f, 0x3a, 0xb0, 0x5a,
+	0x74, 0x01, 0xba, 0x03, 0x48, 0x65, 0x23, 0x01, 0xc5, 0x49, 0x16, 0x63, 0x6d, 0x26, 0x7b, 0xe6,
+	0x5a, 0x4c, 0x46, 0x3b, 0x01, 0xfd, 0x34, 0x8b, 0xf5, 0xe5, 0x04, 0x3a, 0x82, 0x1b, 0x96, 0x6c,
+	0xbd, 0x3c, 0x31, 0xdb, 0x8b, 0x6d, 0x63, 0xf6, 0xb6, 0x35, 0x7b, 0x7b, 0x6f, 0x42, 0xe8, 0x56,
+	0x54, 0x9d, 0xdf, 0xfd, 0xd6, 0x74, 0xbc, 0x55, 0x93, 0xcf, 0x22, 0xc5, 0x32, 0x4b, 0xc5, 0x32,
+	0x5b, 0x5f, 0xc1, 0xda, 0x9c, 0xdb, 0x50, 0x0b, 0xea, 0x69, 0xe6, 0xe3, 0x07, 0x74, 0x8c, 0x75,
+	0x37, 0x5d, 0x67, 0xab, 0xb4, 0x5d, 0xf5, 0x6a, 0x69, 0xe6, 0x1f, 0xd2, 0xf1, 0x89, 0x0a, 0xa1,
+	0x97, 0xa0, 0x3a, 0xe4, 0x67, 0x58, 0x64, 0xa1, 0xa4, 0xc6, 0x45, 0x55, 0xaf, 0x32, 0xe4, 0x67,
+	0xf7, 0x55, 0xe0, 0xbd, 0xca, 0x4f, 0x0f, 0x9b, 0xce, 0xe5, 0xc3, 0xa6, 0xd3, 0xe2, 0x50, 0x2f,
+	0x38, 0x11, 0x35, 0xa1, 0x46, 0xd2, 0x14, 0x5b, 0xf7, 0xaa, 0x0e, 0x2c, 0x7a, 0x40, 0xd2, 0x74,
+	0x42, 0x43, 0x6f, 0x43, 0x39, 0x4b, 0x03, 0x4e, 0xfa, 0xf4, 0x99, 0xee, 0xfc, 0xcc, 0xe0, 0xc7,
+	0x11, 0x49, 0x3c, 0x4b, 0x9e, 0x39, 0xf3, 0x04, 0x6a, 0x33, 0x0c, 0xf4, 0x32, 0xd4, 0x75, 0xbb,
+	0xe7, 0xce, 0x5c, 0xd1, 0x41, 0x7b, 0xea, 0x3a, 0x2c, 0x0f, 0x68, 0x18, 0x0c, 0xa4, 0x1d, 0xb6,
+	0xf9, 0x35, 0x93, 0xf5, 0x7b, 0x07, 0x6a, 0x33, 0xcf, 0x02, 0xdd, 0x05, 0x57, 0x3f, 0x09, 0x4c,
+	0x23, 0xda, 0x53, 0x7d, 0xc6, 0x72, 0xc0, 0xa9, 0x18, 0xb0, 0xa8, 0xaf, 0x4f, 0x58, 0xf2, 0xd6,
+	0x35, 0xbe, 0x3f, 0x81, 0x4f, 0x2c, 0x8a, 0x0e, 0xa1, 0xa5, 0xe6, 0x21, 0x59, 0x44, 0x39, 0xf1,
+	0x23, 0x8a, 0xfd, 0xf1, 0xd7, 0x24, 0x91, 0x61, 0x42, 0x71, 0x4a, 0x79, 0x8f, 0x26, 0x92, 0x04,
+	0x66, 0xbb, 0x2c, 0x79, 0xcd, 0x98, 0x8c, 0x4e, 0x2c, 0xb1, 0x6b, 0x79, 0xc7, 0x39, 0x6d, 0xe6,
+	0x82, 0x3f, 0x3b, 0xb0, 0x36, 0xf7, 0xf2, 0xd0, 0xab, 0xb0, 0x9a, 0x72, 0x96, 0x32, 0x41, 0x39,
+	0xf6, 0x89, 0xa0, 0xe6, 0x6a, 0x15, 0xaf, 0x6e, 0xa3, 0x5d, 0x15, 0x44, 0x3b, 0x50, 0x4d, 0x39,
+	0xed, 0x85, 0xe2, 0x3f, 0x3a, 0x6d, 0xaa, 0x42, 0x07, 0x50, 0x8f, 0xa9, 0x10, 0xda, 0xb3, 0x34,
+	0x22, 0x63, 0xb7, 0xf4, 0xfc, 0x69, 0x56, 0x26, 0xca, 0x3d, 0x25, 0x6c, 0x7d, 0x0c, 0xf5, 0xc2,
+	0xdb, 0x47, 0x77, 0xe0, 0x66, 0x4a, 0x78, 0x28, 0xc7, 0xb3, 0xed, 0x51, 0x75, 0xd4, 0xbd, 0x1b,
+	0x06, 0xb8, 0xb2, 0x1f, 0x5f, 0x00, 0x4c, 0xd7, 0x00, 0xda, 0x81, 0xdb, 0x6a, 0x1c, 0x98, 0x8e,
+	0x24, 0x4d, 0xd4, 0x8d, 0x05, 0xa6, 0x89, 0xee, 0xfe, 0x64, 0xee, 0xe6, 0x2d, 0x6e, 0x28, 0xd2,
+	0x7e, 0xce, 0xd9, 0xd7, 0x94, 0x83, 0x79, 0x2f, 0xfc, 0x51, 0x82, 0x95, 0x03, 0x22, 0x06, 0xb4,
+	0x3f, 0xc9, 0xfe, 0x1a, 0xac, 0x19, 0x8f, 0xcd, 0xef, 0x13, 0x63, 0xbd, 0x23, 0xbb, 0x54, 0x5a,
+	0x50, 0x9f, 0xf2, 0xa6, 0xab, 0xa5, 0x66, 0x59, 0x6a, 0xbf, 0xbc, 0x7b, 0x8d, 0xb1, 0x2e, 0xcb,
+	0xd7, 0x3a, 0xeb, 0x73, 0xd8, 0x36, 0xd2, 0xe7, 0xf0, 0xd7, 0x13, 0x93, 0xea, 0x15, 0x2d, 0x38,
+	0xba, 0xde, 0x65, 0xea, 0x4e, 0xf9, 0xf6, 0xc6, 0x73, 0x8e, 0xd2, 0x0b, 0xbc, 0xe2, 0xad, 0xe7,
+	0x84, 0xe3, 0x82, 0xb7, 0xde, 0x87, 0x0d, 0xbb, 0xeb, 0xf1, 0xd3, 0x63, 0xd4, 0x0b, 0xbc, 0xee,
+	0xdd, 0x4a, 0xf3, 0x89, 0x17, 0xc6, 0xa9, 0xde, 0x8a, 0xda, 0xdc, 0xf8, 0xfa, 0xd9, 0xfd, 0x5d,
+	0xd6, 0x6d, 0x6c, 0x28, 0xea, 0xe9, 0x33, 0x07, 0x88, 0x3a, 0xf0, 0xff, 0xfc, 0x5f, 0x28, 0x9e,
+	0xae, 0xaf, 0x7f, 0xcc, 0xfa, 0xba, 0x99, 0x63, 0xa7, 0x93, 0x3d, 0xd6, 0x3d, 0xfc, 0xe1, 0xbc,
+	0xe1, 0x3c, 0x3a, 0x6f, 0x38, 0x8f, 0xcf, 0x1b, 0xce, 0xef, 0xe7, 0x0d, 0xe7, 0xdb, 0x8b, 0xc6,
+	0xc2, 0xe3, 0x8b, 0xc6, 0xc2, 0x2f, 0x17, 0x8d, 0x85, 0x2f, 0x5f, 0x0f, 0x42, 0x39, 0xc8, 0xfc,
+	0x76, 0x8f, 0xc5, 0x9d, 0x28, 0x4c, 0x68, 0x27, 0xff, 0x96, 0x31, 0xdf, 0x28, 0xc5, 0x4f, 0x1b,
+	0x7f, 0x59, 0x47, 0xdf, 0xfc, 0x77, 0x00, 0xcd, 0xad, 0x27, 0xe2, 0xf3, 0x08, 0x00, 0x00,
 }
 
 func (this *ConsensusParams) Equal(that interface{}) bool {
@@ -465,6 +882,18 @@ func (this *ConsensusParams) Equal(that interface{}) bool {
 	if !this.Version.Equal(&that1.Version) {
 		return false
 	}
+	if !this.Voter.Equal(&that1.Voter) {
+		return false
+	}
+	if !this.Timestamp.Equal(&that1.Timestamp) {
+		return false
+	}
+	if !this.PartSet.Equal(&that1.PartSet) {
+		return false
+	}
+	if !this.Abci.Equal(&that1.Abci) {
+		return false
+	}
 	return true
 }
 func (this *BlockParams) Equal(that interface{}) bool {
@@ -554,6 +983,9 @@ func (this *ValidatorParams) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.VrfSuite != that1.VrfSuite {
+		return false
+	}
 	return true
 }
 func (this *VersionParams) Equal(that interface{}) bool {
@@ -578,16 +1010,19 @@ func (this *VersionParams) Equal(that interface{}) bool {
 	if this.AppVersion != that1.AppVersion {
 		return false
 	}
+	if !this.Upgrade.Equal(that1.Upgrade) {
+		return false
+	}
 	return true
 }
-func (this *HashedParams) Equal(that interface{}) bool {
+func (this *UpgradePlan) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*HashedParams)
+	that1, ok := that.(*UpgradePlan)
 	if !ok {
-		that2, ok := that.(HashedParams)
+		that2, ok := that.(UpgradePlan)
 		if ok {
 			that1 = &that2
 		} else {
@@ -599,46 +1034,244 @@ func (this *HashedParams) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.BlockMaxBytes != that1.BlockMaxBytes {
+	if this.BlockVersion != that1.BlockVersion {
 		return false
 	}
-	if this.BlockMaxGas != that1.BlockMaxGas {
+	if this.Height != that1.Height {
 		return false
 	}
 	return true
 }
-func (m *ConsensusParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *VoterParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ConsensusParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *ConsensusParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*VoterParams)
+	if !ok {
+		that2, ok := that.(VoterParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.VoterElectionThreshold != that1.VoterElectionThreshold {
+		return false
+	}
+	if this.MaxTolerableByzantinePercentage != that1.MaxTolerableByzantinePercentage {
+		return false
+	}
+	return true
+}
+func (this *TimestampParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TimestampParams)
+	if !ok {
+		that2, ok := that.(TimestampParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProposerBased != that1.ProposerBased {
+		return false
+	}
+	if this.Precision != that1.Precision {
+		return false
+	}
+	if this.MessageDelay != that1.MessageDelay {
+		return false
+	}
+	return true
+}
+func (this *PartSetParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PartSetParams)
+	if !ok {
+		that2, ok := that.(PartSetParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ParityPercentage != that1.ParityPercentage {
+		return false
+	}
+	return true
+}
+func (this *ABCIParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ABCIParams)
+	if !ok {
+		that2, ok := that.(ABCIParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.VoteExtensionsEnableHeight != that1.VoteExtensionsEnableHeight {
+		return false
+	}
+	return true
+}
+func (this *HashedParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HashedParams)
+	if !ok {
+		that2, ok := that.(HashedParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BlockMaxBytes != that1.BlockMaxBytes {
+		return false
+	}
+	if this.BlockMaxGas != that1.BlockMaxGas {
+		return false
+	}
+	if this.VoterElectionThreshold != that1.VoterElectionThreshold {
+		return false
+	}
+	if this.VoterMaxTolerableByzantinePercentage != that1.VoterMaxTolerableByzantinePercentage {
+		return false
+	}
+	if this.TimestampProposerBased != that1.TimestampProposerBased {
+		return false
+	}
+	if this.PartSetParityPercentage != that1.PartSetParityPercentage {
+		return false
+	}
+	if this.AbciVoteExtensionsEnableHeight != that1.AbciVoteExtensionsEnableHeight {
+		return false
+	}
+	if this.ValidatorVrfSuite != that1.ValidatorVrfSuite {
+		return false
+	}
+	return true
+}
+func (m *ConsensusParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsensusParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsensusParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Abci.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3e
+	i--
+	dAtA[i] = 0xda
+	{
+		size, err := m.PartSet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3e
+	i--
+	dAtA[i] = 0xd2
+	{
+		size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3e
+	i--
+	dAtA[i] = 0xca
+	{
+		size, err := m.Voter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3e
+	i--
+	dAtA[i] = 0xc2
+	{
+		size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -733,12 +1366,12 @@ func (m *EvidenceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAgeDuration):])
-	if err5 != nil {
-		return 0, err5
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAgeDuration):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n5
-	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i -= n9
+	i = encodeVarintParams(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x12
 	if m.MaxAgeNumBlocks != 0 {
@@ -769,6 +1402,15 @@ func (m *ValidatorParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VrfSuite) > 0 {
+		i -= len(m.VrfSuite)
+		copy(dAtA[i:], m.VrfSuite)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.VrfSuite)))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc2
+	}
 	if len(m.PubKeyTypes) > 0 {
 		for iNdEx := len(m.PubKeyTypes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.PubKeyTypes[iNdEx])
@@ -801,6 +1443,20 @@ func (m *VersionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Upgrade != nil {
+		{
+			size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc2
+	}
 	if m.AppVersion != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.AppVersion))
 		i--
@@ -809,7 +1465,7 @@ func (m *VersionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *HashedParams) Marshal() (dAtA []byte, err error) {
+func (m *UpgradePlan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -819,70 +1475,340 @@ func (m *HashedParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HashedParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpgradePlan) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HashedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpgradePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockMaxGas != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.BlockMaxGas))
+	if m.Height != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Height))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.BlockMaxBytes != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.BlockMaxBytes))
+	if m.BlockVersion != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlockVersion))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *VoterParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func NewPopulatedValidatorParams(r randyParams, easy bool) *ValidatorParams {
-	this := &ValidatorParams{}
-	v1 := r.Intn(10)
-	this.PubKeyTypes = make([]string, v1)
-	for i := 0; i < v1; i++ {
-		this.PubKeyTypes[i] = string(randStringParams(r))
+
+func (m *VoterParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoterParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTolerableByzantinePercentage != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTolerableByzantinePercentage))
+		i--
+		dAtA[i] = 0x10
 	}
-	if !easy && r.Intn(10) != 0 {
+	if m.VoterElectionThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VoterElectionThreshold))
+		i--
+		dAtA[i] = 0x8
 	}
-	return this
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedVersionParams(r randyParams, easy bool) *VersionParams {
-	this := &VersionParams{}
-	this.AppVersion = uint64(uint64(r.Uint32()))
-	if !easy && r.Intn(10) != 0 {
+func (m *TimestampParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-type randyParams interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+func (m *TimestampParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func randUTF8RuneParams(r randyParams) rune {
+func (m *TimestampParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MessageDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MessageDelay):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintParams(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Precision, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Precision):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintParams(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x12
+	if m.ProposerBased {
+		i--
+		if m.ProposerBased {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartSetParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartSetParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartSetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParityPercentage != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ParityPercentage))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ABCIParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ABCIParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ABCIParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VoteExtensionsEnableHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VoteExtensionsEnableHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HashedParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HashedParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HashedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorVrfSuite) > 0 {
+		i -= len(m.ValidatorVrfSuite)
+		copy(dAtA[i:], m.ValidatorVrfSuite)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ValidatorVrfSuite)))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.AbciVoteExtensionsEnableHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AbciVoteExtensionsEnableHeight))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.PartSetParityPercentage != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PartSetParityPercentage))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.TimestampProposerBased {
+		i--
+		if m.TimestampProposerBased {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.VoterMaxTolerableByzantinePercentage != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VoterMaxTolerableByzantinePercentage))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.VoterElectionThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VoterElectionThreshold))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockMaxBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlockMaxBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedValidatorParams(r randyParams, easy bool) *ValidatorParams {
+	this := &ValidatorParams{}
+	v1 := r.Intn(10)
+	this.PubKeyTypes = make([]string, v1)
+	for i := 0; i < v1; i++ {
+		this.PubKeyTypes[i] = string(randStringParams(r))
+	}
+	this.VrfSuite = string(randStringParams(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedVersionParams(r randyParams, easy bool) *VersionParams {
+	this := &VersionParams{}
+	this.AppVersion = uint64(uint64(r.Uint32()))
+	if r.Intn(5) != 0 {
+		this.Upgrade = NewPopulatedUpgradePlan(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUpgradePlan(r randyParams, easy bool) *UpgradePlan {
+	this := &UpgradePlan{}
+	this.BlockVersion = uint64(uint64(r.Uint32()))
+	this.Height = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Height *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedVoterParams(r randyParams, easy bool) *VoterParams {
+	this := &VoterParams{}
+	this.VoterElectionThreshold = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.VoterElectionThreshold *= -1
+	}
+	this.MaxTolerableByzantinePercentage = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MaxTolerableByzantinePercentage *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPartSetParams(r randyParams, easy bool) *PartSetParams {
+	this := &PartSetParams{}
+	this.ParityPercentage = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedABCIParams(r randyParams, easy bool) *ABCIParams {
+	this := &ABCIParams{}
+	this.VoteExtensionsEnableHeight = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.VoteExtensionsEnableHeight *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyParams interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneParams(r randyParams) rune {
 	ru := r.Intn(62)
 	if ru < 10 {
 		return rune(ru + 48)
@@ -959,6 +1885,14 @@ func (m *ConsensusParams) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.Version.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.Voter.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.Timestamp.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.PartSet.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.Abci.Size()
+	n += 2 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -1009,6 +1943,10 @@ func (m *ValidatorParams) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	l = len(m.VrfSuite)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -1021,6 +1959,80 @@ func (m *VersionParams) Size() (n int) {
 	if m.AppVersion != 0 {
 		n += 1 + sovParams(uint64(m.AppVersion))
 	}
+	if m.Upgrade != nil {
+		l = m.Upgrade.Size()
+		n += 2 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *UpgradePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockVersion != 0 {
+		n += 1 + sovParams(uint64(m.BlockVersion))
+	}
+	if m.Height != 0 {
+		n += 1 + sovParams(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *VoterParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoterElectionThreshold != 0 {
+		n += 1 + sovParams(uint64(m.VoterElectionThreshold))
+	}
+	if m.MaxTolerableByzantinePercentage != 0 {
+		n += 1 + sovParams(uint64(m.MaxTolerableByzantinePercentage))
+	}
+	return n
+}
+
+func (m *TimestampParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposerBased {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Precision)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MessageDelay)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *PartSetParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParityPercentage != 0 {
+		n += 1 + sovParams(uint64(m.ParityPercentage))
+	}
+	return n
+}
+
+func (m *ABCIParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoteExtensionsEnableHeight != 0 {
+		n += 1 + sovParams(uint64(m.VoteExtensionsEnableHeight))
+	}
 	return n
 }
 
@@ -1036,6 +2048,25 @@ func (m *HashedParams) Size() (n int) {
 	if m.BlockMaxGas != 0 {
 		n += 1 + sovParams(uint64(m.BlockMaxGas))
 	}
+	if m.VoterElectionThreshold != 0 {
+		n += 2 + sovParams(uint64(m.VoterElectionThreshold))
+	}
+	if m.VoterMaxTolerableByzantinePercentage != 0 {
+		n += 2 + sovParams(uint64(m.VoterMaxTolerableByzantinePercentage))
+	}
+	if m.TimestampProposerBased {
+		n += 3
+	}
+	if m.PartSetParityPercentage != 0 {
+		n += 2 + sovParams(uint64(m.PartSetParityPercentage))
+	}
+	if m.AbciVoteExtensionsEnableHeight != 0 {
+		n += 2 + sovParams(uint64(m.AbciVoteExtensionsEnableHeight))
+	}
+	l = len(m.ValidatorVrfSuite)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -1154,28 +2185,604 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1000:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Voter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1001:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1002:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PartSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1003:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abci", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Abci.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeIotaMs", wireType)
+			}
+			m.TimeIotaMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeIotaMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvidenceParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvidenceParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvidenceParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeNumBlocks", wireType)
+			}
+			m.MaxAgeNumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgeNumBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxAgeDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyTypes = append(m.PubKeyTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 1000:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VrfSuite", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VrfSuite = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			m.AppVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 1000:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1202,7 +2809,10 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Upgrade == nil {
+				m.Upgrade = &UpgradePlan{}
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1227,7 +2837,7 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockParams) Unmarshal(dAtA []byte) error {
+func (m *UpgradePlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1250,17 +2860,17 @@ func (m *BlockParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpgradePlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpgradePlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockVersion", wireType)
 			}
-			m.MaxBytes = 0
+			m.BlockVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1270,16 +2880,16 @@ func (m *BlockParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxBytes |= int64(b&0x7F) << shift
+				m.BlockVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.MaxGas = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1289,16 +2899,66 @@ func (m *BlockParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxGas |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoterParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoterParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoterParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeIotaMs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterElectionThreshold", wireType)
 			}
-			m.TimeIotaMs = 0
+			m.VoterElectionThreshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1308,7 +2968,26 @@ func (m *BlockParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeIotaMs |= int64(b&0x7F) << shift
+				m.VoterElectionThreshold |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTolerableByzantinePercentage", wireType)
+			}
+			m.MaxTolerableByzantinePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTolerableByzantinePercentage |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1334,7 +3013,7 @@ func (m *BlockParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EvidenceParams) Unmarshal(dAtA []byte) error {
+func (m *TimestampParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1357,17 +3036,17 @@ func (m *EvidenceParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EvidenceParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimestampParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EvidenceParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimestampParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeNumBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerBased", wireType)
 			}
-			m.MaxAgeNumBlocks = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1377,14 +3056,15 @@ func (m *EvidenceParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxAgeNumBlocks |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.ProposerBased = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1411,15 +3091,15 @@ func (m *EvidenceParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxAgeDuration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Precision, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageDelay", wireType)
 			}
-			m.MaxBytes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1429,11 +3109,25 @@ func (m *EvidenceParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxBytes |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MessageDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1455,7 +3149,7 @@ func (m *EvidenceParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorParams) Unmarshal(dAtA []byte) error {
+func (m *PartSetParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1478,17 +3172,17 @@ func (m *ValidatorParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: PartSetParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PartSetParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyTypes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParityPercentage", wireType)
 			}
-			var stringLen uint64
+			m.ParityPercentage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1498,24 +3192,11 @@ func (m *ValidatorParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ParityPercentage |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PubKeyTypes = append(m.PubKeyTypes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1537,7 +3218,7 @@ func (m *ValidatorParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VersionParams) Unmarshal(dAtA []byte) error {
+func (m *ABCIParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1560,17 +3241,17 @@ func (m *VersionParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VersionParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: ABCIParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VersionParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ABCIParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtensionsEnableHeight", wireType)
 			}
-			m.AppVersion = 0
+			m.VoteExtensionsEnableHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1580,7 +3261,7 @@ func (m *VersionParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppVersion |= uint64(b&0x7F) << shift
+				m.VoteExtensionsEnableHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1673,6 +3354,134 @@ func (m *HashedParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 1000:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterElectionThreshold", wireType)
+			}
+			m.VoterElectionThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoterElectionThreshold |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 1001:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterMaxTolerableByzantinePercentage", wireType)
+			}
+			m.VoterMaxTolerableByzantinePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoterMaxTolerableByzantinePercentage |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 1002:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampProposerBased", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimestampProposerBased = bool(v != 0)
+		case 1003:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartSetParityPercentage", wireType)
+			}
+			m.PartSetParityPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartSetParityPercentage |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 1004:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbciVoteExtensionsEnableHeight", wireType)
+			}
+			m.AbciVoteExtensionsEnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AbciVoteExtensionsEnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 1005:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorVrfSuite", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorVrfSuite = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
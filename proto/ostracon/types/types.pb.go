@@ -10,6 +10,7 @@ import (
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	crypto "github.com/line/ostracon/proto/ostracon/crypto"
+	bits "github.com/line/ostracon/proto/ostracon/libs/bits"
 	version "github.com/line/ostracon/proto/ostracon/version"
 	io "io"
 	math "math"
@@ -99,6 +100,12 @@ func (SignedMsgType) EnumDescriptor() ([]byte, []int) {
 type PartSetHeader struct {
 	Total uint32 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
 	Hash  []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// *** Ostracon Extended Fields ***
+	// Number of the data parts of an erasure-coded part set, any that many parts
+	// rebuild the data. 0 if the part set is not erasure coded.
+	DataTotal uint32 `protobuf:"varint,1000,opt,name=data_total,json=dataTotal,proto3" json:"data_total,omitempty"`
+	// Size of the data of an erasure-coded part set.
+	DataSize uint32 `protobuf:"varint,1001,opt,name=data_size,json=dataSize,proto3" json:"data_size,omitempty"`
 }
 
 func (m *PartSetHeader) Reset()         { *m = PartSetHeader{} }
@@ -148,6 +155,20 @@ func (m *PartSetHeader) GetHash() []byte {
 	return nil
 }
 
+func (m *PartSetHeader) GetDataTotal() uint32 {
+	if m != nil {
+		return m.DataTotal
+	}
+	return 0
+}
+
+func (m *PartSetHeader) GetDataSize() uint32 {
+	if m != nil {
+		return m.DataSize
+	}
+	return 0
+}
+
 type Part struct {
 	Index uint32       `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
 	Bytes []byte       `protobuf:"bytes,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
@@ -499,6 +520,12 @@ type Vote struct {
 	ValidatorAddress []byte        `protobuf:"bytes,6,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	ValidatorIndex   int32         `protobuf:"varint,7,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
 	Signature        []byte        `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Vote extension provided by the application. Only precommits for a block can
+	// have it.
+	Extension []byte `protobuf:"bytes,1000,opt,name=extension,proto3" json:"extension,omitempty"`
+	// Signature of the vote extension, which is not covered by the signature of
+	// the vote.
+	ExtensionSignature []byte `protobuf:"bytes,1001,opt,name=extension_signature,json=extensionSignature,proto3" json:"extension_signature,omitempty"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
@@ -590,6 +617,115 @@ func (m *Vote) GetSignature() []byte {
 	return nil
 }
 
+func (m *Vote) GetExtension() []byte {
+	if m != nil {
+		return m.Extension
+	}
+	return nil
+}
+
+func (m *Vote) GetExtensionSignature() []byte {
+	if m != nil {
+		return m.ExtensionSignature
+	}
+	return nil
+}
+
+// AggregatedVote contains the votes of the same type for the same block at the
+// same height and round, whose BLS signatures are aggregated into one.
+type AggregatedVote struct {
+	Type    SignedMsgType  `protobuf:"varint,1,opt,name=type,proto3,enum=ostracon.types.SignedMsgType" json:"type,omitempty"`
+	Height  int64          `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Round   int32          `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	BlockID BlockID        `protobuf:"bytes,4,opt,name=block_id,json=blockId,proto3" json:"block_id"`
+	Voters  *bits.BitArray `protobuf:"bytes,5,opt,name=voters,proto3" json:"voters,omitempty"`
+	// timestamps of the votes of the voters in the bit array, in voter index order
+	Timestamps []time.Time `protobuf:"bytes,6,rep,name=timestamps,proto3,stdtime" json:"timestamps"`
+	Signature  []byte      `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *AggregatedVote) Reset()         { *m = AggregatedVote{} }
+func (m *AggregatedVote) String() string { return proto.CompactTextString(m) }
+func (*AggregatedVote) ProtoMessage()    {}
+func (*AggregatedVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e52e849a4baef8c, []int{6}
+}
+func (m *AggregatedVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregatedVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregatedVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregatedVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedVote.Merge(m, src)
+}
+func (m *AggregatedVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregatedVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatedVote proto.InternalMessageInfo
+
+func (m *AggregatedVote) GetType() SignedMsgType {
+	if m != nil {
+		return m.Type
+	}
+	return UnknownType
+}
+
+func (m *AggregatedVote) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AggregatedVote) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *AggregatedVote) GetBlockID() BlockID {
+	if m != nil {
+		return m.BlockID
+	}
+	return BlockID{}
+}
+
+func (m *AggregatedVote) GetVoters() *bits.BitArray {
+	if m != nil {
+		return m.Voters
+	}
+	return nil
+}
+
+func (m *AggregatedVote) GetTimestamps() []time.Time {
+	if m != nil {
+		return m.Timestamps
+	}
+	return nil
+}
+
+func (m *AggregatedVote) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 // Commit contains the evidence that a block was committed by a set of validators.
 type Commit struct {
 	Height     int64       `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
@@ -604,7 +740,7 @@ func (m *Commit) Reset()         { *m = Commit{} }
 func (m *Commit) String() string { return proto.CompactTextString(m) }
 func (*Commit) ProtoMessage()    {}
 func (*Commit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e52e849a4baef8c, []int{6}
+	return fileDescriptor_0e52e849a4baef8c, []int{7}
 }
 func (m *Commit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -680,7 +816,7 @@ func (m *CommitSig) Reset()         { *m = CommitSig{} }
 func (m *CommitSig) String() string { return proto.CompactTextString(m) }
 func (*CommitSig) ProtoMessage()    {}
 func (*CommitSig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e52e849a4baef8c, []int{7}
+	return fileDescriptor_0e52e849a4baef8c, []int{8}
 }
 func (m *CommitSig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,6 +873,114 @@ func (m *CommitSig) GetSignature() []byte {
 	return nil
 }
 
+// ExtendedCommit is a Commit along with the vote extensions of its precommits.
+// It is not a part of the block, but kept for the proposer of the next height.
+type ExtendedCommit struct {
+	Commit *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// the vote extensions in the same order as the signatures of the commit
+	Extensions []VoteExtension `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions"`
+}
+
+func (m *ExtendedCommit) Reset()         { *m = ExtendedCommit{} }
+func (m *ExtendedCommit) String() string { return proto.CompactTextString(m) }
+func (*ExtendedCommit) ProtoMessage()    {}
+func (*ExtendedCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e52e849a4baef8c, []int{9}
+}
+func (m *ExtendedCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtendedCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtendedCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtendedCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendedCommit.Merge(m, src)
+}
+func (m *ExtendedCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtendedCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendedCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendedCommit proto.InternalMessageInfo
+
+func (m *ExtendedCommit) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+func (m *ExtendedCommit) GetExtensions() []VoteExtension {
+	if m != nil {
+		return m.Extensions
+	}
+	return nil
+}
+
+// VoteExtension is the extension of a precommit and its signature.
+type VoteExtension struct {
+	Extension []byte `protobuf:"bytes,1,opt,name=extension,proto3" json:"extension,omitempty"`
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *VoteExtension) Reset()         { *m = VoteExtension{} }
+func (m *VoteExtension) String() string { return proto.CompactTextString(m) }
+func (*VoteExtension) ProtoMessage()    {}
+func (*VoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e52e849a4baef8c, []int{10}
+}
+func (m *VoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteExtension.Merge(m, src)
+}
+func (m *VoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteExtension proto.InternalMessageInfo
+
+func (m *VoteExtension) GetExtension() []byte {
+	if m != nil {
+		return m.Extension
+	}
+	return nil
+}
+
+func (m *VoteExtension) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 type Proposal struct {
 	Type      SignedMsgType `protobuf:"varint,1,opt,name=type,proto3,enum=ostracon.types.SignedMsgType" json:"type,omitempty"`
 	Height    int64         `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
@@ -751,7 +995,7 @@ func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e52e849a4baef8c, []int{8}
+	return fileDescriptor_0e52e849a4baef8c, []int{11}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -838,7 +1082,7 @@ func (m *SignedHeader) Reset()         { *m = SignedHeader{} }
 func (m *SignedHeader) String() string { return proto.CompactTextString(m) }
 func (*SignedHeader) ProtoMessage()    {}
 func (*SignedHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e52e849a4baef8c, []int{9}
+	return fileDescriptor_0e52e849a4baef8c, []int{12}
 }
 func (m *SignedHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,7 +1135,7 @@ func (m *LightBlock) Reset()         { *m = LightBlock{} }
 func (m *LightBlock) String() string { return proto.CompactTextString(m) }
 func (*LightBlock) ProtoMessage()    {}
 func (*LightBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e52e849a4baef8c, []int{10}
+	return fileDescriptor_0e52e849a4baef8c, []int{13}
 }
 func (m *LightBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -952,7 +1196,7 @@ func (m *BlockMeta) Reset()         { *m = BlockMeta{} }
 func (m *BlockMeta) String() string { return proto.CompactTextString(m) }
 func (*BlockMeta) ProtoMessage()    {}
 func (*BlockMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e52e849a4baef8c, []int{11}
+	return fileDescriptor_0e52e849a4baef8c, []int{14}
 }
 func (m *BlockMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1020,7 +1264,7 @@ func (m *TxProof) Reset()         { *m = TxProof{} }
 func (m *TxProof) String() string { return proto.CompactTextString(m) }
 func (*TxProof) ProtoMessage()    {}
 func (*TxProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e52e849a4baef8c, []int{12}
+	return fileDescriptor_0e52e849a4baef8c, []int{15}
 }
 func (m *TxProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1079,8 +1323,11 @@ func init() {
 	proto.RegisterType((*Header)(nil), "ostracon.types.Header")
 	proto.RegisterType((*Data)(nil), "ostracon.types.Data")
 	proto.RegisterType((*Vote)(nil), "ostracon.types.Vote")
+	proto.RegisterType((*AggregatedVote)(nil), "ostracon.types.AggregatedVote")
 	proto.RegisterType((*Commit)(nil), "ostracon.types.Commit")
 	proto.RegisterType((*CommitSig)(nil), "ostracon.types.CommitSig")
+	proto.RegisterType((*ExtendedCommit)(nil), "ostracon.types.ExtendedCommit")
+	proto.RegisterType((*VoteExtension)(nil), "ostracon.types.VoteExtension")
 	proto.RegisterType((*Proposal)(nil), "ostracon.types.Proposal")
 	proto.RegisterType((*SignedHeader)(nil), "ostracon.types.SignedHeader")
 	proto.RegisterType((*LightBlock)(nil), "ostracon.types.LightBlock")
@@ -1091,95 +1338,106 @@ func init() {
 func init() { proto.RegisterFile("ostracon/types/types.proto", fileDescriptor_0e52e849a4baef8c) }
 
 var fileDescriptor_0e52e849a4baef8c = []byte{
-	// 1397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6e, 0x1a, 0xd7,
-	0x17, 0xf6, 0x98, 0xc1, 0xc0, 0x01, 0x6c, 0x7c, 0x7f, 0x8e, 0x43, 0x70, 0x82, 0x11, 0x3f, 0xb5,
-	0x75, 0xd2, 0x14, 0x12, 0x5a, 0x55, 0xad, 0xba, 0x88, 0xc0, 0x76, 0x1c, 0x14, 0x1b, 0xa3, 0x81,
-	0xa4, 0x6a, 0x37, 0xa3, 0x01, 0xae, 0x87, 0x69, 0x60, 0x66, 0x34, 0xf7, 0xe2, 0xda, 0x79, 0x82,
-	0xca, 0xab, 0xbc, 0x80, 0x57, 0xed, 0xa2, 0xbb, 0x4a, 0x7d, 0x80, 0xae, 0xa3, 0xae, 0xb2, 0x6b,
-	0xa5, 0x4a, 0x69, 0xe5, 0x74, 0x91, 0x76, 0xd5, 0x47, 0xa8, 0xee, 0x9f, 0x19, 0x06, 0xb0, 0xa3,
-	0x28, 0x4a, 0x37, 0x68, 0xee, 0x39, 0xdf, 0xb9, 0xf7, 0x9c, 0xef, 0x7c, 0xf7, 0x0f, 0x90, 0x73,
-	0x08, 0xf5, 0x8c, 0xae, 0x63, 0x97, 0xe9, 0xb1, 0x8b, 0x89, 0xf8, 0x2d, 0xb9, 0x9e, 0x43, 0x1d,
-	0xb4, 0xe8, 0xfb, 0x4a, 0xdc, 0x9a, 0x5b, 0x31, 0x1d, 0xd3, 0xe1, 0xae, 0x32, 0xfb, 0x12, 0xa8,
-	0xdc, 0xba, 0xe9, 0x38, 0xe6, 0x00, 0x97, 0xf9, 0xa8, 0x33, 0x3a, 0x28, 0x53, 0x6b, 0x88, 0x09,
-	0x35, 0x86, 0xae, 0x04, 0xac, 0x05, 0x4b, 0x74, 0xbd, 0x63, 0x97, 0x3a, 0x0c, 0xe9, 0x1c, 0x48,
-	0xe7, 0xd5, 0xc0, 0x79, 0x88, 0x3d, 0x62, 0xf9, 0x79, 0x48, 0x6f, 0x7e, 0x2a, 0xbb, 0x43, 0x63,
-	0x60, 0xf5, 0x0c, 0xea, 0x78, 0xd2, 0x3f, 0x9d, 0xfd, 0xa1, 0x43, 0xb1, 0xf4, 0x15, 0x3f, 0x85,
-	0x74, 0xd3, 0xf0, 0x68, 0x0b, 0xd3, 0x7b, 0xd8, 0xe8, 0x61, 0x0f, 0xad, 0x40, 0x94, 0x3a, 0xd4,
-	0x18, 0x64, 0x95, 0x82, 0xb2, 0x91, 0xd6, 0xc4, 0x00, 0x21, 0x50, 0xfb, 0x06, 0xe9, 0x67, 0xe7,
-	0x0b, 0xca, 0x46, 0x4a, 0xe3, 0xdf, 0xc5, 0x03, 0x50, 0x59, 0x28, 0x8b, 0xb0, 0xec, 0x1e, 0x3e,
-	0xf2, 0x23, 0xf8, 0x80, 0x59, 0x3b, 0xc7, 0x14, 0x13, 0x19, 0x22, 0x06, 0xa8, 0x02, 0x51, 0x5e,
-	0x57, 0x36, 0x52, 0x50, 0x36, 0x92, 0x95, 0xd5, 0x52, 0x40, 0x9e, 0xa8, 0xba, 0xd4, 0x64, 0xde,
-	0x9a, 0xfa, 0xf4, 0xf9, 0xfa, 0x9c, 0x26, 0xa0, 0xc5, 0xaf, 0x20, 0x56, 0x1b, 0x38, 0xdd, 0x47,
-	0xf5, 0xad, 0x20, 0x0d, 0x65, 0x9c, 0x06, 0xba, 0x0f, 0x4b, 0xae, 0xe1, 0x51, 0x9d, 0x60, 0xaa,
-	0xf7, 0x79, 0x0d, 0x7c, 0xc9, 0x64, 0xe5, 0x5a, 0x69, 0xb2, 0x33, 0xa5, 0x89, 0x42, 0xe5, 0x1a,
-	0x69, 0x37, 0x6c, 0x2c, 0xfe, 0x18, 0x85, 0x05, 0x49, 0xc4, 0x67, 0x10, 0x93, 0x64, 0xf3, 0xe5,
-	0x92, 0x95, 0xb5, 0xf1, 0x7c, 0xd2, 0x51, 0xda, 0x74, 0x6c, 0x82, 0x6d, 0x32, 0x22, 0x72, 0x36,
-	0x3f, 0x02, 0xbd, 0x0b, 0xf1, 0x6e, 0xdf, 0xb0, 0x6c, 0xdd, 0xea, 0xf1, 0x6c, 0x12, 0xb5, 0xe4,
-	0xd9, 0xf3, 0xf5, 0xd8, 0x26, 0xb3, 0xd5, 0xb7, 0xb4, 0x18, 0x77, 0xd6, 0x7b, 0x68, 0x15, 0x16,
-	0xfa, 0xd8, 0x32, 0xfb, 0x94, 0x13, 0x12, 0xd1, 0xe4, 0x08, 0x7d, 0x02, 0x2a, 0x13, 0x48, 0x56,
-	0xe5, 0x2b, 0xe7, 0x4a, 0x42, 0x3d, 0x25, 0x5f, 0x3d, 0xa5, 0xb6, 0xaf, 0x9e, 0x5a, 0x9c, 0x2d,
-	0xfc, 0xe4, 0xf7, 0x75, 0x45, 0xe3, 0x11, 0xa8, 0x0a, 0xe9, 0x81, 0x41, 0xa8, 0xde, 0x61, 0x94,
-	0xb1, 0xe5, 0xa3, 0x7c, 0x8a, 0xcb, 0xd3, 0x64, 0x48, 0x4a, 0x65, 0xe2, 0x49, 0x16, 0x23, 0x4c,
-	0x3d, 0xb4, 0x01, 0x19, 0x3e, 0x45, 0xd7, 0x19, 0x0e, 0x2d, 0xaa, 0x73, 0xc6, 0x17, 0x38, 0xe3,
-	0x8b, 0xcc, 0xbe, 0xc9, 0xcd, 0xf7, 0x18, 0xf7, 0x6b, 0x90, 0xe8, 0x19, 0xd4, 0x10, 0x90, 0x18,
-	0x87, 0xc4, 0x99, 0x81, 0x3b, 0xdf, 0x83, 0xa5, 0x40, 0x89, 0x44, 0x40, 0xe2, 0x62, 0x96, 0xb1,
-	0x99, 0x03, 0x6f, 0xc1, 0x8a, 0x8d, 0x8f, 0xa8, 0x3e, 0x8d, 0x4e, 0x70, 0x34, 0x62, 0xbe, 0x87,
-	0x93, 0x11, 0xef, 0xc0, 0x62, 0xd7, 0xa7, 0x5e, 0x60, 0x81, 0x63, 0xd3, 0x81, 0x95, 0xc3, 0xae,
-	0x40, 0xdc, 0x70, 0x5d, 0x01, 0x48, 0x72, 0x40, 0xcc, 0x70, 0x5d, 0xee, 0xba, 0x01, 0xcb, 0xbc,
-	0x46, 0x0f, 0x93, 0xd1, 0x80, 0xca, 0x49, 0x52, 0x1c, 0xb3, 0xc4, 0x1c, 0x9a, 0xb0, 0x73, 0xec,
-	0xff, 0x21, 0x8d, 0x0f, 0xad, 0x1e, 0xb6, 0xbb, 0x58, 0xe0, 0xd2, 0x1c, 0x97, 0xf2, 0x8d, 0x1c,
-	0x74, 0x1d, 0x32, 0xae, 0xe7, 0xb8, 0x0e, 0xc1, 0x9e, 0x6e, 0xf4, 0x7a, 0x1e, 0x26, 0x24, 0xbb,
-	0x28, 0xe6, 0xf3, 0xed, 0x55, 0x61, 0x46, 0x97, 0x20, 0xea, 0x39, 0x23, 0xbb, 0x97, 0x7d, 0xc9,
-	0x28, 0x8b, 0x6a, 0x62, 0xc4, 0xcc, 0x62, 0x6f, 0xfc, 0x25, 0x98, 0x14, 0x23, 0x54, 0x80, 0x24,
-	0xdf, 0xb0, 0x32, 0xc7, 0xbf, 0x85, 0x13, 0x84, 0x8d, 0x2d, 0x5d, 0xcc, 0x82, 0xba, 0x65, 0x50,
-	0x03, 0x65, 0x20, 0x42, 0x8f, 0x48, 0x56, 0x29, 0x44, 0x36, 0x52, 0x1a, 0xfb, 0x2c, 0xfe, 0x39,
-	0x0f, 0xea, 0x43, 0x87, 0x62, 0x74, 0x1b, 0x54, 0xd6, 0x76, 0xae, 0xe4, 0xc5, 0xd9, 0x9d, 0xd1,
-	0xb2, 0x4c, 0x1b, 0xf7, 0xf6, 0x88, 0xd9, 0x3e, 0x76, 0xb1, 0xc6, 0xa1, 0x21, 0x69, 0xce, 0x4f,
-	0x48, 0x73, 0xc5, 0xcf, 0x3e, 0x12, 0x4e, 0x7e, 0x13, 0xe2, 0x81, 0xe2, 0xd4, 0x57, 0x2b, 0x6e,
-	0x89, 0x29, 0x8e, 0xed, 0x06, 0x69, 0xd0, 0x62, 0x1d, 0x29, 0xbc, 0x1a, 0x24, 0x82, 0x63, 0x51,
-	0xea, 0xf6, 0xf5, 0xa4, 0x3f, 0x0e, 0x43, 0xef, 0xc3, 0x72, 0xa0, 0xa3, 0xa0, 0x11, 0x42, 0xbd,
-	0x99, 0xc0, 0xe1, 0x77, 0x22, 0x2c, 0x51, 0x5d, 0x1c, 0x62, 0xa2, 0x25, 0x63, 0x89, 0xd6, 0xf9,
-	0x69, 0x76, 0x15, 0x12, 0xc4, 0x32, 0x6d, 0x83, 0x8e, 0x3c, 0x2c, 0x55, 0x3c, 0x36, 0x14, 0xff,
-	0x51, 0x60, 0x41, 0xec, 0x8a, 0x10, 0x6b, 0xca, 0xf9, 0xac, 0xcd, 0x5f, 0xc4, 0x5a, 0xe4, 0x4d,
-	0x59, 0xbb, 0x03, 0x10, 0xa4, 0x42, 0xb2, 0x6a, 0x21, 0xb2, 0x91, 0xac, 0x5c, 0x99, 0x9e, 0x46,
-	0xa4, 0xd7, 0xb2, 0x4c, 0xb9, 0xe1, 0x43, 0x21, 0xa8, 0x02, 0x2b, 0x86, 0x69, 0x7a, 0xd8, 0x34,
-	0x28, 0xee, 0xe9, 0xe3, 0x3a, 0x5f, 0x0a, 0xa9, 0xfd, 0x6f, 0xec, 0x6c, 0x05, 0x25, 0xff, 0xa6,
-	0x40, 0x22, 0x98, 0x13, 0xdd, 0x81, 0xb4, 0x5f, 0x87, 0x7e, 0x30, 0x30, 0x4c, 0xa9, 0xb3, 0xb5,
-	0x0b, 0x8a, 0xb9, 0x3b, 0x30, 0x4c, 0x2d, 0x29, 0xf3, 0x67, 0x83, 0xf3, 0xbb, 0x36, 0x7f, 0x41,
-	0xd7, 0x26, 0x64, 0x12, 0x79, 0x33, 0x99, 0x4c, 0x34, 0x54, 0x9d, 0x6e, 0xe8, 0x0f, 0xf3, 0x10,
-	0x6f, 0xf2, 0x5d, 0x6b, 0x0c, 0xfe, 0xfb, 0xbd, 0xb3, 0x06, 0x09, 0xd7, 0x19, 0xe8, 0xc2, 0xa3,
-	0x72, 0x4f, 0xdc, 0x75, 0x06, 0xda, 0x8c, 0x44, 0xa2, 0x6f, 0x65, 0x63, 0x2d, 0xbc, 0x05, 0xc6,
-	0x62, 0xd3, 0x8c, 0xd9, 0x90, 0x12, 0x44, 0xc8, 0xdb, 0xb3, 0xc4, 0x18, 0xe0, 0x97, 0xb1, 0x32,
-	0x7d, 0xd3, 0x8b, 0xa4, 0x05, 0x4e, 0x93, 0x28, 0x86, 0x17, 0xd7, 0x8d, 0xbc, 0xbc, 0x57, 0xcf,
-	0x17, 0xb0, 0x26, 0x51, 0xc5, 0x9f, 0x15, 0x80, 0x5d, 0xc6, 0x29, 0xaf, 0x95, 0xdd, 0x7a, 0x84,
-	0x2f, 0xaf, 0x4f, 0xac, 0x7a, 0xf5, 0xfc, 0x66, 0xc9, 0xb5, 0x53, 0x24, 0x9c, 0x71, 0x15, 0xd2,
-	0x63, 0x09, 0x12, 0xec, 0x27, 0x32, 0x33, 0x45, 0x70, 0x15, 0xb5, 0x30, 0xd5, 0x52, 0x87, 0xa1,
-	0x11, 0xfa, 0x18, 0x12, 0xfc, 0x58, 0xe6, 0xe1, 0x7c, 0xf7, 0x24, 0x2b, 0xd9, 0x99, 0x78, 0x86,
-	0x60, 0xb1, 0xf1, 0x43, 0xf9, 0x55, 0xfc, 0x49, 0x81, 0x04, 0xaf, 0x63, 0x0f, 0x53, 0x63, 0xa2,
-	0xe3, 0xca, 0x9b, 0x76, 0xfc, 0x1a, 0x80, 0x98, 0x84, 0x58, 0x8f, 0xb1, 0x54, 0x61, 0x82, 0x5b,
-	0x5a, 0xd6, 0x63, 0x8c, 0x3e, 0x0a, 0xda, 0x13, 0x79, 0x55, 0x7b, 0xe4, 0x61, 0xe1, 0x37, 0xe9,
-	0x32, 0xc4, 0xec, 0xd1, 0x50, 0x67, 0x97, 0x8c, 0x2a, 0x74, 0x6d, 0x8f, 0x86, 0xed, 0x23, 0x52,
-	0xec, 0x43, 0xac, 0x7d, 0xc4, 0x9f, 0x6e, 0x4c, 0xcc, 0x9e, 0xe3, 0xc8, 0x57, 0x83, 0x78, 0xa7,
-	0xc5, 0x99, 0x81, 0x5f, 0x92, 0x08, 0x54, 0xf6, 0x3c, 0xf0, 0x9f, 0x91, 0xec, 0x1b, 0xdd, 0x7c,
-	0xad, 0x27, 0xa1, 0xbc, 0x0d, 0x6f, 0xfc, 0xa2, 0x40, 0x32, 0x74, 0x8a, 0xa0, 0xdb, 0x70, 0xa9,
-	0xb6, 0xbb, 0xbf, 0x79, 0x5f, 0xaf, 0x6f, 0xe9, 0x77, 0x77, 0xab, 0x3b, 0xfa, 0x83, 0xc6, 0xfd,
-	0xc6, 0xfe, 0xe7, 0x8d, 0xcc, 0x5c, 0x6e, 0xf5, 0xe4, 0xb4, 0x80, 0x42, 0xd8, 0x07, 0xf6, 0x23,
-	0xdb, 0xf9, 0xda, 0x46, 0x65, 0x58, 0x99, 0x0c, 0xa9, 0xd6, 0x5a, 0xdb, 0x8d, 0x76, 0x46, 0xc9,
-	0x5d, 0x3a, 0x39, 0x2d, 0x2c, 0x87, 0x22, 0xaa, 0x1d, 0x82, 0x6d, 0x3a, 0x1b, 0xb0, 0xb9, 0xbf,
-	0xb7, 0x57, 0x6f, 0x67, 0xe6, 0x67, 0x02, 0xe4, 0x25, 0x70, 0x1d, 0x96, 0x27, 0x03, 0x1a, 0xf5,
-	0xdd, 0x4c, 0x24, 0x87, 0x4e, 0x4e, 0x0b, 0x8b, 0x21, 0x74, 0xc3, 0x1a, 0xe4, 0xe2, 0xdf, 0x7c,
-	0x9b, 0x9f, 0xfb, 0xfe, 0xbb, 0xbc, 0xc2, 0x2a, 0x4b, 0x4f, 0x9c, 0x25, 0xe8, 0x26, 0x5c, 0x6e,
-	0xd5, 0x77, 0x1a, 0xdb, 0x5b, 0xfa, 0x5e, 0x6b, 0x47, 0x6f, 0x7f, 0xd1, 0xdc, 0x0e, 0x55, 0xb7,
-	0x74, 0x72, 0x5a, 0x48, 0xca, 0x92, 0x2e, 0x42, 0x37, 0xb5, 0xed, 0x87, 0xfb, 0xed, 0xed, 0x8c,
-	0x22, 0xd0, 0x4d, 0x0f, 0x33, 0xc9, 0x71, 0xf4, 0x2d, 0xb8, 0x72, 0x0e, 0x3a, 0x28, 0x6c, 0xf9,
-	0xe4, 0xb4, 0x90, 0x6e, 0x7a, 0x58, 0xec, 0x36, 0x1e, 0x51, 0x82, 0xec, 0x6c, 0xc4, 0x7e, 0x73,
-	0xbf, 0x55, 0xdd, 0xcd, 0x14, 0x72, 0x99, 0x93, 0xd3, 0x42, 0xca, 0x3f, 0x32, 0x19, 0x7e, 0x5c,
-	0x59, 0x6d, 0xe7, 0xe9, 0x59, 0x5e, 0x79, 0x76, 0x96, 0x57, 0xfe, 0x38, 0xcb, 0x2b, 0x4f, 0x5e,
-	0xe4, 0xe7, 0x9e, 0xbd, 0xc8, 0xcf, 0xfd, 0xfa, 0x22, 0x3f, 0xf7, 0xe5, 0x07, 0xa6, 0x45, 0xfb,
-	0xa3, 0x4e, 0xa9, 0xeb, 0x0c, 0xcb, 0x03, 0xcb, 0xc6, 0xe5, 0xe0, 0x9f, 0x8a, 0xf8, 0x03, 0x35,
-	0xf9, 0xc7, 0xa5, 0xb3, 0xc0, 0xad, 0x1f, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x02, 0x91, 0x1b,
-	0x2b, 0x8f, 0x0d, 0x00, 0x00,
+	// 1569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0xdb, 0xc6,
+	0x12, 0x37, 0xf5, 0xad, 0xd1, 0x87, 0xe5, 0x8d, 0x93, 0x28, 0xb2, 0x23, 0x0b, 0x7a, 0x78, 0xef,
+	0x39, 0x79, 0x79, 0x54, 0xe2, 0xf7, 0x50, 0x14, 0xe8, 0x21, 0x90, 0x6c, 0xc7, 0x11, 0xfc, 0x25,
+	0x50, 0x4a, 0x8a, 0xf6, 0x42, 0x50, 0xe2, 0x9a, 0x62, 0x23, 0x93, 0x04, 0xb9, 0x72, 0xec, 0xdc,
+	0x0b, 0x14, 0x3e, 0xe5, 0x1f, 0xf0, 0xa9, 0x3d, 0xf4, 0x56, 0xa0, 0x40, 0xaf, 0x3d, 0x07, 0x3d,
+	0xe5, 0xd6, 0x02, 0x05, 0xd2, 0xc2, 0xb9, 0x24, 0x3d, 0xf5, 0x4f, 0x28, 0xf6, 0x83, 0x14, 0x29,
+	0xdb, 0x41, 0x1a, 0xa4, 0x87, 0x5e, 0x0c, 0xee, 0xcc, 0x6f, 0x76, 0x76, 0x66, 0x7e, 0x33, 0xda,
+	0x35, 0x54, 0x6c, 0x8f, 0xb8, 0xda, 0xc0, 0xb6, 0x1a, 0xe4, 0xc8, 0xc1, 0x1e, 0xff, 0x2b, 0x3b,
+	0xae, 0x4d, 0x6c, 0x54, 0xf4, 0x75, 0x32, 0x93, 0x56, 0xe6, 0x0d, 0xdb, 0xb0, 0x99, 0xaa, 0x41,
+	0xbf, 0x38, 0xaa, 0xb2, 0x64, 0xd8, 0xb6, 0x31, 0xc2, 0x0d, 0xb6, 0xea, 0x8f, 0xf7, 0x1a, 0xc4,
+	0xdc, 0xc7, 0x1e, 0xd1, 0xf6, 0x1d, 0x01, 0x58, 0x08, 0x5c, 0x0c, 0xdc, 0x23, 0x87, 0xd8, 0x14,
+	0x69, 0xef, 0x09, 0x65, 0x35, 0x50, 0x8e, 0xcc, 0xbe, 0xd7, 0xe8, 0x9b, 0x24, 0x72, 0x86, 0xca,
+	0x62, 0xa0, 0x3f, 0xc0, 0xae, 0x67, 0xda, 0x56, 0x44, 0x5b, 0x9d, 0x3a, 0xfd, 0x81, 0x36, 0x32,
+	0x75, 0x8d, 0xd8, 0xae, 0xd0, 0x4f, 0x47, 0x77, 0x60, 0x13, 0x2c, 0x74, 0xf5, 0xc7, 0x50, 0xe8,
+	0x68, 0x2e, 0xe9, 0x62, 0x72, 0x1f, 0x6b, 0x3a, 0x76, 0xd1, 0x3c, 0x24, 0x89, 0x4d, 0xb4, 0x51,
+	0x59, 0xaa, 0x49, 0xcb, 0x05, 0x85, 0x2f, 0x10, 0x82, 0xc4, 0x50, 0xf3, 0x86, 0xe5, 0x58, 0x4d,
+	0x5a, 0xce, 0x2b, 0xec, 0x1b, 0x55, 0x01, 0x74, 0x8d, 0x68, 0x2a, 0x87, 0xbf, 0x4a, 0x33, 0x7c,
+	0x96, 0x8a, 0x7a, 0xcc, 0x66, 0x11, 0xd8, 0x42, 0xf5, 0xcc, 0x27, 0xb8, 0xfc, 0x9a, 0xab, 0x33,
+	0x54, 0xd2, 0x35, 0x9f, 0xe0, 0xfa, 0x1e, 0x24, 0xa8, 0x63, 0xea, 0xcf, 0xb4, 0x74, 0x7c, 0xe8,
+	0xfb, 0x63, 0x0b, 0x2a, 0xed, 0x1f, 0x11, 0xec, 0x09, 0x87, 0x7c, 0x81, 0x56, 0x20, 0xc9, 0xb2,
+	0x56, 0x8e, 0xd7, 0xa4, 0xe5, 0xdc, 0xca, 0x15, 0x39, 0x28, 0x0d, 0xcf, 0xa9, 0xdc, 0xa1, 0xda,
+	0x56, 0xe2, 0xd9, 0x8b, 0xa5, 0x19, 0x85, 0x43, 0xeb, 0x9f, 0x41, 0xba, 0x35, 0xb2, 0x07, 0x8f,
+	0xda, 0x6b, 0x41, 0x10, 0x52, 0x28, 0x88, 0x4d, 0x98, 0x75, 0x34, 0x97, 0xa8, 0x1e, 0x26, 0xea,
+	0x90, 0x65, 0x80, 0xb9, 0xcc, 0xad, 0x5c, 0x97, 0xa3, 0x75, 0x97, 0x23, 0x69, 0x12, 0x3e, 0x0a,
+	0x4e, 0x58, 0x58, 0xff, 0x36, 0x09, 0x29, 0x91, 0xc6, 0x8f, 0x20, 0x2d, 0x4a, 0xc5, 0xdc, 0xe5,
+	0x56, 0x16, 0x26, 0xfb, 0x09, 0x85, 0xbc, 0x6a, 0x5b, 0x1e, 0xb6, 0xbc, 0xb1, 0x27, 0x76, 0xf3,
+	0x2d, 0xd0, 0xbf, 0x20, 0x33, 0x18, 0x6a, 0xa6, 0xa5, 0x9a, 0x3a, 0x3b, 0x4d, 0xb6, 0x95, 0x3b,
+	0x7d, 0xb1, 0x94, 0x5e, 0xa5, 0xb2, 0xf6, 0x9a, 0x92, 0x66, 0xca, 0xb6, 0x8e, 0xae, 0x40, 0x6a,
+	0x88, 0x4d, 0x63, 0x48, 0x58, 0x42, 0xe2, 0x8a, 0x58, 0xa1, 0x0f, 0x21, 0x41, 0xe9, 0x57, 0x4e,
+	0x30, 0xcf, 0x15, 0x99, 0x73, 0x53, 0xf6, 0xb9, 0x29, 0xf7, 0x7c, 0x6e, 0xb6, 0x32, 0xd4, 0xf1,
+	0xd3, 0x5f, 0x96, 0x24, 0x85, 0x59, 0xa0, 0x26, 0x14, 0x46, 0x9a, 0x47, 0xd4, 0x3e, 0x4d, 0x19,
+	0x75, 0x9f, 0x64, 0x5b, 0x5c, 0x9d, 0x4e, 0x86, 0x48, 0xa9, 0x38, 0x78, 0x8e, 0xda, 0x70, 0x91,
+	0x8e, 0x96, 0xa1, 0xc4, 0xb6, 0x18, 0xd8, 0xfb, 0xfb, 0x26, 0x51, 0x59, 0xc6, 0x53, 0x2c, 0xe3,
+	0x45, 0x2a, 0x5f, 0x65, 0xe2, 0xfb, 0x34, 0xf7, 0x0b, 0x82, 0x20, 0x0c, 0x92, 0x66, 0x10, 0xc6,
+	0x0f, 0xa6, 0xfc, 0x37, 0xcc, 0x06, 0x3c, 0xf6, 0x38, 0x24, 0xc3, 0x77, 0x99, 0x88, 0x19, 0xf0,
+	0x36, 0xcc, 0x5b, 0xf8, 0x90, 0xa8, 0xd3, 0xe8, 0x2c, 0x43, 0x23, 0xaa, 0x7b, 0x18, 0xb5, 0xf8,
+	0x27, 0x14, 0x07, 0x7e, 0xea, 0x39, 0x16, 0x18, 0xb6, 0x10, 0x48, 0x19, 0xec, 0x1a, 0x64, 0x34,
+	0xc7, 0xe1, 0x80, 0x1c, 0x03, 0xa4, 0x35, 0xc7, 0x61, 0xaa, 0x9b, 0x30, 0xc7, 0x62, 0x74, 0xb1,
+	0x37, 0x1e, 0x11, 0xb1, 0x49, 0x9e, 0x61, 0x66, 0xa9, 0x42, 0xe1, 0x72, 0x86, 0xfd, 0x07, 0x14,
+	0xf0, 0x81, 0xa9, 0x63, 0x6b, 0x80, 0x39, 0xae, 0xc0, 0x70, 0x79, 0x5f, 0xc8, 0x40, 0x37, 0xa0,
+	0xe4, 0xb8, 0xb6, 0x63, 0x7b, 0xd8, 0x55, 0x35, 0x5d, 0x77, 0xb1, 0xe7, 0x95, 0x8b, 0x7c, 0x3f,
+	0x5f, 0xde, 0xe4, 0x62, 0x74, 0x19, 0x92, 0xae, 0x3d, 0xb6, 0x74, 0xde, 0x71, 0x49, 0x85, 0xaf,
+	0xa8, 0x98, 0xf7, 0xc6, 0x6b, 0x9e, 0x49, 0xbe, 0x42, 0x35, 0xc8, 0xb1, 0x76, 0x17, 0x67, 0xfc,
+	0x8d, 0x2b, 0x81, 0xcb, 0xa8, 0xeb, 0x7a, 0x19, 0x12, 0x6b, 0x1a, 0xd1, 0x50, 0x09, 0xe2, 0xe4,
+	0xd0, 0x2b, 0x4b, 0xb5, 0xf8, 0x72, 0x5e, 0xa1, 0x9f, 0xf5, 0xef, 0xe2, 0x90, 0x78, 0x68, 0x13,
+	0x8c, 0xee, 0x40, 0x82, 0x96, 0x9d, 0x31, 0xb9, 0x78, 0xb6, 0x33, 0xba, 0xa6, 0x61, 0x61, 0x7d,
+	0xdb, 0x33, 0x7a, 0x47, 0x0e, 0x56, 0x18, 0x34, 0x44, 0xcd, 0x58, 0x84, 0x9a, 0xf3, 0xfe, 0xe9,
+	0xe3, 0xe1, 0xc3, 0xaf, 0x42, 0x26, 0x60, 0x5c, 0xe2, 0xcd, 0x8c, 0x9b, 0xa5, 0x8c, 0xa3, 0xdd,
+	0x20, 0x04, 0x4a, 0xba, 0x2f, 0x88, 0xd7, 0x82, 0x6c, 0x30, 0x74, 0xcb, 0xc9, 0x3f, 0x41, 0xfd,
+	0x89, 0x19, 0xfa, 0x0f, 0xcc, 0x05, 0x3c, 0x0a, 0x0a, 0xc1, 0xd9, 0x5b, 0x0a, 0x14, 0x7e, 0x25,
+	0xc2, 0x14, 0x55, 0xf9, 0x10, 0xe3, 0x25, 0x99, 0x50, 0xb4, 0x4d, 0xa5, 0x74, 0x12, 0x7a, 0xa6,
+	0x61, 0x69, 0x64, 0xec, 0x62, 0xc1, 0xe2, 0x89, 0x00, 0x5d, 0x87, 0x2c, 0x3e, 0x24, 0xd8, 0x62,
+	0xc3, 0xe2, 0x15, 0x2f, 0xd0, 0x44, 0x82, 0x6e, 0xc3, 0xa5, 0x60, 0xa1, 0x4e, 0xb6, 0x11, 0x65,
+	0x46, 0x81, 0xae, 0xeb, 0xab, 0xea, 0xcf, 0x63, 0x50, 0x6c, 0x1a, 0x86, 0x8b, 0x0d, 0x8d, 0x60,
+	0xfd, 0x6f, 0x54, 0xc1, 0xff, 0x43, 0x8a, 0x13, 0x53, 0x94, 0x6f, 0x71, 0xb2, 0x05, 0xfd, 0x5d,
+	0x94, 0xe9, 0xef, 0xa2, 0xdc, 0x32, 0x49, 0xd3, 0x75, 0xb5, 0x23, 0x45, 0x60, 0xd1, 0x1a, 0x40,
+	0x50, 0x40, 0x5a, 0xac, 0xf8, 0x5b, 0x17, 0x3e, 0x64, 0x17, 0xad, 0x51, 0x7a, 0xaa, 0x46, 0xf5,
+	0xdf, 0x25, 0x48, 0xf1, 0xc9, 0x15, 0xca, 0x8b, 0x74, 0x7e, 0x5e, 0x62, 0x17, 0xe5, 0x25, 0xfe,
+	0xae, 0x79, 0xb9, 0x0b, 0x10, 0x1c, 0xc5, 0x2b, 0x27, 0x58, 0x84, 0xd7, 0xa6, 0xb7, 0xe1, 0xc7,
+	0xeb, 0x9a, 0x86, 0x18, 0xca, 0x21, 0x13, 0xb4, 0x02, 0xf3, 0x5a, 0x40, 0x88, 0x10, 0x89, 0x04,
+	0xdb, 0x2e, 0x4d, 0x94, 0x13, 0x16, 0xfd, 0x2c, 0x41, 0x36, 0xd8, 0x13, 0xdd, 0x85, 0x82, 0x1f,
+	0x87, 0xba, 0x37, 0xd2, 0x0c, 0xc1, 0xa4, 0x85, 0x0b, 0x82, 0xb9, 0x37, 0xd2, 0x0c, 0x25, 0x27,
+	0xce, 0x4f, 0x17, 0xe7, 0x77, 0x56, 0xec, 0x82, 0xce, 0x8a, 0xb4, 0x72, 0xfc, 0xdd, 0x5a, 0x39,
+	0x52, 0xd0, 0xc4, 0x74, 0x41, 0x3f, 0x97, 0xa0, 0xb8, 0x4e, 0x5b, 0x47, 0xc7, 0xba, 0x28, 0xac,
+	0x0c, 0x29, 0xfe, 0x9b, 0x55, 0x96, 0xa6, 0xaf, 0x17, 0xe1, 0x0c, 0x2b, 0x02, 0x85, 0x56, 0x01,
+	0x82, 0xe6, 0xa3, 0xa1, 0xc4, 0xcf, 0xbb, 0x35, 0xd0, 0xee, 0x5b, 0xf7, 0x51, 0x7e, 0x65, 0x26,
+	0x66, 0xf5, 0x4d, 0x28, 0x44, 0x20, 0xf4, 0xd8, 0x81, 0x5a, 0xdc, 0x54, 0xb2, 0x38, 0xac, 0x9d,
+	0x04, 0x15, 0x9b, 0x0e, 0xea, 0x9b, 0x18, 0x64, 0x3a, 0xec, 0xe7, 0x42, 0x1b, 0xfd, 0xf5, 0x2d,
+	0xbf, 0x00, 0x59, 0xc7, 0x1e, 0xa9, 0x5c, 0x93, 0x60, 0x9a, 0x8c, 0x63, 0x8f, 0x94, 0x33, 0xbc,
+	0x4f, 0xbe, 0x97, 0x89, 0x9e, 0x7a, 0x0f, 0x34, 0x38, 0xd3, 0xd7, 0x16, 0xe4, 0x79, 0x22, 0xc4,
+	0xb5, 0x4d, 0xa6, 0x19, 0xa0, 0x5f, 0x17, 0x71, 0x80, 0xe3, 0x94, 0xd4, 0x30, 0xc0, 0x0b, 0xce,
+	0xc4, 0xde, 0x86, 0x33, 0xf5, 0x1f, 0x24, 0x80, 0x2d, 0x9a, 0x53, 0x16, 0x2b, 0xbd, 0x6e, 0x79,
+	0xcc, 0xbd, 0x1a, 0xf1, 0xba, 0x78, 0x7e, 0xb1, 0x84, 0xef, 0xbc, 0x17, 0x3e, 0x71, 0x13, 0x0a,
+	0x93, 0xbe, 0xf2, 0xb0, 0x7f, 0x90, 0x33, 0x5b, 0x04, 0x77, 0xa0, 0x2e, 0x26, 0x4a, 0xfe, 0x20,
+	0xb4, 0x42, 0x1f, 0x40, 0x96, 0x8d, 0x52, 0x66, 0xce, 0x46, 0x42, 0x6e, 0xa5, 0x7c, 0x1e, 0x91,
+	0x99, 0x6d, 0xe6, 0x40, 0x7c, 0xd5, 0xbf, 0x97, 0x20, 0xcb, 0xe2, 0xd8, 0xc6, 0x44, 0x8b, 0x54,
+	0x5c, 0x7a, 0xd7, 0x8a, 0x5f, 0x07, 0xe0, 0x9b, 0xb0, 0x47, 0x03, 0x67, 0x61, 0x96, 0x49, 0xe8,
+	0xa3, 0x81, 0xfe, 0x40, 0x88, 0x44, 0xc5, 0xdf, 0x54, 0x1e, 0xd1, 0x67, 0x7e, 0x91, 0xae, 0x42,
+	0xda, 0x1a, 0xef, 0xab, 0xf4, 0x76, 0x93, 0xe0, 0xbc, 0xb6, 0xc6, 0xfb, 0xbd, 0x43, 0xaf, 0x3e,
+	0x84, 0x74, 0xef, 0x90, 0xbd, 0x19, 0x28, 0x99, 0x5d, 0xdb, 0x16, 0xd7, 0x55, 0xde, 0x76, 0x19,
+	0x2a, 0x60, 0xb7, 0x33, 0x04, 0x09, 0x7a, 0x2f, 0xf5, 0x5f, 0x3f, 0xf4, 0x1b, 0xdd, 0x7a, 0xab,
+	0xb7, 0x88, 0xb8, 0x86, 0xdd, 0xfc, 0x51, 0x82, 0x5c, 0x68, 0x34, 0xa2, 0x3b, 0x70, 0xb9, 0xb5,
+	0xb5, 0xbb, 0xba, 0xa9, 0xb6, 0xd7, 0xd4, 0x7b, 0x5b, 0xcd, 0x0d, 0xf5, 0xc1, 0xce, 0xe6, 0xce,
+	0xee, 0xc7, 0x3b, 0xa5, 0x99, 0xca, 0x95, 0xe3, 0x93, 0x1a, 0x0a, 0x61, 0x1f, 0x58, 0x8f, 0x2c,
+	0xfb, 0xb1, 0x85, 0x1a, 0x30, 0x1f, 0x35, 0x69, 0xb6, 0xba, 0xeb, 0x3b, 0xbd, 0x92, 0x54, 0xb9,
+	0x7c, 0x7c, 0x52, 0x9b, 0x0b, 0x59, 0x34, 0xfb, 0x1e, 0xb6, 0xc8, 0x59, 0x83, 0xd5, 0xdd, 0xed,
+	0xed, 0x76, 0xaf, 0x14, 0x3b, 0x63, 0x20, 0x06, 0xe0, 0x0d, 0x98, 0x8b, 0x1a, 0xec, 0xb4, 0xb7,
+	0x4a, 0xf1, 0x0a, 0x3a, 0x3e, 0xa9, 0x15, 0x43, 0xe8, 0x1d, 0x73, 0x54, 0xc9, 0x7c, 0xf1, 0x65,
+	0x75, 0xe6, 0xeb, 0xaf, 0xaa, 0x12, 0x8d, 0xac, 0x10, 0x99, 0x25, 0xe8, 0x16, 0x5c, 0xed, 0xb6,
+	0x37, 0x76, 0xd6, 0xd7, 0xd4, 0xed, 0xee, 0x86, 0xda, 0xfb, 0xa4, 0xb3, 0x1e, 0x8a, 0x6e, 0xf6,
+	0xf8, 0xa4, 0x96, 0x13, 0x21, 0x5d, 0x84, 0xee, 0x28, 0xeb, 0x0f, 0x77, 0x7b, 0xeb, 0x25, 0x89,
+	0xa3, 0x3b, 0x2e, 0xa6, 0x94, 0x63, 0xe8, 0xdb, 0x70, 0xed, 0x1c, 0x74, 0x10, 0xd8, 0xdc, 0xf1,
+	0x49, 0xad, 0xd0, 0x71, 0x31, 0xef, 0x36, 0x66, 0x21, 0x43, 0xf9, 0xac, 0xc5, 0x6e, 0x67, 0xb7,
+	0xdb, 0xdc, 0x2a, 0xd5, 0x2a, 0xa5, 0xe3, 0x93, 0x5a, 0xde, 0x1f, 0x99, 0x14, 0x3f, 0x89, 0xac,
+	0xb5, 0xf1, 0xec, 0xb4, 0x2a, 0x3d, 0x3f, 0xad, 0x4a, 0xbf, 0x9e, 0x56, 0xa5, 0xa7, 0x2f, 0xab,
+	0x33, 0xcf, 0x5f, 0x56, 0x67, 0x7e, 0x7a, 0x59, 0x9d, 0xf9, 0xf4, 0xbf, 0x86, 0x49, 0x86, 0xe3,
+	0xbe, 0x3c, 0xb0, 0xf7, 0x1b, 0x23, 0xd3, 0xc2, 0x8d, 0xe0, 0x81, 0xcd, 0xff, 0x2f, 0x10, 0x7d,
+	0x6f, 0xf7, 0x53, 0x4c, 0xfa, 0xbf, 0x3f, 0x06, 0x00, 0x50, 0xd2, 0x17, 0x5c, 0x66, 0x10, 0x00,
+	0x00,
 }
 
 func (m *PartSetHeader) Marshal() (dAtA []byte, err error) {
@@ -1202,6 +1460,20 @@ func (m *PartSetHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DataSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DataSize))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.DataTotal != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DataTotal))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc0
+	}
 	if len(m.Hash) > 0 {
 		i -= len(m.Hash)
 		copy(dAtA[i:], m.Hash)
@@ -1505,6 +1777,24 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExtensionSignature) > 0 {
+		i -= len(m.ExtensionSignature)
+		copy(dAtA[i:], m.ExtensionSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExtensionSignature)))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc2
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -1560,6 +1850,85 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AggregatedVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregatedVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregatedVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Timestamps) > 0 {
+		for iNdEx := len(m.Timestamps) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamps[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamps[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintTypes(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Voters != nil {
+		{
+			size, err := m.Voters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.BlockID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Commit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1653,12 +2022,12 @@ func (m *CommitSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err9 != nil {
-		return 0, err9
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n9
-	i = encodeVarintTypes(dAtA, i, uint64(n9))
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.ValidatorAddress) > 0 {
@@ -1676,7 +2045,7 @@ func (m *CommitSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Proposal) Marshal() (dAtA []byte, err error) {
+func (m *ExtendedCommit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1686,29 +2055,115 @@ func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExtendedCommit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExtendedCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err10 != nil {
-		return 0, err10
-	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	if len(m.Extensions) > 0 {
+		for iNdEx := len(m.Extensions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Extensions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintTypes(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x32
 	{
@@ -1978,6 +2433,12 @@ func (m *PartSetHeader) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.DataTotal != 0 {
+		n += 2 + sovTypes(uint64(m.DataTotal))
+	}
+	if m.DataSize != 0 {
+		n += 2 + sovTypes(uint64(m.DataSize))
+	}
 	return n
 }
 
@@ -2128,6 +2589,48 @@ func (m *Vote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Extension)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ExtensionSignature)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AggregatedVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	l = m.BlockID.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Voters != nil {
+		l = m.Voters.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Timestamps) > 0 {
+		for _, e := range m.Timestamps {
+			l = github_com_gogo_protobuf_types.SizeOfStdTime(e)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2180,6 +2683,42 @@ func (m *CommitSig) Size() (n int) {
 	return n
 }
 
+func (m *ExtendedCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Extensions) > 0 {
+		for _, e := range m.Extensions {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Extension)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *Proposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2375,6 +2914,44 @@ func (m *PartSetHeader) Unmarshal(dAtA []byte) error {
 				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
+		case 1000:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
+			}
+			m.DataTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataTotal |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 1001:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSize", wireType)
+			}
+			m.DataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3563,6 +4140,74 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
+		case 1000:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extension = append(m.Extension[:0], dAtA[iNdEx:postIndex]...)
+			if m.Extension == nil {
+				m.Extension = []byte{}
+			}
+			iNdEx = postIndex
+		case 1001:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionSignature = append(m.ExtensionSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtensionSignature == nil {
+				m.ExtensionSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3584,7 +4229,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commit) Unmarshal(dAtA []byte) error {
+func (m *AggregatedVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3607,17 +4252,17 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregatedVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregatedVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Height = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3627,16 +4272,16 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.Type |= SignedMsgType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Round = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3646,7 +4291,251 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Round |= int32(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Voters == nil {
+				m.Voters = &bits.BitArray{}
+			}
+			if err := m.Voters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timestamps = append(m.Timestamps, time.Time{})
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&(m.Timestamps[len(m.Timestamps)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3943,6 +4832,244 @@ func (m *CommitSig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExtendedCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtendedCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtendedCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extensions = append(m.Extensions, VoteExtension{})
+			if err := m.Extensions[len(m.Extensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extension = append(m.Extension[:0], dAtA[iNdEx:postIndex]...)
+			if m.Extension == nil {
+				m.Extension = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Proposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -83,7 +83,8 @@ type Validator struct {
 	StakingPower     int64            `protobuf:"varint,3,opt,name=staking_power,json=stakingPower,proto3" json:"staking_power,omitempty"`
 	ProposerPriority int64            `protobuf:"varint,4,opt,name=proposer_priority,json=proposerPriority,proto3" json:"proposer_priority,omitempty"`
 	// *** Ostracon Extended Fields ***
-	VotingPower int64 `protobuf:"varint,1000,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	VotingPower       int64  `protobuf:"varint,1000,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	ProofOfPossession []byte `protobuf:"bytes,1001,opt,name=proof_of_possession,json=proofOfPossession,proto3" json:"proof_of_possession,omitempty"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
@@ -154,6 +155,13 @@ func (m *Validator) GetVotingPower() int64 {
 	return 0
 }
 
+func (m *Validator) GetProofOfPossession() []byte {
+	if m != nil {
+		return m.ProofOfPossession
+	}
+	return nil
+}
+
 type SimpleValidator struct {
 	PubKey       *crypto.PublicKey `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
 	StakingPower int64             `protobuf:"varint,2,opt,name=staking_power,json=stakingPower,proto3" json:"staking_power,omitempty"`
@@ -215,31 +223,32 @@ func init() {
 func init() { proto.RegisterFile("ostracon/types/validator.proto", fileDescriptor_eeeaf81579407bf3) }
 
 var fileDescriptor_eeeaf81579407bf3 = []byte{
-	// 369 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xbf, 0x6e, 0xea, 0x30,
-	0x18, 0xc5, 0x63, 0x40, 0xa0, 0x6b, 0x72, 0xff, 0xe0, 0xdb, 0x21, 0xcd, 0x90, 0x22, 0xba, 0x44,
-	0xaa, 0x9a, 0x48, 0x30, 0xb1, 0xb2, 0x74, 0x60, 0x41, 0x41, 0xea, 0xd0, 0x25, 0x4a, 0x82, 0x95,
-	0x5a, 0x09, 0xd8, 0xb2, 0x1d, 0x2a, 0xbf, 0x45, 0x1f, 0x8b, 0x91, 0xa9, 0xea, 0x54, 0x55, 0xb0,
-	0xf4, 0x31, 0x2a, 0x92, 0x26, 0x01, 0xb5, 0x55, 0xb7, 0xe4, 0xfb, 0x1d, 0x9f, 0xef, 0x1c, 0xe9,
-	0x83, 0x16, 0x15, 0x92, 0x07, 0x11, 0x5d, 0xb9, 0x52, 0x31, 0x2c, 0xdc, 0x75, 0x90, 0x92, 0x45,
-	0x20, 0x29, 0x77, 0x18, 0xa7, 0x92, 0xa2, 0x3f, 0x25, 0x77, 0x72, 0x6e, 0x9e, 0xc5, 0x34, 0xa6,
-	0x39, 0x72, 0x0f, 0x5f, 0x85, 0xca, 0x34, 0x2b, 0x97, 0x88, 0x2b, 0x26, 0xa9, 0x9b, 0x60, 0x25,
-	0x0a, 0x36, 0x50, 0x50, 0xbf, 0x2d, 0x4d, 0xe7, 0x58, 0xa2, 0x31, 0x84, 0xd5, 0x12, 0x61, 0x80,
-	0x7e, 0xd3, 0xee, 0x0e, 0xcf, 0x9d, 0xd3, 0x35, 0x4e, 0xf5, 0xc2, 0x3b, 0x12, 0x23, 0x07, 0xfe,
-	0x97, 0x54, 0x06, 0xa9, 0x2f, 0x64, 0x90, 0x90, 0x55, 0xec, 0x33, 0xfa, 0x80, 0xb9, 0xd1, 0xec,
-	0x03, 0xbb, 0xe9, 0xf5, 0x72, 0x34, 0x2f, 0xc8, 0xec, 0x00, 0x06, 0x4f, 0x00, 0xfe, 0xaa, 0x9c,
-	0x90, 0x01, 0x3b, 0xc1, 0x62, 0xc1, 0xb1, 0x38, 0x6c, 0x05, 0xb6, 0xee, 0x95, 0xbf, 0x68, 0x0c,
-	0x3b, 0x2c, 0x0b, 0xfd, 0x04, 0x2b, 0xa3, 0xd1, 0x07, 0x76, 0x77, 0x68, 0xd6, 0x79, 0x8a, 0x42,
-	0xce, 0x2c, 0x0b, 0x53, 0x12, 0x4d, 0xb1, 0x9a, 0xb4, 0x36, 0x2f, 0x17, 0x9a, 0xd7, 0x66, 0x59,
-	0x38, 0xc5, 0x0a, 0x5d, 0xc2, 0xdf, 0x5f, 0x85, 0xd1, 0xc5, 0x51, 0x0e, 0x74, 0x05, 0x7b, 0x8c,
-	0x53, 0x46, 0x05, 0xe6, 0x3e, 0xe3, 0x84, 0x72, 0x22, 0x95, 0xd1, 0xca, 0x85, 0xff, 0x4a, 0x30,
-	0xfb, 0x98, 0xa3, 0x01, 0xd4, 0xd7, 0x54, 0xd6, 0x86, 0x6f, 0x9d, 0x5c, 0xd8, 0x2d, 0x86, 0x45,
-	0xb1, 0x04, 0xfe, 0x9d, 0x93, 0x25, 0x4b, 0x71, 0xdd, 0x6e, 0x54, 0x77, 0x00, 0x3f, 0x75, 0xf8,
-	0x3e, 0x7d, 0xe3, 0x73, 0xfa, 0xc9, 0xcd, 0x66, 0x67, 0x81, 0xed, 0xce, 0x02, 0xaf, 0x3b, 0x0b,
-	0x3c, 0xee, 0x2d, 0x6d, 0xbb, 0xb7, 0xb4, 0xe7, 0xbd, 0xa5, 0xdd, 0x5d, 0xc7, 0x44, 0xde, 0x67,
-	0xa1, 0x13, 0xd1, 0xa5, 0x9b, 0x92, 0x15, 0x76, 0xab, 0x33, 0x28, 0x2e, 0xe4, 0xf4, 0xb6, 0xc2,
-	0x76, 0x3e, 0x1d, 0xbd, 0x07, 0x00, 0x00, 0xff, 0xff, 0x14, 0xa3, 0xe5, 0xb9, 0x74, 0x02, 0x00,
-	0x00,
+	// 397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x3f, 0x6f, 0xd4, 0x30,
+	0x18, 0xc6, 0xe3, 0xbb, 0xaa, 0x11, 0xbe, 0xf0, 0xe7, 0x5c, 0x86, 0x90, 0x21, 0x9c, 0xc2, 0x12,
+	0x09, 0x91, 0x48, 0xed, 0xd4, 0xb5, 0x0b, 0x43, 0x07, 0xa2, 0x9c, 0xc4, 0xc0, 0x12, 0x25, 0x39,
+	0x5f, 0xb0, 0x92, 0xe6, 0xb5, 0x6c, 0xa7, 0xc8, 0x9f, 0x80, 0x95, 0x8f, 0xd5, 0xb1, 0x23, 0x13,
+	0x42, 0x77, 0x0b, 0x7c, 0x0b, 0x74, 0x0e, 0x49, 0x7a, 0x02, 0xc4, 0x66, 0xbf, 0xbf, 0xc7, 0xcf,
+	0xfb, 0x3e, 0xd6, 0x8b, 0x7d, 0x90, 0x4a, 0xe4, 0x25, 0xb4, 0xb1, 0xd2, 0x9c, 0xca, 0xf8, 0x36,
+	0x6f, 0xd8, 0x26, 0x57, 0x20, 0x22, 0x2e, 0x40, 0x01, 0x79, 0x32, 0xf0, 0xc8, 0x70, 0xef, 0x79,
+	0x05, 0x15, 0x18, 0x14, 0x1f, 0x4e, 0xbd, 0xca, 0xf3, 0x46, 0x97, 0x52, 0x68, 0xae, 0x20, 0xae,
+	0xa9, 0x96, 0x3d, 0x0b, 0x34, 0x76, 0xde, 0x0f, 0xa6, 0x6b, 0xaa, 0xc8, 0x25, 0xc6, 0x63, 0x13,
+	0xe9, 0xa2, 0xd5, 0x3c, 0x5c, 0x9c, 0xbf, 0x88, 0x8e, 0xdb, 0x44, 0xe3, 0x8b, 0xf4, 0x81, 0x98,
+	0x44, 0xf8, 0x4c, 0x81, 0xca, 0x9b, 0x4c, 0xaa, 0xbc, 0x66, 0x6d, 0x95, 0x71, 0xf8, 0x44, 0x85,
+	0x3b, 0x5f, 0xa1, 0x70, 0x9e, 0x2e, 0x0d, 0x5a, 0xf7, 0x24, 0x39, 0x80, 0xe0, 0xf3, 0x0c, 0x3f,
+	0x1a, 0x9d, 0x88, 0x8b, 0xed, 0x7c, 0xb3, 0x11, 0x54, 0x1e, 0xba, 0xa2, 0xd0, 0x49, 0x87, 0x2b,
+	0xb9, 0xc4, 0x36, 0xef, 0x8a, 0xac, 0xa6, 0xda, 0x9d, 0xad, 0x50, 0xb8, 0x38, 0xf7, 0xa6, 0x79,
+	0xfa, 0x40, 0x51, 0xd2, 0x15, 0x0d, 0x2b, 0xaf, 0xa9, 0xbe, 0x3a, 0xb9, 0xfb, 0xf6, 0xd2, 0x4a,
+	0x4f, 0x79, 0x57, 0x5c, 0x53, 0x4d, 0x5e, 0xe1, 0xc7, 0x7f, 0x1b, 0xc6, 0x91, 0x0f, 0xe6, 0x20,
+	0xaf, 0xf1, 0x92, 0x0b, 0xe0, 0x20, 0xa9, 0xc8, 0xb8, 0x60, 0x20, 0x98, 0xd2, 0xee, 0x89, 0x11,
+	0x3e, 0x1b, 0x40, 0xf2, 0xbb, 0x4e, 0x02, 0xec, 0xdc, 0x82, 0x9a, 0x0c, 0x7f, 0xd8, 0x46, 0xb8,
+	0xe8, 0x8b, 0xbd, 0x61, 0x8c, 0xcf, 0xb8, 0x00, 0xd8, 0x66, 0xb0, 0xcd, 0x38, 0x48, 0x49, 0xa5,
+	0x64, 0xd0, 0xba, 0x3f, 0x6d, 0x93, 0x6b, 0x69, 0xd8, 0xbb, 0x6d, 0x32, 0x92, 0xa0, 0xc6, 0x4f,
+	0xd7, 0xec, 0x86, 0x37, 0x74, 0xfa, 0x8e, 0x8b, 0x29, 0x34, 0xfa, 0x5f, 0xe8, 0x7f, 0xc7, 0x9d,
+	0xfd, 0x19, 0xf7, 0xea, 0xed, 0xdd, 0xce, 0x47, 0xf7, 0x3b, 0x1f, 0x7d, 0xdf, 0xf9, 0xe8, 0xcb,
+	0xde, 0xb7, 0xee, 0xf7, 0xbe, 0xf5, 0x75, 0xef, 0x5b, 0x1f, 0xde, 0x54, 0x4c, 0x7d, 0xec, 0x8a,
+	0xa8, 0x84, 0x9b, 0xb8, 0x61, 0x2d, 0x8d, 0xc7, 0xbd, 0xe9, 0x57, 0xea, 0x78, 0x19, 0x8b, 0x53,
+	0x53, 0xbd, 0xf8, 0x35, 0x00, 0x62, 0x00, 0x4a, 0xc4, 0xa5, 0x02, 0x00, 0x00,
 }
 
 func (m *ValidatorSet) Marshal() (dAtA []byte, err error) {
@@ -304,6 +313,15 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProofOfPossession) > 0 {
+		i -= len(m.ProofOfPossession)
+		copy(dAtA[i:], m.ProofOfPossession)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.ProofOfPossession)))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xca
+	}
 	if m.VotingPower != 0 {
 		i = encodeVarintValidator(dAtA, i, uint64(m.VotingPower))
 		i--
@@ -431,6 +449,10 @@ func (m *Validator) Size() (n int) {
 	if m.VotingPower != 0 {
 		n += 2 + sovValidator(uint64(m.VotingPower))
 	}
+	l = len(m.ProofOfPossession)
+	if l > 0 {
+		n += 2 + l + sovValidator(uint64(l))
+	}
 	return n
 }
 
@@ -712,6 +734,40 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 1001:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofOfPossession", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofOfPossession = append(m.ProofOfPossession[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofOfPossession == nil {
+				m.ProofOfPossession = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValidator(dAtA[iNdEx:])
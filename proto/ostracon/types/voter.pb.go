@@ -74,86 +74,27 @@ func (m *VoterSet) GetTotalVotingPower() int64 {
 	return 0
 }
 
-type VoterParams struct {
-	VoterElectionThreshold          int32 `protobuf:"varint,1,opt,name=voter_election_threshold,json=voterElectionThreshold,proto3" json:"voter_election_threshold,omitempty"`
-	MaxTolerableByzantinePercentage int32 `protobuf:"varint,2,opt,name=max_tolerable_byzantine_percentage,json=maxTolerableByzantinePercentage,proto3" json:"max_tolerable_byzantine_percentage,omitempty"`
-}
-
-func (m *VoterParams) Reset()         { *m = VoterParams{} }
-func (m *VoterParams) String() string { return proto.CompactTextString(m) }
-func (*VoterParams) ProtoMessage()    {}
-func (*VoterParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9565f097e29c5b3, []int{1}
-}
-func (m *VoterParams) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *VoterParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_VoterParams.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *VoterParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VoterParams.Merge(m, src)
-}
-func (m *VoterParams) XXX_Size() int {
-	return m.Size()
-}
-func (m *VoterParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_VoterParams.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_VoterParams proto.InternalMessageInfo
-
-func (m *VoterParams) GetVoterElectionThreshold() int32 {
-	if m != nil {
-		return m.VoterElectionThreshold
-	}
-	return 0
-}
-
-func (m *VoterParams) GetMaxTolerableByzantinePercentage() int32 {
-	if m != nil {
-		return m.MaxTolerableByzantinePercentage
-	}
-	return 0
-}
-
 func init() {
 	proto.RegisterType((*VoterSet)(nil), "ostracon.types.VoterSet")
-	proto.RegisterType((*VoterParams)(nil), "ostracon.types.VoterParams")
 }
 
 func init() { proto.RegisterFile("ostracon/types/voter.proto", fileDescriptor_d9565f097e29c5b3) }
 
 var fileDescriptor_d9565f097e29c5b3 = []byte{
-	// 301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xb1, 0x4e, 0x32, 0x41,
-	0x14, 0x85, 0x99, 0x9f, 0x40, 0xfe, 0x0c, 0x89, 0x31, 0x53, 0x98, 0x95, 0x62, 0x24, 0x54, 0x14,
-	0xba, 0x1b, 0xb5, 0xb1, 0x26, 0x31, 0x16, 0x36, 0x64, 0x25, 0x14, 0x36, 0x93, 0xd9, 0xe5, 0x06,
-	0x26, 0xce, 0xce, 0xdd, 0xcc, 0x5e, 0x11, 0x7c, 0x0a, 0x0b, 0x1f, 0xca, 0x92, 0xd2, 0xd2, 0xc0,
-	0x8b, 0x18, 0x26, 0x2c, 0x09, 0xb6, 0xf7, 0xfb, 0xce, 0x99, 0xc9, 0xe1, 0x5d, 0xac, 0xc8, 0xeb,
-	0x1c, 0x5d, 0x42, 0xab, 0x12, 0xaa, 0x64, 0x81, 0x04, 0x3e, 0x2e, 0x3d, 0x12, 0x8a, 0x93, 0x9a,
-	0xc5, 0x81, 0x75, 0xe5, 0x5f, 0x57, 0x5b, 0x33, 0xd5, 0x84, 0x7b, 0xbf, 0xff, 0xc2, 0xff, 0x4f,
-	0x76, 0xf1, 0x27, 0x20, 0x71, 0xcd, 0xdb, 0xa1, 0xaa, 0x8a, 0x58, 0xaf, 0x39, 0xe8, 0xdc, 0x9c,
-	0xc7, 0xc7, 0x65, 0xf1, 0xa4, 0x0e, 0xa7, 0x7b, 0x51, 0x5c, 0x72, 0x41, 0x48, 0xda, 0xaa, 0x05,
-	0x92, 0x71, 0x33, 0x55, 0xe2, 0x1b, 0xf8, 0xe8, 0x5f, 0x8f, 0x0d, 0x9a, 0xe9, 0x69, 0x20, 0x93,
-	0x00, 0x46, 0xbb, 0x7b, 0xff, 0x93, 0xf1, 0x4e, 0x78, 0x6d, 0xa4, 0xbd, 0x2e, 0x2a, 0x71, 0xc7,
-	0xa3, 0xd0, 0xa3, 0xc0, 0x42, 0x4e, 0x06, 0x9d, 0xa2, 0xb9, 0x87, 0x6a, 0x8e, 0x76, 0x1a, 0xb1,
-	0x1e, 0x1b, 0xb4, 0xd2, 0xb3, 0xc0, 0xef, 0xf7, 0x78, 0x5c, 0x53, 0xf1, 0xc8, 0xfb, 0x85, 0x5e,
-	0x2a, 0x42, 0x0b, 0x5e, 0x67, 0x16, 0x54, 0xb6, 0x7a, 0xd7, 0x8e, 0x8c, 0x03, 0x55, 0x82, 0xcf,
-	0xc1, 0x91, 0x9e, 0x41, 0xf8, 0x47, 0x2b, 0xbd, 0x28, 0xf4, 0x72, 0x5c, 0x8b, 0xc3, 0xda, 0x1b,
-	0x1d, 0xb4, 0xe1, 0xc3, 0xd7, 0x46, 0xb2, 0xf5, 0x46, 0xb2, 0x9f, 0x8d, 0x64, 0x1f, 0x5b, 0xd9,
-	0x58, 0x6f, 0x65, 0xe3, 0x7b, 0x2b, 0x1b, 0xcf, 0x57, 0x33, 0x43, 0xf3, 0xd7, 0x2c, 0xce, 0xb1,
-	0x48, 0xac, 0x71, 0x90, 0x1c, 0xd6, 0x0c, 0xeb, 0x25, 0xc7, 0xe3, 0x66, 0xed, 0x70, 0xbd, 0xfd,
-	0x0d, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x9a, 0x18, 0xbd, 0xa1, 0x01, 0x00, 0x00,
+	// 200 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0x2f, 0x2e, 0x29,
+	0x4a, 0x4c, 0xce, 0xcf, 0xd3, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x2f, 0xcb, 0x2f, 0x49, 0x2d,
+	0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc9, 0xe9, 0x81, 0xe5, 0xa4, 0xe4, 0xd0,
+	0xd5, 0x26, 0xe6, 0x64, 0xa6, 0x24, 0x96, 0xe4, 0x43, 0xd5, 0x2b, 0x65, 0x73, 0x71, 0x84, 0x81,
+	0xb4, 0x07, 0xa7, 0x96, 0x08, 0x19, 0x72, 0xb1, 0x81, 0x8d, 0x2a, 0x96, 0x60, 0x54, 0x60, 0xd6,
+	0xe0, 0x36, 0x92, 0xd4, 0x43, 0x35, 0x4c, 0x2f, 0x0c, 0xa6, 0x39, 0x08, 0xaa, 0x50, 0x48, 0x87,
+	0x4b, 0xa8, 0x24, 0xbf, 0x24, 0x31, 0x27, 0xbe, 0x2c, 0xbf, 0x24, 0x33, 0x2f, 0x3d, 0xbe, 0x20,
+	0xbf, 0x3c, 0xb5, 0x48, 0x82, 0x49, 0x81, 0x51, 0x83, 0x39, 0x48, 0x00, 0x2c, 0x13, 0x06, 0x96,
+	0x08, 0x00, 0x89, 0x3b, 0xb9, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
+	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94,
+	0x6e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x4e, 0x66, 0x5e, 0xaa,
+	0x3e, 0xdc, 0xd9, 0x60, 0x67, 0xea, 0xa3, 0xfa, 0x22, 0x89, 0x0d, 0x2c, 0x6a, 0x0c, 0x18, 0x00,
+	0x9a, 0x13, 0xb6, 0x13, 0x0a, 0x01, 0x00, 0x00,
 }
 
 func (m *VoterSet) Marshal() (dAtA []byte, err error) {
@@ -198,39 +139,6 @@ func (m *VoterSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *VoterParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *VoterParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *VoterParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxTolerableByzantinePercentage != 0 {
-		i = encodeVarintVoter(dAtA, i, uint64(m.MaxTolerableByzantinePercentage))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.VoterElectionThreshold != 0 {
-		i = encodeVarintVoter(dAtA, i, uint64(m.VoterElectionThreshold))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
 func encodeVarintVoter(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVoter(v)
 	base := offset
@@ -260,21 +168,6 @@ func (m *VoterSet) Size() (n int) {
 	return n
 }
 
-func (m *VoterParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.VoterElectionThreshold != 0 {
-		n += 1 + sovVoter(uint64(m.VoterElectionThreshold))
-	}
-	if m.MaxTolerableByzantinePercentage != 0 {
-		n += 1 + sovVoter(uint64(m.MaxTolerableByzantinePercentage))
-	}
-	return n
-}
-
 func sovVoter(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -384,94 +277,6 @@ func (m *VoterSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoterParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowVoter
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VoterParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoterParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoterElectionThreshold", wireType)
-			}
-			m.VoterElectionThreshold = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVoter
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VoterElectionThreshold |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTolerableByzantinePercentage", wireType)
-			}
-			m.MaxTolerableByzantinePercentage = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVoter
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxTolerableByzantinePercentage |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipVoter(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthVoter
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func skipVoter(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,11 +1,13 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	ctypes "github.com/line/ostracon/rpc/core/types"
 	"github.com/line/ostracon/types"
 )
 
@@ -81,3 +83,94 @@ func WaitForOneEvent(c EventsClient, evtTyp string, timeout time.Duration) (type
 		return nil, errors.New("timed out waiting for event")
 	}
 }
+
+// GetVerifiedRandomBeacon gets the random beacon at the given height, or at the latest height if height is nil, and
+// verifies it against the header and the validator set of its height got from c.
+//
+// The random beacon is only as trustworthy as the headers got from c, so c should be a light client proxy (see
+// light/rpc) unless the node behind it is trusted. The VRF suite of the chain is the one in the consensus params of the
+// height got from c.
+func GetVerifiedRandomBeacon(ctx context.Context, c Client, height *int64) (*ctypes.ResultRandomBeacon, error) {
+	beacon, err := c.RandomBeacon(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := c.ConsensusParams(ctx, &beacon.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := c.Commit(ctx, &beacon.Height)
+	if err != nil {
+		return nil, err
+	}
+	if commit.Header == nil {
+		return nil, fmt.Errorf("header %d is not available", beacon.Height)
+	}
+
+	validators := make([]*types.Validator, 0)
+	page, perPage := 1, 100
+	for {
+		res, err := c.Validators(ctx, &beacon.Height, &page, &perPage)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, res.Validators...)
+		if len(res.Validators) == 0 || len(validators) >= res.Total {
+			break
+		}
+		page++
+	}
+
+	vals := &types.ValidatorSet{Validators: validators}
+	if err := VerifyRandomBeacon(beacon, commit.Header, vals, params.ConsensusParams.Validator.VrfSuite); err != nil {
+		return nil, err
+	}
+	return beacon, nil
+}
+
+// VerifyRandomBeacon verifies the random beacon against the header at its height and the validator set of the header.
+//
+// The last proof hash of the random beacon, the VRF output of the previous block (the hash of the genesis doc for the
+// initial block), needs no other block since the proof in the header is only valid for the message made of it.
+//
+// The proof is verified under vrfSuite, the Validator.VrfSuite of the consensus params of the chain.
+func VerifyRandomBeacon(beacon *ctypes.ResultRandomBeacon, header *types.Header, vals *types.ValidatorSet,
+	vrfSuite string) error {
+	switch {
+	case beacon.Height != header.Height:
+		return fmt.Errorf("random beacon height %d does not match header height %d", beacon.Height, header.Height)
+	case beacon.Round != header.Round:
+		return fmt.Errorf("random beacon round %d does not match header round %d", beacon.Round, header.Round)
+	case !bytes.Equal(beacon.ProposerAddress, header.ProposerAddress):
+		return fmt.Errorf("random beacon proposer %X does not match header proposer %X",
+			beacon.ProposerAddress, header.ProposerAddress)
+	case !bytes.Equal(beacon.Proof, header.Proof):
+		return errors.New("random beacon proof does not match header proof")
+	case !bytes.Equal(vals.Hash(), header.ValidatorsHash):
+		return fmt.Errorf("validators hash %X does not match header validators hash %X",
+			vals.Hash(), header.ValidatorsHash)
+	}
+
+	// verifies the proof for the message made of the last proof hash
+	if err := types.VerifyProposer(header, vals, beacon.LastProofHash, vrfSuite); err != nil {
+		return err
+	}
+	if message := types.MakeRoundHash(beacon.LastProofHash, header.Height-1, header.Round); !bytes.Equal(
+		beacon.Message, message) {
+		return fmt.Errorf("random beacon message %X does not match %X", beacon.Message, message)
+	}
+	_, proposer := vals.GetByAddress(header.ProposerAddress)
+	if !proposer.PubKey.Equals(beacon.ProposerPubKey) {
+		return errors.New("random beacon proposer public key does not match the validator's")
+	}
+	output, err := types.ProposerProofHash(header, vals, vrfSuite)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(beacon.Output, output) {
+		return fmt.Errorf("random beacon output %X does not match the output of the proof %X", beacon.Output, output)
+	}
+	return nil
+}
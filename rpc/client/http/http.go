@@ -300,6 +300,15 @@ func (c *baseRPCClient) UnconfirmedTxs(
 	return result, nil
 }
 
+func (c *baseRPCClient) UnconfirmedTx(ctx context.Context, hash []byte) (*ctypes.ResultUnconfirmedTx, error) {
+	result := new(ctypes.ResultUnconfirmedTx)
+	_, err := c.caller.Call(ctx, "unconfirmed_tx", map[string]interface{}{"hash": hash}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) NumUnconfirmedTxs(ctx context.Context) (*ctypes.ResultUnconfirmedTxs, error) {
 	result := new(ctypes.ResultUnconfirmedTxs)
 	_, err := c.caller.Call(ctx, "num_unconfirmed_txs", map[string]interface{}{}, result)
@@ -318,6 +327,15 @@ func (c *baseRPCClient) CheckTx(ctx context.Context, tx types.Tx) (*ctypes.Resul
 	return result, nil
 }
 
+func (c *baseRPCClient) RemoveTx(ctx context.Context, hash []byte) (*ctypes.ResultRemoveTx, error) {
+	result := new(ctypes.ResultRemoveTx)
+	_, err := c.caller.Call(ctx, "remove_tx", map[string]interface{}{"hash": hash}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	result := new(ctypes.ResultNetInfo)
 	_, err := c.caller.Call(ctx, "net_info", map[string]interface{}{}, result)
@@ -448,6 +466,19 @@ func (c *baseRPCClient) Commit(ctx context.Context, height *int64) (*ctypes.Resu
 	return result, nil
 }
 
+func (c *baseRPCClient) RandomBeacon(ctx context.Context, height *int64) (*ctypes.ResultRandomBeacon, error) {
+	result := new(ctypes.ResultRandomBeacon)
+	params := make(map[string]interface{})
+	if height != nil {
+		params["height"] = height
+	}
+	_, err := c.caller.Call(ctx, "random_beacon", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	result := new(ctypes.ResultTx)
 	params := map[string]interface{}{
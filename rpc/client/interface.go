@@ -69,6 +69,7 @@ type SignClient interface {
 	BlockByHash(ctx context.Context, hash []byte) (*ctypes.ResultBlock, error)
 	BlockResults(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error)
 	Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error)
+	RandomBeacon(ctx context.Context, height *int64) (*ctypes.ResultRandomBeacon, error)
 	Validators(ctx context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error)
 	Voters(ctx context.Context, height *int64, page, perPage *int) (*ctypes.ResultVoters, error)
 	Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error)
@@ -117,8 +118,12 @@ type EventsClient interface {
 // MempoolClient shows us data about current mempool state.
 type MempoolClient interface {
 	UnconfirmedTxs(ctx context.Context, limit *int) (*ctypes.ResultUnconfirmedTxs, error)
+	UnconfirmedTx(ctx context.Context, hash []byte) (*ctypes.ResultUnconfirmedTx, error)
 	NumUnconfirmedTxs(context.Context) (*ctypes.ResultUnconfirmedTxs, error)
 	CheckTx(context.Context, types.Tx) (*ctypes.ResultCheckTx, error)
+	// RemoveTx is only available when the unsafe routes are enabled. The tx is
+	// kept in the cache, so it can't be resubmitted until it's evicted.
+	RemoveTx(ctx context.Context, hash []byte) (*ctypes.ResultRemoveTx, error)
 }
 
 // EvidenceClient is used for submitting an evidence of the malicious
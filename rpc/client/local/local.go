@@ -103,6 +103,10 @@ func (c *Local) UnconfirmedTxs(ctx context.Context, limit *int) (*ctypes.ResultU
 	return core.UnconfirmedTxs(c.ctx, limit)
 }
 
+func (c *Local) UnconfirmedTx(ctx context.Context, hash []byte) (*ctypes.ResultUnconfirmedTx, error) {
+	return core.UnconfirmedTx(c.ctx, hash)
+}
+
 func (c *Local) NumUnconfirmedTxs(ctx context.Context) (*ctypes.ResultUnconfirmedTxs, error) {
 	return core.NumUnconfirmedTxs(c.ctx)
 }
@@ -111,6 +115,10 @@ func (c *Local) CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultCheckTx
 	return core.CheckTx(c.ctx, tx)
 }
 
+func (c *Local) RemoveTx(ctx context.Context, hash []byte) (*ctypes.ResultRemoveTx, error) {
+	return core.UnsafeRemoveTx(c.ctx, hash)
+}
+
 func (c *Local) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	return core.NetInfo(c.ctx)
 }
@@ -169,6 +177,10 @@ func (c *Local) Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit
 	return core.Commit(c.ctx, height)
 }
 
+func (c *Local) RandomBeacon(ctx context.Context, height *int64) (*ctypes.ResultRandomBeacon, error) {
+	return core.RandomBeacon(c.ctx, height)
+}
+
 func (c *Local) Validators(ctx context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error) {
 	return core.Validators(c.ctx, height, page, perPage)
 }
@@ -545,6 +545,52 @@ func (_m *Client) Quit() <-chan struct{} {
 	return r0
 }
 
+// RandomBeacon provides a mock function with given fields: ctx, height
+func (_m *Client) RandomBeacon(ctx context.Context, height *int64) (*coretypes.ResultRandomBeacon, error) {
+	ret := _m.Called(ctx, height)
+
+	var r0 *coretypes.ResultRandomBeacon
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) *coretypes.ResultRandomBeacon); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultRandomBeacon)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveTx provides a mock function with given fields: ctx, hash
+func (_m *Client) RemoveTx(ctx context.Context, hash []byte) (*coretypes.ResultRemoveTx, error) {
+	ret := _m.Called(ctx, hash)
+
+	var r0 *coretypes.ResultRemoveTx
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) *coretypes.ResultRemoveTx); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultRemoveTx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Reset provides a mock function with given fields:
 func (_m *Client) Reset() error {
 	ret := _m.Called()
@@ -705,6 +751,29 @@ func (_m *Client) TxSearch(ctx context.Context, query string, prove bool, page *
 	return r0, r1
 }
 
+// UnconfirmedTx provides a mock function with given fields: ctx, hash
+func (_m *Client) UnconfirmedTx(ctx context.Context, hash []byte) (*coretypes.ResultUnconfirmedTx, error) {
+	ret := _m.Called(ctx, hash)
+
+	var r0 *coretypes.ResultUnconfirmedTx
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) *coretypes.ResultUnconfirmedTx); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultUnconfirmedTx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnconfirmedTxs provides a mock function with given fields: ctx, limit
 func (_m *Client) UnconfirmedTxs(ctx context.Context, limit *int) (*coretypes.ResultUnconfirmedTxs, error) {
 	ret := _m.Called(ctx, limit)
@@ -194,6 +194,48 @@ func TestGenesisAndValidators(t *testing.T) {
 	}
 }
 
+func TestRandomBeacon(t *testing.T) {
+	for i, c := range GetClients() {
+		err := client.WaitForHeight(c, 3, nil)
+		require.NoError(t, err)
+
+		// the random beacon of the initial block is made of the genesis doc
+		h := int64(1)
+		beacon, err := client.GetVerifiedRandomBeacon(context.Background(), c, &h)
+		require.NoError(t, err, "%d", i)
+		gen, err := c.Genesis(context.Background())
+		require.NoError(t, err, "%d", i)
+		assert.EqualValues(t, gen.Genesis.Hash(), beacon.LastProofHash)
+
+		// the random beacon is made of the output of the previous block
+		h = 2
+		beacon2, err := client.GetVerifiedRandomBeacon(context.Background(), c, &h)
+		require.NoError(t, err, "%d", i)
+		assert.Equal(t, beacon.Output, beacon2.LastProofHash)
+		assert.NotEqual(t, beacon.Output, beacon2.Output)
+
+		// latest
+		_, err = client.GetVerifiedRandomBeacon(context.Background(), c, nil)
+		require.NoError(t, err, "%d", i)
+
+		// forged random beacons are rejected
+		commit, err := c.Commit(context.Background(), &h)
+		require.NoError(t, err)
+		vals, err := c.Validators(context.Background(), &h, nil, nil)
+		require.NoError(t, err)
+		valSet := &types.ValidatorSet{Validators: vals.Validators}
+		require.NoError(t, client.VerifyRandomBeacon(beacon2, commit.Header, valSet, ""))
+
+		forged := *beacon2
+		forged.Output = beacon.Output
+		assert.Error(t, client.VerifyRandomBeacon(&forged, commit.Header, valSet, ""))
+		forged = *beacon2
+		forged.LastProofHash = beacon2.Output
+		forged.Message = types.MakeRoundHash(forged.LastProofHash, forged.Height-1, forged.Round)
+		assert.Error(t, client.VerifyRandomBeacon(&forged, commit.Header, valSet, ""))
+	}
+}
+
 func TestABCIQuery(t *testing.T) {
 	for i, c := range GetClients() {
 		// write something
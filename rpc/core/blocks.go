@@ -3,6 +3,7 @@ package core
 import (
 	"fmt"
 
+	tmbytes "github.com/line/ostracon/libs/bytes"
 	tmmath "github.com/line/ostracon/libs/math"
 	ctypes "github.com/line/ostracon/rpc/core/types"
 	rpctypes "github.com/line/ostracon/rpc/jsonrpc/types"
@@ -127,6 +128,68 @@ func Commit(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCommit, erro
 	return ctypes.NewResultCommit(&header, commit, true), nil
 }
 
+// RandomBeacon gets the VRF proof and output of the block at a given height,
+// which can be used as publicly verifiable randomness.
+// If no height is provided, it will fetch the random beacon of the latest block.
+// More: https://docs.tendermint.com/master/rpc/#/Info/random_beacon
+func RandomBeacon(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultRandomBeacon, error) {
+	height, err := getHeight(env.BlockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	header, vals, err := loadHeaderAndValidators(height)
+	if err != nil {
+		return nil, err
+	}
+	_, proposer := vals.GetByAddress(header.ProposerAddress)
+	if proposer == nil {
+		return nil, fmt.Errorf("proposer %X of block %d is not a validator", header.ProposerAddress, height)
+	}
+	// The VRF suite is pinned in the genesis consensus params.
+	vrfSuite := env.GenDoc.ConsensusParams.Validator.VrfSuite
+	output, err := types.ProposerProofHash(header, vals, vrfSuite)
+	if err != nil {
+		return nil, err
+	}
+
+	// The output of the genesis doc is its hash.
+	lastProofHash := env.GenDoc.Hash()
+	if height > env.GenDoc.InitialHeight {
+		lastHeader, lastVals, err := loadHeaderAndValidators(height - 1)
+		if err != nil {
+			return nil, err
+		}
+		lastProofHash, err = types.ProposerProofHash(lastHeader, lastVals, vrfSuite)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ctypes.ResultRandomBeacon{
+		Height:          height,
+		Round:           header.Round,
+		ProposerAddress: header.ProposerAddress,
+		ProposerPubKey:  proposer.PubKey,
+		Proof:           header.Proof,
+		Output:          tmbytes.HexBytes(output),
+		LastProofHash:   lastProofHash,
+		Message:         types.MakeRoundHash(lastProofHash, height-1, header.Round),
+	}, nil
+}
+
+func loadHeaderAndValidators(height int64) (*types.Header, *types.ValidatorSet, error) {
+	blockMeta := env.BlockStore.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return nil, nil, fmt.Errorf("block %d is not available", height)
+	}
+	vals, err := env.StateStore.LoadValidators(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &blockMeta.Header, vals, nil
+}
+
 // BlockResults gets ABCIResults at a given height.
 // If no height is provided, it will fetch results for the latest block.
 //
@@ -132,3 +132,7 @@ func (mockBlockStore) LoadSeenCommit(height int64) *types.Commit         { retur
 func (mockBlockStore) PruneBlocks(height int64) (uint64, error)          { return 0, nil }
 func (mockBlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
 }
+func (mockBlockStore) SaveBlockWithExtendedCommit(block *types.Block, blockParts *types.PartSet,
+	extCommit *types.ExtendedCommit) {
+}
+func (mockBlockStore) LoadBlockExtendedCommit(height int64) *types.ExtendedCommit { return nil }
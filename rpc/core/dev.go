@@ -1,6 +1,8 @@
 package core
 
 import (
+	"fmt"
+
 	ctypes "github.com/line/ostracon/rpc/core/types"
 	rpctypes "github.com/line/ostracon/rpc/jsonrpc/types"
 )
@@ -10,3 +12,27 @@ func UnsafeFlushMempool(ctx *rpctypes.Context) (*ctypes.ResultUnsafeFlushMempool
 	env.Mempool.Flush()
 	return &ctypes.ResultUnsafeFlushMempool{}, nil
 }
+
+// UnsafeRemoveTx removes the transaction of the given hash from the mempool
+// and its WAL, so that it's not restored after a restart. The tx is kept in
+// the cache, so that it's not added again when it's received from the peers;
+// nor can it be resubmitted until it's evicted from the cache.
+func UnsafeRemoveTx(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultRemoveTx, error) {
+	txKey, err := txKeyFromHash(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	env.Mempool.Lock()
+	defer env.Mempool.Unlock()
+
+	// wait for the txs being rechecked, so that none of them is removed
+	if err := env.Mempool.FlushAppConn(); err != nil {
+		return nil, err
+	}
+	if _, ok := env.Mempool.UnconfirmedTxByKey(txKey); !ok {
+		return nil, fmt.Errorf("tx (%X) not found in the mempool", hash)
+	}
+	env.Mempool.RemoveTxByKey(txKey, false)
+	return &ctypes.ResultRemoveTx{}, nil
+}
@@ -146,6 +146,33 @@ func UnconfirmedTxs(ctx *rpctypes.Context, limitPtr *int) (*ctypes.ResultUnconfi
 		Txs:        txs}, nil
 }
 
+// UnconfirmedTx gets the unconfirmed transaction of the given hash, along with
+// the height when it was added to the mempool, the peers which have sent it
+// and its CheckTx response.
+// More: https://docs.tendermint.com/master/rpc/#/Info/unconfirmed_tx
+func UnconfirmedTx(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultUnconfirmedTx, error) {
+	txKey, err := txKeyFromHash(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	utx, ok := env.Mempool.UnconfirmedTxByKey(txKey)
+	if !ok {
+		return nil, fmt.Errorf("tx (%X) not found in the mempool", hash)
+	}
+
+	res := &ctypes.ResultUnconfirmedTx{
+		Hash:    hash,
+		Height:  utx.Height,
+		Tx:      utx.Tx,
+		Senders: utx.Senders,
+	}
+	if utx.CheckTx != nil {
+		res.CheckTx = *utx.CheckTx
+	}
+	return res, nil
+}
+
 // NumUnconfirmedTxs gets number of unconfirmed transactions.
 // More: https://docs.tendermint.com/master/rpc/#/Info/num_unconfirmed_txs
 func NumUnconfirmedTxs(ctx *rpctypes.Context) (*ctypes.ResultUnconfirmedTxs, error) {
@@ -165,3 +192,14 @@ func CheckTx(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultCheckTx, error)
 	}
 	return &ctypes.ResultCheckTx{ResponseCheckTx: *res}, nil
 }
+
+// txKeyFromHash converts the tx hash into the mempool TxKey. Both are the
+// SHA256 of the tx.
+func txKeyFromHash(hash []byte) ([mempl.TxKeySize]byte, error) {
+	var txKey [mempl.TxKeySize]byte
+	if len(hash) != mempl.TxKeySize {
+		return txKey, fmt.Errorf("expected tx hash of %d bytes, got %d", mempl.TxKeySize, len(hash))
+	}
+	copy(txKey[:], hash)
+	return txKey, nil
+}
@@ -0,0 +1,81 @@
+package core
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/ostracon/abci/example/kvstore"
+	abci "github.com/line/ostracon/abci/types"
+	cfg "github.com/line/ostracon/config"
+	"github.com/line/ostracon/libs/log"
+	mempl "github.com/line/ostracon/mempool"
+	"github.com/line/ostracon/p2p"
+	"github.com/line/ostracon/proxy"
+	rpctypes "github.com/line/ostracon/rpc/jsonrpc/types"
+	"github.com/line/ostracon/types"
+)
+
+func newTestMempool(t *testing.T) *mempl.CListMempool {
+	config := cfg.ResetTestRoot("rpc_core_mempool_test")
+	t.Cleanup(func() { os.RemoveAll(config.RootDir) })
+
+	appConnMem, err := proxy.NewLocalClientCreator(kvstore.NewApplication()).NewABCIClient()
+	require.NoError(t, err)
+	require.NoError(t, appConnMem.Start())
+	t.Cleanup(func() { appConnMem.Stop() }) // nolint:errcheck // ignore for tests
+
+	mempool := mempl.NewCListMempool(config.Mempool, appConnMem, 0)
+	mempool.SetLogger(log.TestingLogger())
+	return mempool
+}
+
+func TestUnconfirmedTx(t *testing.T) {
+	mempool := newTestMempool(t)
+	env = &Environment{Mempool: mempool}
+
+	tx := types.Tx("foo=bar")
+	peerID := p2p.ID("0123456789abcdef0123456789abcdef01234567")
+	require.NoError(t, mempool.CheckTx(tx, nil, mempl.TxInfo{SenderID: 1, SenderP2PID: peerID}))
+
+	res, err := UnconfirmedTx(&rpctypes.Context{}, tx.Hash())
+	require.NoError(t, err)
+	assert.EqualValues(t, tx.Hash(), res.Hash)
+	assert.EqualValues(t, 0, res.Height)
+	assert.Equal(t, tx, res.Tx)
+	assert.Equal(t, []p2p.ID{peerID}, res.Senders)
+	assert.Equal(t, abci.CodeTypeOK, res.CheckTx.Code)
+
+	// unknown tx
+	_, err = UnconfirmedTx(&rpctypes.Context{}, types.Tx("unknown").Hash())
+	assert.Error(t, err)
+
+	// invalid hash
+	_, err = UnconfirmedTx(&rpctypes.Context{}, []byte("invalid"))
+	assert.Error(t, err)
+}
+
+func TestUnsafeRemoveTx(t *testing.T) {
+	mempool := newTestMempool(t)
+	env = &Environment{Mempool: mempool}
+
+	tx := types.Tx("foo=bar")
+	require.NoError(t, mempool.CheckTx(tx, nil, mempl.TxInfo{}))
+
+	_, err := UnsafeRemoveTx(&rpctypes.Context{}, tx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, 0, mempool.Size())
+
+	// the tx stays in the cache
+	assert.Equal(t, mempl.ErrTxInCache, mempool.CheckTx(tx, nil, mempl.TxInfo{}))
+
+	// removing it again fails
+	_, err = UnsafeRemoveTx(&rpctypes.Context{}, tx.Hash())
+	assert.Error(t, err)
+
+	// invalid hash
+	_, err = UnsafeRemoveTx(&rpctypes.Context{}, []byte("invalid"))
+	assert.Error(t, err)
+}
@@ -63,6 +63,7 @@ func Status(ctx *rpctypes.Context) (*ctypes.ResultStatus, error) {
 			EarliestBlockHeight: earliestBlockHeight,
 			EarliestBlockTime:   time.Unix(0, earliestBlockTimeNano),
 			CatchingUp:          env.ConsensusReactor.WaitSync(),
+			HaltReason:          env.ConsensusState.HaltReason(),
 		},
 		ValidatorInfo: ctypes.ValidatorInfo{
 			Address:      env.PubKey.Address(),
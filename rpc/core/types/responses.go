@@ -59,6 +59,22 @@ func NewResultCommit(header *types.Header, commit *types.Commit,
 	}
 }
 
+// Random beacon of a block.
+//
+// Output is the VRF output of Proof, the proof of the proposer of the block
+// for Message, which is made of LastProofHash, the output of the previous
+// block, the height of the previous block and Round.
+type ResultRandomBeacon struct {
+	Height          int64          `json:"height"`
+	Round           int32          `json:"round"`
+	ProposerAddress types.Address  `json:"proposer_address"`
+	ProposerPubKey  crypto.PubKey  `json:"proposer_pub_key"`
+	Proof           bytes.HexBytes `json:"proof"`
+	Output          bytes.HexBytes `json:"output"`
+	LastProofHash   bytes.HexBytes `json:"last_proof_hash"`
+	Message         bytes.HexBytes `json:"message"`
+}
+
 // Info about the node's syncing state
 type SyncInfo struct {
 	LatestBlockHash   bytes.HexBytes `json:"latest_block_hash"`
@@ -72,6 +88,9 @@ type SyncInfo struct {
 	EarliestBlockTime   time.Time      `json:"earliest_block_time"`
 
 	CatchingUp bool `json:"catching_up"`
+
+	// why the node has halted at the halt height or time of its config, if it has
+	HaltReason string `json:"halt_reason"`
 }
 
 // Info about the node's validator
@@ -214,6 +233,15 @@ type ResultUnconfirmedTxs struct {
 	Txs        []types.Tx `json:"txs"`
 }
 
+// Single unconfirmed tx
+type ResultUnconfirmedTx struct {
+	Hash    bytes.HexBytes       `json:"hash"`
+	Height  int64                `json:"height"`
+	Tx      types.Tx             `json:"tx"`
+	Senders []p2p.ID             `json:"senders"`
+	CheckTx abci.ResponseCheckTx `json:"check_tx"`
+}
+
 // Info abci msg
 type ResultABCIInfo struct {
 	Response abci.ResponseInfo `json:"response"`
@@ -232,6 +260,7 @@ type ResultBroadcastEvidence struct {
 // empty results
 type (
 	ResultUnsafeFlushMempool struct{}
+	ResultRemoveTx           struct{}
 	ResultUnsafeProfile      struct{}
 	ResultSubscribe          struct{}
 	ResultUnsubscribe        struct{}
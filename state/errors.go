@@ -1,6 +1,10 @@
 package state
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/line/ostracon/version"
+)
 
 type (
 	ErrInvalidBlock error
@@ -52,6 +56,11 @@ type (
 	ErrNoABCIResponsesForHeight struct {
 		Height int64
 	}
+
+	ErrUnsupportedBlockProtocol struct {
+		Height        int64
+		BlockProtocol uint64
+	}
 )
 
 func (e ErrUnknownBlock) Error() string {
@@ -107,3 +116,14 @@ func (e ErrNoConsensusParamsForHeight) Error() string {
 func (e ErrNoABCIResponsesForHeight) Error() string {
 	return fmt.Sprintf("could not find results for height #%d", e.Height)
 }
+
+func (e ErrUnsupportedBlockProtocol) Error() string {
+	return fmt.Sprintf(
+		"block protocol %d from height #%d is not supported by the software, which supports %d to %d. "+
+			"Upgrade the software",
+		e.BlockProtocol,
+		e.Height,
+		version.MinBlockProtocol,
+		version.BlockProtocol,
+	)
+}
@@ -9,7 +9,6 @@ import (
 
 	abci "github.com/line/ostracon/abci/types"
 	cryptoenc "github.com/line/ostracon/crypto/encoding"
-	"github.com/line/ostracon/crypto/vrf"
 	"github.com/line/ostracon/libs/fail"
 	"github.com/line/ostracon/libs/log"
 	mempl "github.com/line/ostracon/mempool"
@@ -17,6 +16,7 @@ import (
 	tmproto "github.com/line/ostracon/proto/ostracon/types"
 	"github.com/line/ostracon/proxy"
 	"github.com/line/ostracon/types"
+	"github.com/line/ostracon/version"
 )
 
 //-----------------------------------------------------------------------------
@@ -94,13 +94,16 @@ func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher)
 // and txs from the mempool. The max bytes must be big enough to fit the commit.
 // Up to 1/10th of the block space is allcoated for maximum sized evidence.
 // The rest is given to txs, up to the max gas.
+// Once the vote extensions are enabled, the txs are passed to the app by
+// PrepareProposal along with the vote extensions of the lastExtCommit, and the
+// app returns the txs to propose.
 func (blockExec *BlockExecutor) CreateProposalBlock(
 	height int64,
-	state State, commit *types.Commit,
+	state State, lastExtCommit *types.ExtendedCommit,
 	proposerAddr []byte,
 	round int32,
 	proof crypto.Proof,
-) (*types.Block, *types.PartSet) {
+) (*types.Block, *types.PartSet, error) {
 
 	maxBytes := state.ConsensusParams.Block.MaxBytes
 	maxGas := state.ConsensusParams.Block.MaxGas
@@ -108,11 +111,115 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 	evidence, _ := blockExec.evpool.PendingEvidence(state.ConsensusParams.Evidence.MaxBytes)
 
 	// Fetch a limited amount of valid txs
+	commit := lastExtCommit.Commit
 	maxDataBytes := types.MaxDataBytes(maxBytes, commit, evidence)
 
 	txs := blockExec.mempool.ReapMaxBytesMaxGas(maxDataBytes, maxGas)
 
-	return state.MakeBlock(height, txs, commit, evidence, proposerAddr, round, proof)
+	// The apps that don't know the vote extensions aren't asked to prepare the
+	// proposal.
+	if types.VoteExtensionsEnabled(state.ConsensusParams.Abci, height) {
+		rpp, err := blockExec.proxyApp.PrepareProposalSync(abci.RequestPrepareProposal{
+			MaxTxBytes:      maxDataBytes,
+			Txs:             txs.ToSliceOfBytes(),
+			LocalLastCommit: buildExtendedCommitInfo(lastExtCommit, state.LastVoters, state.InitialHeight),
+			Height:          height,
+			ProposerAddress: proposerAddr,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		txs = types.ToTxs(rpp.Txs)
+		if size := types.ComputeProtoSizeForTxs(txs); size > maxDataBytes {
+			return nil, nil, fmt.Errorf("txs returned by PrepareProposal are too big %d (max: %d)", size, maxDataBytes)
+		}
+		if gas := blockExec.gasWanted(txs); maxGas > -1 && gas > maxGas {
+			return nil, nil, fmt.Errorf("txs returned by PrepareProposal want too much gas %d (max: %d)", gas, maxGas)
+		}
+	}
+
+	block, blockParts := state.MakeBlock(height, txs, commit, evidence, proposerAddr, round, proof)
+	return block, blockParts, nil
+}
+
+// gasWanted returns the total gas wanted by the txs in the mempool. The gas of
+// the txs added by the app is unknown, so the app must keep it within the max gas.
+func (blockExec *BlockExecutor) gasWanted(txs types.Txs) int64 {
+	var gas int64
+	for _, tx := range txs {
+		if memTx, ok := blockExec.mempool.UnconfirmedTxByKey(mempl.TxKey(tx)); ok && memTx.CheckTx != nil {
+			gas += memTx.CheckTx.GasWanted
+		}
+	}
+	return gas
+}
+
+// ProcessProposal asks the app whether the proposed block is acceptable. The
+// block must be validated by ValidateBlock beforehand. The block is accepted
+// without asking the app before the vote extensions are enabled.
+func (blockExec *BlockExecutor) ProcessProposal(block *types.Block, state State) (bool, error) {
+	if !types.VoteExtensionsEnabled(state.ConsensusParams.Abci, block.Height) {
+		return true, nil
+	}
+
+	pbh := block.Header.ToProto()
+	if pbh == nil {
+		return false, errors.New("nil header")
+	}
+
+	byzVals := make([]abci.Evidence, 0)
+	for _, evidence := range block.Evidence.Evidence {
+		byzVals = append(byzVals, evidence.ABCI()...)
+	}
+
+	res, err := blockExec.proxyApp.ProcessProposalSync(abci.RequestProcessProposal{
+		Hash:                block.Hash(),
+		Header:              *pbh,
+		Txs:                 block.Txs.ToSliceOfBytes(),
+		ProposedLastCommit:  getBeginBlockValidatorInfo(block, blockExec.store, state.InitialHeight, state.VoterParams),
+		ByzantineValidators: byzVals,
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.IsAccepted(), nil
+}
+
+// ExtendVote asks the app for the extension of the precommit for the given block.
+func (blockExec *BlockExecutor) ExtendVote(vote *types.Vote) ([]byte, error) {
+	res, err := blockExec.proxyApp.ExtendVoteSync(abci.RequestExtendVote{
+		Hash:   vote.BlockID.Hash,
+		Height: vote.Height,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.VoteExtension) > types.MaxVoteExtensionSize {
+		return nil, fmt.Errorf("vote extension from the app is too big %d (max: %d)",
+			len(res.VoteExtension), types.MaxVoteExtensionSize)
+	}
+	return res.VoteExtension, nil
+}
+
+// VerifyVoteExtension asks the app whether the extension of the precommit of
+// another validator is acceptable. The signature of the extension must be
+// verified beforehand.
+func (blockExec *BlockExecutor) VerifyVoteExtension(vote *types.Vote) error {
+	res, err := blockExec.proxyApp.VerifyVoteExtensionSync(abci.RequestVerifyVoteExtension{
+		Hash:             vote.BlockID.Hash,
+		ValidatorAddress: vote.ValidatorAddress,
+		Height:           vote.Height,
+		VoteExtension:    vote.Extension,
+	})
+	if err != nil {
+		return err
+	}
+	if !res.IsAccepted() {
+		return fmt.Errorf("vote extension of %X was rejected by the app (status: %v)",
+			vote.ValidatorAddress, res.Status)
+	}
+	return nil
 }
 
 // ValidateBlock validates the given block against the given state.
@@ -175,6 +282,12 @@ func (blockExec *BlockExecutor) ApplyBlock(
 	if len(validatorUpdates) > 0 {
 		blockExec.logger.Info("updates to validators", "updates", types.ValidatorListString(validatorUpdates))
 	}
+	if paramUpdates := abciResponses.EndBlock.ConsensusParamUpdates; paramUpdates != nil && paramUpdates.Version != nil {
+		nextParams := types.UpdateConsensusParams(state.ConsensusParams, paramUpdates)
+		if upgrade := nextParams.Version.Upgrade; !upgrade.Equal(state.ConsensusParams.Version.Upgrade) {
+			logUpgrade(blockExec.logger, upgrade)
+		}
+	}
 
 	// Update the state with the block and responses.
 	state, err = updateState(state, blockID, &block.Header, abciResponses, validatorUpdates)
@@ -390,6 +503,36 @@ func getBeginBlockValidatorInfo(block *types.Block, store Store,
 	}
 }
 
+// buildExtendedCommitInfo returns the vote extensions of the extended commit
+// along with the voters that signed it.
+func buildExtendedCommitInfo(extCommit *types.ExtendedCommit, voters *types.VoterSet,
+	initialHeight int64) abci.ExtendedCommitInfo {
+	// Initial block -> ExtendedCommitInfo.Votes are empty.
+	if extCommit.Height < initialHeight {
+		return abci.ExtendedCommitInfo{}
+	}
+
+	if commitSize, voterSetLen := extCommit.Size(), voters.Size(); commitSize != voterSetLen {
+		panic(fmt.Sprintf("commit size (%d) doesn't match voterset length (%d) at height %d",
+			commitSize, voterSetLen, extCommit.Height))
+	}
+
+	votes := make([]abci.ExtendedVoteInfo, extCommit.Size())
+	for i, voter := range voters.Voters {
+		votes[i] = abci.ExtendedVoteInfo{
+			Validator:       types.TM2PB.Validator(voter),
+			SignedLastBlock: !extCommit.Signatures[i].Absent(),
+			VoteExtension:   extCommit.Extensions[i].Extension,
+			VotingPower:     voter.VotingPower,
+		}
+	}
+
+	return abci.ExtendedCommitInfo{
+		Round: extCommit.Round,
+		Votes: votes,
+	}
+}
+
 func validateValidatorUpdates(abciUpdates []abci.ValidatorUpdate,
 	params tmproto.ValidatorParams) error {
 	for _, valUpdate := range abciUpdates {
@@ -455,20 +598,57 @@ func updateState(
 
 		state.Version.Consensus.App = nextParams.Version.AppVersion
 
+		// A new upgrade must not be scheduled before the next height.
+		upgrade := nextParams.Version.Upgrade
+		if !upgrade.Equal(state.ConsensusParams.Version.Upgrade) && upgrade.GetHeight() > 0 &&
+			upgrade.Height <= header.Height {
+			return state, fmt.Errorf("error updating consensus params: upgrade height %d is not after height %d",
+				upgrade.Height, header.Height)
+		}
+
+		// The vote extensions must not be enabled before the next height, nor
+		// changed once enabled.
+		if current, next := state.ConsensusParams.Abci.VoteExtensionsEnableHeight,
+			nextParams.Abci.VoteExtensionsEnableHeight; current != next {
+			if current > 0 && current <= header.Height {
+				return state, fmt.Errorf("error updating consensus params: vote extensions enabled at %d can't be changed",
+					current)
+			}
+			if next > 0 && next <= header.Height {
+				return state, fmt.Errorf("error updating consensus params: vote extensions enable height %d is not after height %d",
+					next, header.Height)
+			}
+		}
+
 		// Change results from this height but only applies to the next height.
 		lastHeightParamsChanged = header.Height + 1
 	}
 
+	// Switch to the block protocol of the upgrade from its height.
 	nextVersion := state.Version
+	if upgrade := nextParams.Version.Upgrade; upgrade.GetHeight() > 0 && header.Height+1 >= upgrade.Height {
+		nextVersion.Consensus.Block = upgrade.BlockVersion
+	}
+
+	// The voter params can't be changed before the block protocol whose
+	// ConsensusHash covers them, so that the light clients elect the voters of
+	// the earlier blocks with the voter params of the genesis.
+	if nextVersion.Consensus.Block < version.BlockProtocolExtendedParamsHash &&
+		!nextParams.Voter.Equal(&state.ConsensusParams.Voter) {
+		return state, fmt.Errorf("error updating consensus params: voter params can't be changed before block protocol %d",
+			version.BlockProtocolExtendedParamsHash)
+	}
 
 	// get proof hash from vrf proof
-	proofHash, err := vrf.ProofToHash(header.Proof.Bytes())
+	proofHash, err := types.ProposerProofHash(header, state.Validators, state.ConsensusParams.Validator.VrfSuite)
 	if err != nil {
 		return state, fmt.Errorf("error get proof of hash: %v", err)
 	}
 
+	// The voters of the next height are elected with the params of the next height.
+	voterParams := types.VoterParamsFromProto(&nextParams.Voter)
 	validators := state.NextValidators.Copy()
-	voters := types.SelectVoter(validators, proofHash, state.VoterParams)
+	voters := types.SelectVoter(types.VoterCandidates(validators, nextVersion.Consensus.Block), proofHash, voterParams)
 
 	// NOTE: the AppHash has not been populated.
 	// It will be filled on state.Save.
@@ -476,10 +656,11 @@ func updateState(
 		Version:                          nextVersion,
 		ChainID:                          state.ChainID,
 		InitialHeight:                    state.InitialHeight,
-		VoterParams:                      state.VoterParams,
+		VoterParams:                      voterParams,
 		LastBlockHeight:                  header.Height,
 		LastBlockID:                      blockID,
 		LastBlockTime:                    header.Time,
+		LastBlockVersion:                 header.Version.Block,
 		LastProofHash:                    proofHash,
 		NextValidators:                   nValSet,
 		Validators:                       validators,
@@ -493,6 +674,21 @@ func updateState(
 	}, nil
 }
 
+func logUpgrade(logger log.Logger, upgrade *tmproto.UpgradePlan) {
+	switch {
+	case upgrade.GetHeight() == 0:
+		logger.Info("cancelled the upgrade of the block protocol")
+	case version.IsBlockProtocolSupported(upgrade.BlockVersion):
+		logger.Info("scheduled an upgrade of the block protocol",
+			"block_version", upgrade.BlockVersion, "height", upgrade.Height)
+	default:
+		logger.Error("scheduled an upgrade to a block protocol the software does not support. "+
+			"The node will halt at the height unless the software is upgraded",
+			"block_version", upgrade.BlockVersion, "height", upgrade.Height,
+			"supported", fmt.Sprintf("%d to %d", version.MinBlockProtocol, version.BlockProtocol))
+	}
+}
+
 // Fire NewBlock, NewBlockHeader.
 // Fire TxEvent for every tx.
 // NOTE: if Tendermint crashes before commit, some or all of these events may be published again.
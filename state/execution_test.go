@@ -17,6 +17,7 @@ import (
 	cryptoenc "github.com/line/ostracon/crypto/encoding"
 	"github.com/line/ostracon/crypto/tmhash"
 	"github.com/line/ostracon/libs/log"
+	mempl "github.com/line/ostracon/mempool"
 	mmock "github.com/line/ostracon/mempool/mock"
 	tmproto "github.com/line/ostracon/proto/ostracon/types"
 	tmversion "github.com/line/ostracon/proto/ostracon/version"
@@ -103,7 +104,7 @@ func TestBeginBlockValidators(t *testing.T) {
 
 		proposer := state.Validators.SelectProposer(state.LastProofHash, 1, 0)
 		message := state.MakeHashMessage(0)
-		proof, _ := privVals[proposer.Address.String()].GenerateVRFProof(message)
+		proof, _ := privVals[proposer.Address.String()].GenerateVRFProof(message, "")
 
 		// block for height 2
 		block, _ := state.MakeBlock(2, makeTxs(2), lastCommit, nil, proposer.Address, 0, proof)
@@ -251,7 +252,7 @@ func TestBeginBlockByzantineValidators(t *testing.T) {
 	for _, tc := range testCases {
 		message := state.MakeHashMessage(0)
 		proposer := state.Validators.SelectProposer(state.LastProofHash, 1, 0)
-		proof, _ := privVals[proposer.Address.String()].GenerateVRFProof(message)
+		proof, _ := privVals[proposer.Address.String()].GenerateVRFProof(message, "")
 		block, _ := state.MakeBlock(10, makeTxs(2), lastCommit, nil, proposer.Address, 0, proof)
 		block.Time = now
 		block.Evidence.Evidence = tc.evidence
@@ -270,7 +271,7 @@ func TestBeginBlockByzantineValidators(t *testing.T) {
 	block.LastCommitHash = block.LastCommit.Hash()
 	block.Time = sm.MedianTime(block.LastCommit, state.LastVoters)
 	message := state.MakeHashMessage(block.Round)
-	proof, _ := privVal.GenerateVRFProof(message)
+	proof, _ := privVal.GenerateVRFProof(message, "")
 	block.Proof = bytes.HexBytes(proof)
 
 	state, retainHeight, err := blockExec.ApplyBlock(state, blockID, block)
@@ -281,6 +282,130 @@ func TestBeginBlockByzantineValidators(t *testing.T) {
 	assert.Equal(t, abciEv, app.ByzantineValidators)
 }
 
+// TestCreateProposalBlockPrepareProposal ensures the vote extensions are sent to
+// the app and the txs returned by the app are proposed.
+func TestCreateProposalBlockPrepareProposal(t *testing.T) {
+	app := &testApp{}
+	cc := proxy.NewLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, privVals := makeState(2, 2)
+	stateStore := sm.NewStore(stateDB)
+	mp := gasMempool{gasWanted: map[string]int64{"tx1": 60, "tx2": 50}}
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(),
+		mp, sm.EmptyEvidencePool{})
+
+	prevBlockID := types.BlockID{Hash: state.LastBlockID.Hash, PartSetHeader: types.PartSetHeader{}}
+	commitSigs := []types.CommitSig{
+		types.NewCommitSigForBlock(make([]byte, ed25519.SignatureSize), state.LastVoters.Voters[0].Address, tmtime.Now()),
+		types.NewCommitSigAbsent(),
+	}
+	extCommit := types.NewExtendedCommit(types.NewCommit(1, 0, prevBlockID, commitSigs), []types.VoteExtension{
+		{Extension: []byte("extension"), Signature: []byte("signature")},
+		{},
+	})
+
+	proposer := state.Validators.SelectProposer(state.LastProofHash, 2, 0)
+	proof, _ := privVals[proposer.Address.String()].GenerateVRFProof(state.MakeHashMessage(0), "")
+
+	// the app isn't asked to prepare the proposal before the vote extensions are enabled
+	app.PreparedTxs = [][]byte{[]byte("prepared tx")}
+	state.ConsensusParams.Abci.VoteExtensionsEnableHeight = 3
+	block, _, err := blockExec.CreateProposalBlock(2, state, extCommit, proposer.Address, 0, proof)
+	require.NoError(t, err)
+	assert.Empty(t, block.Txs)
+	assert.Empty(t, app.LocalLastCommit.Votes)
+
+	state.ConsensusParams.Abci.VoteExtensionsEnableHeight = 2
+	block, _, err = blockExec.CreateProposalBlock(2, state, extCommit, proposer.Address, 0, proof)
+	require.NoError(t, err)
+	assert.Equal(t, types.Txs{types.Tx("prepared tx")}, block.Txs)
+
+	require.Len(t, app.LocalLastCommit.Votes, 2)
+	assert.True(t, app.LocalLastCommit.Votes[0].SignedLastBlock)
+	assert.Equal(t, []byte("extension"), app.LocalLastCommit.Votes[0].VoteExtension)
+	assert.False(t, app.LocalLastCommit.Votes[1].SignedLastBlock)
+	assert.Empty(t, app.LocalLastCommit.Votes[1].VoteExtension)
+
+	// the txs returned by the app must fit in the block
+	app.PreparedTxs = [][]byte{make([]byte, state.ConsensusParams.Block.MaxBytes)}
+	_, _, err = blockExec.CreateProposalBlock(2, state, extCommit, proposer.Address, 0, proof)
+	assert.Error(t, err)
+
+	// and the gas wanted by the txs in the mempool must be within the max gas
+	state.ConsensusParams.Block.MaxGas = 100
+	app.PreparedTxs = [][]byte{[]byte("tx1"), []byte("prepared tx")}
+	_, _, err = blockExec.CreateProposalBlock(2, state, extCommit, proposer.Address, 0, proof)
+	assert.NoError(t, err)
+	app.PreparedTxs = [][]byte{[]byte("tx1"), []byte("tx2")}
+	_, _, err = blockExec.CreateProposalBlock(2, state, extCommit, proposer.Address, 0, proof)
+	assert.Error(t, err)
+}
+
+// gasMempool is a mempool with the txs wanting the given gas.
+type gasMempool struct {
+	mmock.Mempool
+	gasWanted map[string]int64
+}
+
+func (mp gasMempool) UnconfirmedTxByKey(txKey [mempl.TxKeySize]byte) (*mempl.UnconfirmedTx, bool) {
+	for tx, gas := range mp.gasWanted {
+		if mempl.TxKey(types.Tx(tx)) == txKey {
+			return &mempl.UnconfirmedTx{Tx: types.Tx(tx), CheckTx: &abci.ResponseCheckTx{GasWanted: gas}}, true
+		}
+	}
+	return nil, false
+}
+
+// TestProcessProposal ensures the proposed block is sent to the app to decide
+// whether it is acceptable.
+func TestProcessProposal(t *testing.T) {
+	app := &testApp{}
+	cc := proxy.NewLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, privVals := makeState(2, 2)
+	stateStore := sm.NewStore(stateDB)
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(),
+		mmock.Mempool{}, sm.EmptyEvidencePool{})
+
+	prevBlockID := types.BlockID{Hash: state.LastBlockID.Hash, PartSetHeader: types.PartSetHeader{}}
+	lastCommit := types.NewCommit(1, 0, prevBlockID, []types.CommitSig{
+		types.NewCommitSigForBlock([]byte("Signature1"), state.LastVoters.Voters[0].Address, tmtime.Now()),
+		types.NewCommitSigAbsent(),
+	})
+	proposer := state.Validators.SelectProposer(state.LastProofHash, 2, 0)
+	proof, _ := privVals[proposer.Address.String()].GenerateVRFProof(state.MakeHashMessage(0), "")
+	block, _ := state.MakeBlock(2, makeTxs(2), lastCommit, nil, proposer.Address, 0, proof)
+
+	// the app isn't asked to process the proposal before the vote extensions are enabled
+	app.RejectedTxs = [][]byte{block.Txs[1]}
+	accepted, err := blockExec.ProcessProposal(block, state)
+	require.NoError(t, err)
+	assert.True(t, accepted)
+	assert.Empty(t, app.ProposedLastCommit.Votes)
+
+	app.RejectedTxs = nil
+	state.ConsensusParams.Abci.VoteExtensionsEnableHeight = 2
+	accepted, err = blockExec.ProcessProposal(block, state)
+	require.NoError(t, err)
+	assert.True(t, accepted)
+	require.Len(t, app.ProposedLastCommit.Votes, 2)
+	assert.True(t, app.ProposedLastCommit.Votes[0].SignedLastBlock)
+	assert.False(t, app.ProposedLastCommit.Votes[1].SignedLastBlock)
+
+	app.RejectedTxs = [][]byte{block.Txs[1]}
+	accepted, err = blockExec.ProcessProposal(block, state)
+	require.NoError(t, err)
+	assert.False(t, accepted)
+}
+
 func TestValidateValidatorUpdates(t *testing.T) {
 	pubkey1 := ed25519.GenPrivKey().PubKey()
 	pubkey2 := ed25519.GenPrivKey().PubKey()
@@ -57,7 +57,7 @@ func makeAndCommitGoodBlock(
 func makeAndApplyGoodBlock(state sm.State, privVal types.PrivValidator, height int64, lastCommit *types.Commit,
 	proposerAddr []byte, blockExec *sm.BlockExecutor, evidence []types.Evidence) (sm.State, types.BlockID, error) {
 	message := state.MakeHashMessage(0)
-	proof, _ := privVal.GenerateVRFProof(message)
+	proof, _ := privVal.GenerateVRFProof(message, "")
 	block, _ := state.MakeBlock(height, makeTxs(height), lastCommit, evidence, proposerAddr, 0, proof)
 	if err := blockExec.ValidateBlock(state, 0, block); err != nil {
 		return state, types.BlockID{}, err
@@ -141,12 +141,15 @@ func makeState(nVals, height int) (sm.State, dbm.DB, map[string]types.PrivValida
 }
 
 func makeBlock(state sm.State, height int64) *types.Block {
-	return makeBlockWithPrivVal(state, makePrivVal(), height)
+	block := makeBlockWithPrivVal(state, makePrivVal(), height)
+	// the VRF output of the block is read with the key of its proposer, so it must be in the validator set
+	block.ProposerAddress = state.Validators.SelectProposer(state.LastProofHash, height, 0).Address
+	return block
 }
 
 func makeBlockWithPrivVal(state sm.State, privVal types.PrivValidator, height int64) *types.Block {
 	message := state.MakeHashMessage(0)
-	proof, _ := privVal.GenerateVRFProof(message)
+	proof, _ := privVal.GenerateVRFProof(message, "")
 	pubKey, _ := privVal.GetPubKey()
 	block, _ := state.MakeBlock(
 		height,
@@ -254,6 +257,10 @@ type testApp struct {
 	CommitVotes         []abci.VoteInfo
 	ByzantineValidators []abci.Evidence
 	ValidatorUpdates    []abci.ValidatorUpdate
+	LocalLastCommit     abci.ExtendedCommitInfo
+	PreparedTxs         [][]byte
+	ProposedLastCommit  abci.LastCommitInfo
+	RejectedTxs         [][]byte
 }
 
 var _ abci.Application = (*testApp)(nil)
@@ -276,6 +283,23 @@ func (app *testApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
 				AppVersion: 1}}}
 }
 
+func (app *testApp) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+	app.LocalLastCommit = req.LocalLastCommit
+	return abci.ResponsePrepareProposal{Txs: append(req.Txs, app.PreparedTxs...)}
+}
+
+func (app *testApp) ProcessProposal(req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+	app.ProposedLastCommit = req.ProposedLastCommit
+	for _, tx := range req.Txs {
+		for _, rejected := range app.RejectedTxs {
+			if bytes.Equal(tx, rejected) {
+				return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+			}
+		}
+	}
+	return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}
+}
+
 func (app *testApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
 	return abci.ResponseDeliverTx{Events: []abci.Event{}}
 }
@@ -0,0 +1,136 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/line/ostracon/types"
+)
+
+// Rollback overwrites the current Ostracon state (height n) with the most
+// recent previous state (height n - 1).
+// Note that this function does not affect application state.
+// The block store is left intact, so that the block n is executed again on
+// the next start.
+func Rollback(bs BlockStore, ss Store) (int64, []byte, error) {
+	invalidState, err := ss.Load()
+	if err != nil {
+		return -1, nil, err
+	}
+	if invalidState.IsEmpty() {
+		return -1, nil, errors.New("no state found")
+	}
+
+	height := bs.Height()
+
+	// NOTE: persistence of state and blocks don't happen atomically. Therefore it is possible that
+	// when the user stopped the node the state wasn't updated but the blockstore was. In this situation
+	// we don't need to rollback any state and can just return early
+	if height == invalidState.LastBlockHeight+1 {
+		return invalidState.LastBlockHeight, invalidState.AppHash, nil
+	}
+
+	// If the state store isn't one below nor equal to the blockstore height than this violates the
+	// invariant
+	if height != invalidState.LastBlockHeight {
+		return -1, nil, fmt.Errorf("statestore height (%d) is not one below or equal to blockstore height (%d)",
+			invalidState.LastBlockHeight, height)
+	}
+
+	// state store height is equal to blockstore height. We're good to proceed with rolling back state
+	rollbackHeight := invalidState.LastBlockHeight - 1
+	rollbackBlock := bs.LoadBlockMeta(rollbackHeight)
+	if rollbackBlock == nil {
+		return -1, nil, fmt.Errorf("block at height %d not found", rollbackHeight)
+	}
+	// We also need to retrieve the latest block because the app hash and last results hash is only agreed upon
+	// in the following block.
+	latestBlock := bs.LoadBlockMeta(invalidState.LastBlockHeight)
+	if latestBlock == nil {
+		return -1, nil, fmt.Errorf("block at height %d not found", invalidState.LastBlockHeight)
+	}
+
+	previousValidatorSet, err := ss.LoadValidators(rollbackHeight + 1)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	previousParams, err := ss.LoadConsensusParams(rollbackHeight + 1)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	// The voters are elected with the params of their height, or the params of the state if those
+	// have not been stored.
+	voterParams := invalidState.VoterParams
+	if !previousParams.Voter.Equal(&tmproto.VoterParams{}) {
+		voterParams = types.VoterParamsFromProto(&previousParams.Voter)
+	}
+
+	previousLastVoterSet, err := ss.LoadVoters(rollbackHeight, voterParams)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	previousProofHash, err := ss.LoadProofHash(rollbackHeight + 1)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	valChangeHeight := invalidState.LastHeightValidatorsChanged
+	// this can only happen if the validator set changed in the last block. The next validators of the
+	// rolled back state are then saved as a whole, which is right whether or not they have changed
+	if valChangeHeight > rollbackHeight+2 {
+		valChangeHeight = rollbackHeight + 2
+	}
+
+	paramsChangeHeight := invalidState.LastHeightConsensusParamsChanged
+	// this can only happen if params changed from the last block
+	if paramsChangeHeight > rollbackHeight {
+		paramsChangeHeight = rollbackHeight + 1
+	}
+
+	// the block protocol may have been switched by an upgrade from the latest height
+	version := invalidState.Version
+	version.Consensus.Block = latestBlock.Header.Version.Block
+	version.Consensus.App = previousParams.Version.AppVersion
+
+	// build the new state from the old state and the prior block
+	rolledBackState := State{
+		Version: version,
+		// immutable fields
+		ChainID:       invalidState.ChainID,
+		InitialHeight: invalidState.InitialHeight,
+
+		VoterParams: voterParams,
+
+		LastBlockHeight:  rollbackBlock.Header.Height,
+		LastBlockID:      rollbackBlock.BlockID,
+		LastBlockTime:    rollbackBlock.Header.Time,
+		LastBlockVersion: rollbackBlock.Header.Version.Block,
+
+		LastProofHash: previousProofHash,
+
+		NextValidators:              invalidState.Validators,
+		Validators:                  previousValidatorSet,
+		Voters:                      invalidState.LastVoters,
+		LastVoters:                  previousLastVoterSet,
+		LastHeightValidatorsChanged: valChangeHeight,
+
+		ConsensusParams:                  previousParams,
+		LastHeightConsensusParamsChanged: paramsChangeHeight,
+
+		LastResultsHash: latestBlock.Header.LastResultsHash,
+		AppHash:         latestBlock.Header.AppHash,
+	}
+
+	// persist the new state. This overrides the invalid one. NOTE: this will also
+	// persist the validator set, the voters, the proof hash and consensus params over
+	// the existing structures, but they should be the same
+	if err := ss.Save(rolledBackState); err != nil {
+		return -1, nil, fmt.Errorf("failed to save rolled back state: %w", err)
+	}
+
+	return rolledBackState.LastBlockHeight, rolledBackState.AppHash, nil
+}
@@ -0,0 +1,158 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/line/ostracon/crypto/tmhash"
+	sm "github.com/line/ostracon/state"
+	"github.com/line/ostracon/types"
+	tmtime "github.com/line/ostracon/types/time"
+)
+
+type rollbackBlockStore struct {
+	sm.BlockStore
+	height int64
+	metas  map[int64]*types.BlockMeta
+}
+
+func (bs *rollbackBlockStore) Height() int64 {
+	return bs.height
+}
+
+func (bs *rollbackBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
+	return bs.metas[height]
+}
+
+func TestRollback(t *testing.T) {
+	const height int64 = 100
+	stateStore, initialState, newState := setupRollbackStates(t, height)
+
+	blockStore := &rollbackBlockStore{
+		height: height,
+		metas: map[int64]*types.BlockMeta{
+			height - 1: {
+				BlockID: initialState.LastBlockID,
+				Header:  types.Header{Height: height - 1, Time: initialState.LastBlockTime},
+			},
+			height: {
+				BlockID: newState.LastBlockID,
+				Header: types.Header{
+					Version:         initialState.Version.Consensus,
+					Height:          height,
+					Time:            newState.LastBlockTime,
+					AppHash:         initialState.AppHash,
+					LastResultsHash: initialState.LastResultsHash,
+				},
+			},
+		},
+	}
+
+	rollbackHeight, rollbackHash, err := sm.Rollback(blockStore, stateStore)
+	require.NoError(t, err)
+	assert.Equal(t, height-1, rollbackHeight)
+	assert.Equal(t, initialState.AppHash, rollbackHash)
+
+	loadedState, err := stateStore.Load()
+	require.NoError(t, err)
+	assert.Equal(t, initialState.LastBlockHeight, loadedState.LastBlockHeight)
+	assert.Equal(t, initialState.LastBlockID, loadedState.LastBlockID)
+	assert.True(t, initialState.LastBlockTime.Equal(loadedState.LastBlockTime))
+	assert.Equal(t, initialState.LastProofHash, loadedState.LastProofHash)
+	assert.Equal(t, initialState.AppHash, loadedState.AppHash)
+	assert.Equal(t, initialState.LastResultsHash, loadedState.LastResultsHash)
+	assert.Equal(t, initialState.Version, loadedState.Version)
+	assert.Equal(t, initialState.ConsensusParams, loadedState.ConsensusParams)
+	assert.Equal(t, initialState.VoterParams, loadedState.VoterParams)
+	assert.Equal(t, initialState.NextValidators.Hash(), loadedState.NextValidators.Hash())
+	assert.Equal(t, initialState.Validators.Hash(), loadedState.Validators.Hash())
+	assert.Equal(t, initialState.Voters.Hash(), loadedState.Voters.Hash())
+	assert.Equal(t, initialState.LastVoters.Hash(), loadedState.LastVoters.Hash())
+
+	// the next validators stored by the rolled back state are the ones before the change
+	nextVals, err := stateStore.LoadValidators(height + 1)
+	require.NoError(t, err)
+	assert.Equal(t, initialState.NextValidators.Hash(), nextVals.Hash())
+}
+
+func TestRollbackNoState(t *testing.T) {
+	stateStore := sm.NewStore(dbm.NewMemDB())
+	blockStore := &rollbackBlockStore{}
+
+	_, _, err := sm.Rollback(blockStore, stateStore)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no state found")
+}
+
+func TestRollbackNoBlocks(t *testing.T) {
+	const height int64 = 100
+	stateStore, _, _ := setupRollbackStates(t, height)
+
+	// the blocks have been pruned
+	blockStore := &rollbackBlockStore{height: height}
+	_, _, err := sm.Rollback(blockStore, stateStore)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "block at height 99 not found")
+}
+
+func TestRollbackDifferentStateHeight(t *testing.T) {
+	const height int64 = 100
+	stateStore, _, newState := setupRollbackStates(t, height)
+
+	// the block store is one height ahead, so there is nothing to roll back
+	blockStore := &rollbackBlockStore{height: height + 1}
+	rollbackHeight, rollbackHash, err := sm.Rollback(blockStore, stateStore)
+	require.NoError(t, err)
+	assert.Equal(t, height, rollbackHeight)
+	assert.Equal(t, newState.AppHash, rollbackHash)
+
+	blockStore.height = height + 2
+	_, _, err = sm.Rollback(blockStore, stateStore)
+	require.Error(t, err)
+	require.Equal(t, err.Error(), "statestore height (100) is not one below or equal to blockstore height (102)")
+}
+
+// setupRollbackStates saves the state at height-1 and then the state at height, whose block changes
+// the validator set and the consensus params, and upgrades the block protocol from height+1.
+func setupRollbackStates(t *testing.T, height int64) (sm.Store, sm.State, sm.State) {
+	initialState, stateDB, _ := makeState(2, int(height))
+	stateStore := sm.NewStore(stateDB)
+	initialState.LastBlockID = makeBlockID(tmhash.Sum([]byte("block_hash_99")), 1, tmhash.Sum([]byte("part_hash")))
+	initialState.LastBlockTime = tmtime.Now()
+	initialState.LastProofHash = tmhash.Sum([]byte("proof_hash_99"))
+	initialState.AppHash = tmhash.Sum([]byte("app_hash_99"))
+	initialState.LastResultsHash = tmhash.Sum([]byte("last_results_hash_99"))
+	require.NoError(t, stateStore.Save(initialState))
+
+	nextVals := initialState.NextValidators.Copy()
+	require.NoError(t, nextVals.UpdateWithChangeSet([]*types.Validator{
+		types.NewValidator(nextVals.Validators[0].PubKey, 2000),
+	}))
+	nextVals.IncrementProposerPriority(1)
+
+	params := initialState.ConsensusParams
+	params.Version.AppVersion = 10
+
+	newState := initialState.Copy()
+	newState.Version.Consensus.App = 10
+	newState.Version.Consensus.Block++
+	newState.LastBlockHeight = height
+	newState.LastBlockID = makeBlockID(tmhash.Sum([]byte("block_hash_100")), 1, tmhash.Sum([]byte("part_hash")))
+	newState.LastBlockTime = initialState.LastBlockTime.Add(1)
+	newState.LastProofHash = tmhash.Sum([]byte("proof_hash_100"))
+	newState.Validators = initialState.NextValidators.Copy()
+	newState.NextValidators = nextVals
+	newState.Voters = types.SelectVoter(newState.Validators, newState.LastProofHash, newState.VoterParams)
+	newState.LastVoters = initialState.Voters.Copy()
+	newState.LastHeightValidatorsChanged = height + 2
+	newState.ConsensusParams = params
+	newState.LastHeightConsensusParamsChanged = height + 1
+	newState.AppHash = tmhash.Sum([]byte("app_hash_100"))
+	newState.LastResultsHash = tmhash.Sum([]byte("last_results_hash_100"))
+	require.NoError(t, stateStore.Save(newState))
+
+	return stateStore, initialState, newState
+}
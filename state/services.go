@@ -23,6 +23,7 @@ type BlockStore interface {
 	LoadBlock(height int64) *types.Block
 
 	SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit)
+	SaveBlockWithExtendedCommit(block *types.Block, blockParts *types.PartSet, extCommit *types.ExtendedCommit)
 
 	PruneBlocks(height int64) (uint64, error)
 
@@ -31,6 +32,7 @@ type BlockStore interface {
 
 	LoadBlockCommit(height int64) *types.Commit
 	LoadSeenCommit(height int64) *types.Commit
+	LoadBlockExtendedCommit(height int64) *types.ExtendedCommit
 }
 
 //-----------------------------------------------------------------------------
@@ -53,7 +55,9 @@ type EmptyEvidencePool struct{}
 func (EmptyEvidencePool) PendingEvidence(maxBytes int64) (ev []types.Evidence, size int64) {
 	return nil, 0
 }
-func (EmptyEvidencePool) AddEvidence(types.Evidence) error                { return nil }
-func (EmptyEvidencePool) Update(State, types.EvidenceList)                {}
-func (EmptyEvidencePool) CheckEvidence(evList types.EvidenceList) error   { return nil }
-func (EmptyEvidencePool) ReportConflictingVotes(voteA, voteB *types.Vote) {}
+func (EmptyEvidencePool) AddEvidence(types.Evidence) error                                          { return nil }
+func (EmptyEvidencePool) Update(State, types.EvidenceList)                                          {}
+func (EmptyEvidencePool) CheckEvidence(evList types.EvidenceList) error                             { return nil }
+func (EmptyEvidencePool) ReportConflictingVotes(voteA, voteB *types.Vote)                           {}
+func (EmptyEvidencePool) ReportConflictingProposals(proposalA, proposalB *types.Proposal)           {}
+func (EmptyEvidencePool) ReportInvalidProposerProof(proposal *types.Proposal, header *types.Header) {}
@@ -51,12 +51,18 @@ type State struct {
 	// immutable
 	ChainID       string
 	InitialHeight int64 // should be 1, not 0, when starting from height 1
-	VoterParams   *types.VoterParams
+
+	// VoterParams are the ConsensusParams.Voter used for electing the Voters.
+	VoterParams *types.VoterParams
 
 	// LastBlockHeight=0 at genesis (ie. block(H=0) does not exist)
 	LastBlockHeight int64
 	LastBlockID     types.BlockID
 	LastBlockTime   time.Time
+	// LastBlockVersion is the block protocol of the last block, whose rules
+	// apply to verifying block.LastCommit. Version.Consensus.Block is the block
+	// protocol of the next block, which differs from it at an upgrade height.
+	LastBlockVersion uint64
 
 	// vrf hash from proof
 	LastProofHash []byte
@@ -98,9 +104,10 @@ func (state State) Copy() State {
 		InitialHeight: state.InitialHeight,
 		VoterParams:   state.VoterParams,
 
-		LastBlockHeight: state.LastBlockHeight,
-		LastBlockID:     state.LastBlockID,
-		LastBlockTime:   state.LastBlockTime,
+		LastBlockHeight:  state.LastBlockHeight,
+		LastBlockID:      state.LastBlockID,
+		LastBlockTime:    state.LastBlockTime,
+		LastBlockVersion: state.LastBlockVersion,
 
 		LastProofHash: state.LastProofHash,
 
@@ -160,6 +167,7 @@ func (state *State) ToProto() (*tmstate.State, error) {
 
 	sm.LastBlockID = state.LastBlockID.ToProto()
 	sm.LastBlockTime = state.LastBlockTime
+	sm.LastBlockVersion = state.LastBlockVersion
 	vals, err := state.Validators.ToProto()
 	if err != nil {
 		return nil, err
@@ -219,6 +227,11 @@ func StateFromProto(pb *tmstate.State) (*State, error) { //nolint:golint
 	state.LastBlockID = *bi
 	state.LastBlockHeight = pb.LastBlockHeight
 	state.LastBlockTime = pb.LastBlockTime
+	state.LastBlockVersion = pb.LastBlockVersion
+	if state.LastBlockVersion == 0 && state.LastBlockHeight > 0 {
+		// the state was saved before the block protocol of the last block was recorded
+		state.LastBlockVersion = state.Version.Consensus.Block
+	}
 
 	vals, err := types.ValidatorSetFromProto(pb.Validators)
 	if err != nil {
@@ -226,7 +239,7 @@ func StateFromProto(pb *tmstate.State) (*State, error) { //nolint:golint
 	}
 	state.Validators = vals
 
-	voters, err := types.VoterSetFromProto(pb.Voters)
+	voters, err := types.VoterSetFromProto(pb.Voters, state.Version.Consensus.Block)
 	if err != nil {
 		return nil, err
 	}
@@ -239,7 +252,7 @@ func StateFromProto(pb *tmstate.State) (*State, error) { //nolint:golint
 	state.NextValidators = nVals
 
 	if state.LastBlockHeight >= 1 { // At Block 1 LastVoters is nil
-		lVoters, err := types.VoterSetFromProto(pb.LastVoters)
+		lVoters, err := types.VoterSetFromProto(pb.LastVoters, state.LastBlockVersion)
 		if err != nil {
 			return nil, err
 		}
@@ -250,6 +263,10 @@ func StateFromProto(pb *tmstate.State) (*State, error) { //nolint:golint
 
 	state.LastHeightValidatorsChanged = pb.LastHeightValidatorsChanged
 	state.ConsensusParams = pb.ConsensusParams
+	if state.ConsensusParams.Voter.Equal(&tmproto.VoterParams{}) && state.VoterParams != nil {
+		// the state was saved before the voter params became a part of the consensus params
+		state.ConsensusParams.Voter = *state.VoterParams.ToProto()
+	}
 	state.LastHeightConsensusParamsChanged = pb.LastHeightConsensusParamsChanged
 	state.LastResultsHash = pb.LastResultsHash
 	state.AppHash = pb.AppHash
@@ -280,9 +297,12 @@ func (state State) MakeBlock(
 
 	// Set time.
 	var timestamp time.Time
-	if height == state.InitialHeight {
+	switch {
+	case state.ConsensusParams.Timestamp.ProposerBased:
+		timestamp = ProposerTime(state.LastBlockTime, height == state.InitialHeight)
+	case height == state.InitialHeight:
 		timestamp = state.LastBlockTime // genesis time
-	} else {
+	default:
 		timestamp = MedianTime(commit, state.LastVoters)
 	}
 
@@ -291,13 +311,19 @@ func (state State) MakeBlock(
 		state.Version.Consensus, state.ChainID,
 		timestamp, state.LastBlockID,
 		state.Voters.Hash(), state.Validators.Hash(), state.NextValidators.Hash(),
-		types.HashConsensusParams(state.ConsensusParams), state.AppHash, state.LastResultsHash,
+		types.HashConsensusParams(state.ConsensusParams, state.Version.Consensus.Block), state.AppHash, state.LastResultsHash,
 		proposerAddress,
 		round,
 		proof,
 	)
 
-	return block, block.MakePartSet(types.BlockPartSizeBytes)
+	return block, state.MakePartSet(block)
+}
+
+// MakePartSet returns the PartSet of the block, which is erasure coded if the consensus
+// params say so.
+func (state State) MakePartSet(block *types.Block) *types.PartSet {
+	return block.MakeErasureCodedPartSet(types.BlockPartSizeBytes, state.ConsensusParams.PartSet.ParityPercentage)
 }
 
 // MedianTime computes a median time for a given Commit (based on Timestamp field of votes messages) and the
@@ -323,6 +349,20 @@ func MedianTime(commit *types.Commit, voters *types.VoterSet) time.Time {
 	return tmtime.WeightedMedian(weightedTimes, totalVotingPower)
 }
 
+// ProposerTime returns the local time of the proposer as the time of the block proposed under the
+// proposer-based timestamps. The time never goes back before the genesis time for the initial block, and
+// always goes past the last block time for the rest.
+func ProposerTime(lastBlockTime time.Time, initial bool) time.Time {
+	now := tmtime.Now()
+	switch {
+	case initial && now.Before(lastBlockTime):
+		return lastBlockTime
+	case !initial && !now.After(lastBlockTime):
+		return lastBlockTime.Add(time.Millisecond)
+	}
+	return now
+}
+
 //------------------------------------------------------------------------
 // Genesis
 
@@ -366,6 +406,7 @@ func MakeGenesisState(genDoc *types.GenesisDoc) (State, error) {
 		validators := make([]*types.Validator, len(genDoc.Validators))
 		for i, val := range genDoc.Validators {
 			validators[i] = types.NewValidator(val.PubKey, val.Power)
+			validators[i].ProofOfPossession = val.ProofOfPossession
 		}
 		validatorSet = types.NewValidatorSet(validators)
 		nextValidatorSet = types.NewValidatorSet(validators)
@@ -377,9 +418,10 @@ func MakeGenesisState(genDoc *types.GenesisDoc) (State, error) {
 		InitialHeight: genDoc.InitialHeight,
 		VoterParams:   genDoc.VoterParams,
 
-		LastBlockHeight: 0,
-		LastBlockID:     types.BlockID{},
-		LastBlockTime:   genDoc.GenesisTime,
+		LastBlockHeight:  0,
+		LastBlockID:      types.BlockID{},
+		LastBlockTime:    genDoc.GenesisTime,
+		LastBlockVersion: InitStateVersion.Consensus.Block,
 
 		// genesis block use the hash of GenesisDoc instead for the `LastProofHash`
 		LastProofHash: genDoc.Hash(),
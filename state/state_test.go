@@ -18,6 +18,7 @@ import (
 	cfg "github.com/line/ostracon/config"
 	"github.com/line/ostracon/crypto/ed25519"
 	cryptoenc "github.com/line/ostracon/crypto/encoding"
+	"github.com/line/ostracon/libs/log"
 	"github.com/line/ostracon/libs/rand"
 	tmrand "github.com/line/ostracon/libs/rand"
 	tmstate "github.com/line/ostracon/proto/ostracon/state"
@@ -25,6 +26,7 @@ import (
 	sm "github.com/line/ostracon/state"
 	"github.com/line/ostracon/types"
 	tmtime "github.com/line/ostracon/types/time"
+	"github.com/line/ostracon/version"
 )
 
 // setupTestCase does setup common to all test cases.
@@ -994,6 +996,7 @@ func TestStoreLoadValidatorsIncrementsProposerPriority(t *testing.T) {
 	stateStore := sm.NewStore(stateDB)
 	state.Validators = genValSet(valSetSize)
 	state.Validators.SelectProposer([]byte{}, 1, 0)
+	state.Voters = types.ToVoterAll(state.Validators.Validators)
 	state.NextValidators = state.Validators.Copy()
 	state.NextValidators.SelectProposer([]byte{}, 2, 0)
 	err := stateStore.Save(state)
@@ -1106,6 +1109,7 @@ func TestConsensusParamsChangesSaveLoad(t *testing.T) {
 		params[i] = *types.DefaultConsensusParams()
 		params[i].Block.MaxBytes += int64(i)
 		params[i].Block.TimeIotaMs = 10
+		params[i].Voter.VoterElectionThreshold += int32(i)
 	}
 
 	// Build the params history by running updateState
@@ -1127,6 +1131,7 @@ func TestConsensusParamsChangesSaveLoad(t *testing.T) {
 		state, err = sm.UpdateState(state, blockID, &header, responses, validatorUpdates)
 
 		require.Nil(t, err)
+		require.Equal(t, types.VoterParamsFromProto(&cp.Voter), state.VoterParams)
 		err := stateStore.Save(state)
 		require.NoError(t, err)
 	}
@@ -1153,6 +1158,103 @@ func TestConsensusParamsChangesSaveLoad(t *testing.T) {
 	}
 }
 
+// TestUpdateStateUpgrade tests the block protocol is switched from the height
+// of the upgrade scheduled in the consensus params.
+func TestUpdateStateUpgrade(t *testing.T) {
+	tearDown, stateDB, state := setupTestCase(t)
+	defer tearDown(t)
+
+	blockVersion := state.Version.Consensus.Block
+	params := state.ConsensusParams
+
+	// the upgrade must not be scheduled for a past height
+	params.Version.Upgrade = &tmproto.UpgradePlan{BlockVersion: blockVersion + 1, Height: 1}
+	header, blockID, responses := makeHeaderPartsResponsesParams(state, params)
+	responses.EndBlock.ConsensusParamUpdates.Version = &params.Version
+	_, err := sm.UpdateState(state, blockID, &header, responses, nil)
+	require.Error(t, err)
+
+	// the upgrade scheduled by the block at height 1 is used from height 3, and
+	// the state keeps the block protocol of the last block to verify its commit
+	params.Version.Upgrade = &tmproto.UpgradePlan{BlockVersion: blockVersion + 1, Height: 3}
+	expected := []uint64{blockVersion, blockVersion + 1, blockVersion + 1}
+	expectedLast := blockVersion
+	for i, expectedVersion := range expected {
+		header, blockID, responses := makeHeaderPartsResponsesParams(state, params)
+		responses.EndBlock.ConsensusParamUpdates.Version = &params.Version
+		state, err = sm.UpdateState(state, blockID, &header, responses, nil)
+		require.NoError(t, err)
+		assert.Equal(t, expectedVersion, state.Version.Consensus.Block, "version of height %d", i+2)
+		assert.Equal(t, expectedLast, state.LastBlockVersion, "version of height %d", i+1)
+		expectedLast = expectedVersion
+	}
+
+	// the software refuses to validate blocks of the block protocol it does not support
+	blockExec := sm.NewBlockExecutor(sm.NewStore(stateDB), log.TestingLogger(), nil, nil, sm.EmptyEvidencePool{})
+	err = blockExec.ValidateBlock(state, 0, makeBlock(state, state.LastBlockHeight+1))
+	assert.Equal(t, sm.ErrUnsupportedBlockProtocol{Height: 4, BlockProtocol: blockVersion + 1}, err)
+}
+
+// TestUpdateStateVoteExtensionsEnableHeight tests the vote extensions are
+// enabled from a future height, and can't be changed once enabled.
+func TestUpdateStateVoteExtensionsEnableHeight(t *testing.T) {
+	tearDown, _, state := setupTestCase(t)
+	defer tearDown(t)
+
+	params := state.ConsensusParams
+
+	// the vote extensions must not be enabled from a past height
+	params.Abci.VoteExtensionsEnableHeight = 1
+	header, blockID, responses := makeHeaderPartsResponsesParams(state, params)
+	_, err := sm.UpdateState(state, blockID, &header, responses, nil)
+	require.Error(t, err)
+
+	// the vote extensions enabled from height 3 by the block at height 1 can be
+	// changed by the block at height 2
+	params.Abci.VoteExtensionsEnableHeight = 3
+	header, blockID, responses = makeHeaderPartsResponsesParams(state, params)
+	state, err = sm.UpdateState(state, blockID, &header, responses, nil)
+	require.NoError(t, err)
+	params.Abci.VoteExtensionsEnableHeight = 4
+	header, blockID, responses = makeHeaderPartsResponsesParams(state, params)
+	state, err = sm.UpdateState(state, blockID, &header, responses, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, state.ConsensusParams.Abci.VoteExtensionsEnableHeight)
+	header, blockID, responses = makeHeaderPartsResponsesParams(state, params)
+	state, err = sm.UpdateState(state, blockID, &header, responses, nil)
+	require.NoError(t, err)
+
+	// but not by the block at height 4
+	for _, enableHeight := range []int64{0, 10} {
+		params.Abci.VoteExtensionsEnableHeight = enableHeight
+		header, blockID, responses = makeHeaderPartsResponsesParams(state, params)
+		_, err = sm.UpdateState(state, blockID, &header, responses, nil)
+		assert.Error(t, err)
+	}
+}
+
+// TestUpdateStateVoterParams tests the voter params can't be changed before
+// the block protocol whose ConsensusHash commits to them.
+func TestUpdateStateVoterParams(t *testing.T) {
+	tearDown, _, state := setupTestCase(t)
+	defer tearDown(t)
+
+	params := state.ConsensusParams
+	params.Voter.VoterElectionThreshold++
+
+	legacy := state.Copy()
+	legacy.Version.Consensus.Block = version.BlockProtocolExtendedParamsHash - 1
+	header, blockID, responses := makeHeaderPartsResponsesParams(legacy, params)
+	_, err := sm.UpdateState(legacy, blockID, &header, responses, nil)
+	assert.Error(t, err)
+
+	state.Version.Consensus.Block = version.BlockProtocolExtendedParamsHash
+	header, blockID, responses = makeHeaderPartsResponsesParams(state, params)
+	state, err = sm.UpdateState(state, blockID, &header, responses, nil)
+	require.NoError(t, err)
+	assert.Equal(t, params.Voter, state.ConsensusParams.Voter)
+}
+
 func TestStateProto(t *testing.T) {
 	tearDown, _, state := setupTestCase(t)
 	defer tearDown(t)
@@ -1187,6 +1289,20 @@ func TestStateProto(t *testing.T) {
 	}
 }
 
+func TestStateFromProtoWithoutConsensusVoterParams(t *testing.T) {
+	tearDown, _, state := setupTestCase(t)
+	defer tearDown(t)
+
+	// states saved before the voter params became a part of the consensus params
+	pbs, err := state.ToProto()
+	require.NoError(t, err)
+	pbs.ConsensusParams.Voter = tmproto.VoterParams{}
+
+	smt, err := sm.StateFromProto(pbs)
+	require.NoError(t, err)
+	assert.Equal(t, *state.VoterParams.ToProto(), smt.ConsensusParams.Voter)
+}
+
 func TestState_MakeHashMessage(t *testing.T) {
 	_, _, state := setupTestCase(t)
 	message1 := state.MakeHashMessage(0)
@@ -1194,7 +1310,7 @@ func TestState_MakeHashMessage(t *testing.T) {
 	require.False(t, bytes.Equal(message1, message2))
 
 	privVal := makePrivVal()
-	proof, _ := privVal.GenerateVRFProof(message1)
+	proof, _ := privVal.GenerateVRFProof(message1, "")
 	pubKey, _ := privVal.GetPubKey()
 	output, _ := pubKey.VRFVerify(proof, message1)
 	state.LastProofHash = output
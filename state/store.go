@@ -33,6 +33,10 @@ func calcProofHashKey(height int64) []byte {
 	return []byte(fmt.Sprintf("proofHashKey:%v", height))
 }
 
+func calcVotersKey(height int64) []byte {
+	return []byte(fmt.Sprintf("votersKey:%v", height))
+}
+
 func calcConsensusParamsKey(height int64) []byte {
 	return []byte(fmt.Sprintf("consensusParamsKey:%v", height))
 }
@@ -62,6 +66,8 @@ type Store interface {
 	LoadValidators(int64) (*types.ValidatorSet, error)
 	// LoadVoters loads the voter set at a given height
 	LoadVoters(int64, *types.VoterParams) (*types.VoterSet, error)
+	// LoadProofHash loads the proof hash used to elect the proposer and the voters at a given height
+	LoadProofHash(int64) ([]byte, error)
 	// LoadABCIResponses loads the abciResponse for a given height
 	LoadABCIResponses(int64) (*tmstate.ABCIResponses, error)
 	// LoadConsensusParams loads the consensus params for a given height
@@ -173,6 +179,10 @@ func (store dbStore) save(state State, key []byte) error {
 			return err
 		}
 	}
+	// Save current voters.
+	if err := store.saveVotersInfo(nextHeight, state.Validators, state.Voters); err != nil {
+		return err
+	}
 	// Save next validators.
 	if err := store.saveValidatorsInfo(nextHeight+1, state.LastHeightValidatorsChanged, state.NextValidators); err != nil {
 		return err
@@ -209,12 +219,19 @@ func (store dbStore) Bootstrap(state State) error {
 		if err := store.saveValidatorsInfo(height-1, height-1, vals); err != nil {
 			return err
 		}
+		if err := store.saveVotersInfo(height-1, vals, state.LastVoters); err != nil {
+			return err
+		}
 	}
 
 	if err := store.saveValidatorsInfo(height, height, state.Validators); err != nil {
 		return err
 	}
 
+	if err := store.saveVotersInfo(height, state.Validators, state.Voters); err != nil {
+		return err
+	}
+
 	if err := store.saveValidatorsInfo(height+1, height+1, state.NextValidators); err != nil {
 		return err
 	}
@@ -224,7 +241,7 @@ func (store dbStore) Bootstrap(state State) error {
 		return err
 	}
 
-	if err := store.db.Set(calcProofHashKey(height+1), state.LastProofHash); err != nil {
+	if err := store.db.Set(calcProofHashKey(height), state.LastProofHash); err != nil {
 		return err
 	}
 	return store.db.SetSync(stateKey, state.Bytes())
@@ -336,6 +353,11 @@ func (store dbStore) PruneStates(from int64, to int64) error {
 			}
 		}
 
+		err = batch.Delete(calcVotersKey(h))
+		if err != nil {
+			return err
+		}
+
 		err = batch.Delete(calcABCIResponsesKey(h))
 		if err != nil {
 			return err
@@ -474,57 +496,124 @@ func (store dbStore) LoadValidators(height int64) (*types.ValidatorSet, error) {
 
 // LoadVoters loads the VoterSet for a given height.
 // Returns ErrNoValSetForHeight if the validator set can't be found for this height.
-// Returns ErrNoProofHashForHeight if the proof hash can't be found for this height.
+// Returns ErrNoProofHashForHeight if neither the voters nor the proof hash can be found for this height.
+// The voters elected when the block at this height was made are returned if they have been stored.
+// Otherwise, e.g. for heights stored by an older version, they are re-elected from the validator set
+// and the proof hash with the voter params of the height, or voterParams if those have not been stored.
 // We cannot get the voters for latest height, because we save next validators for latest height+1 and
 // proof hash for latest height
 func (store dbStore) LoadVoters(height int64, voterParams *types.VoterParams) (*types.VoterSet, error) {
-	valInfo, err := loadValidatorsInfo(store.db, height)
-	if err != nil || valInfo == nil {
-		return nil, ErrNoValSetForHeight{height}
+	vals, err := store.LoadValidators(height)
+	if err != nil {
+		return nil, err
 	}
-	if valInfo.ValidatorSet == nil {
-		lastStoredHeight := lastStoredHeightFor(height, valInfo.LastHeightChanged)
-		valInfo2, err := loadValidatorsInfo(store.db, lastStoredHeight)
-		if err != nil || valInfo2 == nil || valInfo2.ValidatorSet == nil {
-			return nil,
-				fmt.Errorf("couldn't find validators at height %d (height %d was originally requested): %w",
-					lastStoredHeight,
-					height,
-					err,
-				)
-		}
 
-		vs, err := types.ValidatorSetFromProto(valInfo2.ValidatorSet)
-		if err != nil {
-			return nil, err
-		}
-
-		vs.IncrementProposerPriority(tmmath.SafeConvertInt32(height - lastStoredHeight)) // mutate
-		vi2, err := vs.ToProto()
-		if err != nil {
-			return nil, err
-		}
-
-		valInfo2.ValidatorSet = vi2
-		valInfo = valInfo2
+	votersInfo, err := loadVotersInfo(store.db, height)
+	if err != nil {
+		return nil, err
+	}
+	if votersInfo != nil {
+		return votersFromVotersInfo(vals, votersInfo, height)
 	}
 
-	vip, err := types.ValidatorSetFromProto(valInfo.ValidatorSet)
+	proofHash, err := store.LoadProofHash(height)
 	if err != nil {
 		return nil, err
 	}
 
+	params, err := store.LoadConsensusParams(height)
+	if err == nil && !params.Voter.Equal(&tmproto.VoterParams{}) {
+		voterParams = types.VoterParamsFromProto(&params.Voter)
+	}
+
+	return types.SelectVoter(vals, proofHash, voterParams), nil
+}
+
+// LoadProofHash loads the proof hash for a given height, that is the VRF output of the proof in the
+// previous block, or the hash of the genesis doc at the initial height. The proposer and the voters
+// of the height are elected with it.
+// Returns ErrNoProofHashForHeight if the proof hash can't be found for this height.
+func (store dbStore) LoadProofHash(height int64) ([]byte, error) {
 	proofHash, err := store.db.Get(calcProofHashKey(height))
 	if err != nil {
 		// DATA HAS BEEN CORRUPTED OR THE SPEC HAS CHANGED
-		tmos.Exit(fmt.Sprintf(`LoadValidators: ProofHash has been corrupted or its spec has changed:
+		tmos.Exit(fmt.Sprintf(`LoadProofHash: ProofHash has been corrupted or its spec has changed:
                 %v\n`, err))
 	}
 	if len(proofHash) == 0 {
 		return nil, ErrNoProofHashForHeight{height}
 	}
+	return proofHash, nil
+}
+
+// loadVotersInfo returns nil without an error if the voters have not been stored for the height.
+func loadVotersInfo(db dbm.DB, height int64) (*tmstate.VotersInfo, error) {
+	buf, err := db.Get(calcVotersKey(height))
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) == 0 {
+		return nil, nil
+	}
+
+	v := new(tmstate.VotersInfo)
+	err = v.Unmarshal(buf)
+	if err != nil {
+		// DATA HAS BEEN CORRUPTED OR THE SPEC HAS CHANGED
+		tmos.Exit(fmt.Sprintf(`LoadVoters: Data has been corrupted or its spec has changed:
+                %v\n`, err))
+	}
+
+	return v, nil
+}
+
+// votersFromVotersInfo rebuilds the voter set from the validator set it was elected from.
+func votersFromVotersInfo(vals *types.ValidatorSet, votersInfo *tmstate.VotersInfo, height int64) (
+	*types.VoterSet, error) {
+	if len(votersInfo.ValidatorIndices) != len(votersInfo.VotingPowers) {
+		return nil, fmt.Errorf("voters at height %d have %d indices but %d voting powers",
+			height, len(votersInfo.ValidatorIndices), len(votersInfo.VotingPowers))
+	}
+	voters := make([]*types.Validator, len(votersInfo.ValidatorIndices))
+	for i, idx := range votersInfo.ValidatorIndices {
+		if idx < 0 || int(idx) >= vals.Size() {
+			return nil, fmt.Errorf("voter index %d at height %d is out of the validator set of size %d",
+				idx, height, vals.Size())
+		}
+		voter := vals.Validators[idx].Copy()
+		voter.VotingPower = votersInfo.VotingPowers[i]
+		voters[i] = voter
+	}
+	return types.WrapValidatorsToVoterSet(voters), nil
+}
+
+// saveVotersInfo persists the voters elected from the validator set as their indices in it.
+//
+// `height` is the height for which the voters are responsible for signing. Nothing is persisted
+// if the voter set is nil, so that LoadVoters re-elects them.
+func (store dbStore) saveVotersInfo(height int64, valSet *types.ValidatorSet, voterSet *types.VoterSet) error {
+	if voterSet == nil || valSet == nil {
+		return nil
+	}
+	votersInfo := &tmstate.VotersInfo{
+		ValidatorIndices: make([]int32, len(voterSet.Voters)),
+		VotingPowers:     make([]int64, len(voterSet.Voters)),
+	}
+	for i, voter := range voterSet.Voters {
+		idx, val := valSet.GetByAddress(voter.Address)
+		if val == nil {
+			return fmt.Errorf("voter %X at height %d is not in the validator set", voter.Address, height)
+		}
+		votersInfo.ValidatorIndices[i] = idx
+		votersInfo.VotingPowers[i] = voter.VotingPower
+	}
+
+	bz, err := votersInfo.Marshal()
+	if err != nil {
+		return err
+	}
 
-	return types.SelectVoter(vip, proofHash, voterParams), nil
+	return store.db.Set(calcVotersKey(height), bz)
 }
 
 func lastStoredHeightFor(height, lastHeightChanged int64) int64 {
@@ -46,6 +46,130 @@ func TestStoreLoadValidators(t *testing.T) {
 	assert.NotZero(t, loadedVals.Size())
 }
 
+func TestStoreLoadVoters(t *testing.T) {
+	stateDB := dbm.NewMemDB()
+	stateStore := sm.NewStore(stateDB)
+	vals, _ := types.RandValidatorSet(10, 10)
+	voterParams := &types.VoterParams{VoterElectionThreshold: 1, MaxTolerableByzantinePercentage: 20}
+
+	// 1) LoadVoters loads the voters stored with the state rather than re-electing them
+	err := sm.SaveValidatorsInfo(stateDB, 10, 10, []byte{}, vals)
+	require.NoError(t, err)
+	voters := make([]*types.Validator, 3)
+	for i := range voters {
+		voters[i] = vals.Validators[i].Copy()
+		voters[i].VotingPower = int64(i + 1)
+	}
+	voterSet := types.WrapValidatorsToVoterSet(voters)
+	state := sm.State{
+		InitialHeight:               1,
+		LastBlockHeight:             9,
+		Validators:                  vals,
+		NextValidators:              vals,
+		Voters:                      voterSet,
+		LastVoters:                  voterSet,
+		LastHeightValidatorsChanged: 10,
+		LastProofHash:               []byte("proof hash"),
+	}
+	err = stateStore.Save(state)
+	require.NoError(t, err)
+
+	loadedVoters, err := stateStore.LoadVoters(10, voterParams)
+	require.NoError(t, err)
+	assert.Equal(t, voterSet.Hash(), loadedVoters.Hash())
+	for i, voter := range loadedVoters.Voters {
+		assert.Equal(t, voterSet.Voters[i].Address, voter.Address)
+		assert.Equal(t, voterSet.Voters[i].VotingPower, voter.VotingPower)
+	}
+
+	// 2) LoadVoters elects the voters from the proof hash if they have not been stored
+	proofHash := []byte("legacy proof hash")
+	err = sm.SaveValidatorsInfo(stateDB, 20, 20, []byte{}, vals)
+	require.NoError(t, err)
+	err = sm.SaveValidatorsInfo(stateDB, 21, 20, proofHash, vals)
+	require.NoError(t, err)
+
+	loadedVoters, err = stateStore.LoadVoters(20, voterParams)
+	require.NoError(t, err)
+	assert.Equal(t, types.SelectVoter(vals, proofHash, voterParams).Hash(), loadedVoters.Hash())
+
+	// 3) LoadVoters fails if neither the voters nor the proof hash have been stored
+	_, err = stateStore.LoadVoters(21, voterParams)
+	assert.IsType(t, sm.ErrNoProofHashForHeight{}, err)
+}
+
+func TestStoreLoadLegacyVoters(t *testing.T) {
+	stateDB := dbm.NewMemDB()
+	stateStore := sm.NewStore(stateDB)
+
+	// a BLS validator, which joined the set before the proofs-of-possession
+	// were required, is elected under the old election rules
+	vals, _ := types.RandValidatorSet(30, 10)
+	val := types.NewMockPV(types.PrivKeyBLS).ExtractIntoValidator(50)
+	legacyVals := types.NewValidatorSet(append(vals.Validators, val))
+	idx, _ := legacyVals.GetByAddress(val.Address)
+	legacyVals.Validators[idx].ProofOfPossession = nil
+
+	// the voters of a DB saved before the voters were stored, under the
+	// voter params of the height rather than the current ones
+	params := types.DefaultConsensusParams()
+	params.Voter = tmproto.VoterParams{VoterElectionThreshold: 1, MaxTolerableByzantinePercentage: 20}
+	proofHash := []byte("legacy proof hash")
+	err := sm.SaveValidatorsInfo(stateDB, 10, 10, []byte{}, legacyVals)
+	require.NoError(t, err)
+	err = stateStore.Save(sm.State{
+		InitialHeight:                    1,
+		LastBlockHeight:                  9,
+		Validators:                       legacyVals,
+		NextValidators:                   legacyVals,
+		LastHeightValidatorsChanged:      10,
+		ConsensusParams:                  *params,
+		LastHeightConsensusParamsChanged: 10,
+		LastProofHash:                    proofHash,
+	})
+	require.NoError(t, err)
+
+	loadedVoters, err := stateStore.LoadVoters(10, types.DefaultVoterParams())
+	require.NoError(t, err)
+	expected := types.SelectVoter(legacyVals, proofHash, types.VoterParamsFromProto(&params.Voter))
+	assert.Equal(t, expected.Hash(), loadedVoters.Hash())
+	assert.NotEqual(t, types.SelectVoter(legacyVals, proofHash, types.DefaultVoterParams()).Hash(),
+		loadedVoters.Hash())
+	assert.True(t, loadedVoters.HasAddress(val.Address))
+}
+
+func TestStoreLoadProofHash(t *testing.T) {
+	stateStore := sm.NewStore(dbm.NewMemDB())
+	vals, _ := types.RandValidatorSet(3, 10)
+	state := sm.State{
+		InitialHeight:               1,
+		LastBlockHeight:             9,
+		Validators:                  vals,
+		NextValidators:              vals,
+		Voters:                      types.ToVoterAll(vals.Validators),
+		LastVoters:                  types.ToVoterAll(vals.Validators),
+		LastHeightValidatorsChanged: 1,
+		LastProofHash:               []byte("proof hash"),
+	}
+
+	// the proof hash of the last block elects the proposer of the next height
+	require.NoError(t, stateStore.Save(state))
+	proofHash, err := stateStore.LoadProofHash(10)
+	require.NoError(t, err)
+	assert.Equal(t, state.LastProofHash, proofHash)
+
+	_, err = stateStore.LoadProofHash(11)
+	assert.IsType(t, sm.ErrNoProofHashForHeight{}, err)
+
+	// so does the bootstrapped state
+	state.LastBlockHeight = 19
+	state.LastProofHash = []byte("bootstrapped proof hash")
+	require.NoError(t, stateStore.Bootstrap(state))
+	proofHash, err = stateStore.LoadProofHash(20)
+	require.NoError(t, err)
+	assert.Equal(t, state.LastProofHash, proofHash)
+}
+
 func BenchmarkLoadValidators(b *testing.B) {
 	const valSetSize = 100
 
@@ -7,12 +7,18 @@ import (
 
 	"github.com/line/ostracon/crypto"
 	"github.com/line/ostracon/types"
+	"github.com/line/ostracon/version"
 )
 
 //-----------------------------------------------------
 // Validate block
 
 func validateBlock(state State, round int32, block *types.Block) error {
+	// The block protocol may have been switched to one the software does not support by an upgrade.
+	if !version.IsBlockProtocolSupported(state.Version.Consensus.Block) {
+		return ErrUnsupportedBlockProtocol{Height: block.Height, BlockProtocol: state.Version.Consensus.Block}
+	}
+
 	// Validate internal consistency.
 	if err := block.ValidateBasic(); err != nil {
 		return err
@@ -57,7 +63,7 @@ func validateBlock(state State, round int32, block *types.Block) error {
 			block.AppHash,
 		)
 	}
-	hashCP := types.HashConsensusParams(state.ConsensusParams)
+	hashCP := types.HashConsensusParams(state.ConsensusParams, block.Version.Block)
 	if !bytes.Equal(block.ConsensusHash, hashCP) {
 		return fmt.Errorf("wrong Block.Header.ConsensusHash.  Expected %X, got %v",
 			hashCP,
@@ -96,8 +102,9 @@ func validateBlock(state State, round int32, block *types.Block) error {
 		}
 	} else {
 		// LastCommit.Signatures length is checked in VerifyCommit.
+		// The LastCommit is verified under the rules of the block protocol of the last block.
 		if err := state.LastVoters.VerifyCommit(
-			state.ChainID, state.LastBlockID, block.Height-1, block.LastCommit); err != nil {
+			state.ChainID, state.LastBlockID, block.Height-1, block.LastCommit, state.LastBlockVersion); err != nil {
 			return err
 		}
 	}
@@ -118,6 +125,9 @@ func validateBlock(state State, round int32, block *types.Block) error {
 	}
 
 	// Validate block Time
+	// NOTE: Under the proposer-based timestamps, the time is not derived from the last commit; whether
+	// the time is close enough to the real time is checked by the consensus when the proposal arrives.
+	proposerBased := state.ConsensusParams.Timestamp.ProposerBased
 	switch {
 	case block.Height > state.InitialHeight:
 		if !block.Time.After(state.LastBlockTime) {
@@ -126,6 +136,9 @@ func validateBlock(state State, round int32, block *types.Block) error {
 				state.LastBlockTime,
 			)
 		}
+		if proposerBased {
+			break
+		}
 		medianTime := MedianTime(block.LastCommit, state.LastVoters)
 		if !block.Time.Equal(medianTime) {
 			return fmt.Errorf("invalid block time. Expected %v, got %v",
@@ -136,6 +149,15 @@ func validateBlock(state State, round int32, block *types.Block) error {
 
 	case block.Height == state.InitialHeight:
 		genesisTime := state.LastBlockTime
+		if proposerBased {
+			if block.Time.Before(genesisTime) {
+				return fmt.Errorf("block time %v is before genesis time %v",
+					block.Time,
+					genesisTime,
+				)
+			}
+			break
+		}
 		if !block.Time.Equal(genesisTime) {
 			return fmt.Errorf("block time %v is not equal to genesis time %v",
 				block.Time,
@@ -173,7 +195,7 @@ func validateBlock(state State, round int32, block *types.Block) error {
 	message := state.MakeHashMessage(block.Round)
 	_, val := state.Validators.GetByAddress(block.ProposerAddress)
 	proof := crypto.Proof(block.Proof)
-	_, err := val.PubKey.VRFVerify(proof, message)
+	_, err := types.VRFVerify(val.PubKey, proof, message, state.ConsensusParams.Validator.VrfSuite)
 	if err != nil {
 		return types.NewErrInvalidProof(fmt.Sprintf(
 			"verification failed: %s; proof: %v, prevProofHash: %v, height=%d, round=%d, addr: %v",
@@ -18,6 +18,7 @@ import (
 	"github.com/line/ostracon/state/mocks"
 	"github.com/line/ostracon/types"
 	tmtime "github.com/line/ostracon/types/time"
+	"github.com/line/ostracon/version"
 )
 
 const validationTestsStopHeight int64 = 10
@@ -80,7 +81,7 @@ func TestValidateBlockHeader(t *testing.T) {
 		*/
 		for _, tc := range testCases {
 			message := state.MakeHashMessage(0)
-			proof, _ := privVals[proposerAddr.String()].GenerateVRFProof(message)
+			proof, _ := privVals[proposerAddr.String()].GenerateVRFProof(message, "")
 			block, _ := state.MakeBlock(height, makeTxs(height), lastCommit, nil, proposerAddr, 0, proof)
 			tc.malleateBlock(block)
 			err := blockExec.ValidateBlock(state, 0, block)
@@ -96,6 +97,92 @@ func TestValidateBlockHeader(t *testing.T) {
 	}
 }
 
+func TestValidateBlockTimeProposerBased(t *testing.T) {
+	proxyApp := newTestApp()
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, privVals := makeState(3, 1)
+	state.ConsensusParams.Timestamp.ProposerBased = true
+	stateStore := sm.NewStore(stateDB)
+	blockExec := sm.NewBlockExecutor(
+		stateStore,
+		log.TestingLogger(),
+		proxyApp.Consensus(),
+		memmock.Mempool{},
+		sm.EmptyEvidencePool{},
+	)
+	lastCommit := types.NewCommit(0, 0, types.BlockID{}, nil)
+
+	for height := int64(1); height < validationTestsStopHeight; height++ {
+		proposerAddr := state.Validators.SelectProposer(state.LastProofHash, height, 0).Address
+		message := state.MakeHashMessage(0)
+		proof, _ := privVals[proposerAddr.String()].GenerateVRFProof(message, "")
+		block, _ := state.MakeBlock(height, makeTxs(height), lastCommit, nil, proposerAddr, 0, proof)
+
+		// the time of the proposer is taken instead of the median time of the last commit
+		require.NoError(t, blockExec.ValidateBlock(state, 0, block), "height %d", height)
+		block.Time = state.LastBlockTime.Add(time.Hour)
+		require.NoError(t, blockExec.ValidateBlock(state, 0, block), "height %d", height)
+
+		// but it never goes back
+		block.Time = state.LastBlockTime.Add(-time.Millisecond)
+		require.Error(t, blockExec.ValidateBlock(state, 0, block), "height %d", height)
+		if height > 1 {
+			block.Time = state.LastBlockTime
+			require.Error(t, blockExec.ValidateBlock(state, 0, block), "height %d", height)
+		}
+
+		var err error
+		state, _, lastCommit, err = makeAndCommitGoodBlock(state, height, lastCommit, proposerAddr, blockExec, privVals, nil)
+		require.NoError(t, err, "height %d", height)
+	}
+}
+
+func TestValidateBlockConsensusHashBeforeExtendedParams(t *testing.T) {
+	proxyApp := newTestApp()
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, privVals := makeState(3, 1)
+	state.Version.Consensus.Block = version.BlockProtocolExtendedParamsHash - 1
+	state.ConsensusParams.Voter = tmproto.VoterParams{VoterElectionThreshold: 2, MaxTolerableByzantinePercentage: 30}
+	state.ConsensusParams.PartSet.ParityPercentage = 50
+	stateStore := sm.NewStore(stateDB)
+	blockExec := sm.NewBlockExecutor(
+		stateStore,
+		log.TestingLogger(),
+		proxyApp.Consensus(),
+		memmock.Mempool{},
+		sm.EmptyEvidencePool{},
+	)
+	lastCommit := types.NewCommit(0, 0, types.BlockID{}, nil)
+
+	// the hash made by the versions before the voter, timestamp and part set params were hashed
+	hp := tmproto.HashedParams{
+		BlockMaxBytes: state.ConsensusParams.Block.MaxBytes,
+		BlockMaxGas:   state.ConsensusParams.Block.MaxGas,
+	}
+	bz, err := hp.Marshal()
+	require.NoError(t, err)
+	preSeriesHash := tmhash.Sum(bz)
+
+	proposerAddr := state.Validators.SelectProposer(state.LastProofHash, 1, 0).Address
+	message := state.MakeHashMessage(0)
+	proof, _ := privVals[proposerAddr.String()].GenerateVRFProof(message, "")
+	block, _ := state.MakeBlock(1, makeTxs(1), lastCommit, nil, proposerAddr, 0, proof)
+	assert.Equal(t, preSeriesHash, []byte(block.ConsensusHash))
+	require.NoError(t, blockExec.ValidateBlock(state, 0, block))
+
+	// from the block protocol, the params are hashed as well
+	state.Version.Consensus.Block = version.BlockProtocolExtendedParamsHash
+	block, _ = state.MakeBlock(1, makeTxs(1), lastCommit, nil, proposerAddr, 0, proof)
+	assert.NotEqual(t, preSeriesHash, []byte(block.ConsensusHash))
+	require.NoError(t, blockExec.ValidateBlock(state, 0, block))
+	block.ConsensusHash = preSeriesHash
+	require.Error(t, blockExec.ValidateBlock(state, 0, block))
+}
+
 func TestValidateBlockCommit(t *testing.T) {
 	proxyApp := newTestApp()
 	require.NoError(t, proxyApp.Start())
@@ -137,7 +224,7 @@ func TestValidateBlockCommit(t *testing.T) {
 				[]types.CommitSig{wrongHeightVote.CommitSig()},
 			)
 			message := state.MakeHashMessage(0)
-			proof, _ := privVals[proposerAddr.String()].GenerateVRFProof(message)
+			proof, _ := privVals[proposerAddr.String()].GenerateVRFProof(message, "")
 			block, _ := state.MakeBlock(height, makeTxs(height), wrongHeightCommit, nil, proposerAddr, 0, proof)
 			err = blockExec.ValidateBlock(state, 0, block)
 			_, isErrInvalidCommitHeight := err.(types.ErrInvalidCommitHeight)
@@ -254,7 +341,7 @@ func TestValidateBlockEvidence(t *testing.T) {
 				currentBytes += int64(len(newEv.Bytes()))
 			}
 			message := state.MakeHashMessage(0)
-			proof, _ := privVals[proposerAddr.String()].GenerateVRFProof(message)
+			proof, _ := privVals[proposerAddr.String()].GenerateVRFProof(message, "")
 			block, _ := state.MakeBlock(height, makeTxs(height), lastCommit, evidence, proposerAddr, 0, proof)
 			err := blockExec.ValidateBlock(state, 0, block)
 			if assert.Error(t, err) {
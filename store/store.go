@@ -99,7 +99,13 @@ func (bs *BlockStore) LoadBlock(height int64) *types.Block {
 
 	pbb := new(tmproto.Block)
 	buf := []byte{}
-	for i := 0; i < int(blockMeta.BlockID.PartSetHeader.Total); i++ {
+	// The block is in the data parts of an erasure-coded part set.
+	psh := blockMeta.BlockID.PartSetHeader
+	total := psh.Total
+	if psh.IsErasureCoded() {
+		total = psh.DataTotal
+	}
+	for i := 0; i < int(total); i++ {
 		part := bs.LoadBlockPart(height, i)
 		// If the part is missing (e.g. since it has been deleted after we
 		// loaded the block meta) we consider the whole block to be missing.
@@ -108,6 +114,9 @@ func (bs *BlockStore) LoadBlock(height int64) *types.Block {
 		}
 		buf = append(buf, part.Bytes...)
 	}
+	if psh.IsErasureCoded() && len(buf) > int(psh.DataSize) {
+		buf = buf[:psh.DataSize]
+	}
 	err := proto.Unmarshal(buf, pbb)
 	if err != nil {
 		// NOTE: The existence of meta should imply the existence of the
@@ -245,6 +254,30 @@ func (bs *BlockStore) LoadSeenCommit(height int64) *types.Commit {
 	return commit
 }
 
+// LoadBlockExtendedCommit returns the locally seen ExtendedCommit for the given
+// height, which has the vote extensions of the precommits along with the seen
+// commit. If no extended commit is found for the given height, it returns nil.
+func (bs *BlockStore) LoadBlockExtendedCommit(height int64) *types.ExtendedCommit {
+	var pbec = new(tmproto.ExtendedCommit)
+	bz, err := bs.db.Get(calcExtendedCommitKey(height))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return nil
+	}
+	err = proto.Unmarshal(bz, pbec)
+	if err != nil {
+		panic(fmt.Sprintf("error reading block extended commit: %v", err))
+	}
+
+	extCommit, err := types.ExtendedCommitFromProto(pbec)
+	if err != nil {
+		panic(fmt.Errorf("error from proto extended commit: %w", err))
+	}
+	return extCommit
+}
+
 // PruneBlocks removes block up to (but not including) a height. It returns number of blocks pruned.
 func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 	if height <= 0 {
@@ -298,6 +331,9 @@ func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 		if err := batch.Delete(calcSeenCommitKey(h)); err != nil {
 			return 0, err
 		}
+		if err := batch.Delete(calcExtendedCommitKey(h)); err != nil {
+			return 0, err
+		}
 		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
 			if err := batch.Delete(calcBlockPartKey(h, p)); err != nil {
 				return 0, err
@@ -394,6 +430,26 @@ func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, s
 	bs.saveState()
 }
 
+// SaveBlockWithExtendedCommit persists the given block and blockParts like
+// SaveBlock, with the commit of the extCommit as the seen commit. The extCommit
+// is saved as well to deliver the vote extensions to the proposer of the next height.
+func (bs *BlockStore) SaveBlockWithExtendedCommit(block *types.Block, blockParts *types.PartSet,
+	extCommit *types.ExtendedCommit) {
+	if extCommit == nil {
+		panic("BlockStore can only save a non-nil extended commit")
+	}
+
+	// Save the extended commit before the block, since the block store state is
+	// flushed at the end of SaveBlock.
+	pbec := extCommit.ToProto()
+	extCommitBytes := mustEncode(pbec)
+	if err := bs.db.Set(calcExtendedCommitKey(block.Height), extCommitBytes); err != nil {
+		panic(err)
+	}
+
+	bs.SaveBlock(block, blockParts, extCommit.Commit)
+}
+
 func (bs *BlockStore) saveBlockPart(height int64, index int, part *types.Part) {
 	pbp, err := part.ToProto()
 	if err != nil {
@@ -443,6 +499,10 @@ func calcSeenCommitKey(height int64) []byte {
 	return []byte(fmt.Sprintf("SC:%v", height))
 }
 
+func calcExtendedCommitKey(height int64) []byte {
+	return []byte(fmt.Sprintf("EC:%v", height))
+}
+
 func calcBlockHashKey(hash []byte) []byte {
 	return []byte(fmt.Sprintf("BH:%x", hash))
 }
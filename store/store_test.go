@@ -367,6 +367,35 @@ func TestBlockStoreSaveLoadBlock(t *testing.T) {
 	}
 }
 
+func TestBlockStoreSaveLoadExtendedCommit(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	defer cleanup()
+
+	block := makeBlock(bs.Height()+1, state, new(types.Commit))
+	partSet := block.MakePartSet(2)
+	seenCommit := makeTestCommit(block.Height, tmtime.Now())
+	extCommit := types.NewExtendedCommit(seenCommit, []types.VoteExtension{
+		{Extension: []byte("extension"), Signature: []byte("signature")},
+	})
+	bs.SaveBlockWithExtendedCommit(block, partSet, extCommit)
+
+	loaded := bs.LoadBlockExtendedCommit(block.Height)
+	require.NotNil(t, loaded)
+	assert.Equal(t, extCommit.Extensions, loaded.Extensions)
+	assert.Equal(t, seenCommit.Hash(), loaded.Commit.Hash())
+	assert.Equal(t, seenCommit.Hash(), bs.LoadSeenCommit(block.Height).Hash())
+
+	// a block saved without the extended commit has none
+	block = makeBlock(bs.Height()+1, state, seenCommit)
+	bs.SaveBlock(block, block.MakePartSet(2), makeTestCommit(block.Height, tmtime.Now()))
+	assert.Nil(t, bs.LoadBlockExtendedCommit(block.Height))
+
+	// the extended commit is pruned along with the block
+	_, err := bs.PruneBlocks(block.Height)
+	require.NoError(t, err)
+	assert.Nil(t, bs.LoadBlockExtendedCommit(block.Height-1))
+}
+
 func TestLoadBaseMeta(t *testing.T) {
 	config := cfg.ResetTestRoot("blockchain_reactor_test")
 	defer os.RemoveAll(config.RootDir)
@@ -577,6 +606,21 @@ func TestBlockFetchAtHeight(t *testing.T) {
 	require.Nil(t, blockAtHeightPlus2, "expecting an unsuccessful load of Height()+2")
 }
 
+func TestLoadErasureCodedBlock(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	defer cleanup()
+	block := makeBlock(bs.Height()+1, state, new(types.Commit))
+
+	partSet := block.MakeErasureCodedPartSet(16, 50)
+	require.True(t, partSet.IsErasureCoded())
+	bs.SaveBlock(block, partSet, makeTestCommit(10, tmtime.Now()))
+
+	loaded := bs.LoadBlock(bs.Height())
+	require.NotNil(t, loaded)
+	require.Equal(t, block.Hash(), loaded.Hash())
+	require.Equal(t, partSet.Header(), bs.LoadBlockMeta(bs.Height()).BlockID.PartSetHeader)
+}
+
 func doFn(fn func() (interface{}, error)) (res interface{}, err error, panicErr error) {
 	defer func() {
 		if r := recover(); r != nil {
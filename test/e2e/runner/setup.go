@@ -193,11 +193,16 @@ func MakeGenesis(testnet *e2e.Testnet) (types.GenesisDoc, error) {
 		InitialHeight:   testnet.InitialHeight,
 	}
 	for validator, power := range testnet.Validators {
+		pop, err := types.ProofOfPossession(validator.PrivvalKey)
+		if err != nil {
+			return genesis, err
+		}
 		genesis.Validators = append(genesis.Validators, types.GenesisValidator{
-			Name:    validator.Name,
-			Address: validator.PrivvalKey.PubKey().Address(),
-			PubKey:  validator.PrivvalKey.PubKey(),
-			Power:   power,
+			Name:              validator.Name,
+			Address:           validator.PrivvalKey.PubKey().Address(),
+			PubKey:            validator.PrivvalKey.PubKey(),
+			Power:             power,
+			ProofOfPossession: pop,
 		})
 	}
 	// The validator set will be sorted internally by Tendermint ranked by power,
@@ -173,6 +173,15 @@ func defaultEnterPrevote(cs *State, height int64, round int32) {
 		return
 	}
 
+	// Let the app decide whether the proposal block is acceptable
+	accepted, err := cs.blockExec.ProcessProposal(cs.ProposalBlock, cs.state)
+	if err != nil || !accepted {
+		// ProposalBlock is rejected by the app, prevote nil.
+		logger.Error("enterPrevote: ProposalBlock is rejected by the app", "err", err)
+		cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
 	// Prevote cs.ProposalBlock
 	// NOTE: the proposal signature is validated when it is received,
 	// and the proposal block parts are validated as they are received (against the merkle hash in the proposal)
@@ -37,12 +37,17 @@ func (emptyMempool) FlushAppConn() error           { return nil }
 func (emptyMempool) TxsAvailable() <-chan struct{} { return make(chan struct{}) }
 func (emptyMempool) EnableTxsAvailable()           {}
 func (emptyMempool) TxsBytes() int64               { return 0 }
+func (emptyMempool) UnconfirmedTxByKey(_ [mempl.TxKeySize]byte) (*mempl.UnconfirmedTx, bool) {
+	return nil, false
+}
+func (emptyMempool) RemoveTxByKey(_ [mempl.TxKeySize]byte, _ bool) {}
 
 func (emptyMempool) TxsFront() *clist.CElement    { return nil }
 func (emptyMempool) TxsWaitChan() <-chan struct{} { return nil }
 
-func (emptyMempool) InitWAL() error { return nil }
-func (emptyMempool) CloseWAL()      {}
+func (emptyMempool) InitWAL() error                { return nil }
+func (emptyMempool) ReplayWAL(txs types.Txs) error { return nil }
+func (emptyMempool) CloseWAL()                     {}
 
 //-----------------------------------------------------------------------------
 // mockProxyApp uses ABCIResponses to give the right results.
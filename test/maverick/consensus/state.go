@@ -533,6 +533,12 @@ func (cs *State) GetRoundStateSimpleJSON() ([]byte, error) {
 	return tmjson.Marshal(cs.RoundState.RoundStateSimple())
 }
 
+// HaltReason returns an empty string, since the maverick never halts at the
+// halt height or time of the config.
+func (cs *State) HaltReason() string {
+	return ""
+}
+
 // GetValidators returns a copy of the current validators.
 func (cs *State) GetValidators() (int64, []*types.Validator) {
 	cs.mtx.RLock()
@@ -812,7 +818,7 @@ func (cs *State) reconstructLastCommit(state sm.State) {
 			state.LastBlockHeight))
 	}
 
-	lastPrecommits := types.CommitToVoteSet(state.ChainID, seenCommit, state.LastVoters)
+	lastPrecommits := types.CommitToVoteSet(state.ChainID, seenCommit, state.LastVoters, state.LastBlockVersion)
 	if !lastPrecommits.HasTwoThirdsMajority() {
 		panic("Failed to reconstruct LastCommit: Does not have +2/3 maj")
 	}
@@ -909,7 +915,7 @@ func (cs *State) updateToState(state sm.State) {
 	cs.ValidRound = -1
 	cs.ValidBlock = nil
 	cs.ValidBlockParts = nil
-	cs.Votes = cstypes.NewHeightVoteSet(state.ChainID, height, voters)
+	cs.Votes = cstypes.NewHeightVoteSet(state.ChainID, height, voters, state.Version.Consensus.Block)
 	cs.CommitRound = -1
 	cs.LastVoters = state.LastVoters
 	cs.TriggeredTimeoutPrecommit = false
@@ -1252,15 +1258,15 @@ func (cs *State) createProposalBlock() (block *types.Block, blockParts *types.Pa
 		panic("entered createProposalBlock with privValidator being nil")
 	}
 
-	var commit *types.Commit
+	var extCommit *types.ExtendedCommit
 	switch {
 	case cs.Height == cs.state.InitialHeight:
 		// We're creating a proposal for the first block.
 		// The commit is empty, but not nil.
-		commit = types.NewCommit(0, 0, types.BlockID{}, nil)
+		extCommit = types.NewExtendedCommit(types.NewCommit(0, 0, types.BlockID{}, nil), nil)
 	case cs.LastCommit.HasTwoThirdsMajority():
-		// Make the commit from LastCommit
-		commit = cs.LastCommit.MakeCommit()
+		// Make the commit from LastCommit along with the vote extensions
+		extCommit = cs.LastCommit.MakeExtendedCommit()
 	default: // This shouldn't happen.
 		cs.Logger.Error("enterPropose: Cannot propose anything: No commit for the previous block")
 		return
@@ -1275,12 +1281,19 @@ func (cs *State) createProposalBlock() (block *types.Block, blockParts *types.Pa
 	proposerAddr := cs.privValidatorPubKey.Address()
 
 	message := cs.GetState().MakeHashMessage(cs.Round)
-	proof, err := cs.privValidator.GenerateVRFProof(message)
+	proof, err := cs.privValidator.GenerateVRFProof(message, cs.state.ConsensusParams.Validator.VrfSuite)
 	if err != nil {
 		cs.Logger.Error(fmt.Sprintf("enterPropose: %v", err))
 		return
 	}
-	return cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, proposerAddr, cs.Round, proof)
+	block, blockParts, err = cs.blockExec.CreateProposalBlock(
+		cs.Height, cs.state, extCommit, proposerAddr, cs.Round, proof,
+	)
+	if err != nil {
+		cs.Logger.Error(fmt.Sprintf("enterPropose: %v", err))
+		return nil, nil
+	}
+	return block, blockParts
 }
 
 // Enter: any +2/3 prevotes at next round.
@@ -211,10 +211,15 @@ func initFilesWithConfig(config *cfg.Config) error {
 		if err != nil {
 			return fmt.Errorf("can't get pubkey: %w", err)
 		}
+		pop, err := types.ProofOfPossession(pv.Key.PrivKey)
+		if err != nil {
+			return fmt.Errorf("can't generate proof-of-possession: %w", err)
+		}
 		genDoc.Validators = []types.GenesisValidator{{
-			Address: pubKey.Address(),
-			PubKey:  pubKey,
-			Power:   10,
+			Address:           pubKey.Address(),
+			PubKey:            pubKey,
+			Power:             10,
+			ProofOfPossession: pop,
 		}}
 
 		if err := genDoc.SaveAs(genFile); err != nil {
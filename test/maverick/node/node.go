@@ -25,6 +25,7 @@ import (
 	cfg "github.com/line/ostracon/config"
 	"github.com/line/ostracon/consensus"
 	"github.com/line/ostracon/crypto"
+	"github.com/line/ostracon/crypto/vrf"
 	"github.com/line/ostracon/evidence"
 	tmjson "github.com/line/ostracon/libs/json"
 	"github.com/line/ostracon/libs/log"
@@ -685,6 +686,12 @@ func NewNode(config *cfg.Config,
 		return nil, err
 	}
 
+	vrfSuite := state.ConsensusParams.Validator.VrfSuite
+	if vrfSuite == "" {
+		vrfSuite = vrf.DefaultSuite()
+	}
+	logger.Info("VRF suite", "suite", vrfSuite)
+
 	// Create the proxyApp and establish connections to the ABCI app (consensus, mempool, query).
 	proxyApp, err := createAndStartProxyAppConns(clientCreator, logger)
 	if err != nil {
@@ -1300,7 +1307,7 @@ func makeNodeInfo(
 		Channels: []byte{
 			bcChannel,
 			cs.StateChannel, cs.DataChannel, cs.VoteChannel, cs.VoteSetBitsChannel,
-			mempl.MempoolChannel,
+			mempl.MempoolChannel, mempl.MempoolAnnounceChannel,
 			evidence.EvidenceChannel,
 			statesync.SnapshotChannel, statesync.ChunkChannel,
 		},
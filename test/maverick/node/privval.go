@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"io/ioutil"
 
-	"github.com/line/ostracon/crypto/vrf"
-
 	"github.com/line/ostracon/crypto"
 	"github.com/line/ostracon/crypto/ed25519"
 	tmbytes "github.com/line/ostracon/libs/bytes"
@@ -266,13 +264,12 @@ func (pv *FilePV) SignProposal(chainID string, proposal *tmproto.Proposal) error
 	return nil
 }
 
-func (pv *FilePV) GenerateVRFProof(message []byte) (crypto.Proof, error) {
+func (pv *FilePV) GenerateVRFProof(message []byte, vrfSuite string) (crypto.Proof, error) {
 	privKey, ok := pv.Key.PrivKey.(ed25519.PrivKey)
 	if !ok {
 		return nil, fmt.Errorf("VRF proof unsupported")
 	}
-	proof, err := vrf.Prove(privKey, message)
-	return crypto.Proof(proof), err
+	return privKey.VRFProveWithSuite(vrfSuite, message)
 }
 
 // Save persists the FilePV to disk.
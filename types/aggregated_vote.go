@@ -0,0 +1,257 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/line/ostracon/crypto/bls"
+	"github.com/line/ostracon/libs/bits"
+	tmbytes "github.com/line/ostracon/libs/bytes"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
+)
+
+var (
+	ErrAggregatedVoteOverlapping = errors.New("aggregated vote overlaps with votes already aggregated")
+)
+
+// AggregatedVote represents the votes of the same type for the same block at
+// the same height and round, whose BLS signatures are aggregated into one. It
+// is used to gossip many votes of BLS voters in one message.
+type AggregatedVote struct {
+	Type       tmproto.SignedMsgType `json:"type"`
+	Height     int64                 `json:"height"`
+	Round      int32                 `json:"round"`
+	BlockID    BlockID               `json:"block_id"` // zero if vote is nil.
+	Voters     *bits.BitArray        `json:"voters"`   // voter index -> included?
+	Timestamps []time.Time           `json:"timestamps"`
+	Signature  []byte                `json:"signature"`
+}
+
+// NewAggregatedVote aggregates the signatures of the given votes. The votes must
+// be of the same type for the same block at the same height and round, signed
+// with BLS keys, and sorted by validator index without duplicates.
+func NewAggregatedVote(numVoters int, votes []*Vote) (*AggregatedVote, error) {
+	if len(votes) == 0 {
+		return nil, errors.New("no votes to aggregate")
+	}
+	first := votes[0]
+	for i, vote := range votes {
+		if vote.Type != first.Type || vote.Height != first.Height || vote.Round != first.Round ||
+			!vote.BlockID.Equals(first.BlockID) {
+			return nil, fmt.Errorf("vote %v is not for the same step and block as %v", vote, first)
+		}
+		if vote.ValidatorIndex < 0 || int(vote.ValidatorIndex) >= numVoters {
+			return nil, fmt.Errorf("validator index %d is out of range of %d voters", vote.ValidatorIndex, numVoters)
+		}
+		if i > 0 && vote.ValidatorIndex <= votes[i-1].ValidatorIndex {
+			return nil, fmt.Errorf("votes are not sorted by validator index: %d after %d",
+				vote.ValidatorIndex, votes[i-1].ValidatorIndex)
+		}
+	}
+	signature, err := aggregateSignatures(votes)
+	if err != nil {
+		return nil, err
+	}
+	return makeAggregatedVote(numVoters, votes, signature), nil
+}
+
+// makeAggregatedVote makes an AggregatedVote of the votes, which must be valid
+// for NewAggregatedVote, and their aggregated signature.
+func makeAggregatedVote(numVoters int, votes []*Vote, signature []byte) *AggregatedVote {
+	av := &AggregatedVote{
+		Type:       votes[0].Type,
+		Height:     votes[0].Height,
+		Round:      votes[0].Round,
+		BlockID:    votes[0].BlockID,
+		Voters:     bits.NewBitArray(numVoters),
+		Timestamps: make([]time.Time, len(votes)),
+		Signature:  signature,
+	}
+	for i, vote := range votes {
+		av.Voters.SetIndex(int(vote.ValidatorIndex), true)
+		av.Timestamps[i] = vote.Timestamp
+	}
+	return av
+}
+
+// aggregateSignatures aggregates the BLS signatures of the given votes.
+func aggregateSignatures(votes []*Vote) (signature []byte, err error) {
+	for _, vote := range votes {
+		if len(vote.Signature) != bls.SignatureSize {
+			return nil, fmt.Errorf("vote %v is not signed with a BLS key", vote)
+		}
+		if signature == nil {
+			signature = vote.Signature
+			continue
+		}
+		if signature, err = bls.AddSignature(signature, vote.Signature); err != nil {
+			return nil, fmt.Errorf("fail to aggregate signature: %w", err)
+		}
+	}
+	return signature, nil
+}
+
+// Size returns the number of the aggregated votes.
+func (av *AggregatedVote) Size() int {
+	if av == nil {
+		return 0
+	}
+	return len(av.Timestamps)
+}
+
+// Votes returns the aggregated votes for the given voter set. The signatures of
+// the returned votes are nil.
+func (av *AggregatedVote) Votes(voters *VoterSet) ([]*Vote, error) {
+	if av.Voters.Size() != voters.Size() {
+		return nil, fmt.Errorf("expected %d voters, got %d", voters.Size(), av.Voters.Size())
+	}
+	votes := make([]*Vote, 0, len(av.Timestamps))
+	for idx := 0; idx < av.Voters.Size(); idx++ {
+		if !av.Voters.GetIndex(idx) {
+			continue
+		}
+		if len(votes) == len(av.Timestamps) {
+			return nil, errors.New("more voters than timestamps")
+		}
+		addr, _ := voters.GetByIndex(int32(idx))
+		votes = append(votes, &Vote{
+			Type:             av.Type,
+			Height:           av.Height,
+			Round:            av.Round,
+			BlockID:          av.BlockID,
+			Timestamp:        av.Timestamps[len(votes)],
+			ValidatorAddress: addr,
+			ValidatorIndex:   int32(idx),
+		})
+	}
+	if len(votes) != len(av.Timestamps) {
+		return nil, fmt.Errorf("expected %d voters, got %d", len(av.Timestamps), len(votes))
+	}
+	return votes, nil
+}
+
+// ValidateBasic performs basic validation.
+func (av *AggregatedVote) ValidateBasic() error {
+	if av == nil {
+		return errors.New("nil aggregated vote")
+	}
+
+	if !IsVoteTypeValid(av.Type) {
+		return errors.New("invalid Type")
+	}
+
+	if av.Height < 0 {
+		return errors.New("negative Height")
+	}
+
+	if av.Round < 0 {
+		return errors.New("negative Round")
+	}
+
+	if err := av.BlockID.ValidateBasic(); err != nil {
+		return fmt.Errorf("wrong BlockID: %v", err)
+	}
+
+	// BlockID.ValidateBasic would not err if we for instance have an empty hash but a
+	// non-empty PartsSetHeader:
+	if !av.BlockID.IsZero() && !av.BlockID.IsComplete() {
+		return fmt.Errorf("blockID must be either empty or complete, got: %v", av.BlockID)
+	}
+
+	if av.Voters == nil {
+		return errors.New("voters bit array is missing")
+	}
+	if av.Voters.Size() > MaxVotesCount {
+		return fmt.Errorf("voters bit array is too big: %d, max: %d", av.Voters.Size(), MaxVotesCount)
+	}
+	if len(av.Voters.Elems) != (av.Voters.Size()+63)/64 {
+		return fmt.Errorf("voters bit array has %d elements for %d bits", len(av.Voters.Elems), av.Voters.Size())
+	}
+
+	numVoters := 0
+	for idx := 0; idx < av.Voters.Size(); idx++ {
+		if av.Voters.GetIndex(idx) {
+			numVoters++
+		}
+	}
+	if numVoters == 0 {
+		return errors.New("no voters")
+	}
+	if numVoters != len(av.Timestamps) {
+		return fmt.Errorf("expected %d timestamps, got %d", numVoters, len(av.Timestamps))
+	}
+
+	if len(av.Signature) != bls.SignatureSize {
+		return fmt.Errorf("expected signature size to be %d bytes, got %d bytes",
+			bls.SignatureSize, len(av.Signature))
+	}
+
+	return nil
+}
+
+// String returns a string representation of AggregatedVote.
+//
+// 1. voters bit array
+// 2. height
+// 3. round
+// 4. type
+// 5. first 6 bytes of block hash
+// 6. first 6 bytes of signature
+func (av *AggregatedVote) String() string {
+	if av == nil {
+		return "nil-AggregatedVote"
+	}
+	return fmt.Sprintf("AggregatedVote{%v %v/%02d/%v %X %X}",
+		av.Voters,
+		av.Height,
+		av.Round,
+		av.Type,
+		tmbytes.Fingerprint(av.BlockID.Hash),
+		tmbytes.Fingerprint(av.Signature),
+	)
+}
+
+// ToProto converts the handwritten type to proto generated type
+func (av *AggregatedVote) ToProto() *tmproto.AggregatedVote {
+	if av == nil {
+		return nil
+	}
+
+	return &tmproto.AggregatedVote{
+		Type:       av.Type,
+		Height:     av.Height,
+		Round:      av.Round,
+		BlockID:    av.BlockID.ToProto(),
+		Voters:     av.Voters.ToProto(),
+		Timestamps: av.Timestamps,
+		Signature:  av.Signature,
+	}
+}
+
+// AggregatedVoteFromProto converts a proto generated type to a handwritten type
+// return type, nil if everything converts safely, otherwise nil, error
+func AggregatedVoteFromProto(pav *tmproto.AggregatedVote) (*AggregatedVote, error) {
+	if pav == nil {
+		return nil, errors.New("nil aggregated vote")
+	}
+
+	blockID, err := BlockIDFromProto(&pav.BlockID)
+	if err != nil {
+		return nil, err
+	}
+
+	av := new(AggregatedVote)
+	av.Type = pav.Type
+	av.Height = pav.Height
+	av.Round = pav.Round
+	av.BlockID = *blockID
+	if pav.Voters != nil {
+		av.Voters = new(bits.BitArray)
+		av.Voters.FromProto(pav.Voters)
+	}
+	av.Timestamps = pav.Timestamps
+	av.Signature = pav.Signature
+
+	return av, av.ValidateBasic()
+}
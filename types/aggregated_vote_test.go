@@ -0,0 +1,110 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/ostracon/crypto"
+	"github.com/line/ostracon/crypto/bls"
+	"github.com/line/ostracon/crypto/composite"
+	"github.com/line/ostracon/crypto/ed25519"
+	"github.com/line/ostracon/libs/bits"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
+)
+
+func exampleAggregatedVote(t *testing.T, privKeys ...crypto.PrivKey) (*AggregatedVote, []*Vote) {
+	votes := make([]*Vote, len(privKeys))
+	for i, privKey := range privKeys {
+		vote := examplePrecommit()
+		vote.ValidatorAddress = privKey.PubKey().Address()
+		vote.ValidatorIndex = int32(i)
+		vote.Timestamp = vote.Timestamp.Add(time.Duration(i) * time.Millisecond)
+		sig, err := privKey.Sign(VoteSignBytes("test_chain_id", vote.ToProto()))
+		require.NoError(t, err)
+		vote.Signature = sig
+		votes[i] = vote
+	}
+	av, err := NewAggregatedVote(len(privKeys)+1, votes)
+	require.NoError(t, err)
+	return av, votes
+}
+
+func TestNewAggregatedVote(t *testing.T) {
+	av, votes := exampleAggregatedVote(t, composite.GenPrivKey(), bls.GenPrivKey())
+	assert.Equal(t, 2, av.Size())
+	assert.True(t, av.Voters.GetIndex(0))
+	assert.True(t, av.Voters.GetIndex(1))
+	assert.False(t, av.Voters.GetIndex(2))
+	assert.Equal(t, []time.Time{votes[0].Timestamp, votes[1].Timestamp}, av.Timestamps)
+	assert.NoError(t, av.ValidateBasic())
+
+	// not sorted
+	_, err := NewAggregatedVote(3, []*Vote{votes[1], votes[0]})
+	assert.Error(t, err)
+	// out of range
+	_, err = NewAggregatedVote(1, votes)
+	assert.Error(t, err)
+	// different blocks
+	other := votes[1].Copy()
+	other.BlockID = BlockID{}
+	_, err = NewAggregatedVote(3, []*Vote{votes[0], other})
+	assert.Error(t, err)
+
+	// not BLS
+	_, edVotes := exampleAggregatedVote(t, composite.GenPrivKey(), composite.GenPrivKey())
+	edKey := ed25519.GenPrivKey()
+	sig, err := edKey.Sign(VoteSignBytes("test_chain_id", edVotes[1].ToProto()))
+	require.NoError(t, err)
+	edVotes[1].Signature = sig
+	_, err = NewAggregatedVote(3, edVotes)
+	assert.Error(t, err)
+}
+
+func TestAggregatedVoteValidateBasic(t *testing.T) {
+	testCases := []struct {
+		testName  string
+		malleate  func(*AggregatedVote)
+		expectErr bool
+	}{
+		{"Good AggregatedVote", func(av *AggregatedVote) {}, false},
+		{"Invalid Type", func(av *AggregatedVote) { av.Type = tmproto.SignedMsgType(0x03) }, true},
+		{"Negative Height", func(av *AggregatedVote) { av.Height = -1 }, true},
+		{"Negative Round", func(av *AggregatedVote) { av.Round = -1 }, true},
+		{"Invalid BlockID", func(av *AggregatedVote) {
+			av.BlockID = BlockID{[]byte{1, 2, 3}, PartSetHeader{Total: 111, Hash: []byte("blockparts")}}
+		}, true},
+		{"Nil Voters", func(av *AggregatedVote) { av.Voters = nil }, true},
+		{"Too Many Voters", func(av *AggregatedVote) { av.Voters = bits.NewBitArray(MaxVotesCount + 1) }, true},
+		{"Malformed Voters", func(av *AggregatedVote) { av.Voters.Elems = nil }, true},
+		{"No Voters", func(av *AggregatedVote) { av.Voters = bits.NewBitArray(3); av.Timestamps = nil }, true},
+		{"Missing Timestamp", func(av *AggregatedVote) { av.Timestamps = av.Timestamps[1:] }, true},
+		{"Invalid Signature", func(av *AggregatedVote) { av.Signature = av.Signature[1:] }, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			av, _ := exampleAggregatedVote(t, composite.GenPrivKey(), composite.GenPrivKey())
+			tc.malleate(av)
+			assert.Equal(t, tc.expectErr, av.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
+func TestAggregatedVoteProtobuf(t *testing.T) {
+	av, _ := exampleAggregatedVote(t, composite.GenPrivKey(), composite.GenPrivKey())
+
+	pav := av.ToProto()
+	av2, err := AggregatedVoteFromProto(pav)
+	require.NoError(t, err)
+	assert.Equal(t, av, av2)
+
+	_, err = AggregatedVoteFromProto(nil)
+	assert.Error(t, err)
+
+	pav.Voters = nil
+	_, err = AggregatedVoteFromProto(pav)
+	assert.Error(t, err)
+}
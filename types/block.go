@@ -145,6 +145,24 @@ func (b *Block) MakePartSet(partSize uint32) *PartSet {
 	if b == nil {
 		return nil
 	}
+	return NewPartSetFromData(b.marshal(), partSize)
+}
+
+// MakeErasureCodedPartSet returns an erasure-coded PartSet of a serialized block, with
+// parityPercentage percent of parity parts. If parityPercentage is 0, it is the same as
+// MakePartSet.
+// CONTRACT: partSize is greater than zero.
+func (b *Block) MakeErasureCodedPartSet(partSize uint32, parityPercentage uint32) *PartSet {
+	if b == nil {
+		return nil
+	}
+	if parityPercentage == 0 {
+		return b.MakePartSet(partSize)
+	}
+	return NewErasureCodedPartSetFromData(b.marshal(), partSize, parityPercentage)
+}
+
+func (b *Block) marshal() []byte {
 	b.mtx.Lock()
 	defer b.mtx.Unlock()
 
@@ -156,7 +174,7 @@ func (b *Block) MakePartSet(partSize uint32) *PartSet {
 	if err != nil {
 		panic(err)
 	}
-	return NewPartSetFromData(bz, partSize)
+	return bz
 }
 
 // HashesTo is a convenience function that checks if a block hashes to the given argument.
@@ -397,8 +415,9 @@ func (h *Header) Populate(
 //
 // NOTE: Timestamp validation is subtle and handled elsewhere.
 func (h Header) ValidateBasic() error {
-	if h.Version.Block != version.BlockProtocol {
-		return fmt.Errorf("block protocol is incorrect: got: %d, want: %d ", h.Version.Block, version.BlockProtocol)
+	if !version.IsBlockProtocolSupported(h.Version.Block) {
+		return fmt.Errorf("block protocol is not supported: got: %d, want: %d to %d",
+			h.Version.Block, version.MinBlockProtocol, version.BlockProtocol)
 	}
 	if len(h.ChainID) > MaxChainIDLen {
 		return fmt.Errorf("chainID is too long; got: %d, max: %d", len(h.ChainID), MaxChainIDLen)
@@ -860,18 +879,29 @@ func (commit *Commit) MaxCommitBytes() int64 {
 	return MaxCommitBytes(sigSizes, len(commit.AggregatedSignature))
 }
 
-// CommitToVoteSet constructs a VoteSet from the Commit and validator set.
+// CommitToVoteSet constructs a VoteSet from the Commit and validator set, whose
+// votes are verified under the rules of the block protocol blockVersion.
 // Panics if signatures from the commit can't be added to the voteset.
 // Inverse of VoteSet.MakeCommit().
-func CommitToVoteSet(chainID string, commit *Commit, voters *VoterSet) *VoteSet {
-	voteSet := NewVoteSet(chainID, commit.Height, commit.Round, tmproto.PrecommitType, voters)
+func CommitToVoteSet(chainID string, commit *Commit, voters *VoterSet, blockVersion uint64) *VoteSet {
+	return commitToVoteSet(chainID, commit, nil, voters, blockVersion)
+}
+
+func commitToVoteSet(chainID string, commit *Commit, extensions []VoteExtension, voters *VoterSet,
+	blockVersion uint64) *VoteSet {
+	voteSet := NewVoteSet(chainID, commit.Height, commit.Round, tmproto.PrecommitType, voters, blockVersion)
 	blsPubKeys := make([]bls.PubKey, 0, len(commit.Signatures))
 	msgs := make([][]byte, 0, len(commit.Signatures))
+	aggregatedVotes := make([]*Vote, 0, len(commit.Signatures))
 	for idx, commitSig := range commit.Signatures {
 		if commitSig.Absent() {
 			continue // OK, some precommits can be missing.
 		}
 		vote := commit.GetVote(int32(idx))
+		if extensions != nil && vote.BlockID.Equals(commit.BlockID) {
+			vote.Extension = extensions[idx].Extension
+			vote.ExtensionSignature = extensions[idx].Signature
+		}
 		if vote.Signature != nil {
 			added, err := voteSet.AddVote(vote)
 			if !added || err != nil {
@@ -890,6 +920,7 @@ func CommitToVoteSet(chainID string, commit *Commit, voters *VoterSet) *VoteSet
 			msg := VoteSignBytes(chainID, vote.ToProto())
 			blsPubKeys = append(blsPubKeys, voter.PubKey.(composite.PubKey).SignKey.(bls.PubKey))
 			msgs = append(msgs, msg)
+			aggregatedVotes = append(aggregatedVotes, vote)
 		}
 	}
 	if commit.AggregatedSignature != nil {
@@ -897,6 +928,10 @@ func CommitToVoteSet(chainID string, commit *Commit, voters *VoterSet) *VoteSet
 		if err != nil {
 			panic(fmt.Sprintf("Failed to VerifyAggregatedSignature : %v", err))
 		}
+		// Keep the aggregated signature so that the commit can be made again.
+		voteSet.mtx.Lock()
+		voteSet.addAggregate(aggregatedVotes, commit.AggregatedSignature)
+		voteSet.mtx.Unlock()
 	}
 	return voteSet
 }
@@ -1120,8 +1155,10 @@ func (commit *Commit) ToProto() *tmproto.Commit {
 	return c
 }
 
-// VerifySignatures validates the signatures in this commit.
-func (commit *Commit) VerifySignatures(chainID string, vals []*Validator) error {
+// VerifySignatures validates the signatures in this commit under the rules of
+// the block protocol blockVersion.
+func (commit *Commit) VerifySignatures(chainID string, vals []*Validator, blockVersion uint64) error {
+	sigBatch := newCommitSigBatch(blockVersion)
 	blsPubKeys := make([]bls.PubKey, 0, len(commit.Signatures))
 	messages := make([][]byte, 0, len(commit.Signatures))
 	for idx, commitSig := range commit.Signatures {
@@ -1133,7 +1170,8 @@ func (commit *Commit) VerifySignatures(chainID string, vals []*Validator) error
 		if val := vals[idx]; val != nil {
 			voteSignBytes := commit.VoteSignBytes(chainID, int32(idx))
 			if commitSig.Signature != nil {
-				if !val.PubKey.VerifySignature(voteSignBytes, commitSig.Signature) {
+				if !sigBatch.add(idx, val, voteSignBytes, commitSig.Signature) &&
+					!VerifySignature(val.PubKey, voteSignBytes, commitSig.Signature, blockVersion) {
 					return fmt.Errorf("wrong signature (#%d): %X", idx, commitSig.Signature)
 				}
 			} else {
@@ -1154,6 +1192,9 @@ func (commit *Commit) VerifySignatures(chainID string, vals []*Validator) error
 		}
 	}
 
+	if err := sigBatch.verify(); err != nil {
+		return err
+	}
 	if err := bls.VerifyAggregatedSignature(commit.AggregatedSignature, blsPubKeys, messages); err != nil {
 		return fmt.Errorf("wrong aggregated signature: %X; %s", commit.AggregatedSignature, err)
 	}
@@ -1194,6 +1235,130 @@ func CommitFromProto(cp *tmproto.Commit) (*Commit, error) {
 
 //-----------------------------------------------------------------------------
 
+// VoteExtension is the extension of a precommit in an ExtendedCommit along
+// with its signature.
+type VoteExtension struct {
+	Extension []byte `json:"extension"`
+	Signature []byte `json:"signature"`
+}
+
+// ExtendedCommit is a Commit with the vote extensions of its precommits. It is
+// not a part of the block but is kept by the block store, so that the extensions
+// can be delivered to the proposer of the next height.
+type ExtendedCommit struct {
+	*Commit `json:"commit"`
+	// Extensions are in the same order as the Commit.Signatures.
+	Extensions []VoteExtension `json:"extensions"`
+}
+
+// NewExtendedCommit returns a new ExtendedCommit.
+func NewExtendedCommit(commit *Commit, extensions []VoteExtension) *ExtendedCommit {
+	return &ExtendedCommit{
+		Commit:     commit,
+		Extensions: extensions,
+	}
+}
+
+// ToVoteSet constructs a VoteSet from the ExtendedCommit and validator set with
+// the extensions set to the votes.
+// Panics if signatures from the commit can't be added to the voteset.
+// Inverse of VoteSet.MakeExtendedCommit().
+func (extCommit *ExtendedCommit) ToVoteSet(chainID string, voters *VoterSet, blockVersion uint64) *VoteSet {
+	return commitToVoteSet(chainID, extCommit.Commit, extCommit.Extensions, voters, blockVersion)
+}
+
+// VerifyExtensions verifies the signatures of the vote extensions under the
+// rules of the block protocol blockVersion.
+func (extCommit *ExtendedCommit) VerifyExtensions(chainID string, vals []*Validator, blockVersion uint64) error {
+	for idx, ext := range extCommit.Extensions {
+		if len(ext.Signature) == 0 || vals[idx] == nil {
+			continue
+		}
+		vote := extCommit.GetVote(int32(idx))
+		vote.Extension = ext.Extension
+		vote.ExtensionSignature = ext.Signature
+		if err := vote.VerifyExtension(chainID, vals[idx].PubKey, blockVersion); err != nil {
+			return fmt.Errorf("wrong vote extension signature (#%d): %w", idx, err)
+		}
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation that doesn't involve state data.
+func (extCommit *ExtendedCommit) ValidateBasic() error {
+	if extCommit.Commit == nil {
+		return errors.New("nil commit")
+	}
+	if err := extCommit.Commit.ValidateBasic(); err != nil {
+		return err
+	}
+	if len(extCommit.Extensions) != len(extCommit.Signatures) {
+		return fmt.Errorf("number of extensions (%d) does not match number of signatures (%d)",
+			len(extCommit.Extensions), len(extCommit.Signatures))
+	}
+	for i, ext := range extCommit.Extensions {
+		if len(ext.Extension) == 0 && len(ext.Signature) == 0 {
+			continue
+		}
+		if !extCommit.Signatures[i].ForBlock() {
+			return fmt.Errorf("unexpected vote extension (#%d) of a precommit not for the block", i)
+		}
+		if len(ext.Extension) > 0 && len(ext.Signature) == 0 {
+			return fmt.Errorf("vote extension signature (#%d) is missing", i)
+		}
+		if len(ext.Signature) > MaxSignatureSize {
+			return fmt.Errorf("vote extension signature (#%d) is too big %d (max: %d)",
+				i, len(ext.Signature), MaxSignatureSize)
+		}
+	}
+	return nil
+}
+
+// ToProto converts ExtendedCommit to protobuf
+func (extCommit *ExtendedCommit) ToProto() *tmproto.ExtendedCommit {
+	if extCommit == nil {
+		return nil
+	}
+
+	ec := new(tmproto.ExtendedCommit)
+	ec.Commit = extCommit.Commit.ToProto()
+	exts := make([]tmproto.VoteExtension, len(extCommit.Extensions))
+	for i, ext := range extCommit.Extensions {
+		exts[i] = tmproto.VoteExtension{
+			Extension: ext.Extension,
+			Signature: ext.Signature,
+		}
+	}
+	ec.Extensions = exts
+	return ec
+}
+
+// ExtendedCommitFromProto converts a protobuf ExtendedCommit to ExtendedCommit.
+// It returns an error if the extended commit is invalid.
+func ExtendedCommitFromProto(ecp *tmproto.ExtendedCommit) (*ExtendedCommit, error) {
+	if ecp == nil {
+		return nil, errors.New("nil ExtendedCommit")
+	}
+
+	commit, err := CommitFromProto(ecp.Commit)
+	if err != nil {
+		return nil, err
+	}
+
+	exts := make([]VoteExtension, len(ecp.Extensions))
+	for i, ext := range ecp.Extensions {
+		exts[i] = VoteExtension{
+			Extension: ext.Extension,
+			Signature: ext.Signature,
+		}
+	}
+
+	extCommit := NewExtendedCommit(commit, exts)
+	return extCommit, extCommit.ValidateBasic()
+}
+
+//-----------------------------------------------------------------------------
+
 // Data contains the set of transactions included in the block
 type Data struct {
 
@@ -1458,14 +1623,7 @@ func BlockIDFromProto(bID *tmproto.BlockID) (*BlockID, error) {
 // GetSignatureKey is a utility function for referencing a specified public key as a BLS key for signature.
 // If the key is not BLS, return nil
 func GetSignatureKey(pubKey crypto.PubKey) *bls.PubKey {
-	for {
-		if compPubKey, ok := pubKey.(composite.PubKey); ok {
-			pubKey = compPubKey.SignKey
-		} else {
-			break
-		}
-	}
-	if blsPubKey, ok := pubKey.(bls.PubKey); ok {
+	if blsPubKey, ok := signKey(pubKey).(bls.PubKey); ok {
 		return &blsPubKey
 	}
 	return nil
@@ -9,7 +9,6 @@ import (
 	"math"
 	"os"
 	"reflect"
-	"strings"
 	"testing"
 	"time"
 
@@ -194,7 +193,7 @@ func makeBlockIDRandom() BlockID {
 	)
 	rand.Read(blockHash)   //nolint: errcheck // ignore errcheck for read
 	rand.Read(partSetHash) //nolint: errcheck // ignore errcheck for read
-	return BlockID{blockHash, PartSetHeader{123, partSetHash}}
+	return BlockID{blockHash, PartSetHeader{Total: 123, Hash: partSetHash}}
 }
 
 func makeBlockID(hash []byte, partSetSize uint32, partSetHash []byte) BlockID {
@@ -390,7 +389,7 @@ func TestMaxCommitBytes(t *testing.T) {
 	pub3, _ := pv3.GetPubKey()
 
 	blockID := BlockID{tmrand.Bytes(tmhash.Size),
-		PartSetHeader{math.MaxUint32, tmrand.Bytes(tmhash.Size)}}
+		PartSetHeader{Total: math.MaxUint32, Hash: tmrand.Bytes(tmhash.Size)}}
 
 	chainID := "mychain2"
 
@@ -651,7 +650,7 @@ func TestCommitSigNumOfBytes(t *testing.T) {
 	pub3, _ := pv3.GetPubKey()
 
 	blockID := BlockID{tmrand.Bytes(tmhash.Size),
-		PartSetHeader{math.MaxInt32, tmrand.Bytes(tmhash.Size)}}
+		PartSetHeader{Total: math.MaxInt32, Hash: tmrand.Bytes(tmhash.Size)}}
 	chainID := "mychain1"
 	timestamp := time.Date(math.MaxInt64, 0, 0, 0, 0, 0, math.MaxInt64, time.UTC)
 
@@ -717,7 +716,7 @@ func TestMaxCommitBytesMany(t *testing.T) {
 	commitCount := 100
 	commitSig := make([]CommitSig, commitCount)
 	blockID := BlockID{tmrand.Bytes(tmhash.Size),
-		PartSetHeader{math.MaxInt32, tmrand.Bytes(tmhash.Size)}}
+		PartSetHeader{Total: math.MaxInt32, Hash: tmrand.Bytes(tmhash.Size)}}
 
 	chainID := "mychain3"
 	timestamp := time.Date(math.MaxInt64, 0, 0, 0, 0, 0, math.MaxInt64, time.UTC)
@@ -747,7 +746,7 @@ func TestMaxCommitBytesAggregated(t *testing.T) {
 	commitCount := 100
 	commitSig := make([]CommitSig, commitCount)
 	blockID := BlockID{tmrand.Bytes(tmhash.Size),
-		PartSetHeader{math.MaxInt32, tmrand.Bytes(tmhash.Size)}}
+		PartSetHeader{Total: math.MaxInt32, Hash: tmrand.Bytes(tmhash.Size)}}
 
 	timestamp := time.Date(math.MaxInt64, 0, 0, 0, 0, 0, math.MaxInt64, time.UTC)
 
@@ -778,7 +777,7 @@ func TestMaxCommitBytesMixed(t *testing.T) {
 	commitCount := 100
 	commitSig := make([]CommitSig, commitCount)
 	blockID := BlockID{tmrand.Bytes(tmhash.Size),
-		PartSetHeader{math.MaxInt32, tmrand.Bytes(tmhash.Size)}}
+		PartSetHeader{Total: math.MaxInt32, Hash: tmrand.Bytes(tmhash.Size)}}
 
 	chainID := "mychain4"
 	timestamp := time.Date(math.MaxInt64, 0, 0, 0, 0, 0, math.MaxInt64, time.UTC)
@@ -912,7 +911,7 @@ func TestCommitToVoteSet(t *testing.T) {
 	assert.NoError(t, err)
 
 	chainID := voteSet.ChainID()
-	voteSet2 := CommitToVoteSet(chainID, commit, voterSet)
+	voteSet2 := CommitToVoteSet(chainID, commit, voterSet, version.BlockProtocol)
 
 	for i := int32(0); int(i) < len(vals); i++ {
 		// This is the vote before `MakeCommit`.
@@ -945,7 +944,7 @@ func TestCommitToVoteSet(t *testing.T) {
 	}
 }
 
-func TestMakeCommitPanicByAggregatedCommitAndVoteSet(t *testing.T) {
+func TestMakeCommitByAggregatedCommitAndVoteSet(t *testing.T) {
 	lastID := makeBlockIDRandom()
 	h := int64(3)
 
@@ -954,24 +953,13 @@ func TestMakeCommitPanicByAggregatedCommitAndVoteSet(t *testing.T) {
 	assert.NoError(t, err)
 
 	chainID := voteSet.ChainID()
-	voteSet2 := CommitToVoteSet(chainID, commit, voterSet)
-
-	// panic test
-	defer func() {
-		err := recover()
-		if err != nil {
-			wantStr := "This signature of commitSig is already aggregated: commitSig: <"
-			gotStr := fmt.Sprintf("%v", err)
-			isPanic := strings.Contains(gotStr, wantStr)
-			assert.True(t, isPanic)
-		} else {
-			t.Log("Not aggregated")
-		}
-	}()
-	if commit.AggregatedSignature != nil {
-		voteSet2.MakeCommit()
-		assert.Fail(t, "Don't reach here")
-	}
+	voteSet2 := CommitToVoteSet(chainID, commit, voterSet, version.BlockProtocol)
+
+	// the aggregated signature is kept to make the same commit again
+	commit2 := voteSet2.MakeCommit()
+	assert.Equal(t, commit.Hash(), commit2.Hash())
+	assert.Equal(t, commit.AggregatedSignature, commit2.AggregatedSignature)
+	assert.NoError(t, voterSet.VerifyCommit(chainID, lastID, h-1, commit2, version.BlockProtocol))
 }
 
 func TestCommitToVoteSetWithVotesForNilBlock(t *testing.T) {
@@ -1022,7 +1010,7 @@ func TestCommitToVoteSetWithVotesForNilBlock(t *testing.T) {
 		if tc.valid {
 			commit := voteSet.MakeCommit() // panics without > 2/3 valid votes
 			assert.NotNil(t, commit)
-			err := voterSet.VerifyCommit(voteSet.ChainID(), blockID, height-1, commit)
+			err := voterSet.VerifyCommit(voteSet.ChainID(), blockID, height-1, commit, version.BlockProtocol)
 			assert.Nil(t, err)
 		} else {
 			assert.Panics(t, func() { voteSet.MakeCommit() })
@@ -187,6 +187,19 @@ func (b *EventBus) PublishEventTx(data EventDataTx) error {
 	return b.pubsub.PublishWithEvents(ctx, data, events)
 }
 
+// PublishEventExpiredTx publishes an expired tx event. Note it will add the
+// predefined key (tx.hash), so that the tx can be subscribed to.
+func (b *EventBus) PublishEventExpiredTx(data EventDataExpiredTx) error {
+	// no explicit deadline for publishing events
+	ctx := context.Background()
+
+	events := map[string][]string{
+		EventTypeKey: {EventExpiredTx},
+		TxHashKey:    {fmt.Sprintf("%X", data.Tx.Hash())},
+	}
+	return b.pubsub.PublishWithEvents(ctx, data, events)
+}
+
 func (b *EventBus) PublishEventNewRoundStep(data EventDataRoundState) error {
 	return b.Publish(EventNewRoundStep, data)
 }
@@ -267,6 +280,10 @@ func (NopEventBus) PublishEventTx(data EventDataTx) error {
 	return nil
 }
 
+func (NopEventBus) PublishEventExpiredTx(data EventDataExpiredTx) error {
+	return nil
+}
+
 func (NopEventBus) PublishEventNewRoundStep(data EventDataRoundState) error {
 	return nil
 }
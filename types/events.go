@@ -36,6 +36,11 @@ const (
 	EventUnlock           = "Unlock"
 	EventValidBlock       = "ValidBlock"
 	EventVote             = "Vote"
+
+	// Mempool events.
+	// These are triggered from the mempool package, after a block has been
+	// committed, so that the users learn their txs were dropped.
+	EventExpiredTx = "ExpiredTx"
 )
 
 // ENCODING / DECODING
@@ -56,6 +61,7 @@ func init() {
 	tmjson.RegisterType(EventDataVote{}, "tendermint/event/Vote")
 	tmjson.RegisterType(EventDataValidatorSetUpdates{}, "tendermint/event/ValidatorSetUpdates")
 	tmjson.RegisterType(EventDataString(""), "tendermint/event/ProposalString")
+	tmjson.RegisterType(EventDataExpiredTx{}, "tendermint/event/ExpiredTx")
 }
 
 // Most event messages are basic types (a block, a transaction)
@@ -125,6 +131,13 @@ type EventDataValidatorSetUpdates struct {
 	ValidatorUpdates []*Validator `json:"validator_updates"`
 }
 
+// A tx purged from the mempool since it had been there longer than its TTL
+type EventDataExpiredTx struct {
+	Tx Tx `json:"tx"`
+
+	Height int64 `json:"height"` // Height of the block after which the tx was purged
+}
+
 // PUBSUB
 
 const (
@@ -140,6 +153,7 @@ const (
 
 var (
 	EventQueryCompleteProposal    = QueryForEvent(EventCompleteProposal)
+	EventQueryExpiredTx           = QueryForEvent(EventExpiredTx)
 	EventQueryLock                = QueryForEvent(EventLock)
 	EventQueryNewBlock            = QueryForEvent(EventNewBlock)
 	EventQueryNewBlockHeader      = QueryForEvent(EventNewBlockHeader)
@@ -177,3 +191,8 @@ type BlockEventPublisher interface {
 type TxEventPublisher interface {
 	PublishEventTx(EventDataTx) error
 }
+
+// MempoolEventPublisher publishes all mempool related events
+type MempoolEventPublisher interface {
+	PublishEventExpiredTx(EventDataExpiredTx) error
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	abci "github.com/line/ostracon/abci/types"
+	"github.com/line/ostracon/crypto"
 	"github.com/line/ostracon/crypto/merkle"
 	"github.com/line/ostracon/crypto/tmhash"
 	tmjson "github.com/line/ostracon/libs/json"
@@ -28,11 +29,34 @@ func MaxEvidenceBytes(ev Evidence) int64 {
 	case *LightClientAttackEvidence:
 		// FIXME 🏺 need this?
 		return 0
+	case *ConflictingProposalEvidence:
+		return (1 + maxProposalBytes(len(ev.ProposalA.Signature)) + 2) + // ProposalA
+			(1 + maxProposalBytes(len(ev.ProposalB.Signature)) + 2) + // ProposalB
+			(1 + 20 + 1) + // ProposerAddress
+			(1 + 9) + // TotalVotingPower
+			(1 + 9) + // ValidatorPower
+			(1 + 17 + 1) // Timestamp
+	case *InvalidProposerProofEvidence:
+		return (1 + maxProposalBytes(len(ev.Proposal.Signature)) + 2) + // Proposal
+			(1 + MaxHeaderBytes + 2) + // Header
+			(1 + 9) + // TotalVotingPower
+			(1 + 9) + // ValidatorPower
+			(1 + 17 + 1) // Timestamp
 	default:
 		panic(fmt.Sprintf("unsupported evidence: %+v", ev))
 	}
 }
 
+func maxProposalBytes(signSize int) int64 {
+	return (1 + 1) + // Type
+		(1 + 9) + // Height
+		(1 + 5) + // Round
+		(1 + 10) + // POLRound
+		(1 + 76 + 1) + // BlockID
+		(1 + 17 + 1) + // Timestamp
+		(1 + int64(signSize) + 1) // Signature
+}
+
 // Evidence represents any provable malicious activity by a validator.
 // Verification logic for each evidence is part of the evidence module.
 type Evidence interface {
@@ -72,12 +96,13 @@ func NewDuplicateVoteEvidence(vote1, vote2 *Vote, blockTime time.Time, voterSet
 		return nil
 	}
 
+	// The vote extensions are irrelevant to the evidence and would only make it bigger.
 	if strings.Compare(vote1.BlockID.Key(), vote2.BlockID.Key()) == -1 {
-		voteA = vote1
-		voteB = vote2
+		voteA = vote1.withoutExtension()
+		voteB = vote2.withoutExtension()
 	} else {
-		voteA = vote2
-		voteB = vote1
+		voteA = vote2.withoutExtension()
+		voteB = vote1.withoutExtension()
 	}
 	return &DuplicateVoteEvidence{
 		VoteA:            voteA,
@@ -428,6 +453,311 @@ func LightClientAttackEvidenceFromProto(lpb *tmproto.LightClientAttackEvidence)
 	return l, l.ValidateBasic()
 }
 
+//------------------------------------ PROPOSER EVIDENCE --------------------------------------
+
+// ConflictingProposalEvidence contains evidence of a proposer signing two conflicting proposals
+// for the same height and round.
+type ConflictingProposalEvidence struct {
+	ProposalA       *Proposal `json:"proposal_a"`
+	ProposalB       *Proposal `json:"proposal_b"`
+	ProposerAddress Address   `json:"proposer_address"`
+
+	// abci specific information
+	TotalVotingPower int64
+	ValidatorPower   int64
+	Timestamp        time.Time
+}
+
+var _ Evidence = &ConflictingProposalEvidence{}
+
+// NewConflictingProposalEvidence creates ConflictingProposalEvidence with right ordering given two
+// conflicting proposals of the proposer elected from vals. If one of the proposals is nil or the
+// proposer is not in vals, evidence returned is nil as well.
+func NewConflictingProposalEvidence(proposal1, proposal2 *Proposal, proposerAddress Address, blockTime time.Time,
+	vals *ValidatorSet, voterSet *VoterSet) *ConflictingProposalEvidence {
+	if proposal1 == nil || proposal2 == nil || vals == nil || voterSet == nil {
+		return nil
+	}
+	_, val := vals.GetByAddress(proposerAddress)
+	if val == nil {
+		return nil
+	}
+
+	proposalA, proposalB := proposal1, proposal2
+	if strings.Compare(proposal1.BlockID.Key(), proposal2.BlockID.Key()) != -1 {
+		proposalA, proposalB = proposal2, proposal1
+	}
+	return &ConflictingProposalEvidence{
+		ProposalA:        proposalA,
+		ProposalB:        proposalB,
+		ProposerAddress:  proposerAddress,
+		TotalVotingPower: voterSet.TotalVotingPower(),
+		ValidatorPower:   val.StakingPower,
+		Timestamp:        blockTime,
+	}
+}
+
+// ABCI returns the application relevant representation of the evidence
+func (cpe *ConflictingProposalEvidence) ABCI() []abci.Evidence {
+	return []abci.Evidence{{
+		Type: abci.EvidenceType_CONFLICTING_PROPOSAL,
+		Validator: abci.Validator{
+			Address: cpe.ProposerAddress,
+			Power:   cpe.ValidatorPower,
+		},
+		Height:           cpe.ProposalA.Height,
+		Time:             cpe.Timestamp,
+		TotalVotingPower: cpe.TotalVotingPower,
+	}}
+}
+
+// Bytes returns the proto-encoded evidence as a byte array.
+func (cpe *ConflictingProposalEvidence) Bytes() []byte {
+	pbe := cpe.ToProto()
+	bz, err := pbe.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// Hash returns the hash of the evidence.
+func (cpe *ConflictingProposalEvidence) Hash() []byte {
+	return tmhash.Sum(cpe.Bytes())
+}
+
+// Height returns the height of the infraction
+func (cpe *ConflictingProposalEvidence) Height() int64 {
+	return cpe.ProposalA.Height
+}
+
+// String returns a string representation of the evidence.
+func (cpe *ConflictingProposalEvidence) String() string {
+	return fmt.Sprintf("ConflictingProposalEvidence{ProposalA: %v, ProposalB: %v, Proposer: %v}",
+		cpe.ProposalA, cpe.ProposalB, cpe.ProposerAddress)
+}
+
+// Time returns the time of the infraction
+func (cpe *ConflictingProposalEvidence) Time() time.Time {
+	return cpe.Timestamp
+}
+
+// ValidateBasic performs basic validation.
+func (cpe *ConflictingProposalEvidence) ValidateBasic() error {
+	if cpe == nil {
+		return errors.New("empty conflicting proposal evidence")
+	}
+
+	if cpe.ProposalA == nil || cpe.ProposalB == nil {
+		return fmt.Errorf("one or both of the proposals are empty %v, %v", cpe.ProposalA, cpe.ProposalB)
+	}
+	if err := cpe.ProposalA.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid ProposalA: %w", err)
+	}
+	if err := cpe.ProposalB.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid ProposalB: %w", err)
+	}
+	if len(cpe.ProposerAddress) != crypto.AddressSize {
+		return fmt.Errorf("expected ProposerAddress size to be %d bytes, got %d bytes",
+			crypto.AddressSize,
+			len(cpe.ProposerAddress),
+		)
+	}
+	// Enforce Proposals are lexicographically sorted on blockID
+	if strings.Compare(cpe.ProposalA.BlockID.Key(), cpe.ProposalB.BlockID.Key()) >= 0 {
+		return errors.New("conflicting proposals in invalid order")
+	}
+	return nil
+}
+
+// ToProto encodes ConflictingProposalEvidence to protobuf
+func (cpe *ConflictingProposalEvidence) ToProto() *tmproto.ConflictingProposalEvidence {
+	return &tmproto.ConflictingProposalEvidence{
+		ProposalA:        cpe.ProposalA.ToProto(),
+		ProposalB:        cpe.ProposalB.ToProto(),
+		ProposerAddress:  cpe.ProposerAddress,
+		TotalVotingPower: cpe.TotalVotingPower,
+		ValidatorPower:   cpe.ValidatorPower,
+		Timestamp:        cpe.Timestamp,
+	}
+}
+
+// ConflictingProposalEvidenceFromProto decodes protobuf into ConflictingProposalEvidence
+func ConflictingProposalEvidenceFromProto(pb *tmproto.ConflictingProposalEvidence) (
+	*ConflictingProposalEvidence, error) {
+	if pb == nil {
+		return nil, errors.New("nil conflicting proposal evidence")
+	}
+
+	pA, err := ProposalFromProto(pb.ProposalA)
+	if err != nil {
+		return nil, err
+	}
+
+	pB, err := ProposalFromProto(pb.ProposalB)
+	if err != nil {
+		return nil, err
+	}
+
+	cpe := &ConflictingProposalEvidence{
+		ProposalA:        pA,
+		ProposalB:        pB,
+		ProposerAddress:  pb.ProposerAddress,
+		TotalVotingPower: pb.TotalVotingPower,
+		ValidatorPower:   pb.ValidatorPower,
+		Timestamp:        pb.Timestamp,
+	}
+
+	return cpe, cpe.ValidateBasic()
+}
+
+// InvalidProposerProofEvidence contains evidence of a proposer signing a proposal of a block whose
+// VRF proof is invalid. The header of the block is included so that the proof can be verified
+// against the proposal signed by the proposer.
+type InvalidProposerProofEvidence struct {
+	Proposal *Proposal `json:"proposal"`
+	Header   *Header   `json:"header"`
+
+	// abci specific information
+	TotalVotingPower int64
+	ValidatorPower   int64
+	Timestamp        time.Time
+}
+
+var _ Evidence = &InvalidProposerProofEvidence{}
+
+// NewInvalidProposerProofEvidence creates InvalidProposerProofEvidence given a proposal and the
+// header of the proposed block. If one of them is nil or the proposer is not in vals, evidence
+// returned is nil as well.
+func NewInvalidProposerProofEvidence(proposal *Proposal, header *Header, blockTime time.Time,
+	vals *ValidatorSet, voterSet *VoterSet) *InvalidProposerProofEvidence {
+	if proposal == nil || header == nil || vals == nil || voterSet == nil {
+		return nil
+	}
+	_, val := vals.GetByAddress(header.ProposerAddress)
+	if val == nil {
+		return nil
+	}
+	return &InvalidProposerProofEvidence{
+		Proposal:         proposal,
+		Header:           header,
+		TotalVotingPower: voterSet.TotalVotingPower(),
+		ValidatorPower:   val.StakingPower,
+		Timestamp:        blockTime,
+	}
+}
+
+// ABCI returns the application relevant representation of the evidence
+func (ippe *InvalidProposerProofEvidence) ABCI() []abci.Evidence {
+	return []abci.Evidence{{
+		Type: abci.EvidenceType_INVALID_PROPOSER_PROOF,
+		Validator: abci.Validator{
+			Address: ippe.Header.ProposerAddress,
+			Power:   ippe.ValidatorPower,
+		},
+		Height:           ippe.Proposal.Height,
+		Time:             ippe.Timestamp,
+		TotalVotingPower: ippe.TotalVotingPower,
+	}}
+}
+
+// Bytes returns the proto-encoded evidence as a byte array.
+func (ippe *InvalidProposerProofEvidence) Bytes() []byte {
+	pbe := ippe.ToProto()
+	bz, err := pbe.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// Hash returns the hash of the evidence.
+func (ippe *InvalidProposerProofEvidence) Hash() []byte {
+	return tmhash.Sum(ippe.Bytes())
+}
+
+// Height returns the height of the infraction
+func (ippe *InvalidProposerProofEvidence) Height() int64 {
+	return ippe.Proposal.Height
+}
+
+// String returns a string representation of the evidence.
+func (ippe *InvalidProposerProofEvidence) String() string {
+	return fmt.Sprintf("InvalidProposerProofEvidence{Proposal: %v, Header: %v}", ippe.Proposal, ippe.Header.StringIndented(""))
+}
+
+// Time returns the time of the infraction
+func (ippe *InvalidProposerProofEvidence) Time() time.Time {
+	return ippe.Timestamp
+}
+
+// ValidateBasic performs basic validation.
+func (ippe *InvalidProposerProofEvidence) ValidateBasic() error {
+	if ippe == nil {
+		return errors.New("empty invalid proposer proof evidence")
+	}
+
+	if ippe.Proposal == nil || ippe.Header == nil {
+		return fmt.Errorf("the proposal or the header is empty %v, %v", ippe.Proposal, ippe.Header)
+	}
+	if err := ippe.Proposal.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid Proposal: %w", err)
+	}
+	if err := ippe.Header.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid Header: %w", err)
+	}
+	if ippe.Header.Height != ippe.Proposal.Height || ippe.Header.Round != ippe.Proposal.Round {
+		return fmt.Errorf("the header is not proposed at the height/round of the proposal: %d/%d vs %d/%d",
+			ippe.Header.Height, ippe.Header.Round, ippe.Proposal.Height, ippe.Proposal.Round)
+	}
+	if !bytes.Equal(ippe.Header.Hash(), ippe.Proposal.BlockID.Hash) {
+		return fmt.Errorf("the header hash %X does not match the proposal %X",
+			ippe.Header.Hash(), ippe.Proposal.BlockID.Hash)
+	}
+	return nil
+}
+
+// ToProto encodes InvalidProposerProofEvidence to protobuf
+func (ippe *InvalidProposerProofEvidence) ToProto() *tmproto.InvalidProposerProofEvidence {
+	return &tmproto.InvalidProposerProofEvidence{
+		Proposal:         ippe.Proposal.ToProto(),
+		Header:           ippe.Header.ToProto(),
+		TotalVotingPower: ippe.TotalVotingPower,
+		ValidatorPower:   ippe.ValidatorPower,
+		Timestamp:        ippe.Timestamp,
+	}
+}
+
+// InvalidProposerProofEvidenceFromProto decodes protobuf into InvalidProposerProofEvidence
+func InvalidProposerProofEvidenceFromProto(pb *tmproto.InvalidProposerProofEvidence) (
+	*InvalidProposerProofEvidence, error) {
+	if pb == nil {
+		return nil, errors.New("nil invalid proposer proof evidence")
+	}
+
+	proposal, err := ProposalFromProto(pb.Proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := HeaderFromProto(pb.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	ippe := &InvalidProposerProofEvidence{
+		Proposal:         proposal,
+		Header:           &header,
+		TotalVotingPower: pb.TotalVotingPower,
+		ValidatorPower:   pb.ValidatorPower,
+		Timestamp:        pb.Timestamp,
+	}
+
+	return ippe, ippe.ValidateBasic()
+}
+
 //------------------------------------------------------------------------------------------
 
 // EvidenceList is a list of Evidence. Evidences is not a word.
@@ -492,6 +822,22 @@ func EvidenceToProto(evidence Evidence) (*tmproto.Evidence, error) {
 			},
 		}, nil
 
+	case *ConflictingProposalEvidence:
+		pbev := evi.ToProto()
+		return &tmproto.Evidence{
+			Sum: &tmproto.Evidence_ConflictingProposalEvidence{
+				ConflictingProposalEvidence: pbev,
+			},
+		}, nil
+
+	case *InvalidProposerProofEvidence:
+		pbev := evi.ToProto()
+		return &tmproto.Evidence{
+			Sum: &tmproto.Evidence_InvalidProposerProofEvidence{
+				InvalidProposerProofEvidence: pbev,
+			},
+		}, nil
+
 	default:
 		return nil, fmt.Errorf("toproto: evidence is not recognized: %T", evi)
 	}
@@ -509,6 +855,10 @@ func EvidenceFromProto(evidence *tmproto.Evidence) (Evidence, error) {
 		return DuplicateVoteEvidenceFromProto(evi.DuplicateVoteEvidence)
 	case *tmproto.Evidence_LightClientAttackEvidence:
 		return LightClientAttackEvidenceFromProto(evi.LightClientAttackEvidence)
+	case *tmproto.Evidence_ConflictingProposalEvidence:
+		return ConflictingProposalEvidenceFromProto(evi.ConflictingProposalEvidence)
+	case *tmproto.Evidence_InvalidProposerProofEvidence:
+		return InvalidProposerProofEvidenceFromProto(evi.InvalidProposerProofEvidence)
 	default:
 		return nil, errors.New("evidence is not recognized")
 	}
@@ -517,6 +867,8 @@ func EvidenceFromProto(evidence *tmproto.Evidence) (Evidence, error) {
 func init() {
 	tmjson.RegisterType(&DuplicateVoteEvidence{}, "tendermint/DuplicateVoteEvidence")
 	tmjson.RegisterType(&LightClientAttackEvidence{}, "tendermint/LightClientAttackEvidence")
+	tmjson.RegisterType(&ConflictingProposalEvidence{}, "ostracon/ConflictingProposalEvidence")
+	tmjson.RegisterType(&InvalidProposerProofEvidence{}, "ostracon/InvalidProposerProofEvidence")
 }
 
 //-------------------------------------------- ERRORS --------------------------------------
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/line/ostracon/abci/types"
 	"github.com/line/ostracon/crypto"
 	"github.com/line/ostracon/crypto/tmhash"
 	tmrand "github.com/line/ostracon/libs/rand"
@@ -250,6 +251,129 @@ func TestLightClientAttackEvidenceValidation(t *testing.T) {
 
 }
 
+func TestConflictingProposalEvidence(t *testing.T) {
+	forAllPrivKeyTypes(t, func(t *testing.T, name string, kt PrivKeyType) {
+		val := NewMockPV(kt)
+		blockID := makeBlockID(tmhash.Sum([]byte("blockhash")), math.MaxInt32, tmhash.Sum([]byte("partshash")))
+		blockID2 := makeBlockID(tmhash.Sum([]byte("blockhash2")), math.MaxInt32, tmhash.Sum([]byte("partshash")))
+		const chainID = "mychain"
+		validator := val.ExtractIntoValidator(10)
+		vals := NewValidatorSet([]*Validator{validator})
+		voterSet := ToVoterAll(vals.Validators)
+
+		proposal1 := makeProposal(t, val, chainID, math.MaxInt64, math.MaxInt32, blockID, defaultVoteTime)
+		proposal2 := makeProposal(t, val, chainID, math.MaxInt64, math.MaxInt32, blockID2, defaultVoteTime)
+		ev := NewConflictingProposalEvidence(proposal1, proposal2, validator.Address, defaultVoteTime, vals, voterSet)
+		require.NotNil(t, ev)
+		// the proposals are ordered
+		assert.Equal(t, ev, NewConflictingProposalEvidence(proposal2, proposal1, validator.Address, defaultVoteTime,
+			vals, voterSet))
+		assert.NoError(t, ev.ValidateBasic())
+		assert.Equal(t, ev.Hash(), tmhash.Sum(ev.Bytes()))
+		assert.NotNil(t, ev.String())
+		assert.Equal(t, int64(math.MaxInt64), ev.Height())
+		assert.True(t, MaxEvidenceBytes(ev) >= int64(len(ev.Bytes())))
+
+		abciEv := ev.ABCI()
+		require.Len(t, abciEv, 1)
+		assert.Equal(t, abci.EvidenceType_CONFLICTING_PROPOSAL, abciEv[0].Type)
+		assert.Equal(t, validator.Address.Bytes(), abciEv[0].Validator.Address)
+		assert.Equal(t, int64(10), abciEv[0].Validator.Power)
+		assert.Equal(t, voterSet.TotalVotingPower(), abciEv[0].TotalVotingPower)
+
+		// the proposer must be a validator
+		assert.Nil(t, NewConflictingProposalEvidence(proposal1, proposal2, crypto.CRandBytes(crypto.AddressSize),
+			defaultVoteTime, vals, voterSet))
+
+		testCases := []struct {
+			testName         string
+			malleateEvidence func(*ConflictingProposalEvidence)
+			expectErr        bool
+		}{
+			{"Good ConflictingProposalEvidence", func(ev *ConflictingProposalEvidence) {}, false},
+			{"Nil proposal A", func(ev *ConflictingProposalEvidence) { ev.ProposalA = nil }, true},
+			{"Nil proposal B", func(ev *ConflictingProposalEvidence) { ev.ProposalB = nil }, true},
+			{"Invalid proposal", func(ev *ConflictingProposalEvidence) { ev.ProposalA.Signature = nil }, true},
+			{"Invalid proposal order", func(ev *ConflictingProposalEvidence) {
+				ev.ProposalA, ev.ProposalB = ev.ProposalB, ev.ProposalA
+			}, true},
+			{"Same proposals", func(ev *ConflictingProposalEvidence) { ev.ProposalB = ev.ProposalA }, true},
+			{"Invalid proposer address", func(ev *ConflictingProposalEvidence) { ev.ProposerAddress = []byte("addr") }, true},
+		}
+		for _, tc := range testCases {
+			tc := tc
+			t.Run(tc.testName, func(t *testing.T) {
+				proposal1 := makeProposal(t, val, chainID, 10, 2, blockID, defaultVoteTime)
+				proposal2 := makeProposal(t, val, chainID, 10, 2, blockID2, defaultVoteTime)
+				ev := NewConflictingProposalEvidence(proposal1, proposal2, validator.Address, defaultVoteTime, vals, voterSet)
+				tc.malleateEvidence(ev)
+				assert.Equal(t, tc.expectErr, ev.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+			})
+		}
+	})
+}
+
+func TestInvalidProposerProofEvidence(t *testing.T) {
+	forAllPrivKeyTypes(t, func(t *testing.T, name string, kt PrivKeyType) {
+		val := NewMockPV(kt)
+		const chainID = "mychain"
+		validator := val.ExtractIntoValidator(10)
+		vals := NewValidatorSet([]*Validator{validator})
+		voterSet := ToVoterAll(vals.Validators)
+
+		makeEvidence := func() *InvalidProposerProofEvidence {
+			header := makeHeaderRandom()
+			header.Round = 2
+			header.ProposerAddress = validator.Address
+			header.Proof = crypto.CRandBytes(80)
+			blockID := makeBlockID(header.Hash(), math.MaxInt32, tmhash.Sum([]byte("partshash")))
+			proposal := makeProposal(t, val, chainID, header.Height, header.Round, blockID, defaultVoteTime)
+			return NewInvalidProposerProofEvidence(proposal, header, defaultVoteTime, vals, voterSet)
+		}
+
+		ev := makeEvidence()
+		require.NotNil(t, ev)
+		assert.Equal(t, ev.Hash(), tmhash.Sum(ev.Bytes()))
+		assert.NotNil(t, ev.String())
+		assert.Equal(t, ev.Header.Height, ev.Height())
+		assert.True(t, MaxEvidenceBytes(ev) >= int64(len(ev.Bytes())))
+
+		abciEv := ev.ABCI()
+		require.Len(t, abciEv, 1)
+		assert.Equal(t, abci.EvidenceType_INVALID_PROPOSER_PROOF, abciEv[0].Type)
+		assert.Equal(t, validator.Address.Bytes(), abciEv[0].Validator.Address)
+		assert.Equal(t, int64(10), abciEv[0].Validator.Power)
+		assert.Equal(t, voterSet.TotalVotingPower(), abciEv[0].TotalVotingPower)
+
+		// the proposer must be a validator
+		header := makeHeaderRandom()
+		assert.Nil(t, NewInvalidProposerProofEvidence(ev.Proposal, header, defaultVoteTime, vals, voterSet))
+
+		testCases := []struct {
+			testName         string
+			malleateEvidence func(*InvalidProposerProofEvidence)
+			expectErr        bool
+		}{
+			{"Good InvalidProposerProofEvidence", func(ev *InvalidProposerProofEvidence) {}, false},
+			{"Nil proposal", func(ev *InvalidProposerProofEvidence) { ev.Proposal = nil }, true},
+			{"Nil header", func(ev *InvalidProposerProofEvidence) { ev.Header = nil }, true},
+			{"Invalid proposal", func(ev *InvalidProposerProofEvidence) { ev.Proposal.Signature = nil }, true},
+			{"Invalid header", func(ev *InvalidProposerProofEvidence) { ev.Header.LastResultsHash = []byte("hash") }, true},
+			{"Different height", func(ev *InvalidProposerProofEvidence) { ev.Proposal.Height++ }, true},
+			{"Different round", func(ev *InvalidProposerProofEvidence) { ev.Proposal.Round++ }, true},
+			{"Different block", func(ev *InvalidProposerProofEvidence) { ev.Header.Proof = crypto.CRandBytes(80) }, true},
+		}
+		for _, tc := range testCases {
+			tc := tc
+			t.Run(tc.testName, func(t *testing.T) {
+				ev := makeEvidence()
+				tc.malleateEvidence(ev)
+				assert.Equal(t, tc.expectErr, ev.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+			})
+		}
+	})
+}
+
 func TestMockEvidenceValidateBasic(t *testing.T) {
 	goodEvidence := NewMockDuplicateVoteEvidence(int64(1), time.Now(), "mock-chain-id")
 	assert.Nil(t, goodEvidence.ValidateBasic())
@@ -280,6 +404,25 @@ func makeVote(
 	return v
 }
 
+func makeProposal(
+	t *testing.T, val PrivValidator, chainID string, height int64, round int32, blockID BlockID,
+	time time.Time) *Proposal {
+	p := &Proposal{
+		Type:      tmproto.ProposalType,
+		Height:    height,
+		Round:     round,
+		POLRound:  -1,
+		BlockID:   blockID,
+		Timestamp: time,
+	}
+
+	ppb := p.ToProto()
+	err := val.SignProposal(chainID, ppb)
+	require.NoError(t, err)
+	p.Signature = ppb.Signature
+	return p
+}
+
 func makeHeaderRandom() *Header {
 	return &Header{
 		Version:            tmversion.Consensus{Block: version.BlockProtocol, App: 1},
@@ -325,6 +468,13 @@ func TestEvidenceProto(t *testing.T) {
 		header2.LastBlockID = blockID
 		header2.ChainID = chainID
 
+		// -------- Proposals --------
+		proposerAddress := val.ExtractIntoValidator(1).Address
+		p := makeProposal(t, val, chainID, math.MaxInt64, 1, blockID, defaultVoteTime)
+		p2 := makeProposal(t, val, chainID, math.MaxInt64, 1, blockID2, defaultVoteTime)
+		p3 := makeProposal(t, val, chainID, header1.Height, header1.Round,
+			makeBlockID(header1.Hash(), math.MaxInt32, tmhash.Sum([]byte("partshash"))), defaultVoteTime)
+
 		tests := []struct {
 			testName     string
 			evidence     Evidence
@@ -336,6 +486,15 @@ func TestEvidenceProto(t *testing.T) {
 			{"DuplicateVoteEvidence nil voteB", &DuplicateVoteEvidence{VoteA: v, VoteB: nil}, false, true},
 			{"DuplicateVoteEvidence nil voteA", &DuplicateVoteEvidence{VoteA: nil, VoteB: v}, false, true},
 			{"DuplicateVoteEvidence success", &DuplicateVoteEvidence{VoteA: v2, VoteB: v}, false, false},
+			{"ConflictingProposalEvidence empty fail", &ConflictingProposalEvidence{}, false, true},
+			{"ConflictingProposalEvidence nil proposalB", &ConflictingProposalEvidence{ProposalA: p,
+				ProposerAddress: proposerAddress}, false, true},
+			{"ConflictingProposalEvidence success", &ConflictingProposalEvidence{ProposalA: p2, ProposalB: p,
+				ProposerAddress: proposerAddress}, false, false},
+			{"InvalidProposerProofEvidence empty fail", &InvalidProposerProofEvidence{}, false, true},
+			{"InvalidProposerProofEvidence nil header", &InvalidProposerProofEvidence{Proposal: p3}, false, true},
+			{"InvalidProposerProofEvidence success", &InvalidProposerProofEvidence{Proposal: p3, Header: header1},
+				false, false},
 		}
 		for _, tt := range tests {
 			tt := tt
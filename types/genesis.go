@@ -33,6 +33,9 @@ type GenesisValidator struct {
 	PubKey  crypto.PubKey `json:"pub_key"`
 	Power   int64         `json:"power"`
 	Name    string        `json:"name"`
+
+	// ProofOfPossession is required if PubKey contains a BLS key
+	ProofOfPossession []byte `json:"proof_of_possession,omitempty"`
 }
 
 type VoterParams struct {
@@ -94,16 +97,33 @@ func (genDoc *GenesisDoc) ValidateAndComplete() error {
 		genDoc.InitialHeight = 1
 	}
 
+	if genDoc.VoterParams != nil {
+		if err := genDoc.VoterParams.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// The voter params of the consensus params are filled in from voter_params if they are left empty,
+	// and vice versa.
 	if genDoc.ConsensusParams == nil {
 		genDoc.ConsensusParams = DefaultConsensusParams()
-	} else if err := ValidateConsensusParams(*genDoc.ConsensusParams); err != nil {
+		if genDoc.VoterParams != nil {
+			genDoc.ConsensusParams.Voter = *genDoc.VoterParams.ToProto()
+		}
+	} else if genDoc.ConsensusParams.Voter.Equal(&tmproto.VoterParams{}) {
+		genDoc.ConsensusParams.Voter = *DefaultVoterParams().ToProto()
+		if genDoc.VoterParams != nil {
+			genDoc.ConsensusParams.Voter = *genDoc.VoterParams.ToProto()
+		}
+	}
+	if err := ValidateConsensusParams(*genDoc.ConsensusParams); err != nil {
 		return err
 	}
 
 	if genDoc.VoterParams == nil {
-		genDoc.VoterParams = DefaultVoterParams()
-	} else if err := genDoc.VoterParams.Validate(); err != nil {
-		return err
+		genDoc.VoterParams = VoterParamsFromProto(&genDoc.ConsensusParams.Voter)
+	} else if !genDoc.VoterParams.ToProto().Equal(&genDoc.ConsensusParams.Voter) {
+		return errors.New("voter_params in genesis doc must match consensus_params.voter")
 	}
 
 	for i, v := range genDoc.Validators {
@@ -116,6 +136,9 @@ func (genDoc *GenesisDoc) ValidateAndComplete() error {
 		if len(v.Address) == 0 {
 			genDoc.Validators[i].Address = v.PubKey.Address()
 		}
+		if err := VerifyProofOfPossession(v.PubKey, v.ProofOfPossession); err != nil {
+			return fmt.Errorf("validator %v in the genesis file: %w", v, err)
+		}
 	}
 
 	if genDoc.GenesisTime.IsZero() {
@@ -8,8 +8,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/line/ostracon/crypto/composite"
 	"github.com/line/ostracon/crypto/ed25519"
 	tmjson "github.com/line/ostracon/libs/json"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
 	tmtime "github.com/line/ostracon/types/time"
 )
 
@@ -91,7 +93,7 @@ func TestGenesisGood(t *testing.T) {
 	// create a base gendoc from struct
 	baseGenDoc := &GenesisDoc{
 		ChainID:    "abc",
-		Validators: []GenesisValidator{{pubkey.Address(), pubkey, 10, "myval"}},
+		Validators: []GenesisValidator{{pubkey.Address(), pubkey, 10, "myval", nil}},
 	}
 	genDocBytes, err = tmjson.Marshal(baseGenDoc)
 	assert.NoError(t, err, "error marshalling genDoc")
@@ -131,6 +133,61 @@ func TestGenesisGood(t *testing.T) {
 	}
 }
 
+func TestGenesisVoterParams(t *testing.T) {
+	voterParams := &VoterParams{VoterElectionThreshold: 10, MaxTolerableByzantinePercentage: 30}
+
+	// voter_params fill in the voter params of the consensus params
+	genDoc := &GenesisDoc{ChainID: "abc", VoterParams: voterParams}
+	require.NoError(t, genDoc.ValidateAndComplete())
+	assert.Equal(t, *voterParams.ToProto(), genDoc.ConsensusParams.Voter)
+
+	genDoc = &GenesisDoc{ChainID: "abc", VoterParams: voterParams, ConsensusParams: DefaultConsensusParams()}
+	genDoc.ConsensusParams.Voter = tmproto.VoterParams{}
+	require.NoError(t, genDoc.ValidateAndComplete())
+	assert.Equal(t, *voterParams.ToProto(), genDoc.ConsensusParams.Voter)
+
+	// the voter params of the consensus params fill in voter_params
+	genDoc = &GenesisDoc{ChainID: "abc", ConsensusParams: DefaultConsensusParams()}
+	genDoc.ConsensusParams.Voter = *voterParams.ToProto()
+	require.NoError(t, genDoc.ValidateAndComplete())
+	assert.Equal(t, voterParams, genDoc.VoterParams)
+
+	// both are filled in with the default
+	genDoc = &GenesisDoc{ChainID: "abc"}
+	require.NoError(t, genDoc.ValidateAndComplete())
+	assert.Equal(t, DefaultVoterParams(), genDoc.VoterParams)
+	assert.Equal(t, *DefaultVoterParams().ToProto(), genDoc.ConsensusParams.Voter)
+
+	// they must match if both are given
+	genDoc = &GenesisDoc{ChainID: "abc", VoterParams: voterParams, ConsensusParams: DefaultConsensusParams()}
+	assert.Error(t, genDoc.ValidateAndComplete())
+}
+
+func TestGenesisValidatorProofOfPossession(t *testing.T) {
+	privKey := composite.GenPrivKey()
+	pubKey := privKey.PubKey()
+	pop, err := ProofOfPossession(privKey)
+	require.NoError(t, err)
+
+	genDoc := &GenesisDoc{
+		ChainID:    "abc",
+		Validators: []GenesisValidator{{PubKey: pubKey, Power: 10, Name: "myval"}},
+	}
+	require.Error(t, genDoc.ValidateAndComplete(), "expected error for BLS key without proof-of-possession")
+
+	genDoc.Validators[0].ProofOfPossession = pop[:len(pop)-1]
+	require.Error(t, genDoc.ValidateAndComplete(), "expected error for invalid proof-of-possession")
+
+	genDoc.Validators[0].ProofOfPossession = pop
+	require.NoError(t, genDoc.ValidateAndComplete())
+
+	genDocBytes, err := tmjson.Marshal(genDoc)
+	require.NoError(t, err)
+	genDoc2, err := GenesisDocFromJSON(genDocBytes)
+	require.NoError(t, err)
+	assert.Equal(t, pop, genDoc2.Validators[0].ProofOfPossession)
+}
+
 func TestGenesisSaveAs(t *testing.T) {
 	tmpfile, err := ioutil.TempFile("", "genesis")
 	require.NoError(t, err)
@@ -169,7 +226,7 @@ func randomGenesisDoc() *GenesisDoc {
 		ChainID:         "abc",
 		InitialHeight:   1000,
 		VoterParams:     DefaultVoterParams(),
-		Validators:      []GenesisValidator{{pubkey.Address(), pubkey, 10, "myval"}},
+		Validators:      []GenesisValidator{{pubkey.Address(), pubkey, 10, "myval", nil}},
 		ConsensusParams: DefaultConsensusParams(),
 		AppHash:         []byte{1, 2, 3},
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	tmproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/line/ostracon/version"
 )
 
 // LightBlock is a SignedHeader and a ValidatorSet.
@@ -30,7 +31,7 @@ func (lb LightBlock) ValidateBasic(chainID string) error {
 	if err := lb.SignedHeader.ValidateBasic(chainID); err != nil {
 		return fmt.Errorf("invalid signed header: %w", err)
 	}
-	if err := lb.VoterSet.ValidateBasic(); err != nil {
+	if err := lb.VoterSet.validateBasic(lb.Version.Block); err != nil {
 		return fmt.Errorf("invalid voter set: %w", err)
 	}
 
@@ -116,7 +117,11 @@ func LightBlockFromProto(pb *tmproto.LightBlock) (*LightBlock, error) {
 	}
 
 	if pb.VoterSet != nil {
-		voters, err := VoterSetFromProto(pb.VoterSet)
+		blockVersion := version.BlockProtocol
+		if lb.SignedHeader != nil && lb.Header != nil {
+			blockVersion = lb.Version.Block
+		}
+		voters, err := VoterSetFromProto(pb.VoterSet, blockVersion)
 		if err != nil {
 			return nil, err
 		}
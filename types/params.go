@@ -7,7 +7,9 @@ import (
 
 	abci "github.com/line/ostracon/abci/types"
 	"github.com/line/ostracon/crypto/tmhash"
+	"github.com/line/ostracon/crypto/vrf"
 	tmproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/line/ostracon/version"
 )
 
 const (
@@ -20,6 +22,10 @@ const (
 	// MaxBlockPartsCount is the maximum number of block parts.
 	MaxBlockPartsCount = (MaxBlockSizeBytes / BlockPartSizeBytes) + 1
 
+	// MaxPartSetParityPercentage is the maximum number of the parity parts per 100
+	// data parts of the erasure-coded block parts.
+	MaxPartSetParityPercentage = 100
+
 	DefaultVoterElectionThreshold          = 33
 	DefaultMaxTolerableByzantinePercentage = 20
 )
@@ -31,6 +37,10 @@ func DefaultConsensusParams() *tmproto.ConsensusParams {
 		Evidence:  DefaultEvidenceParams(),
 		Validator: DefaultValidatorParams(),
 		Version:   DefaultVersionParams(),
+		Voter:     *DefaultVoterParams().ToProto(),
+		Timestamp: DefaultTimestampParams(),
+		PartSet:   DefaultPartSetParams(),
+		Abci:      DefaultABCIParams(),
 	}
 }
 
@@ -73,6 +83,38 @@ func DefaultVersionParams() tmproto.VersionParams {
 	}
 }
 
+// DefaultTimestampParams returns a default TimestampParams, which derives the
+// block time from the last commit.
+func DefaultTimestampParams() tmproto.TimestampParams {
+	return tmproto.TimestampParams{
+		ProposerBased: false,
+		Precision:     500 * time.Millisecond,
+		MessageDelay:  2 * time.Second,
+	}
+}
+
+// DefaultPartSetParams returns a default PartSetParams, which does not erasure code
+// the block parts.
+func DefaultPartSetParams() tmproto.PartSetParams {
+	return tmproto.PartSetParams{
+		ParityPercentage: 0,
+	}
+}
+
+// DefaultABCIParams returns a default ABCIParams, which disables the vote
+// extensions.
+func DefaultABCIParams() tmproto.ABCIParams {
+	return tmproto.ABCIParams{
+		VoteExtensionsEnableHeight: 0,
+	}
+}
+
+// VoteExtensionsEnabled returns true if the precommits at the height are extended
+// by the app, and the app prepares and processes the proposals of the height.
+func VoteExtensionsEnabled(params tmproto.ABCIParams, height int64) bool {
+	return params.VoteExtensionsEnableHeight > 0 && height >= params.VoteExtensionsEnableHeight
+}
+
 func IsValidPubkeyType(params tmproto.ValidatorParams, pubkeyType string) bool {
 	for i := 0; i < len(params.PubKeyTypes); i++ {
 		if params.PubKeyTypes[i] == pubkeyType {
@@ -137,20 +179,70 @@ func ValidateConsensusParams(params tmproto.ConsensusParams) error {
 		}
 	}
 
+	if params.Validator.VrfSuite != "" && !vrf.IsSupported(params.Validator.VrfSuite) {
+		return fmt.Errorf("validator.VrfSuite %q is not supported (supported: %v)",
+			params.Validator.VrfSuite, vrf.Suites())
+	}
+
+	if err := VoterParamsFromProto(&params.Voter).Validate(); err != nil {
+		return fmt.Errorf("voter: %w", err)
+	}
+
+	if params.Timestamp.Precision < 0 || (params.Timestamp.ProposerBased && params.Timestamp.Precision == 0) {
+		return fmt.Errorf("timestamp.Precision must be greater than 0 if proposer based. Got %v",
+			params.Timestamp.Precision)
+	}
+
+	if params.Timestamp.MessageDelay < 0 || (params.Timestamp.ProposerBased && params.Timestamp.MessageDelay == 0) {
+		return fmt.Errorf("timestamp.MessageDelay must be greater than 0 if proposer based. Got %v",
+			params.Timestamp.MessageDelay)
+	}
+
+	if params.PartSet.ParityPercentage > MaxPartSetParityPercentage {
+		return fmt.Errorf("partSet.ParityPercentage is too big. %d > %d",
+			params.PartSet.ParityPercentage, MaxPartSetParityPercentage)
+	}
+
+	if params.Abci.VoteExtensionsEnableHeight < 0 {
+		return fmt.Errorf("abci.VoteExtensionsEnableHeight must not be negative. Got %d",
+			params.Abci.VoteExtensionsEnableHeight)
+	}
+
+	if upgrade := params.Version.Upgrade; upgrade != nil {
+		if upgrade.Height < 0 {
+			return fmt.Errorf("version.Upgrade.Height must not be negative. Got %v", upgrade.Height)
+		}
+
+		if upgrade.Height > 0 && upgrade.BlockVersion == 0 {
+			return errors.New("version.Upgrade.BlockVersion must be greater than 0 if an upgrade is scheduled")
+		}
+	}
+
 	return nil
 }
 
 // Hash returns a hash of a subset of the parameters to store in the block header.
-// Only the Block.MaxBytes and Block.MaxGas are included in the hash.
+// Only the Block.MaxBytes and Block.MaxGas are included in the hash, and from
+// version.BlockProtocolExtendedParamsHash the Voter params, the Timestamp.ProposerBased,
+// the PartSet.ParityPercentage, the Abci.VoteExtensionsEnableHeight and the Validator.VrfSuite as well, so that the
+// headers of earlier block protocols keep their hash.
 // This allows the ConsensusParams to evolve more without breaking the block
 // protocol. No need for a Merkle tree here, just a small struct to hash.
-func HashConsensusParams(params tmproto.ConsensusParams) []byte {
+func HashConsensusParams(params tmproto.ConsensusParams, blockVersion uint64) []byte {
 	hasher := tmhash.New()
 
 	hp := tmproto.HashedParams{
 		BlockMaxBytes: params.Block.MaxBytes,
 		BlockMaxGas:   params.Block.MaxGas,
 	}
+	if blockVersion >= version.BlockProtocolExtendedParamsHash {
+		hp.VoterElectionThreshold = params.Voter.VoterElectionThreshold
+		hp.VoterMaxTolerableByzantinePercentage = params.Voter.MaxTolerableByzantinePercentage
+		hp.TimestampProposerBased = params.Timestamp.ProposerBased
+		hp.PartSetParityPercentage = params.PartSet.ParityPercentage
+		hp.AbciVoteExtensionsEnableHeight = params.Abci.VoteExtensionsEnableHeight
+		hp.ValidatorVrfSuite = params.Validator.VrfSuite
+	}
 
 	bz, err := hp.Marshal()
 	if err != nil {
@@ -186,10 +278,32 @@ func UpdateConsensusParams(params tmproto.ConsensusParams, params2 *abci.Consens
 	if params2.Validator != nil {
 		// Copy params2.Validator.PubkeyTypes, and set result's value to the copy.
 		// This avoids having to initialize the slice to 0 values, and then write to it again.
+		// The VrfSuite is pinned at genesis, so it isn't updated.
 		res.Validator.PubKeyTypes = append([]string{}, params2.Validator.PubKeyTypes...)
 	}
 	if params2.Version != nil {
 		res.Version.AppVersion = params2.Version.AppVersion
+		// The upgrade is only changed if it is set, so that the app doesn't cancel
+		// it by updating the app version. It is cancelled by a height of 0.
+		if upgrade := params2.Version.Upgrade; upgrade != nil {
+			if upgrade.Height == 0 {
+				res.Version.Upgrade = nil
+			} else {
+				res.Version.Upgrade = &tmproto.UpgradePlan{BlockVersion: upgrade.BlockVersion, Height: upgrade.Height}
+			}
+		}
+	}
+	if params2.Voter != nil {
+		res.Voter = *params2.Voter
+	}
+	if params2.Timestamp != nil {
+		res.Timestamp = *params2.Timestamp
+	}
+	if params2.PartSet != nil {
+		res.PartSet = *params2.PartSet
+	}
+	if params2.Abci != nil {
+		res.Abci = *params2.Abci
 	}
 	return res
 }
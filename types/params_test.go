@@ -9,7 +9,9 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	abci "github.com/line/ostracon/abci/types"
+	"github.com/line/ostracon/crypto/vrf"
 	tmproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/line/ostracon/version"
 )
 
 var (
@@ -41,6 +43,32 @@ func TestConsensusParamsValidation(t *testing.T) {
 		13: {makeParams(1, 0, 10, 2, 0, []string{}), false},
 		// test invalid pubkey type provided
 		14: {makeParams(1, 0, 10, 2, 0, []string{"potatoes make good pubkeys"}), false},
+		// test voter params
+		15: {makeParamsWithVoter(makeParams(1, 0, 10, 2, 0, valEd25519), 0, 33), true},
+		16: {makeParamsWithVoter(makeParams(1, 0, 10, 2, 0, valEd25519), -1, 20), false},
+		17: {makeParamsWithVoter(makeParams(1, 0, 10, 2, 0, valEd25519), 33, 0), false},
+		18: {makeParamsWithVoter(makeParams(1, 0, 10, 2, 0, valEd25519), 33, 34), false},
+		// test timestamp params
+		19: {makeParamsWithTimestamp(makeParams(1, 0, 10, 2, 0, valEd25519), false, 0, 0), true},
+		20: {makeParamsWithTimestamp(makeParams(1, 0, 10, 2, 0, valEd25519), true, time.Second, time.Second), true},
+		21: {makeParamsWithTimestamp(makeParams(1, 0, 10, 2, 0, valEd25519), true, 0, time.Second), false},
+		22: {makeParamsWithTimestamp(makeParams(1, 0, 10, 2, 0, valEd25519), true, time.Second, 0), false},
+		23: {makeParamsWithTimestamp(makeParams(1, 0, 10, 2, 0, valEd25519), false, -time.Second, 0), false},
+		// test upgrade plan
+		24: {makeParamsWithUpgrade(makeParams(1, 0, 10, 2, 0, valEd25519), 0, 0), true},
+		25: {makeParamsWithUpgrade(makeParams(1, 0, 10, 2, 0, valEd25519), 12, 100), true},
+		26: {makeParamsWithUpgrade(makeParams(1, 0, 10, 2, 0, valEd25519), 0, 100), false},
+		27: {makeParamsWithUpgrade(makeParams(1, 0, 10, 2, 0, valEd25519), 12, -1), false},
+		// test part set params
+		28: {makeParamsWithPartSet(makeParams(1, 0, 10, 2, 0, valEd25519), 50), true},
+		29: {makeParamsWithPartSet(makeParams(1, 0, 10, 2, 0, valEd25519), 100), true},
+		30: {makeParamsWithPartSet(makeParams(1, 0, 10, 2, 0, valEd25519), 101), false},
+		// test abci params
+		31: {makeParamsWithABCI(makeParams(1, 0, 10, 2, 0, valEd25519), 100), true},
+		32: {makeParamsWithABCI(makeParams(1, 0, 10, 2, 0, valEd25519), -1), false},
+		// test vrf suite
+		33: {makeParamsWithVRFSuite(makeParams(1, 0, 10, 2, 0, valEd25519), vrf.SuiteEdwards25519SHA512ELL2), true},
+		34: {makeParamsWithVRFSuite(makeParams(1, 0, 10, 2, 0, valEd25519), "ECVRF-UNKNOWN"), false},
 	}
 	for i, tc := range testCases {
 		if tc.valid {
@@ -72,9 +100,81 @@ func makeParams(
 		Validator: tmproto.ValidatorParams{
 			PubKeyTypes: pubkeyTypes,
 		},
+		Voter: *DefaultVoterParams().ToProto(),
 	}
 }
 
+func makeParamsWithVoter(
+	params tmproto.ConsensusParams,
+	voterElectionThreshold, maxTolerableByzantinePercentage int32,
+) tmproto.ConsensusParams {
+	params.Voter = tmproto.VoterParams{
+		VoterElectionThreshold:          voterElectionThreshold,
+		MaxTolerableByzantinePercentage: maxTolerableByzantinePercentage,
+	}
+	return params
+}
+
+func makeParamsWithTimestamp(
+	params tmproto.ConsensusParams,
+	proposerBased bool,
+	precision, messageDelay time.Duration,
+) tmproto.ConsensusParams {
+	params.Timestamp = tmproto.TimestampParams{
+		ProposerBased: proposerBased,
+		Precision:     precision,
+		MessageDelay:  messageDelay,
+	}
+	return params
+}
+
+func makeParamsWithUpgrade(
+	params tmproto.ConsensusParams,
+	blockVersion uint64,
+	height int64,
+) tmproto.ConsensusParams {
+	params.Version.Upgrade = &tmproto.UpgradePlan{
+		BlockVersion: blockVersion,
+		Height:       height,
+	}
+	return params
+}
+
+func makeParamsWithPartSet(
+	params tmproto.ConsensusParams,
+	parityPercentage uint32,
+) tmproto.ConsensusParams {
+	params.PartSet = tmproto.PartSetParams{
+		ParityPercentage: parityPercentage,
+	}
+	return params
+}
+
+func makeParamsWithABCI(
+	params tmproto.ConsensusParams,
+	voteExtensionsEnableHeight int64,
+) tmproto.ConsensusParams {
+	params.Abci = tmproto.ABCIParams{
+		VoteExtensionsEnableHeight: voteExtensionsEnableHeight,
+	}
+	return params
+}
+
+func makeParamsWithVRFSuite(
+	params tmproto.ConsensusParams,
+	vrfSuite string,
+) tmproto.ConsensusParams {
+	params.Validator.VrfSuite = vrfSuite
+	return params
+}
+
+func TestVoteExtensionsEnabled(t *testing.T) {
+	assert.False(t, VoteExtensionsEnabled(tmproto.ABCIParams{}, 1))
+	assert.False(t, VoteExtensionsEnabled(tmproto.ABCIParams{VoteExtensionsEnableHeight: 10}, 9))
+	assert.True(t, VoteExtensionsEnabled(tmproto.ABCIParams{VoteExtensionsEnableHeight: 10}, 10))
+	assert.True(t, VoteExtensionsEnabled(tmproto.ABCIParams{VoteExtensionsEnableHeight: 10}, 11))
+}
+
 func TestConsensusParamsHash(t *testing.T) {
 	params := []tmproto.ConsensusParams{
 		makeParams(4, 2, 10, 3, 1, valEd25519),
@@ -85,11 +185,17 @@ func TestConsensusParamsHash(t *testing.T) {
 		makeParams(9, 5, 10, 4, 1, valEd25519),
 		makeParams(7, 8, 10, 9, 1, valEd25519),
 		makeParams(4, 6, 10, 5, 1, valEd25519),
+		makeParamsWithVoter(makeParams(4, 6, 10, 5, 1, valEd25519), 10, 20),
+		makeParamsWithVoter(makeParams(4, 6, 10, 5, 1, valEd25519), 10, 30),
+		makeParamsWithTimestamp(makeParams(4, 6, 10, 5, 1, valEd25519), true, time.Second, time.Second),
+		makeParamsWithPartSet(makeParams(4, 6, 10, 5, 1, valEd25519), 50),
+		makeParamsWithABCI(makeParams(4, 6, 10, 5, 1, valEd25519), 100),
+		makeParamsWithVRFSuite(makeParams(4, 6, 10, 5, 1, valEd25519), vrf.SuiteEdwards25519SHA512TAI),
 	}
 
 	hashes := make([][]byte, len(params))
 	for i := range params {
-		hashes[i] = HashConsensusParams(params[i])
+		hashes[i] = HashConsensusParams(params[i], version.BlockProtocol)
 	}
 
 	// make sure there are no duplicates...
@@ -102,6 +208,19 @@ func TestConsensusParamsHash(t *testing.T) {
 	}
 }
 
+func TestConsensusParamsHashBlockProtocol(t *testing.T) {
+	params := makeParams(4, 6, 10, 5, 1, valEd25519)
+	extended := makeParamsWithPartSet(makeParamsWithVoter(params, 10, 30), 50)
+	extended = makeParamsWithTimestamp(extended, true, time.Second, time.Second)
+	extended = makeParamsWithVRFSuite(extended, vrf.SuiteEdwards25519SHA512TAI)
+
+	// the extended params are not hashed before the block protocol
+	before := version.BlockProtocolExtendedParamsHash - 1
+	assert.Equal(t, HashConsensusParams(params, before), HashConsensusParams(extended, before))
+	assert.NotEqual(t, HashConsensusParams(params, version.BlockProtocolExtendedParamsHash),
+		HashConsensusParams(extended, version.BlockProtocolExtendedParamsHash))
+}
+
 func TestConsensusParamsUpdate(t *testing.T) {
 	testCases := []struct {
 		params        tmproto.ConsensusParams
@@ -133,12 +252,89 @@ func TestConsensusParamsUpdate(t *testing.T) {
 			},
 			makeParams(100, 200, 10, 300, 50, valSecp256k1),
 		},
+		// voter updates
+		{
+			makeParams(1, 2, 10, 3, 0, valEd25519),
+			&abci.ConsensusParams{
+				Voter: &tmproto.VoterParams{
+					VoterElectionThreshold:          100,
+					MaxTolerableByzantinePercentage: 10,
+				},
+			},
+			makeParamsWithVoter(makeParams(1, 2, 10, 3, 0, valEd25519), 100, 10),
+		},
+		// timestamp updates
+		{
+			makeParams(1, 2, 10, 3, 0, valEd25519),
+			&abci.ConsensusParams{
+				Timestamp: &tmproto.TimestampParams{
+					ProposerBased: true,
+					Precision:     time.Second,
+					MessageDelay:  3 * time.Second,
+				},
+			},
+			makeParamsWithTimestamp(makeParams(1, 2, 10, 3, 0, valEd25519), true, time.Second, 3*time.Second),
+		},
+		// upgrade plan updates
+		{
+			makeParams(1, 2, 10, 3, 0, valEd25519),
+			&abci.ConsensusParams{
+				Version: &tmproto.VersionParams{
+					Upgrade: &tmproto.UpgradePlan{BlockVersion: 12, Height: 100},
+				},
+			},
+			makeParamsWithUpgrade(makeParams(1, 2, 10, 3, 0, valEd25519), 12, 100),
+		},
+		// the upgrade plan is kept by an update of the app version
+		{
+			makeParamsWithUpgrade(makeParams(1, 2, 10, 3, 0, valEd25519), 12, 100),
+			&abci.ConsensusParams{
+				Version: &tmproto.VersionParams{AppVersion: 1},
+			},
+			func() tmproto.ConsensusParams {
+				params := makeParamsWithUpgrade(makeParams(1, 2, 10, 3, 0, valEd25519), 12, 100)
+				params.Version.AppVersion = 1
+				return params
+			}(),
+		},
+		// the upgrade plan is cancelled by a height of 0
+		{
+			makeParamsWithUpgrade(makeParams(1, 2, 10, 3, 0, valEd25519), 12, 100),
+			&abci.ConsensusParams{
+				Version: &tmproto.VersionParams{
+					Upgrade: &tmproto.UpgradePlan{},
+				},
+			},
+			makeParams(1, 2, 10, 3, 0, valEd25519),
+		},
+		// abci updates
+		{
+			makeParams(1, 2, 10, 3, 0, valEd25519),
+			&abci.ConsensusParams{
+				Abci: &tmproto.ABCIParams{VoteExtensionsEnableHeight: 100},
+			},
+			makeParamsWithABCI(makeParams(1, 2, 10, 3, 0, valEd25519), 100),
+		},
 	}
 	for _, tc := range testCases {
 		assert.Equal(t, tc.updatedParams, UpdateConsensusParams(tc.params, tc.updates))
 	}
 }
 
+func TestConsensusParamsUpdate_VRFSuite(t *testing.T) {
+	params := makeParamsWithVRFSuite(makeParams(1, 2, 10, 3, 0, valEd25519), vrf.SuiteEdwards25519SHA512TAI)
+
+	// the vrf suite pinned at genesis isn't changed by the app
+	updated := UpdateConsensusParams(params,
+		&abci.ConsensusParams{Validator: &tmproto.ValidatorParams{
+			PubKeyTypes: valSecp256k1,
+			VrfSuite:    vrf.SuiteEdwards25519SHA512ELL2,
+		}})
+
+	assert.Equal(t, valSecp256k1, updated.Validator.PubKeyTypes)
+	assert.Equal(t, vrf.SuiteEdwards25519SHA512TAI, updated.Validator.VrfSuite)
+}
+
 func TestConsensusParamsUpdate_AppVersion(t *testing.T) {
 	params := makeParams(1, 2, 10, 3, 0, valEd25519)
 
@@ -9,15 +9,28 @@ import (
 	"github.com/line/ostracon/crypto/merkle"
 	"github.com/line/ostracon/libs/bits"
 	tmbytes "github.com/line/ostracon/libs/bytes"
+	"github.com/line/ostracon/libs/erasure"
 	tmjson "github.com/line/ostracon/libs/json"
 	tmmath "github.com/line/ostracon/libs/math"
 	tmsync "github.com/line/ostracon/libs/sync"
 	tmproto "github.com/line/ostracon/proto/ostracon/types"
 )
 
+const (
+	// MaxErasureCodedPartsCount is the maximum number of the parts of an erasure-coded part set.
+	MaxErasureCodedPartsCount = erasure.MaxShards
+
+	// MaxBlockPartSizeBytes is the maximum size of one block part. The parts of an
+	// erasure-coded part set may be bigger than BlockPartSizeBytes, so that the number
+	// of the parts of a large block does not exceed MaxErasureCodedPartsCount.
+	MaxBlockPartSizeBytes = MaxBlockSizeBytes / (MaxErasureCodedPartsCount * 100 / (100 + MaxPartSetParityPercentage))
+)
+
 var (
 	ErrPartSetUnexpectedIndex = errors.New("error part set unexpected index")
 	ErrPartSetInvalidProof    = errors.New("error part set invalid proof")
+	ErrPartSetInvalidSize     = errors.New("error part set invalid part size")
+	ErrPartSetInvalidCoding   = errors.New("error part set invalid erasure coding")
 )
 
 type Part struct {
@@ -26,10 +39,11 @@ type Part struct {
 	Proof merkle.Proof     `json:"proof"`
 }
 
-// ValidateBasic performs basic validation.
+// ValidateBasic performs basic validation. The parts of a part set, which is
+// not erasure-coded, are limited to BlockPartSizeBytes by PartSet.AddPart.
 func (part *Part) ValidateBasic() error {
-	if len(part.Bytes) > int(BlockPartSizeBytes) {
-		return fmt.Errorf("too big: %d bytes, max: %d", len(part.Bytes), BlockPartSizeBytes)
+	if len(part.Bytes) > MaxBlockPartSizeBytes {
+		return fmt.Errorf("too big: %d bytes, max: %d", len(part.Bytes), MaxBlockPartSizeBytes)
 	}
 	if err := part.Proof.ValidateBasic(); err != nil {
 		return fmt.Errorf("wrong Proof: %w", err)
@@ -94,13 +108,23 @@ func PartFromProto(pb *tmproto.Part) (*Part, error) {
 type PartSetHeader struct {
 	Total uint32           `json:"total"`
 	Hash  tmbytes.HexBytes `json:"hash"`
+
+	// Number of the data parts of an erasure-coded part set, any that many parts rebuild
+	// the data. 0 if the part set is not erasure coded.
+	DataTotal uint32 `json:"data_total,omitempty"`
+	// Size of the data of an erasure-coded part set.
+	DataSize uint32 `json:"data_size,omitempty"`
 }
 
 // String returns a string representation of PartSetHeader.
 //
 // 1. total number of parts
 // 2. first 6 bytes of the hash
+// 3. number of the data parts, if erasure coded
 func (psh PartSetHeader) String() string {
+	if psh.IsErasureCoded() {
+		return fmt.Sprintf("%v:%X:%v", psh.Total, tmbytes.Fingerprint(psh.Hash), psh.DataTotal)
+	}
 	return fmt.Sprintf("%v:%X", psh.Total, tmbytes.Fingerprint(psh.Hash))
 }
 
@@ -108,8 +132,42 @@ func (psh PartSetHeader) IsZero() bool {
 	return psh.Total == 0 && len(psh.Hash) == 0
 }
 
+// IsErasureCoded returns true if the parts are erasure coded.
+func (psh PartSetHeader) IsErasureCoded() bool {
+	return psh.DataTotal > 0
+}
+
+// PartSize returns the size of every part of an erasure-coded part set.
+func (psh PartSetHeader) PartSize() uint32 {
+	if !psh.IsErasureCoded() {
+		return 0
+	}
+	return (psh.DataSize + psh.DataTotal - 1) / psh.DataTotal
+}
+
+// ValidateErasureCoding checks that the parts are erasure coded with parityPercentage percent of
+// parity parts of BlockPartSizeBytes, or are not erasure coded if parityPercentage is 0.
+func (psh PartSetHeader) ValidateErasureCoding(parityPercentage uint32) error {
+	if parityPercentage == 0 {
+		if psh.IsErasureCoded() {
+			return errors.New("erasure-coded parts, expected none")
+		}
+		return nil
+	}
+	if !psh.IsErasureCoded() {
+		return errors.New("parts not erasure coded")
+	}
+	dataTotal, total := ErasureCodedPartsCount(int(psh.DataSize), BlockPartSizeBytes, parityPercentage)
+	if psh.DataTotal != dataTotal || psh.Total != total {
+		return fmt.Errorf("wrong erasure coding: %d of %d parts, expected %d of %d parts",
+			psh.DataTotal, psh.Total, dataTotal, total)
+	}
+	return nil
+}
+
 func (psh PartSetHeader) Equals(other PartSetHeader) bool {
-	return psh.Total == other.Total && bytes.Equal(psh.Hash, other.Hash)
+	return psh.Total == other.Total && bytes.Equal(psh.Hash, other.Hash) &&
+		psh.DataTotal == other.DataTotal && psh.DataSize == other.DataSize
 }
 
 // ValidateBasic performs basic validation.
@@ -118,6 +176,17 @@ func (psh PartSetHeader) ValidateBasic() error {
 	if err := ValidateHash(psh.Hash); err != nil {
 		return fmt.Errorf("wrong Hash: %w", err)
 	}
+	if psh.IsErasureCoded() {
+		if psh.DataTotal >= psh.Total || psh.Total > MaxErasureCodedPartsCount {
+			return fmt.Errorf("wrong DataTotal: %d of %d parts, max: %d parts",
+				psh.DataTotal, psh.Total, MaxErasureCodedPartsCount)
+		}
+		if psh.DataSize < psh.DataTotal || psh.PartSize() > MaxBlockPartSizeBytes {
+			return fmt.Errorf("wrong DataSize: %d in %d parts", psh.DataSize, psh.DataTotal)
+		}
+	} else if psh.DataSize != 0 {
+		return errors.New("non-zero DataSize without erasure coding")
+	}
 	return nil
 }
 
@@ -128,8 +197,10 @@ func (psh *PartSetHeader) ToProto() tmproto.PartSetHeader {
 	}
 
 	return tmproto.PartSetHeader{
-		Total: psh.Total,
-		Hash:  psh.Hash,
+		Total:     psh.Total,
+		Hash:      psh.Hash,
+		DataTotal: psh.DataTotal,
+		DataSize:  psh.DataSize,
 	}
 }
 
@@ -141,6 +212,8 @@ func PartSetHeaderFromProto(ppsh *tmproto.PartSetHeader) (*PartSetHeader, error)
 	psh := new(PartSetHeader)
 	psh.Total = ppsh.Total
 	psh.Hash = ppsh.Hash
+	psh.DataTotal = ppsh.DataTotal
+	psh.DataSize = ppsh.DataSize
 
 	return psh, psh.ValidateBasic()
 }
@@ -158,6 +231,10 @@ type PartSet struct {
 	// a count of the total size (in bytes). Used to ensure that the
 	// part set doesn't exceed the maximum block bytes
 	byteSize int64
+
+	// erasure coding, see PartSetHeader
+	dataTotal uint32
+	dataSize  uint32
 }
 
 // Returns an immutable, full PartSet from the data bytes.
@@ -193,6 +270,82 @@ func NewPartSetFromData(data []byte, partSize uint32) *PartSet {
 	}
 }
 
+// ErasureCodedPartsCount returns the number of the data parts and the total number of the parts
+// of an erasure-coded part set of dataSize bytes. The data is split into parts of about partSize
+// bytes, and parityPercentage percent of parity parts are added, but the parts are made bigger
+// if the total number of the parts would exceed MaxErasureCodedPartsCount.
+// CONTRACT: partSize is greater than zero, and parityPercentage is in (0, MaxPartSetParityPercentage].
+func ErasureCodedPartsCount(dataSize int, partSize uint32, parityPercentage uint32) (dataTotal, total uint32) {
+	dataTotal = uint32((dataSize + int(partSize) - 1) / int(partSize))
+	if dataTotal == 0 {
+		dataTotal = 1
+	}
+	parityTotal := func() uint32 {
+		return (dataTotal*parityPercentage + 99) / 100
+	}
+	for dataTotal > 1 && dataTotal+parityTotal() > MaxErasureCodedPartsCount {
+		dataTotal--
+	}
+	return dataTotal, dataTotal + parityTotal()
+}
+
+// NewErasureCodedPartSetFromData returns an immutable, full and erasure-coded PartSet from the data
+// bytes. The data bytes are split into data parts of the same size, the last one padded with
+// zeros, and parityPercentage percent of parity parts are computed from them, so that any
+// data-parts count of the parts rebuild the data. The merkle tree is computed over all the parts.
+// CONTRACT: partSize is greater than zero, and parityPercentage is in (0, MaxPartSetParityPercentage].
+func NewErasureCodedPartSetFromData(data []byte, partSize uint32, parityPercentage uint32) *PartSet {
+	dataTotal, total := ErasureCodedPartsCount(len(data), partSize, parityPercentage)
+	header := PartSetHeader{Total: total, DataTotal: dataTotal, DataSize: uint32(len(data))}
+	size := int(header.PartSize())
+	if size == 0 {
+		size = 1
+	}
+
+	partsBytes := make([][]byte, total)
+	for i := 0; i < int(dataTotal); i++ {
+		partsBytes[i] = make([]byte, size)
+		copy(partsBytes[i], data[tmmath.MinInt(len(data), i*size):tmmath.MinInt(len(data), (i+1)*size)])
+	}
+	code, err := erasure.New(int(dataTotal), int(total-dataTotal))
+	if err != nil {
+		panic(err)
+	}
+	if err := code.Encode(partsBytes); err != nil {
+		panic(err)
+	}
+
+	ps := newPartSetFromParts(partsBytes)
+	ps.dataTotal = dataTotal
+	ps.dataSize = uint32(len(data))
+	ps.byteSize = int64(len(data))
+	return ps
+}
+
+// newPartSetFromParts returns a full PartSet from the bytes of the parts, and computes the
+// merkle tree.
+func newPartSetFromParts(partsBytes [][]byte) *PartSet {
+	total := uint32(len(partsBytes))
+	parts := make([]*Part, total)
+	partsBitArray := bits.NewBitArray(int(total))
+	root, proofs := merkle.ProofsFromByteSlices(partsBytes)
+	for i := uint32(0); i < total; i++ {
+		parts[i] = &Part{
+			Index: i,
+			Bytes: partsBytes[i],
+			Proof: *proofs[i],
+		}
+		partsBitArray.SetIndex(int(i), true)
+	}
+	return &PartSet{
+		total:         total,
+		hash:          root,
+		parts:         parts,
+		partsBitArray: partsBitArray,
+		count:         total,
+	}
+}
+
 // Returns an empty PartSet ready to be populated.
 func NewPartSetFromHeader(header PartSetHeader) *PartSet {
 	return &PartSet{
@@ -202,6 +355,8 @@ func NewPartSetFromHeader(header PartSetHeader) *PartSet {
 		partsBitArray: bits.NewBitArray(int(header.Total)),
 		count:         0,
 		byteSize:      0,
+		dataTotal:     header.DataTotal,
+		dataSize:      header.DataSize,
 	}
 }
 
@@ -210,9 +365,19 @@ func (ps *PartSet) Header() PartSetHeader {
 		return PartSetHeader{}
 	}
 	return PartSetHeader{
-		Total: ps.total,
-		Hash:  ps.hash,
+		Total:     ps.total,
+		Hash:      ps.hash,
+		DataTotal: ps.dataTotal,
+		DataSize:  ps.dataSize,
+	}
+}
+
+// IsErasureCoded returns true if the parts are erasure coded.
+func (ps *PartSet) IsErasureCoded() bool {
+	if ps == nil {
+		return false
 	}
+	return ps.dataTotal > 0
 }
 
 func (ps *PartSet) HasHeader(header PartSetHeader) bool {
@@ -280,6 +445,16 @@ func (ps *PartSet) AddPart(part *Part) (bool, error) {
 		return false, nil
 	}
 
+	// The parts of an erasure-coded part set are all of the same size, and the
+	// parts of the other part sets aren't bigger than BlockPartSizeBytes
+	if ps.IsErasureCoded() {
+		if uint32(len(part.Bytes)) != ps.Header().PartSize() {
+			return false, ErrPartSetInvalidSize
+		}
+	} else if len(part.Bytes) > int(BlockPartSizeBytes) {
+		return false, ErrPartSetInvalidSize
+	}
+
 	// Check hash proof
 	if part.Proof.Verify(ps.Hash(), part.Bytes) != nil {
 		return false, ErrPartSetInvalidProof
@@ -290,9 +465,69 @@ func (ps *PartSet) AddPart(part *Part) (bool, error) {
 	ps.partsBitArray.SetIndex(int(part.Index), true)
 	ps.count++
 	ps.byteSize += int64(len(part.Bytes))
+
+	// Rebuild the missing parts as soon as there are enough parts
+	if ps.IsErasureCoded() {
+		if ps.byteSize > int64(ps.dataSize) {
+			ps.byteSize = int64(ps.dataSize)
+		}
+		if ps.count == ps.dataTotal {
+			if err := ps.reconstruct(); err != nil {
+				return true, err
+			}
+		}
+	}
 	return true, nil
 }
 
+// reconstruct rebuilds the missing parts of an erasure-coded part set, and checks that the
+// rebuilt parts hash to the part set hash, so that any subset of the parts rebuild the same data.
+// Otherwise, the part set remains incomplete.
+func (ps *PartSet) reconstruct() error {
+	partsBytes := make([][]byte, ps.total)
+	for i, part := range ps.parts {
+		if part != nil {
+			partsBytes[i] = part.Bytes
+		}
+	}
+	code, err := erasure.New(int(ps.dataTotal), int(ps.total-ps.dataTotal))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrPartSetInvalidCoding, err)
+	}
+	if err := code.Reconstruct(partsBytes); err != nil {
+		return fmt.Errorf("%w: %v", ErrPartSetInvalidCoding, err)
+	}
+	// encode the parity parts again, since the ones used for the reconstruction may not match
+	// the data parts
+	for i := ps.dataTotal; i < ps.total; i++ {
+		partsBytes[i] = nil
+	}
+	if err := code.Encode(partsBytes); err != nil {
+		return fmt.Errorf("%w: %v", ErrPartSetInvalidCoding, err)
+	}
+
+	// the padding after the data must be zeros, as NewErasureCodedPartSetFromData makes it
+	size := int(ps.Header().PartSize())
+	for i := 0; i < int(ps.dataTotal); i++ {
+		start := tmmath.MaxInt(0, tmmath.MinInt(size, int(ps.dataSize)-i*size))
+		for _, b := range partsBytes[i][start:] {
+			if b != 0 {
+				return fmt.Errorf("%w: non-zero padding", ErrPartSetInvalidCoding)
+			}
+		}
+	}
+
+	full := newPartSetFromParts(partsBytes)
+	if !bytes.Equal(full.hash, ps.hash) {
+		return ErrPartSetInvalidCoding
+	}
+	ps.parts = full.parts
+	ps.partsBitArray = full.partsBitArray
+	ps.count = full.count
+	ps.byteSize = int64(ps.dataSize)
+	return nil
+}
+
 func (ps *PartSet) GetPart(index int) *Part {
 	ps.mtx.Lock()
 	defer ps.mtx.Unlock()
@@ -307,6 +542,9 @@ func (ps *PartSet) GetReader() io.Reader {
 	if !ps.IsComplete() {
 		panic("Cannot GetReader() on incomplete PartSet")
 	}
+	if ps.IsErasureCoded() {
+		return io.LimitReader(NewPartSetReader(ps.parts[:ps.dataTotal]), int64(ps.dataSize))
+	}
 	return NewPartSetReader(ps.parts)
 }
 
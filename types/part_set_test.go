@@ -63,6 +63,96 @@ func TestBasicPartSet(t *testing.T) {
 	assert.Equal(t, data, data2)
 }
 
+func TestErasureCodedPartSet(t *testing.T) {
+	// the last data part is padded
+	data := tmrand.Bytes(testPartSize*20 - 101)
+	partSet := NewErasureCodedPartSetFromData(data, testPartSize, 50)
+
+	header := partSet.Header()
+	assert.True(t, header.IsErasureCoded())
+	assert.EqualValues(t, 20, header.DataTotal)
+	assert.EqualValues(t, 30, header.Total)
+	assert.EqualValues(t, len(data), header.DataSize)
+	assert.EqualValues(t, (len(data)+19)/20, header.PartSize())
+	assert.NoError(t, header.ValidateBasic())
+	assert.NoError(t, header.ValidateErasureCoding(50))
+	assert.Error(t, header.ValidateErasureCoding(0))
+	assert.Error(t, header.ValidateErasureCoding(20))
+	assert.True(t, partSet.IsComplete())
+	assert.EqualValues(t, len(data), partSet.ByteSize())
+
+	// any data-parts count of the parts rebuild the data
+	for _, indices := range [][]int{
+		{0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29},
+		{10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29},
+	} {
+		partSet2 := NewPartSetFromHeader(header)
+		assert.True(t, partSet2.HasHeader(header))
+		for _, i := range indices {
+			added, err := partSet2.AddPart(partSet.GetPart(i))
+			require.NoError(t, err)
+			require.True(t, added)
+		}
+		assert.True(t, partSet2.IsComplete())
+		assert.EqualValues(t, 30, partSet2.Count())
+		assert.EqualValues(t, len(data), partSet2.ByteSize())
+		for i := 0; i < int(header.Total); i++ {
+			assert.Equal(t, partSet.GetPart(i), partSet2.GetPart(i))
+		}
+
+		data2, err := ioutil.ReadAll(partSet2.GetReader())
+		require.NoError(t, err)
+		assert.Equal(t, data, data2)
+	}
+
+	// a part of a wrong size
+	partSet2 := NewPartSetFromHeader(header)
+	part := *partSet.GetPart(0)
+	part.Bytes = part.Bytes[1:]
+	added, err := partSet2.AddPart(&part)
+	assert.False(t, added)
+	assert.ErrorIs(t, err, ErrPartSetInvalidSize)
+
+	// a parity part which doesn't match the data parts
+	partsBytes := make([][]byte, header.Total)
+	for i := range partsBytes {
+		partsBytes[i] = partSet.GetPart(i).Bytes
+	}
+	partsBytes[25] = tmrand.Bytes(len(partsBytes[25]))
+	invalid := newPartSetFromParts(partsBytes)
+	invalid.dataTotal, invalid.dataSize = header.DataTotal, header.DataSize
+	partSet2 = NewPartSetFromHeader(invalid.Header())
+	for i := 10; i < 30; i++ {
+		_, err = partSet2.AddPart(invalid.GetPart(i))
+	}
+	assert.ErrorIs(t, err, ErrPartSetInvalidCoding)
+	assert.False(t, partSet2.IsComplete())
+}
+
+func TestErasureCodedPartsCount(t *testing.T) {
+	testCases := []struct {
+		dataSize         int
+		parityPercentage uint32
+		dataTotal        uint32
+		total            uint32
+	}{
+		{1, 50, 1, 2},
+		{testPartSize, 1, 1, 2},
+		{testPartSize + 1, 50, 2, 3},
+		{testPartSize * 100, 10, 100, 110},
+		{testPartSize * 200, 50, 170, 255},
+		{testPartSize * 200, 100, 128, 256},
+		{MaxBlockSizeBytes, 100, 128, 256},
+	}
+	for _, tc := range testCases {
+		dataTotal, total := ErasureCodedPartsCount(tc.dataSize, testPartSize, tc.parityPercentage)
+		assert.Equal(t, tc.dataTotal, dataTotal, "%+v", tc)
+		assert.Equal(t, tc.total, total, "%+v", tc)
+		psh := PartSetHeader{Total: total, DataTotal: dataTotal, DataSize: uint32(tc.dataSize)}
+		assert.NoError(t, psh.ValidateBasic(), "%+v", tc)
+	}
+}
+
 func TestWrongProof(t *testing.T) {
 	// Construct random data of size partSize * 100
 	data := tmrand.Bytes(testPartSize * 100)
@@ -88,6 +178,19 @@ func TestWrongProof(t *testing.T) {
 	}
 }
 
+func TestPartSetTooBigPart(t *testing.T) {
+	// the parts bigger than BlockPartSizeBytes pass Part.ValidateBasic, which
+	// allows the bigger parts of the erasure-coded part sets
+	partSet := NewPartSetFromData(tmrand.Bytes(int(BlockPartSizeBytes)*2+2), BlockPartSizeBytes+1)
+	part := partSet.GetPart(0)
+	require.NoError(t, part.ValidateBasic())
+
+	partSet2 := NewPartSetFromHeader(partSet.Header())
+	added, err := partSet2.AddPart(part)
+	assert.False(t, added)
+	assert.ErrorIs(t, err, ErrPartSetInvalidSize)
+}
+
 func TestPartSetHeaderValidateBasic(t *testing.T) {
 	testCases := []struct {
 		testName              string
@@ -96,6 +199,19 @@ func TestPartSetHeaderValidateBasic(t *testing.T) {
 	}{
 		{"Good PartSet", func(psHeader *PartSetHeader) {}, false},
 		{"Invalid Hash", func(psHeader *PartSetHeader) { psHeader.Hash = make([]byte, 1) }, true},
+		{"Good erasure coding", func(psHeader *PartSetHeader) {
+			psHeader.DataTotal, psHeader.DataSize = 50, testPartSize*50
+		}, false},
+		{"No parity parts", func(psHeader *PartSetHeader) {
+			psHeader.DataTotal, psHeader.DataSize = 100, testPartSize*100
+		}, true},
+		{"Too many parts", func(psHeader *PartSetHeader) {
+			psHeader.Total, psHeader.DataTotal, psHeader.DataSize = 300, 100, testPartSize*100
+		}, true},
+		{"Too big parts", func(psHeader *PartSetHeader) {
+			psHeader.DataTotal, psHeader.DataSize = 1, MaxBlockPartSizeBytes+1
+		}, true},
+		{"Data size without erasure coding", func(psHeader *PartSetHeader) { psHeader.DataSize = 1 }, true},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -116,7 +232,7 @@ func TestPartValidateBasic(t *testing.T) {
 		expectErr    bool
 	}{
 		{"Good Part", func(pt *Part) {}, false},
-		{"Too big part", func(pt *Part) { pt.Bytes = make([]byte, BlockPartSizeBytes+1) }, true},
+		{"Too big part", func(pt *Part) { pt.Bytes = make([]byte, MaxBlockPartSizeBytes+1) }, true},
 		{"Too big proof", func(pt *Part) {
 			pt.Proof = merkle.Proof{
 				Total:    1,
@@ -147,6 +263,8 @@ func TestParSetHeaderProtoBuf(t *testing.T) {
 		{"success empty", &PartSetHeader{}, true},
 		{"success",
 			&PartSetHeader{Total: 1, Hash: []byte("hash")}, true},
+		{"success erasure coded",
+			&PartSetHeader{Total: 3, Hash: []byte("hash"), DataTotal: 2, DataSize: 100}, true},
 	}
 
 	for _, tc := range testCases {
@@ -22,7 +22,9 @@ type PrivValidator interface {
 	SignVote(chainID string, vote *tmproto.Vote) error
 	SignProposal(chainID string, proposal *tmproto.Proposal) error
 
-	GenerateVRFProof(message []byte) (crypto.Proof, error)
+	// GenerateVRFProof generates the VRF proof of the message, using the VRF suite of the given name (see vrf.GetSuite)
+	// for the ed25519 keys.
+	GenerateVRFProof(message []byte, vrfSuite string) (crypto.Proof, error)
 }
 
 type PrivValidatorsByAddress []PrivValidator
@@ -117,6 +119,15 @@ func (pv MockPV) SignVote(chainID string, vote *tmproto.Vote) error {
 		return err
 	}
 	vote.Signature = sig
+
+	vote.ExtensionSignature = nil
+	if IsVoteExtendable(vote) {
+		extSig, err := pv.PrivKey.Sign(VoteExtensionSignBytes(useChainID, vote))
+		if err != nil {
+			return err
+		}
+		vote.ExtensionSignature = extSig
+	}
 	return nil
 }
 
@@ -138,16 +149,18 @@ func (pv MockPV) SignProposal(chainID string, proposal *tmproto.Proposal) error
 
 func (pv MockPV) ExtractIntoValidator(stakingPower int64) *Validator {
 	pubKey, _ := pv.GetPubKey()
+	pop, _ := ProofOfPossession(pv.PrivKey)
 	return &Validator{
-		Address:      pubKey.Address(),
-		PubKey:       pubKey,
-		StakingPower: stakingPower,
+		Address:           pubKey.Address(),
+		PubKey:            pubKey,
+		StakingPower:      stakingPower,
+		ProofOfPossession: pop,
 	}
 }
 
 // GenerateVRFProof implements PrivValidator.
-func (pv MockPV) GenerateVRFProof(message []byte) (crypto.Proof, error) {
-	return pv.PrivKey.VRFProve(message)
+func (pv MockPV) GenerateVRFProof(message []byte, vrfSuite string) (crypto.Proof, error) {
+	return VRFProve(pv.PrivKey, message, vrfSuite)
 }
 
 // String returns a string representation of the MockPV.
@@ -79,6 +79,14 @@ func (p *Proposal) ValidateBasic() error {
 	return nil
 }
 
+// IsTimely reports whether the proposal was received in time under the proposer-based timestamps,
+// that is, whether recvTime is in [Timestamp - Precision, Timestamp + MessageDelay + Precision].
+func (p *Proposal) IsTimely(recvTime time.Time, params tmproto.TimestampParams) bool {
+	lhs := p.Timestamp.Add(-params.Precision)
+	rhs := p.Timestamp.Add(params.MessageDelay).Add(params.Precision)
+	return !recvTime.Before(lhs) && !recvTime.After(rhs)
+}
+
 // String returns a string representation of the Proposal.
 //
 // 1. height
@@ -67,7 +67,7 @@ func TestProposalVerifySignature(t *testing.T) {
 
 		prop := NewProposal(
 			4, 2, 2,
-			BlockID{tmrand.Bytes(tmhash.Size), PartSetHeader{777, tmrand.Bytes(tmhash.Size)}})
+			BlockID{tmrand.Bytes(tmhash.Size), PartSetHeader{Total: 777, Hash: tmrand.Bytes(tmhash.Size)}})
 		p := prop.ToProto()
 		signBytes := ProposalSignBytes("test_chain_id", p)
 
@@ -173,7 +173,7 @@ func TestProposalValidateBasic(t *testing.T) {
 			{"Invalid Round", func(p *Proposal) { p.Round = -1 }, true},
 			{"Invalid POLRound", func(p *Proposal) { p.POLRound = -2 }, true},
 			{"Invalid BlockId", func(p *Proposal) {
-				p.BlockID = BlockID{[]byte{1, 2, 3}, PartSetHeader{111, []byte("blockparts")}}
+				p.BlockID = BlockID{[]byte{1, 2, 3}, PartSetHeader{Total: 111, Hash: []byte("blockparts")}}
 			}, true},
 			{"Invalid Signature", func(p *Proposal) {
 				p.Signature = make([]byte, 0)
@@ -228,3 +228,29 @@ func TestProposalProtoBuf(t *testing.T) {
 		}
 	}
 }
+
+func TestProposalIsTimely(t *testing.T) {
+	timestamp := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	params := tmproto.TimestampParams{
+		ProposerBased: true,
+		Precision:     time.Second,
+		MessageDelay:  2 * time.Second,
+	}
+	proposal := NewProposal(1, 0, -1, makeBlockID([]byte("hash"), 2, []byte("part_set_hash")))
+	proposal.Timestamp = timestamp
+
+	testCases := []struct {
+		msg      string
+		recvTime time.Time
+		timely   bool
+	}{
+		{"too early", timestamp.Add(-time.Second - time.Millisecond), false},
+		{"earliest", timestamp.Add(-time.Second), true},
+		{"on time", timestamp, true},
+		{"latest", timestamp.Add(3 * time.Second), true},
+		{"too late", timestamp.Add(3*time.Second + time.Millisecond), false},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.timely, proposal.IsTimely(tc.recvTime, params), tc.msg)
+	}
+}
@@ -89,8 +89,9 @@ func (tm2pb) ValidatorUpdate(val *Validator) abci.ValidatorUpdate {
 		panic(err)
 	}
 	return abci.ValidatorUpdate{
-		PubKey: pk,
-		Power:  val.StakingPower,
+		PubKey:            pk,
+		Power:             val.StakingPower,
+		ProofOfPossession: val.ProofOfPossession,
 	}
 }
 
@@ -111,6 +112,10 @@ func (tm2pb) ConsensusParams(params *tmproto.ConsensusParams) *abci.ConsensusPar
 		},
 		Evidence:  &params.Evidence,
 		Validator: &params.Validator,
+		Voter:     &params.Voter,
+		Timestamp: &params.Timestamp,
+		PartSet:   &params.PartSet,
+		Abci:      &params.Abci,
 	}
 }
 
@@ -142,6 +147,7 @@ func (pb2tm) ValidatorUpdates(vals []abci.ValidatorUpdate) ([]*Validator, error)
 			return nil, err
 		}
 		tmVals[i] = NewValidator(pub, v.Power)
+		tmVals[i].ProofOfPossession = v.ProofOfPossession
 	}
 	return tmVals, nil
 }
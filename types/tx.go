@@ -61,6 +61,24 @@ func (txs Txs) IndexByHash(hash []byte) int {
 	return -1
 }
 
+// ToSliceOfBytes converts the Txs to a slice of byte slices, e.g. for ABCI requests.
+func (txs Txs) ToSliceOfBytes() [][]byte {
+	txBzs := make([][]byte, len(txs))
+	for i := 0; i < len(txs); i++ {
+		txBzs[i] = txs[i]
+	}
+	return txBzs
+}
+
+// ToTxs converts a slice of byte slices, e.g. of ABCI responses, to Txs.
+func ToTxs(txBzs [][]byte) Txs {
+	txs := make([]Tx, len(txBzs))
+	for i := 0; i < len(txBzs); i++ {
+		txs[i] = txBzs[i]
+	}
+	return txs
+}
+
 // Proof returns a simple merkle proof for this node.
 // Panics if i < 0 or i >= len(txs)
 // TODO: optimize this!
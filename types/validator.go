@@ -7,6 +7,8 @@ import (
 	"strings"
 
 	"github.com/line/ostracon/crypto"
+	"github.com/line/ostracon/crypto/bls"
+	"github.com/line/ostracon/crypto/composite"
 	ce "github.com/line/ostracon/crypto/encoding"
 	tmrand "github.com/line/ostracon/libs/rand"
 	tmproto "github.com/line/ostracon/proto/ostracon/types"
@@ -26,6 +28,10 @@ type Validator struct {
 
 	VotingPower      int64 `json:"voting_power"`
 	ProposerPriority int64 `json:"proposer_priority"`
+
+	// ProofOfPossession proves that the validator owns the private key of its BLS public key.
+	// It is empty if the public key contains no BLS key.
+	ProofOfPossession []byte `json:"proof_of_possession,omitempty"`
 }
 
 // NewValidator returns a new validator with the given pubkey and voting power.
@@ -59,6 +65,12 @@ func (v *Validator) ValidateBasic() error {
 	return nil
 }
 
+// VerifyProofOfPossession verifies the proof-of-possession of the validator's BLS public key.
+// It returns nil if the public key contains no BLS key.
+func (v *Validator) VerifyProofOfPossession() error {
+	return VerifyProofOfPossession(v.PubKey, v.ProofOfPossession)
+}
+
 // Creates a new copy of the validator so we can mutate ProposerPriority.
 // Panics if the validator is nil.
 func (v *Validator) Copy() *Validator {
@@ -150,11 +162,12 @@ func (v *Validator) ToProto() (*tmproto.Validator, error) {
 	}
 
 	vp := tmproto.Validator{
-		Address:          v.Address,
-		PubKey:           pk,
-		StakingPower:     v.StakingPower,
-		VotingPower:      v.VotingPower,
-		ProposerPriority: v.ProposerPriority,
+		Address:           v.Address,
+		PubKey:            pk,
+		StakingPower:      v.StakingPower,
+		VotingPower:       v.VotingPower,
+		ProposerPriority:  v.ProposerPriority,
+		ProofOfPossession: v.ProofOfPossession,
 	}
 
 	return &vp, nil
@@ -177,10 +190,44 @@ func ValidatorFromProto(vp *tmproto.Validator) (*Validator, error) {
 	v.StakingPower = vp.GetStakingPower()
 	v.VotingPower = vp.GetVotingPower()
 	v.ProposerPriority = vp.GetProposerPriority()
+	v.ProofOfPossession = vp.GetProofOfPossession()
 
 	return v, nil
 }
 
+// ProofOfPossession generates the proof-of-possession of the BLS key contained in the private key.
+// It returns nil if the private key contains no BLS key.
+func ProofOfPossession(privKey crypto.PrivKey) ([]byte, error) {
+	for {
+		switch key := privKey.(type) {
+		case composite.PrivKey:
+			privKey = key.SignKey
+		case *composite.PrivKey:
+			privKey = key.SignKey
+		case bls.PrivKey:
+			return key.ProofOfPossession()
+		default:
+			return nil, nil
+		}
+	}
+}
+
+// VerifyProofOfPossession verifies the proof-of-possession of the BLS key contained in the public key.
+// It returns nil if the public key contains no BLS key.
+func VerifyProofOfPossession(pubKey crypto.PubKey, pop []byte) error {
+	blsPubKey := GetSignatureKey(pubKey)
+	if blsPubKey == nil {
+		return nil
+	}
+	if len(pop) == 0 {
+		return fmt.Errorf("proof-of-possession is missing for BLS public key %X", blsPubKey.Bytes())
+	}
+	if !blsPubKey.VerifyProofOfPossession(pop) {
+		return fmt.Errorf("invalid proof-of-possession %X for BLS public key %X", pop, blsPubKey.Bytes())
+	}
+	return nil
+}
+
 //----------------------------------------
 // RandValidator
 
@@ -201,5 +248,9 @@ func RandValidatorForPrivKey(keyType PrivKeyType, randPower bool, minPower int64
 		panic(fmt.Errorf("could not retrieve pubkey %w", err))
 	}
 	val := NewValidator(pubKey, stakingPower)
+	val.ProofOfPossession, err = ProofOfPossession(privVal.PrivKey)
+	if err != nil {
+		panic(fmt.Errorf("could not generate proof-of-possession %w", err))
+	}
 	return val, privVal
 }
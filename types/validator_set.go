@@ -448,6 +448,20 @@ func verifyUpdates(
 	return tvpAfterRemovals + removedPower, nil
 }
 
+// verifyProofsOfPossession verifies the proof-of-possession of the BLS keys in updates. The update of an existing
+// validator may omit it, then the proof verified when the validator joined the set is retained (see applyUpdates).
+func verifyProofsOfPossession(updates []*Validator, vals *ValidatorSet) error {
+	for _, upd := range updates {
+		if len(upd.ProofOfPossession) == 0 && vals.HasAddress(upd.Address) {
+			continue
+		}
+		if err := upd.VerifyProofOfPossession(); err != nil {
+			return fmt.Errorf("invalid validator update %v: %w", upd, err)
+		}
+	}
+	return nil
+}
+
 func numNewValidators(updates []*Validator, vals *ValidatorSet) int {
 	numNewValidators := 0
 	for _, valUpdate := range updates {
@@ -509,7 +523,10 @@ func (vals *ValidatorSet) applyUpdates(updates []*Validator) {
 			// Apply add or update.
 			merged[i] = updates[0]
 			if bytes.Equal(existing[0].Address, updates[0].Address) {
-				// Validator is present in both, advance existing.
+				// Validator is present in both, retain its proof-of-possession if omitted and advance existing.
+				if len(merged[i].ProofOfPossession) == 0 {
+					merged[i].ProofOfPossession = existing[0].ProofOfPossession
+				}
 				existing = existing[1:]
 			}
 			updates = updates[1:]
@@ -602,6 +619,11 @@ func (vals *ValidatorSet) updateWithChangeSet(changes []*Validator, allowDeletes
 		return errors.New("applying the validator changes would result in empty set")
 	}
 
+	// Verify the proof-of-possession of BLS keys joining the set.
+	if err := verifyProofsOfPossession(updates, vals); err != nil {
+		return err
+	}
+
 	// Verify that applying the 'deletes' against 'vals' will not result in error.
 	// Get the voting power that is going to be removed.
 	removedStakingPower, err := verifyRemovals(deletes, vals)
@@ -637,6 +659,7 @@ func (vals *ValidatorSet) updateWithChangeSet(changes []*Validator, allowDeletes
 // UpdateWithChangeSet attempts to update the validator set with 'changes'.
 // It performs the following steps:
 // - validates the changes making sure there are no duplicates and splits them in updates and deletes
+// - verifies the proof-of-possession of the BLS keys of the new validators
 // - verifies that applying the changes will not result in errors
 // - computes the total voting power BEFORE removals to ensure that in the next steps the priorities
 //   across old and newly added validators are fair
@@ -1343,6 +1343,43 @@ func TestNewValidatorSetFromExistingValidators(t *testing.T) {
 	assert.Equal(t, valSet.CopyIncrementProposerPriority(3), existingValSet.CopyIncrementProposerPriority(3))
 }
 
+func TestValSetUpdateProofOfPossession(t *testing.T) {
+	valSet, _ := RandValidatorSet(3, 10)
+
+	for _, keyType := range []PrivKeyType{PrivKeyComposite, PrivKeyBLS} {
+		pv := NewMockPV(keyType)
+		val := pv.ExtractIntoValidator(10)
+		pop := val.ProofOfPossession
+		require.NotEmpty(t, pop)
+
+		// a BLS key can't join without the proof-of-possession
+		val.ProofOfPossession = nil
+		assert.Error(t, valSet.Copy().UpdateWithChangeSet([]*Validator{val}))
+
+		// nor with the proof-of-possession of another key
+		val.ProofOfPossession, _ = ProofOfPossession(NewMockPV(keyType).PrivKey)
+		assert.Error(t, valSet.Copy().UpdateWithChangeSet([]*Validator{val}))
+
+		val.ProofOfPossession = pop
+		updated := valSet.Copy()
+		require.NoError(t, updated.UpdateWithChangeSet([]*Validator{val}))
+
+		// the proof-of-possession is retained on the update of the staking power
+		update := NewValidator(val.PubKey, 20)
+		require.NoError(t, updated.UpdateWithChangeSet([]*Validator{update}))
+		_, joined := updated.GetByAddress(val.Address)
+		assert.Equal(t, int64(20), joined.StakingPower)
+		assert.Equal(t, pop, joined.ProofOfPossession)
+		assert.Empty(t, update.ProofOfPossession, "the caller's update must not be modified")
+		assert.NoError(t, SelectVoter(updated, []byte{}, DefaultVoterParams()).ValidateBasic())
+	}
+
+	// no proof-of-possession is required for keys other than BLS
+	val := NewMockPV(PrivKeyEd25519).ExtractIntoValidator(10)
+	assert.Empty(t, val.ProofOfPossession)
+	assert.NoError(t, valSet.Copy().UpdateWithChangeSet([]*Validator{val}))
+}
+
 func TestValSetUpdateOverflowRelated(t *testing.T) {
 	testCases := []testVSetCfg{
 		{
@@ -17,6 +17,9 @@ import (
 
 const (
 	nilVoteStr string = "nil-Vote"
+
+	// MaxVoteExtensionSize is the maximum size of the extension of a precommit.
+	MaxVoteExtensionSize int = 65536 // 64kB
 )
 
 var (
@@ -24,6 +27,7 @@ var (
 	ErrVoteInvalidValidatorIndex     = errors.New("invalid validator index")
 	ErrVoteInvalidValidatorAddress   = errors.New("invalid validator address")
 	ErrVoteInvalidSignature          = errors.New("invalid signature")
+	ErrVoteInvalidExtensionSignature = errors.New("invalid vote extension signature")
 	ErrVoteInvalidBlockHash          = errors.New("invalid block hash")
 	ErrVoteNonDeterministicSignature = errors.New("non-deterministic signature")
 	ErrVoteNil                       = errors.New("nil vote")
@@ -59,6 +63,11 @@ type Vote struct {
 	ValidatorAddress Address               `json:"validator_address"`
 	ValidatorIndex   int32                 `json:"validator_index"`
 	Signature        []byte                `json:"signature"`
+
+	// Extension is provided by the application and only precommits for a block can
+	// have it. It is not covered by Signature but by ExtensionSignature.
+	Extension          []byte `json:"extension"`
+	ExtensionSignature []byte `json:"extension_signature"`
 }
 
 func MaxVoteBytes(signSize int) int64 {
@@ -124,11 +133,43 @@ func VoteSignBytes(chainID string, vote *tmproto.Vote) []byte {
 	return bz
 }
 
+// VoteExtensionSignBytes returns the proto-encoding of the canonicalized vote
+// extension, for signing. Panics if the marshaling fails.
+//
+// See CanonicalizeVoteExtension
+func VoteExtensionSignBytes(chainID string, vote *tmproto.Vote) []byte {
+	pb := CanonicalizeVoteExtension(chainID, vote)
+	bz, err := protoio.MarshalDelimited(&pb)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// IsVoteExtendable returns true if the vote can have an extension, that is,
+// it is a precommit for a block.
+func IsVoteExtendable(vote *tmproto.Vote) bool {
+	return vote.Type == tmproto.PrecommitType && len(vote.BlockID.Hash) > 0
+}
+
 func (vote *Vote) Copy() *Vote {
 	voteCopy := *vote
 	return &voteCopy
 }
 
+// withoutExtension returns the vote itself if it has no extension, or a copy
+// of it without the extension.
+func (vote *Vote) withoutExtension() *Vote {
+	if len(vote.Extension) == 0 && len(vote.ExtensionSignature) == 0 {
+		return vote
+	}
+	voteCopy := vote.Copy()
+	voteCopy.Extension = nil
+	voteCopy.ExtensionSignature = nil
+	return voteCopy
+}
+
 // String returns a string representation of Vote.
 //
 // 1. validator index
@@ -168,17 +209,57 @@ func (vote *Vote) String() string {
 	)
 }
 
-func (vote *Vote) Verify(chainID string, pubKey crypto.PubKey) error {
+// signsSameAs returns true if the other vote of the same voter at the same
+// height, round and type has the same sign bytes.
+func (vote *Vote) signsSameAs(other *Vote) bool {
+	return other != nil && vote.BlockID.Equals(other.BlockID) && vote.Timestamp.Equal(other.Timestamp)
+}
+
+// Verify verifies the signature of the vote under the rules of the block
+// protocol blockVersion.
+func (vote *Vote) Verify(chainID string, pubKey crypto.PubKey, blockVersion uint64) error {
 	if !bytes.Equal(pubKey.Address(), vote.ValidatorAddress) {
 		return ErrVoteInvalidValidatorAddress
 	}
 	v := vote.ToProto()
-	if !pubKey.VerifySignature(VoteSignBytes(chainID, v), vote.Signature) {
+	if !VerifySignature(pubKey, VoteSignBytes(chainID, v), vote.Signature, blockVersion) {
 		return ErrVoteInvalidSignature
 	}
 	return nil
 }
 
+// VerifyExtension verifies the signature of the vote extension, if any, under
+// the rules of the block protocol blockVersion.
+func (vote *Vote) VerifyExtension(chainID string, pubKey crypto.PubKey, blockVersion uint64) error {
+	if len(vote.ExtensionSignature) == 0 {
+		return nil
+	}
+	if !bytes.Equal(pubKey.Address(), vote.ValidatorAddress) {
+		return ErrVoteInvalidValidatorAddress
+	}
+	v := vote.ToProto()
+	if !VerifySignature(pubKey, VoteExtensionSignBytes(chainID, v), vote.ExtensionSignature, blockVersion) {
+		return ErrVoteInvalidExtensionSignature
+	}
+	return nil
+}
+
+// ValidateExtension checks that the precommit for a block has the signature of
+// its extension, even of an empty one, if the vote extensions are enabled at the
+// height of the vote, and that the vote has no extension otherwise.
+func (vote *Vote) ValidateExtension(extEnabled bool) error {
+	if extEnabled && IsVoteExtendable(vote.ToProto()) {
+		if len(vote.ExtensionSignature) == 0 {
+			return errors.New("vote extension signature is missing")
+		}
+		return nil
+	}
+	if len(vote.Extension) > 0 || len(vote.ExtensionSignature) > 0 {
+		return errors.New("unexpected vote extension; vote extensions are not enabled")
+	}
+	return nil
+}
+
 // ValidateBasic performs basic validation.
 func (vote *Vote) ValidateBasic() error {
 	if !IsVoteTypeValid(vote.Type) {
@@ -222,6 +303,23 @@ func (vote *Vote) ValidateBasic() error {
 		return fmt.Errorf("signature is too big %d (max: %d)", len(vote.Signature), MaxSignatureSize)
 	}
 
+	if !IsVoteExtendable(vote.ToProto()) {
+		if len(vote.Extension) > 0 || len(vote.ExtensionSignature) > 0 {
+			return errors.New("unexpected vote extension; only precommits for a block can have it")
+		}
+	} else if len(vote.Extension) > 0 && len(vote.ExtensionSignature) == 0 {
+		return errors.New("vote extension signature is missing")
+	}
+
+	if len(vote.Extension) > MaxVoteExtensionSize {
+		return fmt.Errorf("vote extension is too big %d (max: %d)", len(vote.Extension), MaxVoteExtensionSize)
+	}
+
+	if len(vote.ExtensionSignature) > MaxSignatureSize {
+		return fmt.Errorf("vote extension signature is too big %d (max: %d)",
+			len(vote.ExtensionSignature), MaxSignatureSize)
+	}
+
 	return nil
 }
 
@@ -233,14 +331,16 @@ func (vote *Vote) ToProto() *tmproto.Vote {
 	}
 
 	return &tmproto.Vote{
-		Type:             vote.Type,
-		Height:           vote.Height,
-		Round:            vote.Round,
-		BlockID:          vote.BlockID.ToProto(),
-		Timestamp:        vote.Timestamp,
-		ValidatorAddress: vote.ValidatorAddress,
-		ValidatorIndex:   vote.ValidatorIndex,
-		Signature:        vote.Signature,
+		Type:               vote.Type,
+		Height:             vote.Height,
+		Round:              vote.Round,
+		BlockID:            vote.BlockID.ToProto(),
+		Timestamp:          vote.Timestamp,
+		ValidatorAddress:   vote.ValidatorAddress,
+		ValidatorIndex:     vote.ValidatorIndex,
+		Signature:          vote.Signature,
+		Extension:          vote.Extension,
+		ExtensionSignature: vote.ExtensionSignature,
 	}
 }
 
@@ -265,6 +365,8 @@ func VoteFromProto(pv *tmproto.Vote) (*Vote, error) {
 	vote.ValidatorAddress = pv.ValidatorAddress
 	vote.ValidatorIndex = pv.ValidatorIndex
 	vote.Signature = pv.Signature
+	vote.Extension = pv.Extension
+	vote.ExtensionSignature = pv.ExtensionSignature
 
 	return vote, vote.ValidateBasic()
 }
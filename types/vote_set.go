@@ -3,9 +3,11 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/line/ostracon/crypto"
+	"github.com/line/ostracon/crypto/bls"
 	"github.com/line/ostracon/libs/bits"
 	tmjson "github.com/line/ostracon/libs/json"
 	tmsync "github.com/line/ostracon/libs/sync"
@@ -65,6 +67,7 @@ type VoteSet struct {
 	round         int32
 	signedMsgType tmproto.SignedMsgType
 	voterSet      *VoterSet
+	blockVersion  uint64 // the block protocol of the height, whose rules the votes are verified under
 
 	mtx           tmsync.Mutex
 	votesBitArray *bits.BitArray
@@ -73,11 +76,24 @@ type VoteSet struct {
 	maj23         *BlockID               // First 2/3 majority seen
 	votesByBlock  map[string]*blockVotes // string(blockHash|blockParts) -> blockVotes
 	peerMaj23s    map[P2PID]BlockID      // Maj23 for each peer
+
+	// BLS signatures of the votes we have only as a part of an aggregated signature.
+	// The aggregates never overlap, so that they can be added up into the
+	// aggregated signature of a commit.
+	aggregates     []*voteAggregate
+	aggregatedBits *bits.BitArray // valIndex -> in any of aggregates?
+
+	// The individually signed vote of a voter, kept to be evidence of a conflict
+	// with a vote we have only as a part of an aggregated signature, until we
+	// have the individual signature of that one too.
+	signedVotes map[int32]*Vote
 }
 
 // Constructs a new VoteSet struct used to accumulate votes for given height/round.
+// The votes are verified under the rules of the block protocol blockVersion of
+// the height.
 func NewVoteSet(chainID string, height int64, round int32,
-	signedMsgType tmproto.SignedMsgType, voterSet *VoterSet) *VoteSet {
+	signedMsgType tmproto.SignedMsgType, voterSet *VoterSet, blockVersion uint64) *VoteSet {
 	if height == 0 {
 		panic("Cannot make VoteSet for height == 0, doesn't make sense.")
 	}
@@ -87,12 +103,14 @@ func NewVoteSet(chainID string, height int64, round int32,
 		round:         round,
 		signedMsgType: signedMsgType,
 		voterSet:      voterSet,
+		blockVersion:  blockVersion,
 		votesBitArray: bits.NewBitArray(voterSet.Size()),
 		votes:         make([]*Vote, voterSet.Size()),
 		sum:           0,
 		maj23:         nil,
 		votesByBlock:  make(map[string]*blockVotes, voterSet.Size()),
 		peerMaj23s:    make(map[P2PID]BlockID),
+		signedVotes:   make(map[int32]*Vote),
 	}
 }
 
@@ -158,7 +176,12 @@ func (voteSet *VoteSet) AddVote(vote *Vote) (added bool, err error) {
 	voteSet.mtx.Lock()
 	defer voteSet.mtx.Unlock()
 
-	return voteSet.addVote(vote, vote.Verify)
+	return voteSet.addVote(vote, func(chainID string, pubKey crypto.PubKey) error {
+		if err := vote.Verify(chainID, pubKey, voteSet.blockVersion); err != nil {
+			return err
+		}
+		return vote.VerifyExtension(chainID, pubKey, voteSet.blockVersion)
+	})
 }
 
 func (voteSet *VoteSet) AddAggregatedVote(vote *Vote) (added bool, err error) {
@@ -172,7 +195,7 @@ func (voteSet *VoteSet) AddAggregatedVote(vote *Vote) (added bool, err error) {
 		if !bytes.Equal(pubKey.Address(), vote.ValidatorAddress) {
 			return ErrVoteInvalidValidatorAddress
 		}
-		return nil
+		return vote.VerifyExtension(chainID, pubKey, voteSet.blockVersion)
 	})
 }
 
@@ -223,6 +246,18 @@ func (voteSet *VoteSet) addVote(vote *Vote, execVoteVerify func(chainID string,
 		if bytes.Equal(existing.Signature, vote.Signature) {
 			return false, nil // duplicate
 		}
+		if existing.Signature == nil && existing.Timestamp.Equal(vote.Timestamp) {
+			// duplicate of a vote in an aggregated signature, whose own signature
+			// is kept to be evidence of a conflict
+			if vote.Signature == nil || voteSet.signedVote(existing) != nil {
+				return false, nil
+			}
+			if err := execVoteVerify(voteSet.chainID, voter.PubKey); err != nil {
+				return false, fmt.Errorf("failed to verify vote with ChainID %s and PubKey %s: %w",
+					voteSet.chainID, voter.PubKey, err)
+			}
+			return false, voteSet.conflictError(vote, nil)
+		}
 		return false, fmt.Errorf("existing vote: %v; new vote: %v: %w", existing, vote, ErrVoteNonDeterministicSignature)
 	}
 
@@ -234,7 +269,7 @@ func (voteSet *VoteSet) addVote(vote *Vote, execVoteVerify func(chainID string,
 	// Add vote and get conflicting vote if any.
 	added, conflicting := voteSet.addVerifiedVote(vote, blockKey, voter.VotingPower)
 	if conflicting != nil {
-		return added, NewConflictingVoteError(conflicting, vote)
+		return added, voteSet.conflictError(vote, conflicting)
 	}
 	if !added {
 		panic("Expected to add non-conflicting vote")
@@ -242,6 +277,218 @@ func (voteSet *VoteSet) addVote(vote *Vote, execVoteVerify func(chainID string,
 	return added, nil
 }
 
+// AddAggregatedVotes adds the votes of the aggregated vote after verifying the
+// aggregated signature, and returns the votes that were added. The added votes
+// have no signature of their own; the aggregated signature is kept to make
+// commits and aggregated votes.
+// The votes that are already in another aggregated signature are taken out of
+// the aggregated signature, which would otherwise count their signatures twice
+// in a commit. Returns ErrAggregatedVoteOverlapping if their signatures can't be
+// taken out, since we don't know them.
+// Duplicate aggregated votes return added=nil, err=nil.
+// NOTE: VoteSet must not be nil
+// NOTE: AggregatedVote must not be nil
+func (voteSet *VoteSet) AddAggregatedVotes(av *AggregatedVote) (added []*Vote, err error) {
+	if voteSet == nil {
+		panic("AddAggregatedVotes() on nil VoteSet")
+	}
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+
+	return voteSet.addAggregatedVotes(av)
+}
+
+func (voteSet *VoteSet) addAggregatedVotes(av *AggregatedVote) (added []*Vote, err error) {
+	// Make sure the step matches.
+	if (av.Height != voteSet.height) ||
+		(av.Round != voteSet.round) ||
+		(av.Type != voteSet.signedMsgType) {
+		return nil, fmt.Errorf("expected %d/%d/%d, but got %d/%d/%d: %w",
+			voteSet.height, voteSet.round, voteSet.signedMsgType,
+			av.Height, av.Round, av.Type, ErrVoteUnexpectedStep)
+	}
+
+	votes, err := av.Votes(voteSet.voterSet)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrVoteInvalidValidatorIndex)
+	}
+
+	blockKey := av.BlockID.Key()
+	pubKeys := make([]bls.PubKey, 0, len(votes))
+	messages := make([][]byte, 0, len(votes))
+	newVotes := make([]*Vote, 0, len(votes))
+	for i, vote := range votes {
+		_, voter := voteSet.voterSet.GetByIndex(vote.ValidatorIndex)
+		blsPubKey := GetSignatureKey(voter.PubKey)
+		if blsPubKey == nil {
+			return nil, fmt.Errorf("voter %d doesn't have a BLS key: %w", vote.ValidatorIndex, ErrVoteInvalidSignature)
+		}
+		pubKeys = append(pubKeys, *blsPubKey)
+		messages = append(messages, VoteSignBytes(voteSet.chainID, vote.ToProto()))
+
+		// If we already know of this vote, it's not added.
+		if existing, ok := voteSet.getVote(vote.ValidatorIndex, blockKey); ok {
+			if !existing.Timestamp.Equal(vote.Timestamp) {
+				return nil, fmt.Errorf("existing vote: %v; new vote: %v: %w",
+					existing, vote, ErrVoteNonDeterministicSignature)
+			}
+			votes[i] = existing
+			continue
+		}
+		newVotes = append(newVotes, vote)
+	}
+	if len(newVotes) == 0 {
+		return nil, nil // duplicate
+	}
+
+	// Check signature.
+	if err := bls.VerifyAggregatedSignature(av.Signature, pubKeys, messages); err != nil {
+		return nil, fmt.Errorf("failed to verify aggregated vote with ChainID %s: %s: %w",
+			voteSet.chainID, err, ErrVoteInvalidSignature)
+	}
+	votes, signature, err := voteSet.takeOutAggregated(votes, av.Signature)
+	if err != nil {
+		return nil, err
+	}
+	if len(votes) > 0 {
+		voteSet.addAggregate(votes, signature)
+	}
+
+	for _, vote := range newVotes {
+		_, voter := voteSet.voterSet.GetByIndex(vote.ValidatorIndex)
+		// A vote whose signature is aggregated can't be evidence of a conflict, so
+		// conflicting votes are just not added.
+		if ok, _ := voteSet.addVerifiedVote(vote, blockKey, voter.VotingPower); ok {
+			added = append(added, vote)
+		}
+	}
+	return added, nil
+}
+
+// takeOutAggregated takes the votes that are already in our aggregates out of
+// the verified aggregated signature of the votes, so that the aggregates don't
+// overlap, and returns the rest of the votes with their aggregated signature.
+// The signature of such a vote is known if it's a part of an aggregate of ours
+// whose votes are all in the votes, or if we have its individual signature.
+// Returns ErrAggregatedVoteOverlapping if the signature of such a vote isn't known.
+func (voteSet *VoteSet) takeOutAggregated(votes []*Vote, signature []byte) ([]*Vote, []byte, error) {
+	voters := bits.NewBitArray(voteSet.voterSet.Size())
+	for _, vote := range votes {
+		voters.SetIndex(int(vote.ValidatorIndex), true)
+	}
+	overlap := voteSet.aggregatedBits.And(voters)
+	if overlap.IsEmpty() {
+		return votes, signature, nil
+	}
+
+	var err error
+	takenOut := bits.NewBitArray(voteSet.voterSet.Size())
+	for _, aggregate := range voteSet.aggregates {
+		if aggregate.voters.And(overlap).IsEmpty() || !aggregate.voters.Sub(voters).IsEmpty() ||
+			!aggregate.hasVotes(votes) {
+			continue
+		}
+		if signature, err = bls.SubSignature(signature, aggregate.signature); err != nil {
+			return nil, nil, err
+		}
+		takenOut = takenOut.Or(aggregate.voters)
+	}
+	rest := make([]*Vote, 0, len(votes))
+	for _, vote := range votes {
+		valIndex := int(vote.ValidatorIndex)
+		switch {
+		case takenOut.GetIndex(valIndex):
+		case overlap.GetIndex(valIndex):
+			signed := voteSet.signedVote(vote)
+			if signed == nil {
+				return nil, nil, ErrAggregatedVoteOverlapping
+			}
+			if signature, err = bls.SubSignature(signature, signed.Signature); err != nil {
+				return nil, nil, err
+			}
+		default:
+			rest = append(rest, vote)
+		}
+	}
+	return rest, signature, nil
+}
+
+// conflictError returns the error with the conflicting votes of the voter of the
+// vote as evidence, if we have the individual signatures of both. A vote whose
+// signature we have only in an aggregated signature can't be evidence, so the
+// individually signed one is kept until we have the signature of the other.
+func (voteSet *VoteSet) conflictError(vote, conflicting *Vote) error {
+	signed := voteSet.signedVote(vote)
+	if signed == nil {
+		return nil
+	}
+	var other *Vote
+	if conflicting != nil {
+		other = voteSet.signedVote(conflicting)
+	}
+	if other == nil {
+		other = voteSet.signedConflict(signed)
+	}
+	if other == nil {
+		if _, ok := voteSet.signedVotes[vote.ValidatorIndex]; !ok {
+			voteSet.signedVotes[vote.ValidatorIndex] = signed
+		}
+		return nil
+	}
+	return NewConflictingVoteError(other, signed)
+}
+
+// signedVote returns the vote with its individual signature, if we have it.
+func (voteSet *VoteSet) signedVote(vote *Vote) *Vote {
+	if vote.Signature != nil {
+		return vote
+	}
+	if signed := voteSet.signedVotes[vote.ValidatorIndex]; signed != nil && vote.signsSameAs(signed) {
+		return signed
+	}
+	return nil
+}
+
+// signedConflict returns an individually signed vote of the voter for another
+// block than the vote, if we have one.
+func (voteSet *VoteSet) signedConflict(vote *Vote) *Vote {
+	valIndex := vote.ValidatorIndex
+	blockKey := vote.BlockID.Key()
+	if signed := voteSet.signedVotes[valIndex]; signed != nil && signed.BlockID.Key() != blockKey {
+		return signed
+	}
+	if existing := voteSet.votes[valIndex]; existing != nil && existing.BlockID.Key() != blockKey {
+		if signed := voteSet.signedVote(existing); signed != nil {
+			return signed
+		}
+	}
+	for key, votesByBlock := range voteSet.votesByBlock {
+		if key == blockKey {
+			continue
+		}
+		if existing := votesByBlock.getByIndex(valIndex); existing != nil {
+			if signed := voteSet.signedVote(existing); signed != nil {
+				return signed
+			}
+		}
+	}
+	return nil
+}
+
+// addAggregate keeps the signature aggregated from the signatures of the votes.
+func (voteSet *VoteSet) addAggregate(votes []*Vote, signature []byte) {
+	aggregate := &voteAggregate{
+		votes:     votes,
+		voters:    bits.NewBitArray(voteSet.voterSet.Size()),
+		signature: signature,
+	}
+	for _, vote := range votes {
+		aggregate.voters.SetIndex(int(vote.ValidatorIndex), true)
+	}
+	voteSet.aggregates = append(voteSet.aggregates, aggregate)
+	voteSet.aggregatedBits = voteSet.aggregatedBits.Or(aggregate.voters)
+}
+
 // Returns (vote, true) if vote exists for valIndex and blockKey.
 func (voteSet *VoteSet) getVote(valIndex int32, blockKey string) (vote *Vote, ok bool) {
 	if existing := voteSet.votes[valIndex]; existing != nil && existing.BlockID.Key() == blockKey {
@@ -415,6 +662,98 @@ func (voteSet *VoteSet) GetByAddress(address []byte) *Vote {
 	return voteSet.votes[valIndex]
 }
 
+// MakeAggregatedVote aggregates the BLS votes of the given voters into an
+// AggregatedVote, to be gossiped instead of the individual votes. Only the votes
+// for the same block are aggregated, so the block with the most such votes is
+// picked. The votes with an extension are not aggregated, since the extension
+// can't be carried by the AggregatedVote.
+// Returns nil if fewer than two votes can be aggregated.
+func (voteSet *VoteSet) MakeAggregatedVote(voters *bits.BitArray) *AggregatedVote {
+	if voteSet == nil || voters == nil {
+		return nil
+	}
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+
+	type blockAggregate struct {
+		votes      []*Vote
+		signatures [][]byte
+		voters     *bits.BitArray
+	}
+	blockAggregates := make(map[string]*blockAggregate)
+	getBlockAggregate := func(blockKey string) *blockAggregate {
+		ba, ok := blockAggregates[blockKey]
+		if !ok {
+			ba = &blockAggregate{voters: bits.NewBitArray(voteSet.voterSet.Size())}
+			blockAggregates[blockKey] = ba
+		}
+		return ba
+	}
+
+	// The aggregates we have can be forwarded as they are, if the peer lacks all
+	// of their votes.
+	for _, aggregate := range voteSet.aggregates {
+		if !aggregate.isForBlock() || !voteSet.hasAggregatedVotes(aggregate) ||
+			!aggregate.voters.Sub(voters).IsEmpty() {
+			continue
+		}
+		ba := getBlockAggregate(aggregate.votes[0].BlockID.Key())
+		ba.votes = append(ba.votes, aggregate.votes...)
+		ba.signatures = append(ba.signatures, aggregate.signature)
+		ba.voters = ba.voters.Or(aggregate.voters)
+	}
+	for i, vote := range voteSet.votes {
+		if vote == nil || !voters.GetIndex(i) || len(vote.Signature) != bls.SignatureSize ||
+			len(vote.ExtensionSignature) > 0 {
+			continue
+		}
+		ba := getBlockAggregate(vote.BlockID.Key())
+		if ba.voters.GetIndex(i) {
+			continue // already in an aggregate
+		}
+		ba.votes = append(ba.votes, vote)
+		ba.signatures = append(ba.signatures, vote.Signature)
+		ba.voters.SetIndex(i, true)
+	}
+
+	// Pick the block with the most votes, the first one in voter index order for ties.
+	var picked *blockAggregate
+	for _, vote := range voteSet.votes {
+		if vote == nil {
+			continue
+		}
+		if ba, ok := blockAggregates[vote.BlockID.Key()]; ok && (picked == nil || len(ba.votes) > len(picked.votes)) {
+			picked = ba
+		}
+	}
+	if picked == nil || len(picked.votes) < 2 {
+		return nil
+	}
+
+	signature := picked.signatures[0]
+	for _, sig := range picked.signatures[1:] {
+		var err error
+		if signature, err = bls.AddSignature(signature, sig); err != nil {
+			panic(fmt.Sprintf("fail to aggregate signature: %s", err))
+		}
+	}
+	sort.Slice(picked.votes, func(i, j int) bool {
+		return picked.votes[i].ValidatorIndex < picked.votes[j].ValidatorIndex
+	})
+	return makeAggregatedVote(voteSet.voterSet.Size(), picked.votes, signature)
+}
+
+// hasAggregatedVotes returns true if all the votes of the aggregate are the
+// canonical votes of their voters.
+func (voteSet *VoteSet) hasAggregatedVotes(aggregate *voteAggregate) bool {
+	for _, vote := range aggregate.votes {
+		if !vote.signsSameAs(voteSet.votes[vote.ValidatorIndex]) {
+			return false
+		}
+	}
+	return true
+}
+
 func (voteSet *VoteSet) HasTwoThirdsMajority() bool {
 	if voteSet == nil {
 		return false
@@ -618,21 +957,92 @@ func (voteSet *VoteSet) MakeCommit() *Commit {
 	commitSigs := make([]CommitSig, len(voteSet.votes))
 	for i, v := range voteSet.votes {
 		commitSig := v.CommitSig()
-		if !commitSig.Absent() && commitSig.Signature == nil {
-			panic(fmt.Sprintf("This signature of commitSig is already aggregated: commitSig: <%v>", commitSig))
-		}
 		// if block ID exists but doesn't match, exclude sig
 		if commitSig.ForBlock() && !v.BlockID.Equals(*voteSet.maj23) {
 			commitSig = NewCommitSigAbsent()
 		}
 		commitSigs[i] = commitSig
 	}
+
+	// Add up the aggregates whose votes are all in the commit.
+	var aggregatedSignature []byte
+	aggregated := bits.NewBitArray(len(voteSet.votes))
+	for _, aggregate := range voteSet.aggregates {
+		if !voteSet.hasAggregatedVotes(aggregate) {
+			continue
+		}
+		inCommit := true
+		for _, vote := range aggregate.votes {
+			if commitSigs[vote.ValidatorIndex].Absent() {
+				inCommit = false
+				break
+			}
+		}
+		if !inCommit {
+			continue
+		}
+		if aggregatedSignature == nil {
+			aggregatedSignature = aggregate.signature
+		} else {
+			var err error
+			aggregatedSignature, err = bls.AddSignature(aggregatedSignature, aggregate.signature)
+			if err != nil {
+				panic(fmt.Sprintf("fail to aggregate signature: %s\n", err))
+			}
+		}
+		aggregated = aggregated.Or(aggregate.voters)
+	}
+	for i := range commitSigs {
+		switch {
+		case aggregated.GetIndex(i):
+			commitSigs[i].Signature = nil
+		case !commitSigs[i].Absent() && commitSigs[i].Signature == nil:
+			// The signature is in an aggregate that can't be added up, e.g. because
+			// the voter has conflicting votes, so the vote is excluded.
+			commitSigs[i] = NewCommitSigAbsent()
+		}
+	}
+
 	newCommit := NewCommit(voteSet.GetHeight(), voteSet.GetRound(), *voteSet.maj23, commitSigs)
 	newCommit.AggregateSignatures()
+	if aggregatedSignature != nil {
+		if newCommit.AggregatedSignature == nil {
+			newCommit.AggregatedSignature = aggregatedSignature
+		} else {
+			var err error
+			newCommit.AggregatedSignature, err = bls.AddSignature(newCommit.AggregatedSignature, aggregatedSignature)
+			if err != nil {
+				panic(fmt.Sprintf("fail to aggregate signature: %s\n", err))
+			}
+		}
+	}
 
 	return newCommit
 }
 
+// MakeExtendedCommit constructs a Commit from the VoteSet along with the vote
+// extensions of the precommits for the committed block.
+//
+// Panics if the vote type is not PrecommitType or if there's no +2/3 votes for
+// a single block.
+func (voteSet *VoteSet) MakeExtendedCommit() *ExtendedCommit {
+	commit := voteSet.MakeCommit()
+
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+
+	extensions := make([]VoteExtension, len(voteSet.votes))
+	for i, v := range voteSet.votes {
+		if commit.Signatures[i].ForBlock() {
+			extensions[i] = VoteExtension{
+				Extension: v.Extension,
+				Signature: v.ExtensionSignature,
+			}
+		}
+	}
+	return NewExtendedCommit(commit, extensions)
+}
+
 //--------------------------------------------------------------------------------
 
 /*
@@ -675,6 +1085,38 @@ func (vs *blockVotes) getByIndex(index int32) *Vote {
 
 //--------------------------------------------------------------------------------
 
+// voteAggregate is a BLS signature aggregated from the signatures of the votes.
+type voteAggregate struct {
+	votes     []*Vote        // sorted by valIndex
+	voters    *bits.BitArray // valIndex -> in votes?
+	signature []byte
+}
+
+// hasVotes returns true if all the votes of the aggregate are in the votes,
+// which are sorted by valIndex.
+func (aggregate *voteAggregate) hasVotes(votes []*Vote) bool {
+	for _, vote := range aggregate.votes {
+		i := sort.Search(len(votes), func(i int) bool { return votes[i].ValidatorIndex >= vote.ValidatorIndex })
+		if i == len(votes) || !vote.signsSameAs(votes[i]) || vote.ValidatorIndex != votes[i].ValidatorIndex {
+			return false
+		}
+	}
+	return true
+}
+
+// isForBlock returns true if all the votes are for the same block, so that the
+// aggregate can be an AggregatedVote.
+func (aggregate *voteAggregate) isForBlock() bool {
+	for _, vote := range aggregate.votes[1:] {
+		if !vote.BlockID.Equals(aggregate.votes[0].BlockID) {
+			return false
+		}
+	}
+	return true
+}
+
+//--------------------------------------------------------------------------------
+
 // Common interface between *consensus.VoteSet and types.Commit
 type VoteSetReader interface {
 	GetHeight() int64
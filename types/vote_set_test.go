@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/line/ostracon/crypto/bls"
 	"github.com/line/ostracon/crypto/composite"
@@ -17,6 +18,7 @@ import (
 	tmrand "github.com/line/ostracon/libs/rand"
 	tmproto "github.com/line/ostracon/proto/ostracon/types"
 	tmtime "github.com/line/ostracon/types/time"
+	"github.com/line/ostracon/version"
 )
 
 // NOTE: privValidators are in order
@@ -28,7 +30,8 @@ func randVoteSet(
 	votingPower int64,
 ) (*VoteSet, *ValidatorSet, *VoterSet, []PrivValidator) {
 	valSet, voterSet, privValidators := RandVoterSet(numValidators, votingPower)
-	return NewVoteSet("test_chain_id", height, round, signedMsgType, voterSet), valSet, voterSet, privValidators
+	return NewVoteSet("test_chain_id", height, round, signedMsgType, voterSet,
+		version.BlockProtocol), valSet, voterSet, privValidators
 }
 
 func randVoteSetForPrivKeys(
@@ -47,13 +50,18 @@ func randVoteSetForPrivKeys(
 			panic(fmt.Errorf("could not retrieve pubkey %w", err))
 		}
 		val := NewValidator(pubKey, votingPower)
+		val.ProofOfPossession, err = ProofOfPossession(privKeys[i])
+		if err != nil {
+			panic(fmt.Errorf("could not generate proof-of-possession %w", err))
+		}
 		valz[i] = val
 		privValidators[i] = privVal
 	}
 	vals := NewValidatorSet(valz)
 	sort.Sort(PrivValidatorsByAddress(privValidators))
 	voterSet := SelectVoter(vals, []byte{}, DefaultVoterParams())
-	return NewVoteSet("test_chain_id", height, round, signedMsgType, voterSet), vals, voterSet, privValidators
+	return NewVoteSet("test_chain_id", height, round, signedMsgType, voterSet,
+		version.BlockProtocol), vals, voterSet, privValidators
 }
 
 // Convenience: Return new vote with different validator address/index
@@ -263,7 +271,7 @@ func TestVoteSet_2_3MajorityRedux(t *testing.T) {
 
 	blockHash := crypto.CRandBytes(32)
 	blockPartsTotal := uint32(123)
-	blockPartSetHeader := PartSetHeader{blockPartsTotal, crypto.CRandBytes(32)}
+	blockPartSetHeader := PartSetHeader{Total: blockPartsTotal, Hash: crypto.CRandBytes(32)}
 
 	voteProto := &Vote{
 		ValidatorAddress: nil, // NOTE: must fill in
@@ -307,7 +315,7 @@ func TestVoteSet_2_3MajorityRedux(t *testing.T) {
 		require.NoError(t, err)
 		addr := pubKey.Address()
 		vote := withValidator(voteProto, addr, 67)
-		blockPartsHeader := PartSetHeader{blockPartsTotal, crypto.CRandBytes(32)}
+		blockPartsHeader := PartSetHeader{Total: blockPartsTotal, Hash: crypto.CRandBytes(32)}
 		_, err = signAddVote(privValidators[67], withBlockPartSetHeader(vote, blockPartsHeader), voteSet)
 		require.NoError(t, err)
 		blockID, ok = voteSet.TwoThirdsMajority()
@@ -321,7 +329,7 @@ func TestVoteSet_2_3MajorityRedux(t *testing.T) {
 		require.NoError(t, err)
 		addr := pubKey.Address()
 		vote := withValidator(voteProto, addr, 68)
-		blockPartsHeader := PartSetHeader{blockPartsTotal + 1, blockPartSetHeader.Hash}
+		blockPartsHeader := PartSetHeader{Total: blockPartsTotal + 1, Hash: blockPartSetHeader.Hash}
 		_, err = signAddVote(privValidators[68], withBlockPartSetHeader(vote, blockPartsHeader), voteSet)
 		require.NoError(t, err)
 		blockID, ok = voteSet.TwoThirdsMajority()
@@ -488,7 +496,7 @@ func TestVoteSet_Conflicts(t *testing.T) {
 func TestVoteSet_MakeCommit(t *testing.T) {
 	height, round := int64(1), int32(0)
 	voteSet, _, _, privValidators := randVoteSet(height, round, tmproto.PrecommitType, 10, 1)
-	blockHash, blockPartSetHeader := crypto.CRandBytes(32), PartSetHeader{123, crypto.CRandBytes(32)}
+	blockHash, blockPartSetHeader := crypto.CRandBytes(32), PartSetHeader{Total: 123, Hash: crypto.CRandBytes(32)}
 
 	voteProto := &Vote{
 		ValidatorAddress: nil,
@@ -522,7 +530,7 @@ func TestVoteSet_MakeCommit(t *testing.T) {
 		addr := pv.Address()
 		vote := withValidator(voteProto, addr, 6)
 		vote = withBlockHash(vote, tmrand.Bytes(32))
-		vote = withBlockPartSetHeader(vote, PartSetHeader{123, tmrand.Bytes(32)})
+		vote = withBlockPartSetHeader(vote, PartSetHeader{Total: 123, Hash: tmrand.Bytes(32)})
 
 		_, err = signAddVote(privValidators[6], vote, voteSet)
 		require.NoError(t, err)
@@ -606,3 +614,285 @@ func TestVoteSet_MakeCommit(t *testing.T) {
 		}
 	})
 }
+
+func TestVoteSet_MakeExtendedCommit(t *testing.T) {
+	height, round := int64(1), int32(0)
+	voteSet, valSet, voterSet, privValidators := randVoteSet(height, round, tmproto.PrecommitType, 10, 1)
+	blockHash, blockPartSetHeader := crypto.CRandBytes(32), PartSetHeader{Total: 123, Hash: crypto.CRandBytes(32)}
+
+	voteProto := &Vote{
+		ValidatorAddress: nil,
+		ValidatorIndex:   -1,
+		Height:           height,
+		Round:            round,
+		Timestamp:        tmtime.Now(),
+		Type:             tmproto.PrecommitType,
+		BlockID:          BlockID{blockHash, blockPartSetHeader},
+	}
+
+	// 7 out of 10 voted for some block with an extension, and the 8th with an
+	// empty one.
+	for i := int32(0); i < 8; i++ {
+		pv, err := privValidators[i].GetPubKey()
+		require.NoError(t, err)
+		vote := withValidator(voteProto, pv.Address(), i)
+		if i < 7 {
+			vote.Extension = []byte(fmt.Sprintf("extension-%d", i))
+		}
+		v := vote.ToProto()
+		require.NoError(t, privValidators[i].SignVote(voteSet.ChainID(), v))
+		vote.Signature = v.Signature
+		vote.ExtensionSignature = v.ExtensionSignature
+		added, err := voteSet.AddVote(vote)
+		require.NoError(t, err)
+		require.True(t, added)
+	}
+
+	// The 9th voted with a tampered extension.
+	{
+		pv, err := privValidators[8].GetPubKey()
+		require.NoError(t, err)
+		vote := withValidator(voteProto, pv.Address(), 8)
+		vote.Extension = []byte("extension")
+		v := vote.ToProto()
+		require.NoError(t, privValidators[8].SignVote(voteSet.ChainID(), v))
+		vote.Signature = v.Signature
+		vote.ExtensionSignature = v.ExtensionSignature
+		vote.Extension = []byte("tampered")
+		_, err = voteSet.AddVote(vote)
+		require.ErrorIs(t, err, ErrVoteInvalidExtensionSignature)
+	}
+
+	extCommit := voteSet.MakeExtendedCommit()
+	require.NoError(t, extCommit.ValidateBasic())
+	require.Len(t, extCommit.Extensions, 10)
+	for i := 0; i < 10; i++ {
+		if i < 7 {
+			assert.Equal(t, []byte(fmt.Sprintf("extension-%d", i)), extCommit.Extensions[i].Extension)
+			assert.NotEmpty(t, extCommit.Extensions[i].Signature)
+		} else if i == 7 {
+			assert.Empty(t, extCommit.Extensions[i].Extension)
+			assert.NotEmpty(t, extCommit.Extensions[i].Signature)
+		} else {
+			assert.Empty(t, extCommit.Extensions[i].Extension)
+			assert.Empty(t, extCommit.Extensions[i].Signature)
+		}
+	}
+
+	vals := make([]*Validator, voterSet.Size())
+	for i, voter := range voterSet.Voters {
+		_, vals[i] = valSet.GetByAddress(voter.Address)
+	}
+	require.NoError(t, extCommit.VerifyExtensions(voteSet.ChainID(), vals, version.BlockProtocol))
+
+	// proto round trip
+	pbec := extCommit.ToProto()
+	extCommit2, err := ExtendedCommitFromProto(pbec)
+	require.NoError(t, err)
+	assert.Equal(t, extCommit.Extensions, extCommit2.Extensions)
+	assert.Equal(t, extCommit.Commit.Hash(), extCommit2.Commit.Hash())
+
+	// the extensions survive the reconstruction of the vote set
+	voteSet2 := extCommit2.ToVoteSet(voteSet.ChainID(), voterSet, version.BlockProtocol)
+	assert.Equal(t, []byte("extension-3"), voteSet2.GetByIndex(3).Extension)
+	assert.Equal(t, extCommit.Extensions, voteSet2.MakeExtendedCommit().Extensions)
+
+	// an extension of a vote that is absent in the commit is invalid
+	extCommit2.Extensions[9] = VoteExtension{Extension: []byte("ext"), Signature: []byte("sig")}
+	assert.Error(t, extCommit2.ValidateBasic())
+	extCommit2.Extensions = extCommit2.Extensions[:9]
+	assert.Error(t, extCommit2.ValidateBasic())
+}
+
+func TestVoteSet_ConflictsWithAggregatedVotes(t *testing.T) {
+	height, round := int64(1), int32(0)
+	privKeys := make([]crypto.PrivKey, 4)
+	for i := range privKeys {
+		privKeys[i] = composite.GenPrivKey()
+	}
+	voteSet, _, voterSet, privValidators := randVoteSetForPrivKeys(height, round, tmproto.PrevoteType, privKeys, 1)
+
+	signedVote := func(i int, blockID BlockID) *Vote {
+		pubKey, err := privValidators[i].GetPubKey()
+		require.NoError(t, err)
+		vote := &Vote{
+			ValidatorAddress: pubKey.Address(),
+			ValidatorIndex:   int32(i),
+			Height:           height,
+			Round:            round,
+			Type:             tmproto.PrevoteType,
+			Timestamp:        tmtime.Now(),
+			BlockID:          blockID,
+		}
+		v := vote.ToProto()
+		require.NoError(t, privValidators[i].SignVote(voteSet.ChainID(), v))
+		vote.Signature = v.Signature
+		return vote
+	}
+	blockID1, blockID2 := makeBlockIDRandom(), makeBlockIDRandom()
+	vote1, vote2 := signedVote(0, blockID1), signedVote(0, blockID2)
+
+	// val0 votes for blockID1 in an aggregated vote
+	av, err := NewAggregatedVote(voterSet.Size(), []*Vote{vote1, signedVote(1, blockID1)})
+	require.NoError(t, err)
+	added, err := voteSet.AddAggregatedVotes(av)
+	require.NoError(t, err)
+	require.Len(t, added, 2)
+
+	// the conflicting vote for blockID2 can't be proven without the signature of
+	// the vote for blockID1
+	ok, err := voteSet.AddVote(vote2)
+	assert.False(t, ok)
+	assert.NoError(t, err)
+
+	// the individually signed vote for blockID1 proves the conflict
+	ok, err = voteSet.AddVote(vote1)
+	assert.False(t, ok)
+	var conflict *ErrVoteConflictingVotes
+	if assert.ErrorAs(t, err, &conflict) {
+		assert.Equal(t, vote2, conflict.VoteA)
+		assert.Equal(t, vote1, conflict.VoteB)
+	}
+}
+
+func TestVoteSet_AddAggregatedVotes(t *testing.T) {
+	height, round := int64(1), int32(0)
+	privKeys := make([]crypto.PrivKey, 6)
+	for i := range privKeys {
+		privKeys[i] = composite.GenPrivKey()
+	}
+	voteSet, _, voterSet, privValidators := randVoteSetForPrivKeys(height, round, tmproto.PrecommitType, privKeys, 1)
+	blockID := makeBlockIDRandom()
+
+	votes := make([]*Vote, len(privValidators))
+	for i, privVal := range privValidators {
+		pubKey, err := privVal.GetPubKey()
+		require.NoError(t, err)
+		vote := &Vote{
+			ValidatorAddress: pubKey.Address(),
+			ValidatorIndex:   int32(i),
+			Height:           height,
+			Round:            round,
+			Type:             tmproto.PrecommitType,
+			Timestamp:        tmtime.Now(),
+			BlockID:          blockID,
+		}
+		added, err := signAddVote(privVal, vote, voteSet)
+		require.NoError(t, err)
+		require.True(t, added)
+		votes[i] = vote
+	}
+	newVoteSet := func() *VoteSet {
+		return NewVoteSet(voteSet.ChainID(), height, round, tmproto.PrecommitType, voterSet, version.BlockProtocol)
+	}
+	aggregate := func(votes ...*Vote) *AggregatedVote {
+		av, err := NewAggregatedVote(voterSet.Size(), votes)
+		require.NoError(t, err)
+		return av
+	}
+
+	t.Run("all votes", func(t *testing.T) {
+		av := voteSet.MakeAggregatedVote(voteSet.BitArray())
+		require.NotNil(t, av)
+		assert.Equal(t, len(votes), av.Size())
+
+		voteSet2 := newVoteSet()
+		added, err := voteSet2.AddAggregatedVotes(av)
+		require.NoError(t, err)
+		assert.Len(t, added, len(votes))
+		for _, vote := range added {
+			assert.Nil(t, vote.Signature)
+		}
+		assert.True(t, voteSet2.HasAll())
+
+		// duplicate
+		added, err = voteSet2.AddAggregatedVotes(av)
+		assert.NoError(t, err)
+		assert.Empty(t, added)
+
+		// the commit is the same as the one made from the individual votes
+		commit := voteSet2.MakeCommit()
+		assert.NoError(t, voterSet.VerifyCommit(voteSet.ChainID(), blockID, height, commit, version.BlockProtocol))
+		assert.Equal(t, voteSet.MakeCommit().AggregatedSignature, commit.AggregatedSignature)
+
+		// the aggregate is forwarded as it is
+		forwarded := voteSet2.MakeAggregatedVote(voteSet2.BitArray())
+		assert.Equal(t, av, forwarded)
+	})
+
+	t.Run("overlapping aggregates", func(t *testing.T) {
+		voteSet2 := newVoteSet()
+
+		// the individual vote is covered by the aggregate
+		added, err := voteSet2.AddVote(votes[0])
+		require.NoError(t, err)
+		require.True(t, added)
+		addedVotes, err := voteSet2.AddAggregatedVotes(aggregate(votes[0], votes[1], votes[2]))
+		require.NoError(t, err)
+		assert.Len(t, addedVotes, 2)
+
+		// the signature of vote 2 is known only as a part of the aggregate, so it
+		// can't be taken out
+		_, err = voteSet2.AddAggregatedVotes(aggregate(votes[2], votes[3], votes[4]))
+		assert.Equal(t, ErrAggregatedVoteOverlapping, err)
+		assert.False(t, voteSet2.BitArray().GetIndex(3))
+
+		// the whole aggregate is taken out of the overlapping one
+		addedVotes, err = voteSet2.AddAggregatedVotes(aggregate(votes[0], votes[1], votes[2], votes[3], votes[4]))
+		require.NoError(t, err)
+		assert.Len(t, addedVotes, 2)
+
+		// the individual vote is not counted twice
+		commit := voteSet2.MakeCommit()
+		assert.NoError(t, voterSet.VerifyCommit(voteSet.ChainID(), blockID, height, commit, version.BlockProtocol))
+		assert.True(t, commit.Signatures[5].Absent())
+
+		// the peer lacking votes 0-2 can only get the first aggregate
+		voters := voteSet2.BitArray()
+		voters.SetIndex(3, false)
+		av := voteSet2.MakeAggregatedVote(voters)
+		require.NotNil(t, av)
+		assert.Equal(t, 3, av.Size())
+		assert.Nil(t, voteSet2.MakeAggregatedVote(voteSet2.BitArray().Sub(voters)))
+	})
+
+	t.Run("aggregates overlapping with individual votes", func(t *testing.T) {
+		voteSet2 := newVoteSet()
+		for _, vote := range votes[:2] {
+			added, err := voteSet2.AddVote(vote)
+			require.NoError(t, err)
+			require.True(t, added)
+		}
+		addedVotes, err := voteSet2.AddAggregatedVotes(aggregate(votes[0], votes[1], votes[2]))
+		require.NoError(t, err)
+		assert.Len(t, addedVotes, 1)
+
+		// the individual signature of vote 1 is taken out of the overlapping aggregate
+		addedVotes, err = voteSet2.AddAggregatedVotes(aggregate(votes[1], votes[3], votes[4]))
+		require.NoError(t, err)
+		assert.Len(t, addedVotes, 2)
+		added, err := voteSet2.AddVote(votes[5])
+		require.NoError(t, err)
+		require.True(t, added)
+
+		commit := voteSet2.MakeCommit()
+		assert.NoError(t, voterSet.VerifyCommit(voteSet.ChainID(), blockID, height, commit, version.BlockProtocol))
+		for i, commitSig := range commit.Signatures {
+			assert.False(t, commitSig.Absent(), "signature #%d", i)
+		}
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		av := aggregate(votes[0], votes[1])
+		av.Timestamps[1] = av.Timestamps[1].Add(time.Second)
+		_, err := newVoteSet().AddAggregatedVotes(av)
+		assert.ErrorIs(t, err, ErrVoteInvalidSignature)
+	})
+
+	t.Run("unexpected step", func(t *testing.T) {
+		av := aggregate(votes[0], votes[1])
+		av.Round++
+		_, err := newVoteSet().AddAggregatedVotes(av)
+		assert.ErrorIs(t, err, ErrVoteUnexpectedStep)
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/line/ostracon/crypto/tmhash"
 	"github.com/line/ostracon/libs/protoio"
 	tmproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/line/ostracon/version"
 )
 
 func examplePrevote() *Vote {
@@ -220,18 +221,86 @@ func TestVoteVerify(t *testing.T) {
 		vote := examplePrevote()
 		vote.ValidatorAddress = pubkey.Address()
 
-		err = vote.Verify("test_chain_id", ed25519.GenPrivKey().PubKey())
+		err = vote.Verify("test_chain_id", ed25519.GenPrivKey().PubKey(), version.BlockProtocol)
 		if assert.Error(t, err) {
 			assert.Equal(t, ErrVoteInvalidValidatorAddress, err)
 		}
 
-		err = vote.Verify("test_chain_id", pubkey)
+		err = vote.Verify("test_chain_id", pubkey, version.BlockProtocol)
 		if assert.Error(t, err) {
 			assert.Equal(t, ErrVoteInvalidSignature, err)
 		}
 	})
 }
 
+func TestVoteExtension(t *testing.T) {
+	forAllPrivKeyTypes(t, func(t *testing.T, name string, kt PrivKeyType) {
+		privVal := NewMockPV(kt)
+		pubkey, err := privVal.GetPubKey()
+		require.NoError(t, err)
+
+		vote := examplePrecommit()
+		vote.ValidatorAddress = pubkey.Address()
+		vote.Extension = []byte("price:100")
+		v := vote.ToProto()
+		require.NoError(t, privVal.SignVote("test_chain_id", v))
+		require.NotEmpty(t, v.ExtensionSignature)
+		vote.Signature = v.Signature
+		vote.ExtensionSignature = v.ExtensionSignature
+
+		require.NoError(t, vote.ValidateBasic())
+		require.NoError(t, vote.Verify("test_chain_id", pubkey, version.BlockProtocol))
+		require.NoError(t, vote.VerifyExtension("test_chain_id", pubkey, version.BlockProtocol))
+
+		// the extension is not covered by the signature of the vote
+		vote.Extension = []byte("price:200")
+		require.NoError(t, vote.Verify("test_chain_id", pubkey, version.BlockProtocol))
+		assert.Equal(t, ErrVoteInvalidExtensionSignature,
+			vote.VerifyExtension("test_chain_id", pubkey, version.BlockProtocol))
+
+		// the extension is bound to the chain
+		vote.Extension = []byte("price:100")
+		assert.Equal(t, ErrVoteInvalidExtensionSignature,
+			vote.VerifyExtension("other_chain_id", pubkey, version.BlockProtocol))
+
+		// a prevote is never extended
+		prevote := examplePrevote()
+		prevote.Extension = []byte("price:100")
+		v = prevote.ToProto()
+		require.NoError(t, privVal.SignVote("test_chain_id", v))
+		assert.Empty(t, v.ExtensionSignature)
+
+		// an empty extension is signed as well
+		vote.Extension = nil
+		v = vote.ToProto()
+		require.NoError(t, privVal.SignVote("test_chain_id", v))
+		require.NotEmpty(t, v.ExtensionSignature)
+		vote.ExtensionSignature = v.ExtensionSignature
+		require.NoError(t, vote.VerifyExtension("test_chain_id", pubkey, version.BlockProtocol))
+	})
+}
+
+func TestVoteValidateExtension(t *testing.T) {
+	precommit := examplePrecommit()
+	precommit.Extension, precommit.ExtensionSignature = nil, []byte("sig")
+	assert.NoError(t, precommit.ValidateExtension(true))
+	assert.Error(t, precommit.ValidateExtension(false))
+
+	// the signature is mandatory once the vote extensions are enabled
+	precommit.ExtensionSignature = nil
+	assert.Error(t, precommit.ValidateExtension(true))
+	assert.NoError(t, precommit.ValidateExtension(false))
+
+	// the nil precommits and the prevotes have no extension anyway
+	nilPrecommit := examplePrecommit()
+	nilPrecommit.BlockID = BlockID{}
+	assert.NoError(t, nilPrecommit.ValidateExtension(true))
+	prevote := examplePrevote()
+	assert.NoError(t, prevote.ValidateExtension(true))
+	prevote.Extension, prevote.ExtensionSignature = []byte("ext"), []byte("sig")
+	assert.Error(t, prevote.ValidateExtension(true))
+}
+
 func TestMaxVoteBytes(t *testing.T) {
 	// time is varint encoded so need to pick the max.
 	// year int, month Month, day, hour, min, sec, nsec int, loc *Location
@@ -289,12 +358,28 @@ func TestVoteValidateBasic(t *testing.T) {
 		{"Negative Height", func(v *Vote) { v.Height = -1 }, true},
 		{"Negative Round", func(v *Vote) { v.Round = -1 }, true},
 		{"Invalid BlockID", func(v *Vote) {
-			v.BlockID = BlockID{[]byte{1, 2, 3}, PartSetHeader{111, []byte("blockparts")}}
+			v.BlockID = BlockID{[]byte{1, 2, 3}, PartSetHeader{Total: 111, Hash: []byte("blockparts")}}
 		}, true},
 		{"Invalid Address", func(v *Vote) { v.ValidatorAddress = make([]byte, 1) }, true},
 		{"Invalid ValidatorIndex", func(v *Vote) { v.ValidatorIndex = -1 }, true},
 		{"Invalid Signature", func(v *Vote) { v.Signature = nil }, true},
 		{"Too big Signature", func(v *Vote) { v.Signature = make([]byte, MaxSignatureSize+1) }, true},
+		{"Extension", func(v *Vote) { v.Extension, v.ExtensionSignature = []byte("ext"), []byte("sig") }, false},
+		{"Extension without Signature", func(v *Vote) { v.Extension = []byte("ext") }, true},
+		{"Too big Extension", func(v *Vote) {
+			v.Extension, v.ExtensionSignature = make([]byte, MaxVoteExtensionSize+1), []byte("sig")
+		}, true},
+		{"Too big Extension Signature", func(v *Vote) {
+			v.Extension, v.ExtensionSignature = []byte("ext"), make([]byte, MaxSignatureSize+1)
+		}, true},
+		{"Extension of nil Precommit", func(v *Vote) {
+			v.BlockID = BlockID{}
+			v.Extension, v.ExtensionSignature = []byte("ext"), []byte("sig")
+		}, true},
+		{"Extension of Prevote", func(v *Vote) {
+			v.Type = tmproto.PrevoteType
+			v.Extension, v.ExtensionSignature = []byte("ext"), []byte("sig")
+		}, true},
 	}
 	forAllPrivKeyTypes(t, func(t *testing.T, name string, kt PrivKeyType) {
 		privVal := NewMockPV(kt)
@@ -317,9 +402,11 @@ func TestVoteProtobuf(t *testing.T) {
 	forAllPrivKeyTypes(t, func(t *testing.T, name string, kt PrivKeyType) {
 		privVal := NewMockPV(kt)
 		vote := examplePrecommit()
+		vote.Extension = []byte("extension")
 		v := vote.ToProto()
 		err := privVal.SignVote("test_chain_id", v)
 		vote.Signature = v.Signature
+		vote.ExtensionSignature = v.ExtensionSignature
 		require.NoError(t, err)
 
 		testCases := []struct {
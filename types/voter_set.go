@@ -10,12 +10,19 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/line/ostracon/crypto"
+	"github.com/line/ostracon/crypto/batch"
 	"github.com/line/ostracon/crypto/bls"
+	"github.com/line/ostracon/crypto/composite"
+	"github.com/line/ostracon/crypto/ed25519"
 	"github.com/line/ostracon/crypto/merkle"
+	"github.com/line/ostracon/crypto/secp256k1"
 	"github.com/line/ostracon/crypto/tmhash"
+	"github.com/line/ostracon/crypto/vrf"
 	tmmath "github.com/line/ostracon/libs/math"
 	tmrand "github.com/line/ostracon/libs/rand"
 	tmproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/line/ostracon/version"
 )
 
 // VoterSet represent a set of *Validator at a given height.
@@ -34,7 +41,14 @@ func WrapValidatorsToVoterSet(vals []*Validator) *VoterSet {
 	return voterSet
 }
 
+// ValidateBasic performs basic validation of the voters, including the proof-of-possession of their BLS keys.
 func (voters *VoterSet) ValidateBasic() error {
+	return voters.validateBasic(version.BlockProtocol)
+}
+
+// validateBasic performs basic validation of the voters of a block of the block protocol. The BLS keys must have a
+// valid proof-of-possession from version.BlockProtocolProofOfPossession.
+func (voters *VoterSet) validateBasic(blockVersion uint64) error {
 	if voters.IsNilOrEmpty() {
 		return errors.New("voter set is nil or empty")
 	}
@@ -43,6 +57,12 @@ func (voters *VoterSet) ValidateBasic() error {
 		if err := val.ValidateBasic(); err != nil {
 			return fmt.Errorf("invalid validator #%d: %w", idx, err)
 		}
+		// BLS keys must not take part in signature aggregation without proof-of-possession
+		if blockVersion >= version.BlockProtocolProofOfPossession {
+			if err := val.VerifyProofOfPossession(); err != nil {
+				return fmt.Errorf("invalid validator #%d: %w", idx, err)
+			}
+		}
 	}
 
 	return nil
@@ -149,7 +169,10 @@ func (voters *VoterSet) Hash() []byte {
 // application that depends on the LastCommitInfo sent in BeginBlock, which
 // includes which voters signed. For instance, Gaia incentivizes proposers
 // with a bonus for including more than +2/3 of the signatures.
-func (voters *VoterSet) VerifyCommit(chainID string, blockID BlockID, height int64, commit *Commit) error {
+//
+// blockVersion is the block protocol whose signature verification rules apply.
+func (voters *VoterSet) VerifyCommit(chainID string, blockID BlockID, height int64, commit *Commit,
+	blockVersion uint64) error {
 
 	if voters.Size() != len(commit.Signatures) {
 		return NewErrInvalidCommitSignatures(voters.Size(), len(commit.Signatures))
@@ -166,6 +189,7 @@ func (voters *VoterSet) VerifyCommit(chainID string, blockID BlockID, height int
 
 	talliedVotingPower := int64(0)
 	votingPowerNeeded := voters.TotalVotingPower() * 2 / 3 // FIXME: 🏺 arithmetic overflow
+	sigBatch := newCommitSigBatch(blockVersion)
 	blsPubKeys := make([]bls.PubKey, 0, len(commit.Signatures))
 	messages := make([][]byte, 0, len(commit.Signatures))
 	for idx, commitSig := range commit.Signatures {
@@ -180,7 +204,7 @@ func (voters *VoterSet) VerifyCommit(chainID string, blockID BlockID, height int
 		// Validate signature.
 		voteSignBytes := commit.VoteSignBytes(chainID, int32(idx))
 		err, verifiedVotingPower, unverifiedVotingPower := verifySignatureOrCollectBlsPubKeysAndGetVotingPower(
-			idx, commitSig, voter, voteSignBytes, &blsPubKeys, &messages)
+			idx, commitSig, voter, voteSignBytes, sigBatch, &blsPubKeys, &messages)
 		if err != nil {
 			return err
 		}
@@ -196,7 +220,10 @@ func (voters *VoterSet) VerifyCommit(chainID string, blockID BlockID, height int
 		// }
 	}
 
-	// Validate signature.
+	// Validate signatures.
+	if err := sigBatch.verify(); err != nil {
+		return err
+	}
 	if err := bls.VerifyAggregatedSignature(commit.AggregatedSignature, blsPubKeys, messages); err != nil {
 		return fmt.Errorf("wrong aggregated signature: %X; %s", commit.AggregatedSignature, err)
 	}
@@ -215,7 +242,7 @@ func (voters *VoterSet) VerifyCommit(chainID string, blockID BlockID, height int
 // This method is primarily used by the light client and does not check all the
 // signatures.
 func (voters *VoterSet) VerifyCommitLight(chainID string, blockID BlockID,
-	height int64, commit *Commit) error {
+	height int64, commit *Commit, blockVersion uint64) error {
 
 	if voters.Size() != len(commit.Signatures) {
 		return NewErrInvalidCommitSignatures(voters.Size(), len(commit.Signatures))
@@ -233,6 +260,7 @@ func (voters *VoterSet) VerifyCommitLight(chainID string, blockID BlockID,
 	talliedVotingPower := int64(0)
 	talliedUnverifiedVotingPower := int64(0)
 	votingPowerNeeded := voters.TotalVotingPower() * 2 / 3 // FIXME: 🏺 arithmetic overflow
+	sigBatch := newCommitSigBatch(blockVersion)
 	blsPubKeys := make([]bls.PubKey, 0, len(commit.Signatures))
 	messages := make([][]byte, 0, len(commit.Signatures))
 	for idx, commitSig := range commit.Signatures {
@@ -245,11 +273,14 @@ func (voters *VoterSet) VerifyCommitLight(chainID string, blockID BlockID,
 		// This means we don't need the voter address or to do any lookup.
 		// voter := voters.Voters[idx]
 		_, voter := voters.GetByAddress(commitSig.ValidatorAddress)
+		if voter == nil {
+			return fmt.Errorf("signature #%d is from an unknown voter %X", idx, commitSig.ValidatorAddress)
+		}
 
 		// Validate signature.
 		voteSignBytes := commit.VoteSignBytes(chainID, int32(idx))
 		err, verifiedVootingPower, unverifiedVotingPower := verifySignatureOrCollectBlsPubKeysAndGetVotingPower(
-			idx, commitSig, voter, voteSignBytes, &blsPubKeys, &messages)
+			idx, commitSig, voter, voteSignBytes, sigBatch, &blsPubKeys, &messages)
 		if err != nil {
 			return err
 		}
@@ -257,13 +288,16 @@ func (voters *VoterSet) VerifyCommitLight(chainID string, blockID BlockID,
 		talliedVotingPower += verifiedVootingPower
 		talliedUnverifiedVotingPower += unverifiedVotingPower
 
-		// return as soon as +2/3 of the signatures are verified by individual verification
-		if talliedVotingPower > votingPowerNeeded {
-			return nil
+		// return as soon as +2/3 of the signatures are verified by individual and batch verification
+		if talliedVotingPower+sigBatch.votingPower > votingPowerNeeded {
+			return sigBatch.verify()
 		}
 	}
 
 	// add voting power for BLS batch verification and return without error if +2/3 of the signatures are verified
+	if err := sigBatch.verify(); err != nil {
+		return err
+	}
 	if err := bls.VerifyAggregatedSignature(commit.AggregatedSignature, blsPubKeys, messages); err != nil {
 		return fmt.Errorf("wrong aggregated signature: %X; %s", commit.AggregatedSignature, err)
 	}
@@ -283,7 +317,8 @@ func (voters *VoterSet) VerifyCommitLight(chainID string, blockID BlockID,
 //
 // This method is primarily used by the light client and does not check all the
 // signatures.
-func (voters *VoterSet) VerifyCommitLightTrusting(chainID string, commit *Commit, trustLevel tmmath.Fraction) error {
+func (voters *VoterSet) VerifyCommitLightTrusting(chainID string, commit *Commit, trustLevel tmmath.Fraction,
+	blockVersion uint64) error {
 	// sanity check
 	if trustLevel.Denominator == 0 {
 		return errors.New("trustLevel has zero Denominator")
@@ -302,6 +337,7 @@ func (voters *VoterSet) VerifyCommitLightTrusting(chainID string, commit *Commit
 	}
 	votingPowerNeeded := totalVotingPowerMulByNumerator / int64(trustLevel.Denominator)
 
+	sigBatch := newCommitSigBatch(blockVersion)
 	blsPubKeys := make([]bls.PubKey, 0, len(commit.Signatures))
 	messages := make([][]byte, 0, len(commit.Signatures))
 	for idx, commitSig := range commit.Signatures {
@@ -325,7 +361,7 @@ func (voters *VoterSet) VerifyCommitLightTrusting(chainID string, commit *Commit
 			// Verify Signature
 			voteSignBytes := commit.VoteSignBytes(chainID, int32(idx))
 			err, verifiedVotingPower, unverifiedVotingPower := verifySignatureOrCollectBlsPubKeysAndGetVotingPower(
-				idx, commitSig, voter, voteSignBytes, &blsPubKeys, &messages)
+				idx, commitSig, voter, voteSignBytes, sigBatch, &blsPubKeys, &messages)
 			if err != nil {
 				return err
 			}
@@ -333,13 +369,16 @@ func (voters *VoterSet) VerifyCommitLightTrusting(chainID string, commit *Commit
 			talliedVotingPower += verifiedVotingPower
 			talliedUnverifiedVotingPower += unverifiedVotingPower
 
-			if talliedVotingPower > votingPowerNeeded {
-				return nil
+			if talliedVotingPower+sigBatch.votingPower > votingPowerNeeded {
+				return sigBatch.verify()
 			}
 		}
 	}
 
 	// add voting power for BLS batch verification and return without error if trust-level of the signatures are verified
+	if err := sigBatch.verify(); err != nil {
+		return err
+	}
 	if err := bls.VerifyAggregatedSignature(commit.AggregatedSignature, blsPubKeys, messages); err != nil {
 		return fmt.Errorf("wrong aggregated signature: %X; %s", commit.AggregatedSignature, err)
 	}
@@ -353,11 +392,15 @@ func (voters *VoterSet) VerifyCommitLightTrusting(chainID string, commit *Commit
 
 func verifySignatureOrCollectBlsPubKeysAndGetVotingPower(
 	idx int, commitSig CommitSig, val *Validator, voteSignBytes []byte,
-	blsPubKeys *[]bls.PubKey, messages *[][]byte) (error, int64, int64) {
+	sigBatch *commitSigBatch, blsPubKeys *[]bls.PubKey, messages *[][]byte) (error, int64, int64) {
 	verifiedVotingPower := int64(0)
 	unverifiedVotingPower := int64(0)
 	if commitSig.Signature != nil {
-		if !val.PubKey.VerifySignature(voteSignBytes, commitSig.Signature) {
+		if sigBatch.add(idx, val, voteSignBytes, commitSig.Signature) {
+			unverifiedVotingPower = val.VotingPower
+			return nil, verifiedVotingPower, unverifiedVotingPower
+		}
+		if !VerifySignature(val.PubKey, voteSignBytes, commitSig.Signature, sigBatch.blockVersion) {
 			return fmt.Errorf("wrong signature (#%d): %X", idx, commitSig.Signature), verifiedVotingPower, unverifiedVotingPower
 		}
 		verifiedVotingPower = val.VotingPower
@@ -373,6 +416,104 @@ func verifySignatureOrCollectBlsPubKeysAndGetVotingPower(
 	return nil, verifiedVotingPower, unverifiedVotingPower
 }
 
+// VerifySignature verifies the signature of a vote under the rules of the block
+// protocol blockVersion. From version.BlockProtocolZIP215 on, the ed25519
+// signatures are verified under the ZIP-215 rules, which the batch verification
+// of commits follows too, so that every path accepts the same signatures.
+func VerifySignature(pubKey crypto.PubKey, msg, sig []byte, blockVersion uint64) bool {
+	if edPubKey, ok := signKey(pubKey).(ed25519.PubKey); ok && blockVersion >= version.BlockProtocolZIP215 {
+		return edPubKey.VerifySignatureZIP215(msg, sig)
+	}
+	return pubKey.VerifySignature(msg, sig)
+}
+
+// signKey returns the key that the signatures of the public key are made with.
+func signKey(pubKey crypto.PubKey) crypto.PubKey {
+	for {
+		if compPubKey, ok := pubKey.(composite.PubKey); ok {
+			pubKey = compPubKey.SignKey
+		} else {
+			return pubKey
+		}
+	}
+}
+
+// commitSigBatch collects the commit signatures whose keys support batch
+// verification (ed25519 and sr25519), one batch per key type. Signatures of
+// other key types are left to be verified individually.
+type commitSigBatch struct {
+	blockVersion uint64
+	batches      map[string]*keyTypeBatch
+	votingPower  int64
+}
+
+type keyTypeBatch struct {
+	verifier crypto.BatchVerifier
+	indices  []int
+	sigs     [][]byte
+}
+
+func newCommitSigBatch(blockVersion uint64) *commitSigBatch {
+	return &commitSigBatch{blockVersion: blockVersion, batches: make(map[string]*keyTypeBatch)}
+}
+
+// add appends the signature to the batch for the voter's signing key type, and
+// reports whether it was added.
+func (b *commitSigBatch) add(idx int, val *Validator, msg, sig []byte) bool {
+	pubKey := signKey(val.PubKey)
+
+	// the ed25519 batch follows the ZIP-215 rules, which the earlier block protocols don't
+	if pubKey.Type() == ed25519.KeyType && b.blockVersion < version.BlockProtocolZIP215 {
+		return false
+	}
+
+	kb, ok := b.batches[pubKey.Type()]
+	if !ok {
+		verifier, ok := batch.CreateBatchVerifier(pubKey)
+		if !ok {
+			return false
+		}
+		kb = &keyTypeBatch{verifier: verifier}
+		b.batches[pubKey.Type()] = kb
+	}
+	// a malformed key or signature is left to the individual verification,
+	// which reports it
+	if err := kb.verifier.Add(pubKey, msg, sig); err != nil {
+		return false
+	}
+	kb.indices = append(kb.indices, idx)
+	kb.sigs = append(kb.sigs, sig)
+	b.votingPower += val.VotingPower
+	return true
+}
+
+// verify verifies all the collected signatures. If any of them is invalid, the
+// error points out the first invalid signature in commit order.
+func (b *commitSigBatch) verify() error {
+	badIdx := -1
+	var badSig []byte
+	for _, kb := range b.batches {
+		if len(kb.indices) == 0 {
+			continue
+		}
+		ok, valid := kb.verifier.Verify()
+		if ok {
+			continue
+		}
+		for i, v := range valid {
+			if !v && (badIdx < 0 || kb.indices[i] < badIdx) {
+				badIdx = kb.indices[i]
+				badSig = kb.sigs[i]
+			}
+		}
+	}
+	if badIdx >= 0 {
+		return fmt.Errorf("wrong signature (#%d): %X", badIdx, badSig)
+	}
+	b.batches = make(map[string]*keyTypeBatch)
+	return nil
+}
+
 // ToProto converts VoterSet to protobuf
 func (voters *VoterSet) ToProto() (*tmproto.VoterSet, error) {
 	if voters.IsNilOrEmpty() {
@@ -396,8 +537,9 @@ func (voters *VoterSet) ToProto() (*tmproto.VoterSet, error) {
 
 // VoterSetFromProto sets a protobuf VoterSet to the given pointer.
 // It returns an error if any of the validators from the set or the proposer
-// is invalid
-func VoterSetFromProto(vp *tmproto.VoterSet) (*VoterSet, error) {
+// is invalid under the rules of blockVersion, the block protocol of the block
+// the voters are of.
+func VoterSetFromProto(vp *tmproto.VoterSet, blockVersion uint64) (*VoterSet, error) {
 	if vp == nil {
 		return nil, errors.New("nil voter set") // voter set should never be nil, bigger issues are at play if empty
 	}
@@ -414,7 +556,7 @@ func VoterSetFromProto(vp *tmproto.VoterSet) (*VoterSet, error) {
 	voters.Voters = valsProto
 	voters.totalVotingPower = vp.GetTotalVotingPower()
 
-	return voters, voters.ValidateBasic()
+	return voters, voters.validateBasic(blockVersion)
 }
 
 //-----------------
@@ -483,6 +625,26 @@ func SelectVoter(validators *ValidatorSet, proofHash []byte, voterParams *VoterP
 	return WrapValidatorsToVoterSet(voters)
 }
 
+// VoterCandidates returns the validators that can be elected as voters of a block of the block protocol. From
+// version.BlockProtocolProofOfPossession, the validators with a BLS key and no proof-of-possession, which joined the
+// set before the proofs were required, are not elected until they submit one with a validator update.
+func VoterCandidates(validators *ValidatorSet, blockVersion uint64) *ValidatorSet {
+	if blockVersion < version.BlockProtocolProofOfPossession {
+		return validators
+	}
+	candidates := make([]*Validator, 0, len(validators.Validators))
+	for _, val := range validators.Validators {
+		if len(val.ProofOfPossession) == 0 && GetSignatureKey(val.PubKey) != nil {
+			continue
+		}
+		candidates = append(candidates, val)
+	}
+	if len(candidates) == len(validators.Validators) {
+		return validators
+	}
+	return &ValidatorSet{Validators: candidates}
+}
+
 func ToVoterAll(validators []*Validator) *VoterSet {
 	newVoters := make([]*Validator, 0, len(validators))
 	for _, val := range validators {
@@ -491,11 +653,12 @@ func ToVoterAll(validators []*Validator) *VoterSet {
 			continue
 		}
 		newVoters = append(newVoters, &Validator{
-			Address:          val.Address,
-			PubKey:           val.PubKey,
-			StakingPower:     val.StakingPower,
-			VotingPower:      val.StakingPower,
-			ProposerPriority: val.ProposerPriority,
+			Address:           val.Address,
+			PubKey:            val.PubKey,
+			StakingPower:      val.StakingPower,
+			VotingPower:       val.StakingPower,
+			ProposerPriority:  val.ProposerPriority,
+			ProofOfPossession: val.ProofOfPossession,
 		})
 	}
 	return WrapValidatorsToVoterSet(newVoters) // They will be sorted in this function.
@@ -521,6 +684,84 @@ func MakeRoundHash(proofHash []byte, height int64, round int32) []byte {
 	return hash.Sum(nil)
 }
 
+// ProofToHash returns the VRF output of the proof generated by the private key of the given public key.
+// The VRF suite depends on the key type. The proof is not verified.
+func ProofToHash(pubKey crypto.PubKey, proof crypto.Proof, vrfSuite string) (crypto.Output, error) {
+	switch pk := pubKey.(type) {
+	case composite.PubKey:
+		return ProofToHash(pk.VrfKey, proof, vrfSuite)
+	case secp256k1.PubKey:
+		return secp256k1.VRFProofToHash(proof)
+	case ed25519.PubKey:
+		impl, err := vrf.GetSuite(vrfSuite)
+		if err != nil {
+			return nil, err
+		}
+		output, err := impl.ProofToHash(vrf.Proof(proof))
+		return crypto.Output(output), err
+	default:
+		return nil, fmt.Errorf("VRF is not supported by the key type %s", pubKey.Type())
+	}
+}
+
+// VRFProve generates the VRF proof of the message with privKey. The ed25519 keys use the VRF suite of the given
+// name, see vrf.GetSuite.
+func VRFProve(privKey crypto.PrivKey, message []byte, vrfSuite string) (crypto.Proof, error) {
+	switch sk := privKey.(type) {
+	case composite.PrivKey:
+		return VRFProve(sk.VrfKey, message, vrfSuite)
+	case *composite.PrivKey:
+		return VRFProve(sk.VrfKey, message, vrfSuite)
+	case ed25519.PrivKey:
+		return sk.VRFProveWithSuite(vrfSuite, message)
+	default:
+		return privKey.VRFProve(message)
+	}
+}
+
+// VRFVerify verifies that the VRF proof of the message was generated by the owner of pubKey and returns its output.
+// The ed25519 keys use the VRF suite of the given name, see vrf.GetSuite.
+func VRFVerify(pubKey crypto.PubKey, proof crypto.Proof, message []byte, vrfSuite string) (crypto.Output, error) {
+	switch pk := pubKey.(type) {
+	case composite.PubKey:
+		return VRFVerify(pk.VrfKey, proof, message, vrfSuite)
+	case ed25519.PubKey:
+		return pk.VRFVerifyWithSuite(vrfSuite, proof, message)
+	default:
+		return pubKey.VRFVerify(proof, message)
+	}
+}
+
+// ProposerProofHash returns the VRF output of the proof in the header using the key of its proposer found in vals.
+func ProposerProofHash(header *Header, vals *ValidatorSet, vrfSuite string) (crypto.Output, error) {
+	_, proposer := vals.GetByAddress(header.ProposerAddress)
+	if proposer == nil {
+		return nil, fmt.Errorf("proposer %X of height %d is not in the validator set",
+			header.ProposerAddress, header.Height)
+	}
+	return ProofToHash(proposer.PubKey, header.Proof.Bytes(), vrfSuite)
+}
+
+// VerifyProposer verifies that the proposer of the header is the one elected from vals with lastProofHash, the VRF
+// output of the proof in the previous block, and that the proof in the header is a valid VRF proof of the proposer
+// under the VRF suite of the given name.
+func VerifyProposer(header *Header, vals *ValidatorSet, lastProofHash []byte, vrfSuite string) error {
+	if vals.IsNilOrEmpty() {
+		return errors.New("empty validator set")
+	}
+	proposer := vals.SelectProposer(lastProofHash, header.Height, header.Round)
+	if !bytes.Equal(header.ProposerAddress, proposer.Address) {
+		return fmt.Errorf("header.ProposerAddress, %X, is not the proposer %X", header.ProposerAddress, proposer.Address)
+	}
+	message := MakeRoundHash(lastProofHash, header.Height-1, header.Round)
+	if _, err := VRFVerify(proposer.PubKey, crypto.Proof(header.Proof), message, vrfSuite); err != nil {
+		return NewErrInvalidProof(fmt.Sprintf(
+			"verification failed: %s; proof: %v, prevProofHash: %X, height=%d, round=%d, addr: %v",
+			err.Error(), header.Proof, lastProofHash, header.Height, header.Round, header.ProposerAddress))
+	}
+	return nil
+}
+
 // RandVoterSet returns a randomized validator set, useful for testing.
 // NOTE: PrivValidator are in order.
 // UNSTABLE
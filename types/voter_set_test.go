@@ -15,13 +15,19 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/line/ostracon/crypto"
+	"github.com/line/ostracon/crypto/composite"
 	"github.com/line/ostracon/crypto/ed25519"
 	"github.com/line/ostracon/crypto/merkle"
+	"github.com/line/ostracon/crypto/secp256k1"
+	"github.com/line/ostracon/crypto/sr25519"
+	"github.com/line/ostracon/crypto/vrf"
+	tmbytes "github.com/line/ostracon/libs/bytes"
 	tmmath "github.com/line/ostracon/libs/math"
 	"github.com/line/ostracon/libs/rand"
 	tmrand "github.com/line/ostracon/libs/rand"
 	tmproto "github.com/line/ostracon/proto/ostracon/types"
 	tmtime "github.com/line/ostracon/types/time"
+	"github.com/line/ostracon/version"
 )
 
 //-------------------------------------------------------------------
@@ -83,7 +89,7 @@ func TestVoterSet_VerifyCommit_All(t *testing.T) {
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.description, func(t *testing.T) {
-			err := vset.VerifyCommit(tc.chainID, tc.blockID, tc.height, tc.commit)
+			err := vset.VerifyCommit(tc.chainID, tc.blockID, tc.height, tc.commit, version.BlockProtocol)
 			if tc.expErr {
 				if assert.Error(t, err, "VerifyCommit") {
 					assert.Contains(t, err.Error(), tc.description, "VerifyCommit")
@@ -92,7 +98,7 @@ func TestVoterSet_VerifyCommit_All(t *testing.T) {
 				assert.NoError(t, err, "VerifyCommit")
 			}
 
-			err = vset.VerifyCommitLight(tc.chainID, tc.blockID, tc.height, tc.commit)
+			err = vset.VerifyCommitLight(tc.chainID, tc.blockID, tc.height, tc.commit, version.BlockProtocol)
 			if tc.expErr {
 				if assert.Error(t, err, "VerifyCommitLight") {
 					assert.Contains(t, err.Error(), tc.description, "VerifyCommitLight")
@@ -123,12 +129,113 @@ func TestVoterSet_VerifyCommit_CheckAllSignatures(t *testing.T) {
 	vote.Signature = v.Signature
 	commit.Signatures[3] = vote.CommitSig()
 
-	err = voterSet.VerifyCommit(chainID, blockID, h, commit)
+	err = voterSet.VerifyCommit(chainID, blockID, h, commit, version.BlockProtocol)
 	if assert.Error(t, err) {
 		assert.Contains(t, err.Error(), "wrong signature (#3)")
 	}
 }
 
+func TestVoterSet_VerifyCommit_BatchVerificationPinpointsWrongSignature(t *testing.T) {
+	var (
+		chainID = "test_chain_id"
+		h       = int64(3)
+		blockID = makeBlockIDRandom()
+	)
+
+	// ed25519 and sr25519 signatures are batch verified, secp256k1 and BLS ones are not
+	privKeys := []crypto.PrivKey{
+		ed25519.GenPrivKey(),
+		ed25519.GenPrivKey(),
+		sr25519.GenPrivKey(),
+		sr25519.GenPrivKey(),
+		secp256k1.GenPrivKey(),
+		composite.GenPrivKey(),
+	}
+
+	for i := range privKeys {
+		voteSet, _, voterSet, vals := randVoteSetForPrivKeys(h, 0, tmproto.PrecommitType, privKeys, 10)
+		commit, err := MakeCommit(blockID, h, 0, voteSet, vals, time.Now())
+		require.NoError(t, err)
+		require.NoError(t, voterSet.VerifyCommit(chainID, blockID, h, commit, version.BlockProtocol))
+		require.NoError(t, commit.VerifySignatures(chainID, voterSet.Voters, version.BlockProtocol))
+
+		// malleate the signature of the voter at i
+		vote := voteSet.GetByIndex(int32(i))
+		v := vote.ToProto()
+		err = vals[i].SignVote("CentaurusA", v)
+		require.NoError(t, err)
+		vote.Signature = v.Signature
+		commit.Signatures[i] = vote.CommitSig()
+
+		expected := fmt.Sprintf("wrong signature (#%d)", i)
+		err = voterSet.VerifyCommit(chainID, blockID, h, commit, version.BlockProtocol)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), expected)
+		}
+		err = commit.VerifySignatures(chainID, voterSet.Voters, version.BlockProtocol)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), expected)
+		}
+	}
+}
+
+func TestCommitSigBatchBlockProtocol(t *testing.T) {
+	msg := []byte("egg")
+	edPrivKey := ed25519.GenPrivKey()
+	edSig, err := edPrivKey.Sign(msg)
+	require.NoError(t, err)
+	srPrivKey := sr25519.GenPrivKey()
+	srSig, err := srPrivKey.Sign(msg)
+	require.NoError(t, err)
+
+	// ed25519 signatures are verified one by one before the ZIP-215 rules apply
+	sigBatch := newCommitSigBatch(version.BlockProtocolZIP215 - 1)
+	assert.False(t, sigBatch.add(0, NewValidator(edPrivKey.PubKey(), 10), msg, edSig))
+	assert.True(t, sigBatch.add(1, NewValidator(srPrivKey.PubKey(), 10), msg, srSig))
+	assert.NoError(t, sigBatch.verify())
+
+	sigBatch = newCommitSigBatch(version.BlockProtocolZIP215)
+	assert.True(t, sigBatch.add(0, NewValidator(edPrivKey.PubKey(), 10), msg, edSig))
+	assert.True(t, sigBatch.add(1, NewValidator(srPrivKey.PubKey(), 10), msg, srSig))
+	assert.NoError(t, sigBatch.verify())
+}
+
+func TestVerifySignatureBlockProtocol(t *testing.T) {
+	// A is the identity and R a point of order 2, so the signature is only
+	// valid under the cofactored equation of ZIP-215.
+	pubKey := ed25519.PubKey(append([]byte{0x01}, make([]byte, 31)...))
+	r := append([]byte{0xec}, bytes.Repeat([]byte{0xff}, 30)...)
+	sig := append(append(r, 0x7f), make([]byte, 32)...)
+	msg := []byte("egg")
+
+	// the single verification follows the batch verification of the same block protocol
+	assert.False(t, VerifySignature(pubKey, msg, sig, version.BlockProtocolZIP215-1))
+	assert.True(t, VerifySignature(pubKey, msg, sig, version.BlockProtocolZIP215))
+
+	sigBatch := newCommitSigBatch(version.BlockProtocolZIP215)
+	require.True(t, sigBatch.add(0, NewValidator(pubKey, 10), msg, sig))
+	assert.NoError(t, sigBatch.verify())
+}
+
+func TestVoterSet_VerifyCommitLight_UnknownVoter(t *testing.T) {
+	var (
+		chainID = "test_chain_id"
+		h       = int64(3)
+		blockID = makeBlockIDRandom()
+	)
+
+	voteSet, _, voterSet, vals := randVoteSet(h, 0, tmproto.PrecommitType, 4, 10)
+	commit, err := MakeCommit(blockID, h, 0, voteSet, vals, time.Now())
+	require.NoError(t, err)
+
+	// the signature of a voter that isn't in the voter set is refused
+	commit.Signatures[0].ValidatorAddress = ed25519.GenPrivKey().PubKey().Address()
+	err = voterSet.VerifyCommitLight(chainID, blockID, h, commit, version.BlockProtocol)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "unknown voter")
+	}
+}
+
 func TestVoterSet_VerifyCommitLight_ReturnsAsSoonAsMajorityOfVotingPowerSigned(t *testing.T) {
 	var (
 		chainID = "test_chain_id"
@@ -156,7 +263,7 @@ func TestVoterSet_VerifyCommitLight_ReturnsAsSoonAsMajorityOfVotingPowerSigned(t
 	vote.Signature = v.Signature
 	commit.Signatures[3] = vote.CommitSig()
 
-	err = voterSet.VerifyCommitLight(chainID, blockID, h, commit)
+	err = voterSet.VerifyCommitLight(chainID, blockID, h, commit, version.BlockProtocol)
 	assert.NoError(t, err)
 }
 
@@ -187,7 +294,8 @@ func TestVoterSet_VerifyCommitLightTrusting_ReturnsAsSoonAsTrustLevelOfVotingPow
 	vote.Signature = v.Signature
 	commit.Signatures[2] = vote.CommitSig()
 
-	err = voterSet.VerifyCommitLightTrusting(chainID, commit, tmmath.Fraction{Numerator: 1, Denominator: 3})
+	err = voterSet.VerifyCommitLightTrusting(chainID, commit, tmmath.Fraction{Numerator: 1, Denominator: 3},
+		version.BlockProtocol)
 	assert.NoError(t, err)
 }
 
@@ -223,7 +331,7 @@ func TestVoterSet_VerifyCommitLightTrusting(t *testing.T) {
 
 	for _, tc := range testCases {
 		err = tc.voterSet.VerifyCommitLightTrusting("test_chain_id", commit,
-			tmmath.Fraction{Numerator: 1, Denominator: 3})
+			tmmath.Fraction{Numerator: 1, Denominator: 3}, version.BlockProtocol)
 		if tc.err {
 			assert.Error(t, err)
 		} else {
@@ -241,7 +349,7 @@ func TestVoterSet_VerifyCommitLightTrustingErrorsOnOverflow(t *testing.T) {
 	require.NoError(t, err)
 
 	err = voterSet.VerifyCommitLightTrusting("test_chain_id", commit,
-		tmmath.Fraction{Numerator: 25, Denominator: 55})
+		tmmath.Fraction{Numerator: 25, Denominator: 55}, version.BlockProtocol)
 	if assert.Error(t, err) {
 		assert.Contains(t, err.Error(), "int64 overflow")
 	}
@@ -421,7 +529,7 @@ func findLargestStakingPowerGap(t *testing.T, loopCount int, minMaxRate int, max
 		}
 		proposer := valSet.SelectProposer(hash, int64(i), 0)
 		message := MakeRoundHash(hash, int64(i), 0)
-		proof, _ := privMap[proposer.Address.String()].GenerateVRFProof(message)
+		proof, _ := privMap[proposer.Address.String()].GenerateVRFProof(message, "")
 		pubKey, _ := privMap[proposer.Address.String()].GetPubKey()
 		hash, _ = pubKey.VRFVerify(proof, message)
 		totalVoters += voterSet.Size()
@@ -487,7 +595,7 @@ func TestVoterSetProtoBuf(t *testing.T) {
 			require.Error(t, err, tc.msg)
 		}
 
-		vSet, err := VoterSetFromProto(protoVoterSet)
+		vSet, err := VoterSetFromProto(protoVoterSet, version.BlockProtocol)
 		if tc.expPass2 {
 			require.NoError(t, err, tc.msg)
 			require.EqualValues(t, tc.v1, vSet, tc.msg)
@@ -1277,3 +1385,118 @@ func TestMoveWinnerToLast(t *testing.T) {
 	assert.True(t, nextOfTarget == validators.Validators[3])
 
 }
+
+func TestVoterSetProofOfPossession(t *testing.T) {
+	valSet, _ := RandValidatorSet(3, 10)
+	pv := NewMockPV(PrivKeyBLS)
+	val := pv.ExtractIntoValidator(10)
+	pop := val.ProofOfPossession
+	require.NotEmpty(t, pop)
+
+	// a legacy BLS validator, which joined the set before the proofs were required
+	legacy := NewValidatorSet(append(valSet.Copy().Validators, val))
+	idx, _ := legacy.GetByAddress(val.Address)
+	legacy.Validators[idx].ProofOfPossession = nil
+
+	// it is elected under the block protocols before the proofs
+	before := version.BlockProtocolProofOfPossession - 1
+	voters := SelectVoter(VoterCandidates(legacy, before), []byte{}, DefaultVoterParams())
+	assert.True(t, voters.HasAddress(val.Address))
+	assert.Error(t, voters.ValidateBasic())
+	pb, err := voters.ToProto()
+	require.NoError(t, err)
+	_, err = VoterSetFromProto(pb, before)
+	assert.NoError(t, err)
+	_, err = VoterSetFromProto(pb, version.BlockProtocolProofOfPossession)
+	assert.Error(t, err)
+
+	// but not from the block protocol of the proofs
+	voters = SelectVoter(VoterCandidates(legacy, version.BlockProtocolProofOfPossession), []byte{},
+		DefaultVoterParams())
+	assert.False(t, voters.HasAddress(val.Address))
+	assert.Equal(t, valSet.Size(), voters.Size())
+	assert.NoError(t, voters.ValidateBasic())
+
+	// until it submits the proof-of-possession with a validator update
+	update := NewValidator(val.PubKey, 10)
+	update.ProofOfPossession = pop
+	require.NoError(t, legacy.UpdateWithChangeSet([]*Validator{update}))
+	voters = SelectVoter(VoterCandidates(legacy, version.BlockProtocolProofOfPossession), []byte{},
+		DefaultVoterParams())
+	assert.True(t, voters.HasAddress(val.Address))
+	assert.NoError(t, voters.ValidateBasic())
+
+	// an invalid proof-of-possession is rejected
+	idx, _ = voters.GetByAddress(val.Address)
+	voters.Voters[idx].ProofOfPossession, _ = ProofOfPossession(NewMockPV(PrivKeyBLS).PrivKey)
+	assert.Error(t, voters.ValidateBasic())
+}
+
+func TestVerifyProposer(t *testing.T) {
+	vals, _, privVals := RandVoterSet(5, 10)
+	lastProofHash := []byte("last proof hash")
+	proposer := vals.SelectProposer(lastProofHash, 10, 1)
+	var proposerPV, otherPV PrivValidator
+	for _, pv := range privVals {
+		pubKey, err := pv.GetPubKey()
+		require.NoError(t, err)
+		if bytes.Equal(pubKey.Address(), proposer.Address) {
+			proposerPV = pv
+		} else {
+			otherPV = pv
+		}
+	}
+	message := MakeRoundHash(lastProofHash, 9, 1)
+	proof, err := proposerPV.GenerateVRFProof(message, "")
+	require.NoError(t, err)
+	header := &Header{Height: 10, Round: 1, ProposerAddress: proposer.Address, Proof: tmbytes.HexBytes(proof)}
+	assert.NoError(t, VerifyProposer(header, vals, lastProofHash, ""))
+
+	// not elected with the other proof hash
+	assert.Error(t, VerifyProposer(header, vals, []byte("other proof hash"), ""))
+
+	// the proof is not of the VRF suite of the chain
+	err = VerifyProposer(header, vals, lastProofHash, vrf.SuiteEdwards25519SHA512ELL2)
+	assert.IsType(t, ErrInvalidProof{}, err)
+	proof2, err := proposerPV.GenerateVRFProof(message, vrf.SuiteEdwards25519SHA512ELL2)
+	require.NoError(t, err)
+	header2 := &Header{Height: 10, Round: 1, ProposerAddress: proposer.Address, Proof: tmbytes.HexBytes(proof2)}
+	assert.NoError(t, VerifyProposer(header2, vals, lastProofHash, vrf.SuiteEdwards25519SHA512ELL2))
+
+	// not the elected proposer
+	otherPubKey, err := otherPV.GetPubKey()
+	require.NoError(t, err)
+	otherProof, err := otherPV.GenerateVRFProof(message, "")
+	require.NoError(t, err)
+	other := &Header{Height: 10, Round: 1, ProposerAddress: otherPubKey.Address(), Proof: tmbytes.HexBytes(otherProof)}
+	err = VerifyProposer(other, vals, lastProofHash, "")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not the proposer")
+
+	// proof for another round
+	proof, err = proposerPV.GenerateVRFProof(MakeRoundHash(lastProofHash, 9, 0), "")
+	require.NoError(t, err)
+	header.Proof = tmbytes.HexBytes(proof)
+	err = VerifyProposer(header, vals, lastProofHash, "")
+	assert.Error(t, err)
+	assert.IsType(t, ErrInvalidProof{}, err)
+}
+
+func TestProposerProofHash(t *testing.T) {
+	vals, _, privVals := RandVoterSet(1, 10)
+	message := MakeRoundHash([]byte("last proof hash"), 9, 0)
+	proof, err := privVals[0].GenerateVRFProof(message, "")
+	require.NoError(t, err)
+	header := &Header{Height: 10, ProposerAddress: vals.Validators[0].Address, Proof: []byte(proof)}
+
+	output, err := ProposerProofHash(header, vals, "")
+	require.NoError(t, err)
+	expected, err := ProofToHash(vals.Validators[0].PubKey, proof, "")
+	require.NoError(t, err)
+	assert.Equal(t, expected, output)
+
+	// the proposer is not in the validator set
+	otherVals, _, _ := RandVoterSet(1, 10)
+	_, err = ProposerProofHash(header, otherVals, "")
+	assert.Error(t, err)
+}
@@ -31,9 +31,41 @@ const (
 var (
 	// P2PProtocol versions all p2p behaviour and msgs.
 	// This includes proposer selection.
-	P2PProtocol uint64 = 8
+	P2PProtocol uint64 = 9
+
+	// P2PProtocolAggregatedVote is the p2p protocol from which peers accept the
+	// aggregated votes of the consensus reactor.
+	P2PProtocolAggregatedVote uint64 = 9
 
 	// BlockProtocol versions all block data structures and processing.
 	// This includes validity of blocks and state updates.
-	BlockProtocol uint64 = 11
+	BlockProtocol uint64 = 12
+
+	// MinBlockProtocol is the oldest block protocol the software still supports,
+	// so that the blocks made before an upgrade to BlockProtocol can be processed.
+	MinBlockProtocol uint64 = 11
+
+	// BlockProtocolZIP215 is the block protocol from which the ed25519 commit
+	// signatures are batch verified under the ZIP-215 rules, which accept a few
+	// more signatures than the single verification does. The commits of earlier
+	// block protocols are verified one signature at a time.
+	BlockProtocolZIP215 uint64 = 12
+
+	// BlockProtocolExtendedParamsHash is the block protocol from which the
+	// ConsensusHash of the header also covers the voter params, the proposer based
+	// timestamp and the parity of the block parts. The ConsensusHash of earlier
+	// block protocols covers the block params only.
+	BlockProtocolExtendedParamsHash uint64 = 12
+
+	// BlockProtocolProofOfPossession is the block protocol from which the BLS
+	// validators are only elected as voters with a proof-of-possession. The
+	// voters of earlier block protocols may have BLS keys without it, which
+	// joined the validator set before the proofs were required.
+	BlockProtocolProofOfPossession uint64 = 12
 )
+
+// IsBlockProtocolSupported returns true if the software can process the blocks
+// of the block protocol.
+func IsBlockProtocolSupported(blockProtocol uint64) bool {
+	return MinBlockProtocol <= blockProtocol && blockProtocol <= BlockProtocol
+}